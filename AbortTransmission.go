@@ -0,0 +1,90 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	AbortTransmissionPresentNothing uint64 = iota
+	AbortTransmissionPresentDeactivateSRSResourceSetID
+	AbortTransmissionPresentReleaseALL
+	AbortTransmissionPresentChoiceExtension
+)
+
+type AbortTransmission struct {
+	Choice                     uint64
+	DeactivateSRSResourceSetID *SRSResourceSetID
+	ChoiceExtension            *RawIE
+}
+
+func (ie *AbortTransmission) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *AbortTransmission) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case AbortTransmissionPresentDeactivateSRSResourceSetID:
+		if err = ie.DeactivateSRSResourceSetID.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode DeactivateSRSResourceSetID", err)
+			return
+		}
+	case AbortTransmissionPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *AbortTransmission) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *AbortTransmission) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case AbortTransmissionPresentDeactivateSRSResourceSetID:
+		tmp := new(SRSResourceSetID)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("DeactivateSRSResourceSetID", err)
+			return
+		}
+		ie.DeactivateSRSResourceSetID = tmp
+	case AbortTransmissionPresentReleaseALL:
+	case AbortTransmissionPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *AbortTransmission) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case AbortTransmissionPresentDeactivateSRSResourceSetID:
+		if ie.DeactivateSRSResourceSetID == nil {
+			v.absent("DeactivateSRSResourceSetID")
+		} else {
+			v.ie("DeactivateSRSResourceSetID", ie.DeactivateSRSResourceSetID)
+		}
+	case AbortTransmissionPresentReleaseALL:
+	case AbortTransmissionPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
@@ -0,0 +1,309 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type AccessPointPosition struct {
+	LatitudeSign           AccessPointPositionLatitudeSign        `mandatory`
+	Latitude               int64                                  `lb:0,ub:8388607,mandatory`
+	Longitude              int64                                  `lb:-8388608,ub:8388607,mandatory`
+	DirectionOfAltitude    AccessPointPositionDirectionOfAltitude `mandatory`
+	Altitude               int64                                  `lb:0,ub:32767,mandatory`
+	UncertaintySemiMajor   int64                                  `lb:0,ub:127,mandatory`
+	UncertaintySemiMinor   int64                                  `lb:0,ub:127,mandatory`
+	OrientationOfMajorAxis int64                                  `lb:0,ub:179,mandatory`
+	UncertaintyAltitude    int64                                  `lb:0,ub:127,mandatory`
+	Confidence             int64                                  `lb:0,ub:100,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[AccessPointPosition] `optional,extension`
+}
+
+func (ie *AccessPointPosition) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *AccessPointPosition) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.LatitudeSign.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode LatitudeSign", err)
+		return
+	}
+	tmp_Latitude := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 8388607},
+		ext:   false,
+		Value: aper.Integer(ie.Latitude),
+	}
+	if err = tmp_Latitude.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Latitude", err)
+		return
+	}
+	tmp_Longitude := INTEGER{
+		c:     aper.Constraint{Lb: -8388608, Ub: 8388607},
+		ext:   false,
+		Value: aper.Integer(ie.Longitude),
+	}
+	if err = tmp_Longitude.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Longitude", err)
+		return
+	}
+	if err = ie.DirectionOfAltitude.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode DirectionOfAltitude", err)
+		return
+	}
+	tmp_Altitude := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 32767},
+		ext:   false,
+		Value: aper.Integer(ie.Altitude),
+	}
+	if err = tmp_Altitude.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Altitude", err)
+		return
+	}
+	tmp_UncertaintySemiMajor := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 127},
+		ext:   false,
+		Value: aper.Integer(ie.UncertaintySemiMajor),
+	}
+	if err = tmp_UncertaintySemiMajor.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode UncertaintySemiMajor", err)
+		return
+	}
+	tmp_UncertaintySemiMinor := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 127},
+		ext:   false,
+		Value: aper.Integer(ie.UncertaintySemiMinor),
+	}
+	if err = tmp_UncertaintySemiMinor.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode UncertaintySemiMinor", err)
+		return
+	}
+	tmp_OrientationOfMajorAxis := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 179},
+		ext:   false,
+		Value: aper.Integer(ie.OrientationOfMajorAxis),
+	}
+	if err = tmp_OrientationOfMajorAxis.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode OrientationOfMajorAxis", err)
+		return
+	}
+	tmp_UncertaintyAltitude := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 127},
+		ext:   false,
+		Value: aper.Integer(ie.UncertaintyAltitude),
+	}
+	if err = tmp_UncertaintyAltitude.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode UncertaintyAltitude", err)
+		return
+	}
+	tmp_Confidence := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 100},
+		ext:   false,
+		Value: aper.Integer(ie.Confidence),
+	}
+	if err = tmp_Confidence.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Confidence", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *AccessPointPosition) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *AccessPointPosition) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.LatitudeSign.Decode(r.AperReader); err != nil {
+		err = readError("LatitudeSign", err)
+		return
+	}
+	tmp_Latitude := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 8388607},
+		ext: false,
+	}
+	if err = tmp_Latitude.Decode(r.AperReader); err != nil {
+		err = readError("Latitude", err)
+		return
+	}
+	ie.Latitude = int64(tmp_Latitude.Value)
+	tmp_Longitude := INTEGER{
+		c:   aper.Constraint{Lb: -8388608, Ub: 8388607},
+		ext: false,
+	}
+	if err = tmp_Longitude.Decode(r.AperReader); err != nil {
+		err = readError("Longitude", err)
+		return
+	}
+	ie.Longitude = int64(tmp_Longitude.Value)
+	if err = ie.DirectionOfAltitude.Decode(r.AperReader); err != nil {
+		err = readError("DirectionOfAltitude", err)
+		return
+	}
+	tmp_Altitude := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 32767},
+		ext: false,
+	}
+	if err = tmp_Altitude.Decode(r.AperReader); err != nil {
+		err = readError("Altitude", err)
+		return
+	}
+	ie.Altitude = int64(tmp_Altitude.Value)
+	tmp_UncertaintySemiMajor := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 127},
+		ext: false,
+	}
+	if err = tmp_UncertaintySemiMajor.Decode(r.AperReader); err != nil {
+		err = readError("UncertaintySemiMajor", err)
+		return
+	}
+	ie.UncertaintySemiMajor = int64(tmp_UncertaintySemiMajor.Value)
+	tmp_UncertaintySemiMinor := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 127},
+		ext: false,
+	}
+	if err = tmp_UncertaintySemiMinor.Decode(r.AperReader); err != nil {
+		err = readError("UncertaintySemiMinor", err)
+		return
+	}
+	ie.UncertaintySemiMinor = int64(tmp_UncertaintySemiMinor.Value)
+	tmp_OrientationOfMajorAxis := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 179},
+		ext: false,
+	}
+	if err = tmp_OrientationOfMajorAxis.Decode(r.AperReader); err != nil {
+		err = readError("OrientationOfMajorAxis", err)
+		return
+	}
+	ie.OrientationOfMajorAxis = int64(tmp_OrientationOfMajorAxis.Value)
+	tmp_UncertaintyAltitude := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 127},
+		ext: false,
+	}
+	if err = tmp_UncertaintyAltitude.Decode(r.AperReader); err != nil {
+		err = readError("UncertaintyAltitude", err)
+		return
+	}
+	ie.UncertaintyAltitude = int64(tmp_UncertaintyAltitude.Value)
+	tmp_Confidence := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 100},
+		ext: false,
+	}
+	if err = tmp_Confidence.Decode(r.AperReader); err != nil {
+		err = readError("Confidence", err)
+		return
+	}
+	ie.Confidence = int64(tmp_Confidence.Value)
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *AccessPointPosition) Validate() error {
+	var v validator
+	v.ie("LatitudeSign", &ie.LatitudeSign)
+	v.integer("Latitude", ie.Latitude, 0, 8388607)
+	v.integer("Longitude", ie.Longitude, -8388608, 8388607)
+	v.ie("DirectionOfAltitude", &ie.DirectionOfAltitude)
+	v.integer("Altitude", ie.Altitude, 0, 32767)
+	v.integer("UncertaintySemiMajor", ie.UncertaintySemiMajor, 0, 127)
+	v.integer("UncertaintySemiMinor", ie.UncertaintySemiMinor, 0, 127)
+	v.integer("OrientationOfMajorAxis", ie.OrientationOfMajorAxis, 0, 179)
+	v.integer("UncertaintyAltitude", ie.UncertaintyAltitude, 0, 127)
+	v.integer("Confidence", ie.Confidence, 0, 100)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
+
+const (
+	AccessPointPositionLatitudeSignNorth aper.Enumerated = 0
+	AccessPointPositionLatitudeSignSouth aper.Enumerated = 1
+)
+
+type AccessPointPositionLatitudeSign struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:1"`
+}
+
+func (ie *AccessPointPositionLatitudeSign) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 1}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *AccessPointPositionLatitudeSign) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 1}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *AccessPointPositionLatitudeSign) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 2)
+	return v.err()
+}
+
+const (
+	AccessPointPositionDirectionOfAltitudeHeight aper.Enumerated = 0
+	AccessPointPositionDirectionOfAltitudeDepth  aper.Enumerated = 1
+)
+
+type AccessPointPositionDirectionOfAltitude struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:1"`
+}
+
+func (ie *AccessPointPositionDirectionOfAltitude) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 1}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *AccessPointPositionDirectionOfAltitude) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 1}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *AccessPointPositionDirectionOfAltitude) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 2)
+	return v.err()
+}
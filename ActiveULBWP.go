@@ -0,0 +1,252 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type ActiveULBWP struct {
+	LocationAndBandwidth    int64                        `lb:0,ub:37949,valueExt,mandatory`
+	SubcarrierSpacing       ActiveULBWPSubcarrierSpacing `mandatory`
+	CyclicPrefix            ActiveULBWPCyclicPrefix      `mandatory`
+	TxDirectCurrentLocation int64                        `lb:0,ub:3301,valueExt,mandatory`
+	Shift7dot5kHz           *ActiveULBWPShift7dot5kHz    `optional`
+	SRSConfig               SRSConfig                    `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[ActiveULBWP] `optional,extension`
+}
+
+func (ie *ActiveULBWP) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ActiveULBWP) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.Shift7dot5kHz != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	tmp_LocationAndBandwidth := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 37949},
+		ext:   true,
+		Value: aper.Integer(ie.LocationAndBandwidth),
+	}
+	if err = tmp_LocationAndBandwidth.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode LocationAndBandwidth", err)
+		return
+	}
+	if err = ie.SubcarrierSpacing.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SubcarrierSpacing", err)
+		return
+	}
+	if err = ie.CyclicPrefix.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode CyclicPrefix", err)
+		return
+	}
+	tmp_TxDirectCurrentLocation := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 3301},
+		ext:   true,
+		Value: aper.Integer(ie.TxDirectCurrentLocation),
+	}
+	if err = tmp_TxDirectCurrentLocation.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode TxDirectCurrentLocation", err)
+		return
+	}
+	if ie.Shift7dot5kHz != nil {
+		if err = ie.Shift7dot5kHz.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode Shift7dot5kHz", err)
+			return
+		}
+	}
+	if err = ie.SRSConfig.encode(w); err != nil {
+		err = utils.WrapError("Encode SRSConfig", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ActiveULBWP) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ActiveULBWP) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	tmp_LocationAndBandwidth := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 37949},
+		ext: true,
+	}
+	if err = tmp_LocationAndBandwidth.Decode(r.AperReader); err != nil {
+		err = readError("LocationAndBandwidth", err)
+		return
+	}
+	ie.LocationAndBandwidth = int64(tmp_LocationAndBandwidth.Value)
+	if err = ie.SubcarrierSpacing.Decode(r.AperReader); err != nil {
+		err = readError("SubcarrierSpacing", err)
+		return
+	}
+	if err = ie.CyclicPrefix.Decode(r.AperReader); err != nil {
+		err = readError("CyclicPrefix", err)
+		return
+	}
+	tmp_TxDirectCurrentLocation := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 3301},
+		ext: true,
+	}
+	if err = tmp_TxDirectCurrentLocation.Decode(r.AperReader); err != nil {
+		err = readError("TxDirectCurrentLocation", err)
+		return
+	}
+	ie.TxDirectCurrentLocation = int64(tmp_TxDirectCurrentLocation.Value)
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(ActiveULBWPShift7dot5kHz)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("Shift7dot5kHz", err)
+			return
+		}
+		ie.Shift7dot5kHz = tmp
+	}
+	if err = ie.SRSConfig.decode(r); err != nil {
+		err = readError("SRSConfig", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *ActiveULBWP) Validate() error {
+	var v validator
+	v.integer("LocationAndBandwidth", ie.LocationAndBandwidth, 0, 37949)
+	v.ie("SubcarrierSpacing", &ie.SubcarrierSpacing)
+	v.ie("CyclicPrefix", &ie.CyclicPrefix)
+	v.integer("TxDirectCurrentLocation", ie.TxDirectCurrentLocation, 0, 3301)
+	if ie.Shift7dot5kHz != nil {
+		v.ie("Shift7dot5kHz", ie.Shift7dot5kHz)
+	}
+	v.ie("SRSConfig", &ie.SRSConfig)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
+
+const (
+	ActiveULBWPSubcarrierSpacingKHz15  aper.Enumerated = 0
+	ActiveULBWPSubcarrierSpacingKHz30  aper.Enumerated = 1
+	ActiveULBWPSubcarrierSpacingKHz60  aper.Enumerated = 2
+	ActiveULBWPSubcarrierSpacingKHz120 aper.Enumerated = 3
+)
+
+type ActiveULBWPSubcarrierSpacing struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:3,valueExt"`
+}
+
+func (ie *ActiveULBWPSubcarrierSpacing) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *ActiveULBWPSubcarrierSpacing) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *ActiveULBWPSubcarrierSpacing) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 4)
+	return v.err()
+}
+
+const (
+	ActiveULBWPCyclicPrefixNormal   aper.Enumerated = 0
+	ActiveULBWPCyclicPrefixExtended aper.Enumerated = 1
+)
+
+type ActiveULBWPCyclicPrefix struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:1"`
+}
+
+func (ie *ActiveULBWPCyclicPrefix) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 1}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *ActiveULBWPCyclicPrefix) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 1}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *ActiveULBWPCyclicPrefix) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 2)
+	return v.err()
+}
+
+const (
+	ActiveULBWPShift7dot5kHzTrue aper.Enumerated = 0
+)
+
+type ActiveULBWPShift7dot5kHz struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:0,valueExt"`
+}
+
+func (ie *ActiveULBWPShift7dot5kHz) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 0}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *ActiveULBWPShift7dot5kHz) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 0}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *ActiveULBWPShift7dot5kHz) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 1)
+	return v.err()
+}
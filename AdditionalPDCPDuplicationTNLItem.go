@@ -0,0 +1,108 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type AdditionalPDCPDuplicationTNLItem struct {
+	AdditionalPDCPDuplicationUPTNLInformation UPTransportLayerInformation `mandatory`
+	// IEExtensions
+	BHInfo     *BHInfo                                                      `optional,ignore,extension`
+	Extensions ProtocolExtensionContainer[AdditionalPDCPDuplicationTNLItem] `optional,extension`
+}
+
+func (ie *AdditionalPDCPDuplicationTNLItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *AdditionalPDCPDuplicationTNLItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(ie.extensions())
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.AdditionalPDCPDuplicationUPTNLInformation.encode(w); err != nil {
+		err = utils.WrapError("Encode AdditionalPDCPDuplicationUPTNLInformation", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *AdditionalPDCPDuplicationTNLItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *AdditionalPDCPDuplicationTNLItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.AdditionalPDCPDuplicationUPTNLInformation.decode(r); err != nil {
+		err = readError("AdditionalPDCPDuplicationUPTNLInformation", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, ie.decodeExtension); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *AdditionalPDCPDuplicationTNLItem) extensions() (exts []F1apMessageIE) {
+	if ie.BHInfo != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_BHInfo},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.BHInfo,
+		})
+	}
+	return
+}
+
+func (ie *AdditionalPDCPDuplicationTNLItem) decodeExtension(id aper.Integer, r *decoder) (ok bool, err error) {
+	ok = true
+	switch id {
+	case ProtocolIEID_BHInfo:
+		tmp := new(BHInfo)
+		if err = tmp.decode(r); err != nil {
+			err = readError("BHInfo", err)
+			return
+		}
+		ie.BHInfo = tmp
+	default:
+		ok = false
+	}
+	return
+}
+
+func (ie *AdditionalPDCPDuplicationTNLItem) Validate() error {
+	var v validator
+	v.ie("AdditionalPDCPDuplicationUPTNLInformation", &ie.AdditionalPDCPDuplicationUPTNLInformation)
+	if ie.BHInfo != nil {
+		v.ie("BHInfo", ie.BHInfo)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,97 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type AllocationAndRetentionPriority struct {
+	PriorityLevel           PriorityLevel           `mandatory`
+	PreEmptionCapability    PreEmptionCapability    `mandatory`
+	PreEmptionVulnerability PreEmptionVulnerability `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[AllocationAndRetentionPriority] `optional,extension`
+}
+
+func (ie *AllocationAndRetentionPriority) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *AllocationAndRetentionPriority) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.PriorityLevel.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PriorityLevel", err)
+		return
+	}
+	if err = ie.PreEmptionCapability.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PreEmptionCapability", err)
+		return
+	}
+	if err = ie.PreEmptionVulnerability.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PreEmptionVulnerability", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *AllocationAndRetentionPriority) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *AllocationAndRetentionPriority) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.PriorityLevel.Decode(r.AperReader); err != nil {
+		err = readError("PriorityLevel", err)
+		return
+	}
+	if err = ie.PreEmptionCapability.Decode(r.AperReader); err != nil {
+		err = readError("PreEmptionCapability", err)
+		return
+	}
+	if err = ie.PreEmptionVulnerability.Decode(r.AperReader); err != nil {
+		err = readError("PreEmptionVulnerability", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *AllocationAndRetentionPriority) Validate() error {
+	var v validator
+	v.ie("PriorityLevel", &ie.PriorityLevel)
+	v.ie("PreEmptionCapability", &ie.PreEmptionCapability)
+	v.ie("PreEmptionVulnerability", &ie.PreEmptionVulnerability)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,161 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type AlternativeQoSParaSetItem struct {
+	AlternativeQoSParaSetIndex QoSParaSetIndex    `mandatory`
+	GuaranteedFlowBitRateDL    *BitRate           `optional`
+	GuaranteedFlowBitRateUL    *BitRate           `optional`
+	PacketDelayBudget          *PacketDelayBudget `optional`
+	PacketErrorRate            *PacketErrorRate   `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[AlternativeQoSParaSetItem] `optional,extension`
+}
+
+func (ie *AlternativeQoSParaSetItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *AlternativeQoSParaSetItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.GuaranteedFlowBitRateDL != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if ie.GuaranteedFlowBitRateUL != nil {
+		aper.SetBit(optionals, 2)
+	}
+	if ie.PacketDelayBudget != nil {
+		aper.SetBit(optionals, 3)
+	}
+	if ie.PacketErrorRate != nil {
+		aper.SetBit(optionals, 4)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 5)
+	}
+	if err = w.WriteBits(optionals, 5); err != nil {
+		return
+	}
+	if err = ie.AlternativeQoSParaSetIndex.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode AlternativeQoSParaSetIndex", err)
+		return
+	}
+	if ie.GuaranteedFlowBitRateDL != nil {
+		if err = ie.GuaranteedFlowBitRateDL.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode GuaranteedFlowBitRateDL", err)
+			return
+		}
+	}
+	if ie.GuaranteedFlowBitRateUL != nil {
+		if err = ie.GuaranteedFlowBitRateUL.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode GuaranteedFlowBitRateUL", err)
+			return
+		}
+	}
+	if ie.PacketDelayBudget != nil {
+		if err = ie.PacketDelayBudget.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode PacketDelayBudget", err)
+			return
+		}
+	}
+	if ie.PacketErrorRate != nil {
+		if err = ie.PacketErrorRate.encode(w); err != nil {
+			err = utils.WrapError("Encode PacketErrorRate", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *AlternativeQoSParaSetItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *AlternativeQoSParaSetItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(5); err != nil {
+		return
+	}
+	if err = ie.AlternativeQoSParaSetIndex.Decode(r.AperReader); err != nil {
+		err = readError("AlternativeQoSParaSetIndex", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(BitRate)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("GuaranteedFlowBitRateDL", err)
+			return
+		}
+		ie.GuaranteedFlowBitRateDL = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		tmp := new(BitRate)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("GuaranteedFlowBitRateUL", err)
+			return
+		}
+		ie.GuaranteedFlowBitRateUL = tmp
+	}
+	if aper.IsBitSet(optionals, 3) {
+		tmp := new(PacketDelayBudget)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("PacketDelayBudget", err)
+			return
+		}
+		ie.PacketDelayBudget = tmp
+	}
+	if aper.IsBitSet(optionals, 4) {
+		tmp := new(PacketErrorRate)
+		if err = tmp.decode(r); err != nil {
+			err = readError("PacketErrorRate", err)
+			return
+		}
+		ie.PacketErrorRate = tmp
+	}
+	if aper.IsBitSet(optionals, 5) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *AlternativeQoSParaSetItem) Validate() error {
+	var v validator
+	v.ie("AlternativeQoSParaSetIndex", &ie.AlternativeQoSParaSetIndex)
+	if ie.GuaranteedFlowBitRateDL != nil {
+		v.ie("GuaranteedFlowBitRateDL", ie.GuaranteedFlowBitRateDL)
+	}
+	if ie.GuaranteedFlowBitRateUL != nil {
+		v.ie("GuaranteedFlowBitRateUL", ie.GuaranteedFlowBitRateUL)
+	}
+	if ie.PacketDelayBudget != nil {
+		v.ie("PacketDelayBudget", ie.PacketDelayBudget)
+	}
+	if ie.PacketErrorRate != nil {
+		v.ie("PacketErrorRate", ie.PacketErrorRate)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,128 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type AperiodicSRS struct {
+	Aperiodic          AperiodicSRSAperiodic `mandatory`
+	SRSResourceTrigger *SRSResourceTrigger   `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[AperiodicSRS] `optional,extension`
+}
+
+func (ie *AperiodicSRS) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *AperiodicSRS) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.SRSResourceTrigger != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	if err = ie.Aperiodic.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Aperiodic", err)
+		return
+	}
+	if ie.SRSResourceTrigger != nil {
+		if err = ie.SRSResourceTrigger.encode(w); err != nil {
+			err = utils.WrapError("Encode SRSResourceTrigger", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *AperiodicSRS) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *AperiodicSRS) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	if err = ie.Aperiodic.Decode(r.AperReader); err != nil {
+		err = readError("Aperiodic", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(SRSResourceTrigger)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SRSResourceTrigger", err)
+			return
+		}
+		ie.SRSResourceTrigger = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *AperiodicSRS) Validate() error {
+	var v validator
+	v.ie("Aperiodic", &ie.Aperiodic)
+	if ie.SRSResourceTrigger != nil {
+		v.ie("SRSResourceTrigger", ie.SRSResourceTrigger)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
+
+const (
+	AperiodicSRSAperiodicTrue aper.Enumerated = 0
+)
+
+type AperiodicSRSAperiodic struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:0,valueExt"`
+}
+
+func (ie *AperiodicSRSAperiodic) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 0}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *AperiodicSRSAperiodic) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 0}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *AperiodicSRSAperiodic) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 1)
+	return v.err()
+}
@@ -0,0 +1,35 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+const (
+	AreaScopeTrue aper.Enumerated = 0
+)
+
+type AreaScope struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:0,valueExt"`
+}
+
+func (ie *AreaScope) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 0}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *AreaScope) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 0}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *AreaScope) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 1)
+	return v.err()
+}
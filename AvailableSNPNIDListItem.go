@@ -0,0 +1,102 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type AvailableSNPNIDListItem struct {
+	PLMNIdentity     PLMNIdentity           `mandatory`
+	AvailableNIDList []BroadcastNIDListItem `lb:1,ub:maxnoofNIDsupported,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[AvailableSNPNIDListItem] `optional,extension`
+}
+
+func (ie *AvailableSNPNIDListItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *AvailableSNPNIDListItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.PLMNIdentity.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PLMNIdentity", err)
+		return
+	}
+	tmp_AvailableNIDList := sequenceOf[*BroadcastNIDListItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofNIDsupported},
+		ext: false,
+	}
+	for k := range ie.AvailableNIDList {
+		tmp_AvailableNIDList.Value = append(tmp_AvailableNIDList.Value, &ie.AvailableNIDList[k])
+	}
+	if err = tmp_AvailableNIDList.encode(w); err != nil {
+		err = utils.WrapError("Encode AvailableNIDList", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *AvailableSNPNIDListItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *AvailableSNPNIDListItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.PLMNIdentity.Decode(r.AperReader); err != nil {
+		err = readError("PLMNIdentity", err)
+		return
+	}
+	var tmp_AvailableNIDList []*BroadcastNIDListItem
+	if tmp_AvailableNIDList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofNIDsupported}, false, func() *BroadcastNIDListItem { return new(BroadcastNIDListItem) }); err != nil {
+		err = readError("AvailableNIDList", err)
+		return
+	}
+	ie.AvailableNIDList = make([]BroadcastNIDListItem, len(tmp_AvailableNIDList))
+	for k, i := range tmp_AvailableNIDList {
+		ie.AvailableNIDList[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *AvailableSNPNIDListItem) Validate() error {
+	var v validator
+	v.size("PLMNIdentity", len(ie.PLMNIdentity.Value), 3, 3)
+	v.size("AvailableNIDList", len(ie.AvailableNIDList), 1, maxnoofNIDsupported)
+	for i := range ie.AvailableNIDList {
+		v.item("AvailableNIDList", i, &ie.AvailableNIDList[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
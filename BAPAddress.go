@@ -0,0 +1,35 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type BAPAddress struct {
+	Value aper.BitString `aper:"sizeLB:10,sizeUB:10"`
+}
+
+func (ie *BAPAddress) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteBitString(ie.Value.Bytes, uint(ie.Value.NumBits), &aper.Constraint{Lb: 10, Ub: 10}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *BAPAddress) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *BAPAddress) decode(r *decoder) error {
+	if v, n, err := readBitString(r, &aper.Constraint{Lb: 10, Ub: 10}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.BitString{Bytes: v, NumBits: uint64(n)}
+	}
+	return nil
+}
+
+func (ie *BAPAddress) Validate() error {
+	var v validator
+	v.size("Value", int(ie.Value.NumBits), 10, 10)
+	return v.err()
+}
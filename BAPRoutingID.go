@@ -0,0 +1,87 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type BAPRoutingID struct {
+	BAPAddress BAPAddress `mandatory`
+	BAPPathID  BAPPathID  `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[BAPRoutingID] `optional,extension`
+}
+
+func (ie *BAPRoutingID) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *BAPRoutingID) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.BAPAddress.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode BAPAddress", err)
+		return
+	}
+	if err = ie.BAPPathID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode BAPPathID", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *BAPRoutingID) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *BAPRoutingID) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.BAPAddress.decode(r); err != nil {
+		err = readError("BAPAddress", err)
+		return
+	}
+	if err = ie.BAPPathID.decode(r); err != nil {
+		err = readError("BAPPathID", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *BAPRoutingID) Validate() error {
+	var v validator
+	v.ie("BAPAddress", &ie.BAPAddress)
+	v.ie("BAPPathID", &ie.BAPPathID)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,135 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type BAPlayerBHRLCchannelMappingInfo struct {
+	BAPlayerBHRLCchannelMappingInfoToAdd    []BAPlayerBHRLCchannelMappingInfoItem `lb:1,ub:maxnoofMappingEntries,optional`
+	BAPlayerBHRLCchannelMappingInfoToRemove []MappingInformationIndex             `lb:1,ub:maxnoofMappingEntries,optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[BAPlayerBHRLCchannelMappingInfo] `optional,extension`
+}
+
+func (ie *BAPlayerBHRLCchannelMappingInfo) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *BAPlayerBHRLCchannelMappingInfo) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(ie.BAPlayerBHRLCchannelMappingInfoToAdd) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if len(ie.BAPlayerBHRLCchannelMappingInfoToRemove) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 3)
+	}
+	if err = w.WriteBits(optionals, 3); err != nil {
+		return
+	}
+	if len(ie.BAPlayerBHRLCchannelMappingInfoToAdd) > 0 {
+		tmp_BAPlayerBHRLCchannelMappingInfoToAdd := sequenceOf[*BAPlayerBHRLCchannelMappingInfoItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofMappingEntries},
+			ext: false,
+		}
+		for k := range ie.BAPlayerBHRLCchannelMappingInfoToAdd {
+			tmp_BAPlayerBHRLCchannelMappingInfoToAdd.Value = append(tmp_BAPlayerBHRLCchannelMappingInfoToAdd.Value, &ie.BAPlayerBHRLCchannelMappingInfoToAdd[k])
+		}
+		if err = tmp_BAPlayerBHRLCchannelMappingInfoToAdd.encode(w); err != nil {
+			err = utils.WrapError("Encode BAPlayerBHRLCchannelMappingInfoToAdd", err)
+			return
+		}
+	}
+	if len(ie.BAPlayerBHRLCchannelMappingInfoToRemove) > 0 {
+		tmp_BAPlayerBHRLCchannelMappingInfoToRemove := sequenceOf[*MappingInformationIndex]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofMappingEntries},
+			ext: false,
+		}
+		for k := range ie.BAPlayerBHRLCchannelMappingInfoToRemove {
+			tmp_BAPlayerBHRLCchannelMappingInfoToRemove.Value = append(tmp_BAPlayerBHRLCchannelMappingInfoToRemove.Value, &ie.BAPlayerBHRLCchannelMappingInfoToRemove[k])
+		}
+		if err = tmp_BAPlayerBHRLCchannelMappingInfoToRemove.encode(w); err != nil {
+			err = utils.WrapError("Encode BAPlayerBHRLCchannelMappingInfoToRemove", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *BAPlayerBHRLCchannelMappingInfo) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *BAPlayerBHRLCchannelMappingInfo) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(3); err != nil {
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		var tmp_BAPlayerBHRLCchannelMappingInfoToAdd []*BAPlayerBHRLCchannelMappingInfoItem
+		if tmp_BAPlayerBHRLCchannelMappingInfoToAdd, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofMappingEntries}, false, func() *BAPlayerBHRLCchannelMappingInfoItem { return new(BAPlayerBHRLCchannelMappingInfoItem) }); err != nil {
+			err = readError("BAPlayerBHRLCchannelMappingInfoToAdd", err)
+			return
+		}
+		ie.BAPlayerBHRLCchannelMappingInfoToAdd = make([]BAPlayerBHRLCchannelMappingInfoItem, len(tmp_BAPlayerBHRLCchannelMappingInfoToAdd))
+		for k, i := range tmp_BAPlayerBHRLCchannelMappingInfoToAdd {
+			ie.BAPlayerBHRLCchannelMappingInfoToAdd[k] = *i
+		}
+	}
+	if aper.IsBitSet(optionals, 2) {
+		var tmp_BAPlayerBHRLCchannelMappingInfoToRemove []*MappingInformationIndex
+		if tmp_BAPlayerBHRLCchannelMappingInfoToRemove, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofMappingEntries}, false, func() *MappingInformationIndex { return new(MappingInformationIndex) }); err != nil {
+			err = readError("BAPlayerBHRLCchannelMappingInfoToRemove", err)
+			return
+		}
+		ie.BAPlayerBHRLCchannelMappingInfoToRemove = make([]MappingInformationIndex, len(tmp_BAPlayerBHRLCchannelMappingInfoToRemove))
+		for k, i := range tmp_BAPlayerBHRLCchannelMappingInfoToRemove {
+			ie.BAPlayerBHRLCchannelMappingInfoToRemove[k] = *i
+		}
+	}
+	if aper.IsBitSet(optionals, 3) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *BAPlayerBHRLCchannelMappingInfo) Validate() error {
+	var v validator
+	if len(ie.BAPlayerBHRLCchannelMappingInfoToAdd) > 0 {
+		v.size("BAPlayerBHRLCchannelMappingInfoToAdd", len(ie.BAPlayerBHRLCchannelMappingInfoToAdd), 1, maxnoofMappingEntries)
+		for i := range ie.BAPlayerBHRLCchannelMappingInfoToAdd {
+			v.item("BAPlayerBHRLCchannelMappingInfoToAdd", i, &ie.BAPlayerBHRLCchannelMappingInfoToAdd[i])
+		}
+	}
+	if len(ie.BAPlayerBHRLCchannelMappingInfoToRemove) > 0 {
+		v.size("BAPlayerBHRLCchannelMappingInfoToRemove", len(ie.BAPlayerBHRLCchannelMappingInfoToRemove), 1, maxnoofMappingEntries)
+		for i := range ie.BAPlayerBHRLCchannelMappingInfoToRemove {
+			v.item("BAPlayerBHRLCchannelMappingInfoToRemove", i, &ie.BAPlayerBHRLCchannelMappingInfoToRemove[i])
+		}
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,161 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type BAPlayerBHRLCchannelMappingInfoItem struct {
+	MappingInformationIndex MappingInformationIndex `mandatory`
+	PriorHopBAPAddress      *BAPAddress             `optional`
+	IngressbHRLCChannelID   *BHRLCChannelID         `optional`
+	NextHopBAPAddress       *BAPAddress             `optional`
+	EgressbHRLCChannelID    *BHRLCChannelID         `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[BAPlayerBHRLCchannelMappingInfoItem] `optional,extension`
+}
+
+func (ie *BAPlayerBHRLCchannelMappingInfoItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *BAPlayerBHRLCchannelMappingInfoItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.PriorHopBAPAddress != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if ie.IngressbHRLCChannelID != nil {
+		aper.SetBit(optionals, 2)
+	}
+	if ie.NextHopBAPAddress != nil {
+		aper.SetBit(optionals, 3)
+	}
+	if ie.EgressbHRLCChannelID != nil {
+		aper.SetBit(optionals, 4)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 5)
+	}
+	if err = w.WriteBits(optionals, 5); err != nil {
+		return
+	}
+	if err = ie.MappingInformationIndex.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode MappingInformationIndex", err)
+		return
+	}
+	if ie.PriorHopBAPAddress != nil {
+		if err = ie.PriorHopBAPAddress.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode PriorHopBAPAddress", err)
+			return
+		}
+	}
+	if ie.IngressbHRLCChannelID != nil {
+		if err = ie.IngressbHRLCChannelID.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode IngressbHRLCChannelID", err)
+			return
+		}
+	}
+	if ie.NextHopBAPAddress != nil {
+		if err = ie.NextHopBAPAddress.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode NextHopBAPAddress", err)
+			return
+		}
+	}
+	if ie.EgressbHRLCChannelID != nil {
+		if err = ie.EgressbHRLCChannelID.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode EgressbHRLCChannelID", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *BAPlayerBHRLCchannelMappingInfoItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *BAPlayerBHRLCchannelMappingInfoItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(5); err != nil {
+		return
+	}
+	if err = ie.MappingInformationIndex.decode(r); err != nil {
+		err = readError("MappingInformationIndex", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(BAPAddress)
+		if err = tmp.decode(r); err != nil {
+			err = readError("PriorHopBAPAddress", err)
+			return
+		}
+		ie.PriorHopBAPAddress = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		tmp := new(BHRLCChannelID)
+		if err = tmp.decode(r); err != nil {
+			err = readError("IngressbHRLCChannelID", err)
+			return
+		}
+		ie.IngressbHRLCChannelID = tmp
+	}
+	if aper.IsBitSet(optionals, 3) {
+		tmp := new(BAPAddress)
+		if err = tmp.decode(r); err != nil {
+			err = readError("NextHopBAPAddress", err)
+			return
+		}
+		ie.NextHopBAPAddress = tmp
+	}
+	if aper.IsBitSet(optionals, 4) {
+		tmp := new(BHRLCChannelID)
+		if err = tmp.decode(r); err != nil {
+			err = readError("EgressbHRLCChannelID", err)
+			return
+		}
+		ie.EgressbHRLCChannelID = tmp
+	}
+	if aper.IsBitSet(optionals, 5) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *BAPlayerBHRLCchannelMappingInfoItem) Validate() error {
+	var v validator
+	v.ie("MappingInformationIndex", &ie.MappingInformationIndex)
+	if ie.PriorHopBAPAddress != nil {
+		v.ie("PriorHopBAPAddress", ie.PriorHopBAPAddress)
+	}
+	if ie.IngressbHRLCChannelID != nil {
+		v.ie("IngressbHRLCChannelID", ie.IngressbHRLCChannelID)
+	}
+	if ie.NextHopBAPAddress != nil {
+		v.ie("NextHopBAPAddress", ie.NextHopBAPAddress)
+	}
+	if ie.EgressbHRLCChannelID != nil {
+		v.ie("EgressbHRLCChannelID", ie.EgressbHRLCChannelID)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
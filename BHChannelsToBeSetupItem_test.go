@@ -0,0 +1,50 @@
+package ies
+
+import (
+	"testing"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+func TestBHChannelsWire(t *testing.T) {
+	tests := []struct {
+		name string
+		in   testIE
+		out  testIE
+		want []byte
+	}{{
+		name: "BH channel to be set up",
+		in: &BHChannelsToBeSetupItem{
+			BHRLCChannelID: BHRLCChannelID{Value: aper.BitString{Bytes: []byte{0x01, 0x02}, NumBits: 16}},
+			BHQoSInformation: BHQoSInformation{
+				Choice:        BHQoSInformationPresentCPTrafficType,
+				CPTrafficType: &CPTrafficType{Value: 2},
+			},
+			RLCmode:           RLCMode{Value: RLCModeRlcam},
+			BAPCtrlPDUChannel: &BAPCtrlPDUChannel{Value: BAPCtrlPDUChannelTrue},
+		},
+		out: new(BHChannelsToBeSetupItem),
+		// BAP control PDU channel present, channel 0x0102, CP traffic type 2, RLC AM
+		want: []byte{0x40, 0x10, 0x28, 0x80},
+	}, {
+		name: "BH info",
+		in: &BHInfo{
+			BAProutingID: &BAPRoutingID{
+				BAPAddress: BAPAddress{Value: aper.BitString{Bytes: []byte{0x01, 0x40}, NumBits: 10}},
+				BAPPathID:  BAPPathID{Value: aper.BitString{Bytes: []byte{0x00, 0x40}, NumBits: 10}},
+			},
+			EgressBHRLCCHList: []EgressBHRLCCHItem{{
+				NextHopBAPAddress: BAPAddress{Value: aper.BitString{Bytes: []byte{0x00, 0xc0}, NumBits: 10}},
+				BHRLCChannelID:    BHRLCChannelID{Value: aper.BitString{Bytes: []byte{0x00, 0x01}, NumBits: 16}},
+			}},
+		},
+		out: new(BHInfo),
+		// routing ID of BAP address 5 and path 1, egress channel 1 to BAP address 3
+		want: []byte{0x60, 0x05, 0x00, 0x40, 0x06, 0x00, 0x02},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkIE(t, tt.in, tt.out, tt.want)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type BHChannelsToBeSetupModItem struct {
+	BHRLCChannelID     BHRLCChannelID      `mandatory`
+	BHQoSInformation   BHQoSInformation    `mandatory`
+	RLCmode            RLCMode             `mandatory`
+	BAPCtrlPDUChannel  *BAPCtrlPDUChannel  `optional`
+	TrafficMappingInfo *TrafficMappingInfo `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[BHChannelsToBeSetupModItem] `optional,extension`
+}
+
+func (ie *BHChannelsToBeSetupModItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *BHChannelsToBeSetupModItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.BAPCtrlPDUChannel != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if ie.TrafficMappingInfo != nil {
+		aper.SetBit(optionals, 2)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 3)
+	}
+	if err = w.WriteBits(optionals, 3); err != nil {
+		return
+	}
+	if err = ie.BHRLCChannelID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode BHRLCChannelID", err)
+		return
+	}
+	if err = ie.BHQoSInformation.encode(w); err != nil {
+		err = utils.WrapError("Encode BHQoSInformation", err)
+		return
+	}
+	if err = ie.RLCmode.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode RLCmode", err)
+		return
+	}
+	if ie.BAPCtrlPDUChannel != nil {
+		if err = ie.BAPCtrlPDUChannel.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode BAPCtrlPDUChannel", err)
+			return
+		}
+	}
+	if ie.TrafficMappingInfo != nil {
+		if err = ie.TrafficMappingInfo.encode(w); err != nil {
+			err = utils.WrapError("Encode TrafficMappingInfo", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *BHChannelsToBeSetupModItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *BHChannelsToBeSetupModItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(3); err != nil {
+		return
+	}
+	if err = ie.BHRLCChannelID.decode(r); err != nil {
+		err = readError("BHRLCChannelID", err)
+		return
+	}
+	if err = ie.BHQoSInformation.decode(r); err != nil {
+		err = readError("BHQoSInformation", err)
+		return
+	}
+	if err = ie.RLCmode.Decode(r.AperReader); err != nil {
+		err = readError("RLCmode", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(BAPCtrlPDUChannel)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("BAPCtrlPDUChannel", err)
+			return
+		}
+		ie.BAPCtrlPDUChannel = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		tmp := new(TrafficMappingInfo)
+		if err = tmp.decode(r); err != nil {
+			err = readError("TrafficMappingInfo", err)
+			return
+		}
+		ie.TrafficMappingInfo = tmp
+	}
+	if aper.IsBitSet(optionals, 3) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *BHChannelsToBeSetupModItem) Validate() error {
+	var v validator
+	v.ie("BHRLCChannelID", &ie.BHRLCChannelID)
+	v.ie("BHQoSInformation", &ie.BHQoSInformation)
+	v.ie("RLCmode", &ie.RLCmode)
+	if ie.BAPCtrlPDUChannel != nil {
+		v.ie("BAPCtrlPDUChannel", ie.BAPCtrlPDUChannel)
+	}
+	if ie.TrafficMappingInfo != nil {
+		v.ie("TrafficMappingInfo", ie.TrafficMappingInfo)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
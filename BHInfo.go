@@ -0,0 +1,122 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type BHInfo struct {
+	BAProutingID      *BAPRoutingID       `optional`
+	EgressBHRLCCHList []EgressBHRLCCHItem `lb:1,ub:maxnoofEgressLinks,optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[BHInfo] `optional,extension`
+}
+
+func (ie *BHInfo) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *BHInfo) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.BAProutingID != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(ie.EgressBHRLCCHList) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 3)
+	}
+	if err = w.WriteBits(optionals, 3); err != nil {
+		return
+	}
+	if ie.BAProutingID != nil {
+		if err = ie.BAProutingID.encode(w); err != nil {
+			err = utils.WrapError("Encode BAProutingID", err)
+			return
+		}
+	}
+	if len(ie.EgressBHRLCCHList) > 0 {
+		tmp_EgressBHRLCCHList := sequenceOf[*EgressBHRLCCHItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofEgressLinks},
+			ext: false,
+		}
+		for k := range ie.EgressBHRLCCHList {
+			tmp_EgressBHRLCCHList.Value = append(tmp_EgressBHRLCCHList.Value, &ie.EgressBHRLCCHList[k])
+		}
+		if err = tmp_EgressBHRLCCHList.encode(w); err != nil {
+			err = utils.WrapError("Encode EgressBHRLCCHList", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *BHInfo) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *BHInfo) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(3); err != nil {
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(BAPRoutingID)
+		if err = tmp.decode(r); err != nil {
+			err = readError("BAProutingID", err)
+			return
+		}
+		ie.BAProutingID = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		var tmp_EgressBHRLCCHList []*EgressBHRLCCHItem
+		if tmp_EgressBHRLCCHList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofEgressLinks}, false, func() *EgressBHRLCCHItem { return new(EgressBHRLCCHItem) }); err != nil {
+			err = readError("EgressBHRLCCHList", err)
+			return
+		}
+		ie.EgressBHRLCCHList = make([]EgressBHRLCCHItem, len(tmp_EgressBHRLCCHList))
+		for k, i := range tmp_EgressBHRLCCHList {
+			ie.EgressBHRLCCHList[k] = *i
+		}
+	}
+	if aper.IsBitSet(optionals, 3) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *BHInfo) Validate() error {
+	var v validator
+	if ie.BAProutingID != nil {
+		v.ie("BAProutingID", ie.BAProutingID)
+	}
+	if len(ie.EgressBHRLCCHList) > 0 {
+		v.size("EgressBHRLCCHList", len(ie.EgressBHRLCCHList), 1, maxnoofEgressLinks)
+		for i := range ie.EgressBHRLCCHList {
+			v.item("EgressBHRLCCHList", i, &ie.EgressBHRLCCHList[i])
+		}
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
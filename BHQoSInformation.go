@@ -0,0 +1,127 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	BHQoSInformationPresentNothing uint64 = iota
+	BHQoSInformationPresentBHRLCCHQoS
+	BHQoSInformationPresentEUTRANBHRLCCHQoS
+	BHQoSInformationPresentCPTrafficType
+	BHQoSInformationPresentChoiceExtension
+)
+
+type BHQoSInformation struct {
+	Choice           uint64
+	BHRLCCHQoS       *QoSFlowLevelQoSParameters
+	EUTRANBHRLCCHQoS *EUTRANQoS
+	CPTrafficType    *CPTrafficType
+	ChoiceExtension  *RawIE
+}
+
+func (ie *BHQoSInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *BHQoSInformation) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 3, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case BHQoSInformationPresentBHRLCCHQoS:
+		if err = ie.BHRLCCHQoS.encode(w); err != nil {
+			err = utils.WrapError("Encode BHRLCCHQoS", err)
+			return
+		}
+	case BHQoSInformationPresentEUTRANBHRLCCHQoS:
+		if err = ie.EUTRANBHRLCCHQoS.encode(w); err != nil {
+			err = utils.WrapError("Encode EUTRANBHRLCCHQoS", err)
+			return
+		}
+	case BHQoSInformationPresentCPTrafficType:
+		if err = ie.CPTrafficType.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode CPTrafficType", err)
+			return
+		}
+	case BHQoSInformationPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *BHQoSInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *BHQoSInformation) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(3, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case BHQoSInformationPresentBHRLCCHQoS:
+		tmp := new(QoSFlowLevelQoSParameters)
+		if err = tmp.decode(r); err != nil {
+			err = readError("BHRLCCHQoS", err)
+			return
+		}
+		ie.BHRLCCHQoS = tmp
+	case BHQoSInformationPresentEUTRANBHRLCCHQoS:
+		tmp := new(EUTRANQoS)
+		if err = tmp.decode(r); err != nil {
+			err = readError("EUTRANBHRLCCHQoS", err)
+			return
+		}
+		ie.EUTRANBHRLCCHQoS = tmp
+	case BHQoSInformationPresentCPTrafficType:
+		tmp := new(CPTrafficType)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("CPTrafficType", err)
+			return
+		}
+		ie.CPTrafficType = tmp
+	case BHQoSInformationPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *BHQoSInformation) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case BHQoSInformationPresentBHRLCCHQoS:
+		if ie.BHRLCCHQoS == nil {
+			v.absent("BHRLCCHQoS")
+		} else {
+			v.ie("BHRLCCHQoS", ie.BHRLCCHQoS)
+		}
+	case BHQoSInformationPresentEUTRANBHRLCCHQoS:
+		if ie.EUTRANBHRLCCHQoS == nil {
+			v.absent("EUTRANBHRLCCHQoS")
+		} else {
+			v.ie("EUTRANBHRLCCHQoS", ie.EUTRANBHRLCCHQoS)
+		}
+	case BHQoSInformationPresentCPTrafficType:
+		if ie.CPTrafficType == nil {
+			v.absent("CPTrafficType")
+		} else {
+			v.ie("CPTrafficType", ie.CPTrafficType)
+		}
+	case BHQoSInformationPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
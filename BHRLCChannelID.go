@@ -0,0 +1,35 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type BHRLCChannelID struct {
+	Value aper.BitString `aper:"sizeLB:16,sizeUB:16"`
+}
+
+func (ie *BHRLCChannelID) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteBitString(ie.Value.Bytes, uint(ie.Value.NumBits), &aper.Constraint{Lb: 16, Ub: 16}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *BHRLCChannelID) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *BHRLCChannelID) decode(r *decoder) error {
+	if v, n, err := readBitString(r, &aper.Constraint{Lb: 16, Ub: 16}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.BitString{Bytes: v, NumBits: uint64(n)}
+	}
+	return nil
+}
+
+func (ie *BHRLCChannelID) Validate() error {
+	var v validator
+	v.size("Value", int(ie.Value.NumBits), 16, 16)
+	return v.err()
+}
@@ -0,0 +1,176 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	BandwidthSRSPresentNothing uint64 = iota
+	BandwidthSRSPresentFR1
+	BandwidthSRSPresentFR2
+	BandwidthSRSPresentChoiceExtension
+)
+
+type BandwidthSRS struct {
+	Choice          uint64
+	FR1             *BandwidthSRSFR1
+	FR2             *BandwidthSRSFR2
+	ChoiceExtension *RawIE
+}
+
+func (ie *BandwidthSRS) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *BandwidthSRS) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case BandwidthSRSPresentFR1:
+		if err = ie.FR1.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode FR1", err)
+			return
+		}
+	case BandwidthSRSPresentFR2:
+		if err = ie.FR2.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode FR2", err)
+			return
+		}
+	case BandwidthSRSPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *BandwidthSRS) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *BandwidthSRS) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case BandwidthSRSPresentFR1:
+		tmp := new(BandwidthSRSFR1)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("FR1", err)
+			return
+		}
+		ie.FR1 = tmp
+	case BandwidthSRSPresentFR2:
+		tmp := new(BandwidthSRSFR2)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("FR2", err)
+			return
+		}
+		ie.FR2 = tmp
+	case BandwidthSRSPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *BandwidthSRS) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case BandwidthSRSPresentFR1:
+		if ie.FR1 == nil {
+			v.absent("FR1")
+		} else {
+			v.ie("FR1", ie.FR1)
+		}
+	case BandwidthSRSPresentFR2:
+		if ie.FR2 == nil {
+			v.absent("FR2")
+		} else {
+			v.ie("FR2", ie.FR2)
+		}
+	case BandwidthSRSPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
+
+const (
+	BandwidthSRSFR1MHz5   aper.Enumerated = 0
+	BandwidthSRSFR1MHz10  aper.Enumerated = 1
+	BandwidthSRSFR1MHz20  aper.Enumerated = 2
+	BandwidthSRSFR1MHz40  aper.Enumerated = 3
+	BandwidthSRSFR1MHz50  aper.Enumerated = 4
+	BandwidthSRSFR1MHz80  aper.Enumerated = 5
+	BandwidthSRSFR1MHz100 aper.Enumerated = 6
+)
+
+type BandwidthSRSFR1 struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:6,valueExt"`
+}
+
+func (ie *BandwidthSRSFR1) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 6}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *BandwidthSRSFR1) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 6}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *BandwidthSRSFR1) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 7)
+	return v.err()
+}
+
+const (
+	BandwidthSRSFR2MHz50  aper.Enumerated = 0
+	BandwidthSRSFR2MHz100 aper.Enumerated = 1
+	BandwidthSRSFR2MHz200 aper.Enumerated = 2
+	BandwidthSRSFR2MHz400 aper.Enumerated = 3
+)
+
+type BandwidthSRSFR2 struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:3,valueExt"`
+}
+
+func (ie *BandwidthSRSFR2) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *BandwidthSRSFR2) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *BandwidthSRSFR2) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 4)
+	return v.err()
+}
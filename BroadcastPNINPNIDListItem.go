@@ -0,0 +1,102 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type BroadcastPNINPNIDListItem struct {
+	PLMNIdentity     PLMNIdentity `mandatory`
+	BroadcastCAGList []CAGID      `lb:1,ub:maxnoofCAGsupported,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[BroadcastPNINPNIDListItem] `optional,extension`
+}
+
+func (ie *BroadcastPNINPNIDListItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *BroadcastPNINPNIDListItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.PLMNIdentity.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PLMNIdentity", err)
+		return
+	}
+	tmp_BroadcastCAGList := sequenceOf[*CAGID]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofCAGsupported},
+		ext: false,
+	}
+	for k := range ie.BroadcastCAGList {
+		tmp_BroadcastCAGList.Value = append(tmp_BroadcastCAGList.Value, &ie.BroadcastCAGList[k])
+	}
+	if err = tmp_BroadcastCAGList.encode(w); err != nil {
+		err = utils.WrapError("Encode BroadcastCAGList", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *BroadcastPNINPNIDListItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *BroadcastPNINPNIDListItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.PLMNIdentity.Decode(r.AperReader); err != nil {
+		err = readError("PLMNIdentity", err)
+		return
+	}
+	var tmp_BroadcastCAGList []*CAGID
+	if tmp_BroadcastCAGList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofCAGsupported}, false, func() *CAGID { return new(CAGID) }); err != nil {
+		err = readError("BroadcastCAGList", err)
+		return
+	}
+	ie.BroadcastCAGList = make([]CAGID, len(tmp_BroadcastCAGList))
+	for k, i := range tmp_BroadcastCAGList {
+		ie.BroadcastCAGList[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *BroadcastPNINPNIDListItem) Validate() error {
+	var v validator
+	v.size("PLMNIdentity", len(ie.PLMNIdentity.Value), 3, 3)
+	v.size("BroadcastCAGList", len(ie.BroadcastCAGList), 1, maxnoofCAGsupported)
+	for i := range ie.BroadcastCAGList {
+		v.item("BroadcastCAGList", i, &ie.BroadcastCAGList[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
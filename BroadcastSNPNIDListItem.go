@@ -0,0 +1,102 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type BroadcastSNPNIDListItem struct {
+	PLMNIdentity     PLMNIdentity           `mandatory`
+	BroadcastNIDList []BroadcastNIDListItem `lb:1,ub:maxnoofNIDsupported,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[BroadcastSNPNIDListItem] `optional,extension`
+}
+
+func (ie *BroadcastSNPNIDListItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *BroadcastSNPNIDListItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.PLMNIdentity.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PLMNIdentity", err)
+		return
+	}
+	tmp_BroadcastNIDList := sequenceOf[*BroadcastNIDListItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofNIDsupported},
+		ext: false,
+	}
+	for k := range ie.BroadcastNIDList {
+		tmp_BroadcastNIDList.Value = append(tmp_BroadcastNIDList.Value, &ie.BroadcastNIDList[k])
+	}
+	if err = tmp_BroadcastNIDList.encode(w); err != nil {
+		err = utils.WrapError("Encode BroadcastNIDList", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *BroadcastSNPNIDListItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *BroadcastSNPNIDListItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.PLMNIdentity.Decode(r.AperReader); err != nil {
+		err = readError("PLMNIdentity", err)
+		return
+	}
+	var tmp_BroadcastNIDList []*BroadcastNIDListItem
+	if tmp_BroadcastNIDList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofNIDsupported}, false, func() *BroadcastNIDListItem { return new(BroadcastNIDListItem) }); err != nil {
+		err = readError("BroadcastNIDList", err)
+		return
+	}
+	ie.BroadcastNIDList = make([]BroadcastNIDListItem, len(tmp_BroadcastNIDList))
+	for k, i := range tmp_BroadcastNIDList {
+		ie.BroadcastNIDList[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *BroadcastSNPNIDListItem) Validate() error {
+	var v validator
+	v.size("PLMNIdentity", len(ie.PLMNIdentity.Value), 3, 3)
+	v.size("BroadcastNIDList", len(ie.BroadcastNIDList), 1, maxnoofNIDsupported)
+	for i := range ie.BroadcastNIDList {
+		v.item("BroadcastNIDList", i, &ie.BroadcastNIDList[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
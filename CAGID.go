@@ -0,0 +1,35 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type CAGID struct {
+	Value aper.BitString `aper:"sizeLB:32,sizeUB:32"`
+}
+
+func (ie *CAGID) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteBitString(ie.Value.Bytes, uint(ie.Value.NumBits), &aper.Constraint{Lb: 32, Ub: 32}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *CAGID) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *CAGID) decode(r *decoder) error {
+	if v, n, err := readBitString(r, &aper.Constraint{Lb: 32, Ub: 32}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.BitString{Bytes: v, NumBits: uint64(n)}
+	}
+	return nil
+}
+
+func (ie *CAGID) Validate() error {
+	var v validator
+	v.size("Value", int(ie.Value.NumBits), 32, 32)
+	return v.err()
+}
@@ -0,0 +1,31 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type CHOProbability struct {
+	Value aper.Integer `aper:"valueLB:1,valueUB:100"`
+}
+
+func (ie *CHOProbability) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteInteger(int64(ie.Value), &aper.Constraint{Lb: 1, Ub: 100}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *CHOProbability) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadInteger(&aper.Constraint{Lb: 1, Ub: 100}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Integer(v)
+	}
+	return nil
+}
+
+func (ie *CHOProbability) Validate() error {
+	var v validator
+	v.integer("Value", int64(ie.Value), 1, 100)
+	return v.err()
+}
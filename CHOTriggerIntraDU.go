@@ -0,0 +1,37 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+const (
+	CHOTriggerIntraDUChoinitiation aper.Enumerated = 0
+	CHOTriggerIntraDUChoreplace    aper.Enumerated = 1
+	CHOTriggerIntraDUChocancel     aper.Enumerated = 2
+)
+
+type CHOTriggerIntraDU struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:2,valueExt"`
+}
+
+func (ie *CHOTriggerIntraDU) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 2}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *CHOTriggerIntraDU) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 2}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *CHOTriggerIntraDU) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 3)
+	return v.err()
+}
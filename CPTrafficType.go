@@ -0,0 +1,31 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type CPTrafficType struct {
+	Value aper.Integer `aper:"valueLB:1,valueUB:3,valueExt"`
+}
+
+func (ie *CPTrafficType) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteInteger(int64(ie.Value), &aper.Constraint{Lb: 1, Ub: 3}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *CPTrafficType) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadInteger(&aper.Constraint{Lb: 1, Ub: 3}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Integer(v)
+	}
+	return nil
+}
+
+func (ie *CPTrafficType) Validate() error {
+	var v validator
+	v.integer("Value", int64(ie.Value), 1, 3)
+	return v.err()
+}
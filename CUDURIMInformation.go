@@ -0,0 +1,87 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type CUDURIMInformation struct {
+	VictimgNBSetID       GNBSetID             `mandatory`
+	RIMRSDetectionStatus RIMRSDetectionStatus `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[CUDURIMInformation] `optional,extension`
+}
+
+func (ie *CUDURIMInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *CUDURIMInformation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.VictimgNBSetID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode VictimgNBSetID", err)
+		return
+	}
+	if err = ie.RIMRSDetectionStatus.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode RIMRSDetectionStatus", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *CUDURIMInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *CUDURIMInformation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.VictimgNBSetID.decode(r); err != nil {
+		err = readError("VictimgNBSetID", err)
+		return
+	}
+	if err = ie.RIMRSDetectionStatus.Decode(r.AperReader); err != nil {
+		err = readError("RIMRSDetectionStatus", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *CUDURIMInformation) Validate() error {
+	var v validator
+	v.ie("VictimgNBSetID", &ie.VictimgNBSetID)
+	v.ie("RIMRSDetectionStatus", &ie.RIMRSDetectionStatus)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,109 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type CUDURadioInformationTransfer struct {
+	TransactionID            TransactionID            `mandatory,reject`
+	CUDURadioInformationType CUDURadioInformationType `mandatory,ignore`
+	UnknownIEs               []RawIE
+}
+
+func (msg *CUDURadioInformationTransfer) ProcedureCode() int64 {
+	return ProcedureCode_CUDURadioInformationTransfer
+}
+
+func (msg *CUDURadioInformationTransfer) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *CUDURadioInformationTransfer) Criticality() aper.Enumerated {
+	return Criticality_PresentIgnore
+}
+
+func (msg *CUDURadioInformationTransfer) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("CUDURadioInformationTransfer"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *CUDURadioInformationTransfer) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("CUDURadioInformationTransfer"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *CUDURadioInformationTransfer) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_TransactionID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.TransactionID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_CUDURadioInformationType},
+		Criticality: Criticality{Value: Criticality_PresentIgnore},
+		Value:       &msg.CUDURadioInformationType,
+	})
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *CUDURadioInformationTransfer) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *CUDURadioInformationTransfer) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *CUDURadioInformationTransfer) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("CUDURadioInformationTransfer", wire, limits, cuduRadioInformationTransferIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var cuduRadioInformationTransferIEs = []messageIE{
+	{ProtocolIEID_TransactionID, Criticality_PresentReject, true},
+	{ProtocolIEID_CUDURadioInformationType, Criticality_PresentIgnore, true},
+}
+
+func (msg *CUDURadioInformationTransfer) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_TransactionID:
+		var tmp TransactionID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("TransactionID", err)
+			return
+		}
+		msg.TransactionID = tmp
+
+	case ProtocolIEID_CUDURadioInformationType:
+		var tmp CUDURadioInformationType
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("CUDURadioInformationType", err)
+			return
+		}
+		msg.CUDURadioInformationType = tmp
+
+	}
+	return
+}
+
+func (msg *CUDURadioInformationTransfer) Validate() error {
+	var v validator
+	v.integer("TransactionID", int64(msg.TransactionID.Value), 0, 255)
+	v.ie("CUDURadioInformationType", &msg.CUDURadioInformationType)
+	return v.messageErr("CUDURadioInformationTransfer")
+}
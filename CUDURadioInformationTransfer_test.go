@@ -0,0 +1,29 @@
+package ies
+
+import (
+	"testing"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+func TestCUDURadioInformationTransferWire(t *testing.T) {
+	m := &CUDURadioInformationTransfer{
+		TransactionID: TransactionID{Value: 1},
+		CUDURadioInformationType: CUDURadioInformationType{
+			Choice: CUDURadioInformationTypePresentRIM,
+			RIM: &CUDURIMInformation{
+				VictimgNBSetID:       GNBSetID{Value: aper.BitString{Bytes: []byte{0xab, 0xcd, 0xec}, NumBits: 22}},
+				RIMRSDetectionStatus: RIMRSDetectionStatus{Value: RIMRSDetectionStatusRsdisappeared},
+			},
+		},
+	}
+	want := []byte{
+		0x00, 0x1f, 0x40, 0x11, // initiatingMessage, cUDURadioInformationTransfer, ignore, length
+		0x00, 0x00, 0x02, // extension bit, 2 IEs
+		0x00, 0x4e, 0x00, 0x02, 0x00, 0x01, // TransactionID 1
+		0x00, 0xfa, 0x40, 0x04, // CUDURadioInformationType
+		0x15,             // rIM, victim gNB set ID of 22 bits
+		0xab, 0xcd, 0xed, // set ID, RS disappeared
+	}
+	checkMessage(t, m, new(CUDURadioInformationTransfer), want)
+}
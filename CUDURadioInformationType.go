@@ -0,0 +1,87 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	CUDURadioInformationTypePresentNothing uint64 = iota
+	CUDURadioInformationTypePresentRIM
+	CUDURadioInformationTypePresentChoiceExtension
+)
+
+type CUDURadioInformationType struct {
+	Choice          uint64
+	RIM             *CUDURIMInformation
+	ChoiceExtension *RawIE
+}
+
+func (ie *CUDURadioInformationType) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *CUDURadioInformationType) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 1, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case CUDURadioInformationTypePresentRIM:
+		if err = ie.RIM.encode(w); err != nil {
+			err = utils.WrapError("Encode RIM", err)
+			return
+		}
+	case CUDURadioInformationTypePresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *CUDURadioInformationType) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *CUDURadioInformationType) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(1, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case CUDURadioInformationTypePresentRIM:
+		tmp := new(CUDURIMInformation)
+		if err = tmp.decode(r); err != nil {
+			err = readError("RIM", err)
+			return
+		}
+		ie.RIM = tmp
+	case CUDURadioInformationTypePresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *CUDURadioInformationType) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case CUDURadioInformationTypePresentRIM:
+		if ie.RIM == nil {
+			v.absent("RIM")
+		} else {
+			v.ie("RIM", ie.RIM)
+		}
+	case CUDURadioInformationTypePresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
@@ -0,0 +1,181 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type CellTrafficTrace struct {
+	GNBCUUEF1APID                  GNBCUUEF1APID         `mandatory,reject`
+	GNBDUUEF1APID                  GNBDUUEF1APID         `mandatory,reject`
+	TraceID                        TraceID               `mandatory,ignore`
+	TraceCollectionEntityIPAddress TransportLayerAddress `mandatory,ignore`
+	PrivacyIndicator               *PrivacyIndicator     `optional,ignore`
+	TraceCollectionEntityURI       *URIAddress           `optional,ignore`
+	UnknownIEs                     []RawIE
+}
+
+func (msg *CellTrafficTrace) ProcedureCode() int64 {
+	return ProcedureCode_CellTrafficTrace
+}
+
+func (msg *CellTrafficTrace) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *CellTrafficTrace) Criticality() aper.Enumerated {
+	return Criticality_PresentIgnore
+}
+
+func (msg *CellTrafficTrace) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("CellTrafficTrace"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *CellTrafficTrace) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("CellTrafficTrace"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *CellTrafficTrace) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_TraceID},
+		Criticality: Criticality{Value: Criticality_PresentIgnore},
+		Value:       &msg.TraceID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_TraceCollectionEntityIPAddress},
+		Criticality: Criticality{Value: Criticality_PresentIgnore},
+		Value:       &msg.TraceCollectionEntityIPAddress,
+	})
+	if msg.PrivacyIndicator != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_PrivacyIndicator},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.PrivacyIndicator,
+		})
+	}
+	if msg.TraceCollectionEntityURI != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_TraceCollectionEntityURI},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.TraceCollectionEntityURI,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *CellTrafficTrace) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *CellTrafficTrace) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *CellTrafficTrace) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("CellTrafficTrace", wire, limits, cellTrafficTraceIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var cellTrafficTraceIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_TraceID, Criticality_PresentIgnore, true},
+	{ProtocolIEID_TraceCollectionEntityIPAddress, Criticality_PresentIgnore, true},
+	{ProtocolIEID_PrivacyIndicator, Criticality_PresentIgnore, false},
+	{ProtocolIEID_TraceCollectionEntityURI, Criticality_PresentIgnore, false},
+}
+
+func (msg *CellTrafficTrace) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_TraceID:
+		var tmp TraceID
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("TraceID", err)
+			return
+		}
+		msg.TraceID = tmp
+
+	case ProtocolIEID_TraceCollectionEntityIPAddress:
+		var tmp TransportLayerAddress
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("TraceCollectionEntityIPAddress", err)
+			return
+		}
+		msg.TraceCollectionEntityIPAddress = tmp
+
+	case ProtocolIEID_PrivacyIndicator:
+		var tmp PrivacyIndicator
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("PrivacyIndicator", err)
+			return
+		}
+		msg.PrivacyIndicator = &tmp
+
+	case ProtocolIEID_TraceCollectionEntityURI:
+		var tmp URIAddress
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("TraceCollectionEntityURI", err)
+			return
+		}
+		msg.TraceCollectionEntityURI = &tmp
+
+	}
+	return
+}
+
+func (msg *CellTrafficTrace) Validate() error {
+	var v validator
+	v.integer("GNBCUUEF1APID", int64(msg.GNBCUUEF1APID.Value), 0, 4294967295)
+	v.integer("GNBDUUEF1APID", int64(msg.GNBDUUEF1APID.Value), 0, 4294967295)
+	v.ie("TraceID", &msg.TraceID)
+	v.ie("TraceCollectionEntityIPAddress", &msg.TraceCollectionEntityIPAddress)
+	if msg.PrivacyIndicator != nil {
+		v.ie("PrivacyIndicator", msg.PrivacyIndicator)
+	}
+	if msg.TraceCollectionEntityURI != nil {
+		v.ie("TraceCollectionEntityURI", msg.TraceCollectionEntityURI)
+	}
+	return v.messageErr("CellTrafficTrace")
+}
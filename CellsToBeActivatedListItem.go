@@ -0,0 +1,230 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type CellsToBeActivatedListItem struct {
+	NRCGI NRCGI  `mandatory`
+	NRPCI *NRPCI `optional`
+	// IEExtensions
+	GNBCUSystemInformation    *GNBCUSystemInformation                                `optional,reject,extension`
+	AvailablePLMNList         []AvailablePLMNListItem                                `lb:1,ub:maxnoofBPLMNsNR,optional,ignore,extension`
+	ExtendedAvailablePLMNList []ExtendedAvailablePLMNItem                            `lb:1,ub:maxnoofExtendedBPLMNs,optional,ignore,extension`
+	IABInfoIABDonorCU         *IABInfoIABDonorCU                                     `optional,ignore,extension`
+	AvailableSNPNIDList       []AvailableSNPNIDListItem                              `lb:1,ub:maxnoofBPLMNsNR,optional,ignore,extension`
+	Extensions                ProtocolExtensionContainer[CellsToBeActivatedListItem] `optional,extension`
+}
+
+func (ie *CellsToBeActivatedListItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *CellsToBeActivatedListItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(ie.extensions())
+	optionals := []byte{0x0}
+	if ie.NRPCI != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	if err = ie.NRCGI.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode NRCGI", err)
+		return
+	}
+	if ie.NRPCI != nil {
+		if err = ie.NRPCI.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode NRPCI", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *CellsToBeActivatedListItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *CellsToBeActivatedListItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	if err = ie.NRCGI.Decode(r.AperReader); err != nil {
+		err = readError("NRCGI", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(NRPCI)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("NRPCI", err)
+			return
+		}
+		ie.NRPCI = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, ie.decodeExtension); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *CellsToBeActivatedListItem) extensions() (exts []F1apMessageIE) {
+	if ie.GNBCUSystemInformation != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUSystemInformation},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       ie.GNBCUSystemInformation,
+		})
+	}
+	if len(ie.AvailablePLMNList) > 0 {
+		tmp_AvailablePLMNList := sequenceOf[*AvailablePLMNListItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofBPLMNsNR},
+			ext: false,
+		}
+		for k := range ie.AvailablePLMNList {
+			tmp_AvailablePLMNList.Value = append(tmp_AvailablePLMNList.Value, &ie.AvailablePLMNList[k])
+		}
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_AvailablePLMNList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_AvailablePLMNList,
+		})
+	}
+	if len(ie.ExtendedAvailablePLMNList) > 0 {
+		tmp_ExtendedAvailablePLMNList := sequenceOf[*ExtendedAvailablePLMNItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofExtendedBPLMNs},
+			ext: false,
+		}
+		for k := range ie.ExtendedAvailablePLMNList {
+			tmp_ExtendedAvailablePLMNList.Value = append(tmp_ExtendedAvailablePLMNList.Value, &ie.ExtendedAvailablePLMNList[k])
+		}
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ExtendedAvailablePLMNList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_ExtendedAvailablePLMNList,
+		})
+	}
+	if ie.IABInfoIABDonorCU != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_IABInfoIABDonorCU},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.IABInfoIABDonorCU,
+		})
+	}
+	if len(ie.AvailableSNPNIDList) > 0 {
+		tmp_AvailableSNPNIDList := sequenceOf[*AvailableSNPNIDListItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofBPLMNsNR},
+			ext: false,
+		}
+		for k := range ie.AvailableSNPNIDList {
+			tmp_AvailableSNPNIDList.Value = append(tmp_AvailableSNPNIDList.Value, &ie.AvailableSNPNIDList[k])
+		}
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_AvailableSNPNIDList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_AvailableSNPNIDList,
+		})
+	}
+	return
+}
+
+func (ie *CellsToBeActivatedListItem) decodeExtension(id aper.Integer, r *decoder) (ok bool, err error) {
+	ok = true
+	switch id {
+	case ProtocolIEID_gNBCUSystemInformation:
+		tmp := new(GNBCUSystemInformation)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("GNBCUSystemInformation", err)
+			return
+		}
+		ie.GNBCUSystemInformation = tmp
+	case ProtocolIEID_AvailablePLMNList:
+		var tmp []*AvailablePLMNListItem
+		if tmp, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofBPLMNsNR}, false, func() *AvailablePLMNListItem { return new(AvailablePLMNListItem) }); err != nil {
+			err = readError("AvailablePLMNList", err)
+			return
+		}
+		ie.AvailablePLMNList = make([]AvailablePLMNListItem, len(tmp))
+		for k, i := range tmp {
+			ie.AvailablePLMNList[k] = *i
+		}
+	case ProtocolIEID_ExtendedAvailablePLMNList:
+		var tmp []*ExtendedAvailablePLMNItem
+		if tmp, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofExtendedBPLMNs}, false, func() *ExtendedAvailablePLMNItem { return new(ExtendedAvailablePLMNItem) }); err != nil {
+			err = readError("ExtendedAvailablePLMNList", err)
+			return
+		}
+		ie.ExtendedAvailablePLMNList = make([]ExtendedAvailablePLMNItem, len(tmp))
+		for k, i := range tmp {
+			ie.ExtendedAvailablePLMNList[k] = *i
+		}
+	case ProtocolIEID_IABInfoIABDonorCU:
+		tmp := new(IABInfoIABDonorCU)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("IABInfoIABDonorCU", err)
+			return
+		}
+		ie.IABInfoIABDonorCU = tmp
+	case ProtocolIEID_AvailableSNPNIDList:
+		var tmp []*AvailableSNPNIDListItem
+		if tmp, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofBPLMNsNR}, false, func() *AvailableSNPNIDListItem { return new(AvailableSNPNIDListItem) }); err != nil {
+			err = readError("AvailableSNPNIDList", err)
+			return
+		}
+		ie.AvailableSNPNIDList = make([]AvailableSNPNIDListItem, len(tmp))
+		for k, i := range tmp {
+			ie.AvailableSNPNIDList[k] = *i
+		}
+	default:
+		ok = false
+	}
+	return
+}
+
+func (ie *CellsToBeActivatedListItem) Validate() error {
+	var v validator
+	if ie.NRPCI != nil {
+		v.integer("NRPCI", int64(ie.NRPCI.Value), 0, 1007)
+	}
+	if ie.GNBCUSystemInformation != nil {
+		v.ie("GNBCUSystemInformation", ie.GNBCUSystemInformation)
+	}
+	if len(ie.AvailablePLMNList) > 0 {
+		v.size("AvailablePLMNList", len(ie.AvailablePLMNList), 1, maxnoofBPLMNsNR)
+	}
+	if len(ie.ExtendedAvailablePLMNList) > 0 {
+		v.size("ExtendedAvailablePLMNList", len(ie.ExtendedAvailablePLMNList), 1, maxnoofExtendedBPLMNs)
+	}
+	if len(ie.AvailableSNPNIDList) > 0 {
+		v.size("AvailableSNPNIDList", len(ie.AvailableSNPNIDList), 1, maxnoofBPLMNsNR)
+		for i := range ie.AvailableSNPNIDList {
+			v.item("AvailableSNPNIDList", i, &ie.AvailableSNPNIDList[i])
+		}
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,138 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type ConditionalInterDUMobilityInformation struct {
+	ChoTrigger          CHOTriggerInterDU `mandatory`
+	TargetgNBDUUEF1APID *GNBDUUEF1APID    `optional`
+	// IEExtensions
+	EstimatedArrivalProbability *CHOProbability                                                   `optional,ignore,extension`
+	Extensions                  ProtocolExtensionContainer[ConditionalInterDUMobilityInformation] `optional,extension`
+}
+
+func (ie *ConditionalInterDUMobilityInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ConditionalInterDUMobilityInformation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(ie.extensions())
+	optionals := []byte{0x0}
+	if ie.TargetgNBDUUEF1APID != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	if err = ie.ChoTrigger.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode ChoTrigger", err)
+		return
+	}
+	if ie.TargetgNBDUUEF1APID != nil {
+		if err = ie.TargetgNBDUUEF1APID.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode TargetgNBDUUEF1APID", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ConditionalInterDUMobilityInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ConditionalInterDUMobilityInformation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	if err = ie.ChoTrigger.Decode(r.AperReader); err != nil {
+		err = readError("ChoTrigger", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(GNBDUUEF1APID)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("TargetgNBDUUEF1APID", err)
+			return
+		}
+		ie.TargetgNBDUUEF1APID = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, ie.decodeExtension); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *ConditionalInterDUMobilityInformation) extensions() (exts []F1apMessageIE) {
+	if ie.EstimatedArrivalProbability != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_EstimatedArrivalProbability},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.EstimatedArrivalProbability,
+		})
+	}
+	return
+}
+
+func (ie *ConditionalInterDUMobilityInformation) decodeExtension(id aper.Integer, r *decoder) (ok bool, err error) {
+	ok = true
+	switch id {
+	case ProtocolIEID_EstimatedArrivalProbability:
+		tmp := new(CHOProbability)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("EstimatedArrivalProbability", err)
+			return
+		}
+		ie.EstimatedArrivalProbability = tmp
+	default:
+		ok = false
+	}
+	return
+}
+
+func (ie *ConditionalInterDUMobilityInformation) Validate() error {
+	var v validator
+	v.ie("ChoTrigger", &ie.ChoTrigger)
+	if ie.TargetgNBDUUEF1APID != nil {
+		v.integer("TargetgNBDUUEF1APID", int64(ie.TargetgNBDUUEF1APID.Value), 0, 4294967295)
+	}
+	if ie.EstimatedArrivalProbability != nil {
+		v.ie("EstimatedArrivalProbability", ie.EstimatedArrivalProbability)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	// the target gNB-DU UE F1AP ID is present when the CHO trigger is
+	// CHO-replace (C-ifCHOmod)
+	if ie.ChoTrigger.Value == CHOTriggerInterDUChoreplace {
+		if ie.TargetgNBDUUEF1APID == nil {
+			v.absent("TargetgNBDUUEF1APID")
+		}
+	} else if ie.TargetgNBDUUEF1APID != nil {
+		v.violation("TargetgNBDUUEF1APID", ie.TargetgNBDUUEF1APID.Value)
+	}
+	return v.err()
+}
@@ -0,0 +1,28 @@
+package ies
+
+import "testing"
+
+func TestConditionalMobilityInformationWire(t *testing.T) {
+	inter := ConditionalInterDUMobilityInformation{
+		ChoTrigger:                  CHOTriggerInterDU{Value: CHOTriggerInterDUChoreplace},
+		TargetgNBDUUEF1APID:         &GNBDUUEF1APID{Value: 5},
+		EstimatedArrivalProbability: &CHOProbability{Value: 80},
+	}
+	want := []byte{
+		0x68, 0x05, // target gNB-DU UE F1AP ID present, CHO replace, ID 5
+		0x00, 0x00, // 1 extension
+		0x02, 0x4d, 0x40, 0x01, 0x9e, // estimated arrival probability 80
+	}
+	checkIE(t, &inter, new(ConditionalInterDUMobilityInformation), want)
+
+	intra := ConditionalIntraDUMobilityInformation{
+		ChoTrigger:                  CHOTriggerIntraDU{Value: CHOTriggerIntraDUChocancel},
+		EstimatedArrivalProbability: &CHOProbability{Value: 100},
+	}
+	want = []byte{
+		0x28,       // CHO cancel
+		0x00, 0x00, // 1 extension
+		0x02, 0x4d, 0x40, 0x01, 0xc6, // estimated arrival probability 100
+	}
+	checkIE(t, &intra, new(ConditionalIntraDUMobilityInformation), want)
+}
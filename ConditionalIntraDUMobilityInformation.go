@@ -0,0 +1,142 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type ConditionalIntraDUMobilityInformation struct {
+	ChoTrigger          CHOTriggerIntraDU    `mandatory`
+	TargetCellsTocancel []TargetCellListItem `lb:1,ub:maxnoofCHOcells,optional`
+	// IEExtensions
+	EstimatedArrivalProbability *CHOProbability                                                   `optional,ignore,extension`
+	Extensions                  ProtocolExtensionContainer[ConditionalIntraDUMobilityInformation] `optional,extension`
+}
+
+func (ie *ConditionalIntraDUMobilityInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ConditionalIntraDUMobilityInformation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(ie.extensions())
+	optionals := []byte{0x0}
+	if len(ie.TargetCellsTocancel) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	if err = ie.ChoTrigger.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode ChoTrigger", err)
+		return
+	}
+	if len(ie.TargetCellsTocancel) > 0 {
+		tmp_TargetCellsTocancel := sequenceOf[*TargetCellListItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofCHOcells},
+			ext: false,
+		}
+		for k := range ie.TargetCellsTocancel {
+			tmp_TargetCellsTocancel.Value = append(tmp_TargetCellsTocancel.Value, &ie.TargetCellsTocancel[k])
+		}
+		if err = tmp_TargetCellsTocancel.encode(w); err != nil {
+			err = utils.WrapError("Encode TargetCellsTocancel", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ConditionalIntraDUMobilityInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ConditionalIntraDUMobilityInformation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	if err = ie.ChoTrigger.Decode(r.AperReader); err != nil {
+		err = readError("ChoTrigger", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		var tmp_TargetCellsTocancel []*TargetCellListItem
+		if tmp_TargetCellsTocancel, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofCHOcells}, false, func() *TargetCellListItem { return new(TargetCellListItem) }); err != nil {
+			err = readError("TargetCellsTocancel", err)
+			return
+		}
+		ie.TargetCellsTocancel = make([]TargetCellListItem, len(tmp_TargetCellsTocancel))
+		for k, i := range tmp_TargetCellsTocancel {
+			ie.TargetCellsTocancel[k] = *i
+		}
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, ie.decodeExtension); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *ConditionalIntraDUMobilityInformation) extensions() (exts []F1apMessageIE) {
+	if ie.EstimatedArrivalProbability != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_EstimatedArrivalProbability},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.EstimatedArrivalProbability,
+		})
+	}
+	return
+}
+
+func (ie *ConditionalIntraDUMobilityInformation) decodeExtension(id aper.Integer, r *decoder) (ok bool, err error) {
+	ok = true
+	switch id {
+	case ProtocolIEID_EstimatedArrivalProbability:
+		tmp := new(CHOProbability)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("EstimatedArrivalProbability", err)
+			return
+		}
+		ie.EstimatedArrivalProbability = tmp
+	default:
+		ok = false
+	}
+	return
+}
+
+func (ie *ConditionalIntraDUMobilityInformation) Validate() error {
+	var v validator
+	v.ie("ChoTrigger", &ie.ChoTrigger)
+	if len(ie.TargetCellsTocancel) > 0 {
+		v.size("TargetCellsTocancel", len(ie.TargetCellsTocancel), 1, maxnoofCHOcells)
+		for i := range ie.TargetCellsTocancel {
+			v.item("TargetCellsTocancel", i, &ie.TargetCellsTocancel[i])
+		}
+	}
+	if ie.EstimatedArrivalProbability != nil {
+		v.ie("EstimatedArrivalProbability", ie.EstimatedArrivalProbability)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,35 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type ConfiguredEPSTAC struct {
+	Value aper.OctetString `aper:"sizeLB:2,sizeUB:2"`
+}
+
+func (ie *ConfiguredEPSTAC) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteOctetString([]byte(ie.Value), &aper.Constraint{Lb: 2, Ub: 2}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *ConfiguredEPSTAC) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ConfiguredEPSTAC) decode(r *decoder) error {
+	if v, err := readOctetString(r, &aper.Constraint{Lb: 2, Ub: 2}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.OctetString(v)
+	}
+	return nil
+}
+
+func (ie *ConfiguredEPSTAC) Validate() error {
+	var v validator
+	v.size("Value", len(ie.Value), 2, 2)
+	return v.err()
+}
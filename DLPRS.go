@@ -0,0 +1,118 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type DLPRS struct {
+	Prsid              int64            `lb:0,ub:255,mandatory`
+	DlPRSResourceSetID PRSResourceSetID `mandatory`
+	DlPRSResourceID    *PRSResourceID   `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[DLPRS] `optional,extension`
+}
+
+func (ie *DLPRS) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *DLPRS) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.DlPRSResourceID != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	tmp_Prsid := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 255},
+		ext:   false,
+		Value: aper.Integer(ie.Prsid),
+	}
+	if err = tmp_Prsid.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Prsid", err)
+		return
+	}
+	if err = ie.DlPRSResourceSetID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode DlPRSResourceSetID", err)
+		return
+	}
+	if ie.DlPRSResourceID != nil {
+		if err = ie.DlPRSResourceID.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode DlPRSResourceID", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *DLPRS) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *DLPRS) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	tmp_Prsid := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 255},
+		ext: false,
+	}
+	if err = tmp_Prsid.Decode(r.AperReader); err != nil {
+		err = readError("Prsid", err)
+		return
+	}
+	ie.Prsid = int64(tmp_Prsid.Value)
+	if err = ie.DlPRSResourceSetID.Decode(r.AperReader); err != nil {
+		err = readError("DlPRSResourceSetID", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(PRSResourceID)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("DlPRSResourceID", err)
+			return
+		}
+		ie.DlPRSResourceID = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *DLPRS) Validate() error {
+	var v validator
+	v.integer("Prsid", ie.Prsid, 0, 255)
+	v.ie("DlPRSResourceSetID", &ie.DlPRSResourceSetID)
+	if ie.DlPRSResourceID != nil {
+		v.ie("DlPRSResourceID", ie.DlPRSResourceID)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,241 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	DLPRSMutingPatternPresentNothing uint64 = iota
+	DLPRSMutingPatternPresentTwo
+	DLPRSMutingPatternPresentFour
+	DLPRSMutingPatternPresentSix
+	DLPRSMutingPatternPresentEight
+	DLPRSMutingPatternPresentSixteen
+	DLPRSMutingPatternPresentThirtyTwo
+	DLPRSMutingPatternPresentChoiceExtension
+)
+
+type DLPRSMutingPattern struct {
+	Choice          uint64
+	Two             *aper.BitString `lb:2,ub:2`
+	Four            *aper.BitString `lb:4,ub:4`
+	Six             *aper.BitString `lb:6,ub:6`
+	Eight           *aper.BitString `lb:8,ub:8`
+	Sixteen         *aper.BitString `lb:16,ub:16`
+	ThirtyTwo       *aper.BitString `lb:32,ub:32`
+	ChoiceExtension *RawIE
+}
+
+func (ie *DLPRSMutingPattern) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *DLPRSMutingPattern) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 6, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case DLPRSMutingPatternPresentTwo:
+		tmp_Two := BITSTRING{
+			c:     aper.Constraint{Lb: 2, Ub: 2},
+			ext:   false,
+			Value: *ie.Two,
+		}
+		if err = tmp_Two.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode Two", err)
+			return
+		}
+	case DLPRSMutingPatternPresentFour:
+		tmp_Four := BITSTRING{
+			c:     aper.Constraint{Lb: 4, Ub: 4},
+			ext:   false,
+			Value: *ie.Four,
+		}
+		if err = tmp_Four.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode Four", err)
+			return
+		}
+	case DLPRSMutingPatternPresentSix:
+		tmp_Six := BITSTRING{
+			c:     aper.Constraint{Lb: 6, Ub: 6},
+			ext:   false,
+			Value: *ie.Six,
+		}
+		if err = tmp_Six.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode Six", err)
+			return
+		}
+	case DLPRSMutingPatternPresentEight:
+		tmp_Eight := BITSTRING{
+			c:     aper.Constraint{Lb: 8, Ub: 8},
+			ext:   false,
+			Value: *ie.Eight,
+		}
+		if err = tmp_Eight.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode Eight", err)
+			return
+		}
+	case DLPRSMutingPatternPresentSixteen:
+		tmp_Sixteen := BITSTRING{
+			c:     aper.Constraint{Lb: 16, Ub: 16},
+			ext:   false,
+			Value: *ie.Sixteen,
+		}
+		if err = tmp_Sixteen.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode Sixteen", err)
+			return
+		}
+	case DLPRSMutingPatternPresentThirtyTwo:
+		tmp_ThirtyTwo := BITSTRING{
+			c:     aper.Constraint{Lb: 32, Ub: 32},
+			ext:   false,
+			Value: *ie.ThirtyTwo,
+		}
+		if err = tmp_ThirtyTwo.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode ThirtyTwo", err)
+			return
+		}
+	case DLPRSMutingPatternPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *DLPRSMutingPattern) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *DLPRSMutingPattern) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(6, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case DLPRSMutingPatternPresentTwo:
+		tmp_Two := BITSTRING{
+			c:   aper.Constraint{Lb: 2, Ub: 2},
+			ext: false,
+		}
+		if err = tmp_Two.Decode(r.AperReader); err != nil {
+			err = readError("Two", err)
+			return
+		}
+		tmp := tmp_Two.Value
+		ie.Two = &tmp
+	case DLPRSMutingPatternPresentFour:
+		tmp_Four := BITSTRING{
+			c:   aper.Constraint{Lb: 4, Ub: 4},
+			ext: false,
+		}
+		if err = tmp_Four.Decode(r.AperReader); err != nil {
+			err = readError("Four", err)
+			return
+		}
+		tmp := tmp_Four.Value
+		ie.Four = &tmp
+	case DLPRSMutingPatternPresentSix:
+		tmp_Six := BITSTRING{
+			c:   aper.Constraint{Lb: 6, Ub: 6},
+			ext: false,
+		}
+		if err = tmp_Six.Decode(r.AperReader); err != nil {
+			err = readError("Six", err)
+			return
+		}
+		tmp := tmp_Six.Value
+		ie.Six = &tmp
+	case DLPRSMutingPatternPresentEight:
+		tmp_Eight := BITSTRING{
+			c:   aper.Constraint{Lb: 8, Ub: 8},
+			ext: false,
+		}
+		if err = tmp_Eight.Decode(r.AperReader); err != nil {
+			err = readError("Eight", err)
+			return
+		}
+		tmp := tmp_Eight.Value
+		ie.Eight = &tmp
+	case DLPRSMutingPatternPresentSixteen:
+		tmp_Sixteen := BITSTRING{
+			c:   aper.Constraint{Lb: 16, Ub: 16},
+			ext: false,
+		}
+		if err = tmp_Sixteen.Decode(r.AperReader); err != nil {
+			err = readError("Sixteen", err)
+			return
+		}
+		tmp := tmp_Sixteen.Value
+		ie.Sixteen = &tmp
+	case DLPRSMutingPatternPresentThirtyTwo:
+		tmp_ThirtyTwo := BITSTRING{
+			c:   aper.Constraint{Lb: 32, Ub: 32},
+			ext: false,
+		}
+		if err = tmp_ThirtyTwo.Decode(r.AperReader); err != nil {
+			err = readError("ThirtyTwo", err)
+			return
+		}
+		tmp := tmp_ThirtyTwo.Value
+		ie.ThirtyTwo = &tmp
+	case DLPRSMutingPatternPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *DLPRSMutingPattern) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case DLPRSMutingPatternPresentTwo:
+		if ie.Two == nil {
+			v.absent("Two")
+		} else {
+			v.size("Two", int(ie.Two.NumBits), 2, 2)
+		}
+	case DLPRSMutingPatternPresentFour:
+		if ie.Four == nil {
+			v.absent("Four")
+		} else {
+			v.size("Four", int(ie.Four.NumBits), 4, 4)
+		}
+	case DLPRSMutingPatternPresentSix:
+		if ie.Six == nil {
+			v.absent("Six")
+		} else {
+			v.size("Six", int(ie.Six.NumBits), 6, 6)
+		}
+	case DLPRSMutingPatternPresentEight:
+		if ie.Eight == nil {
+			v.absent("Eight")
+		} else {
+			v.size("Eight", int(ie.Eight.NumBits), 8, 8)
+		}
+	case DLPRSMutingPatternPresentSixteen:
+		if ie.Sixteen == nil {
+			v.absent("Sixteen")
+		} else {
+			v.size("Sixteen", int(ie.Sixteen.NumBits), 16, 16)
+		}
+	case DLPRSMutingPatternPresentThirtyTwo:
+		if ie.ThirtyTwo == nil {
+			v.absent("ThirtyTwo")
+		} else {
+			v.size("ThirtyTwo", int(ie.ThirtyTwo.NumBits), 32, 32)
+		}
+	case DLPRSMutingPatternPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
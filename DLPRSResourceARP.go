@@ -0,0 +1,87 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type DLPRSResourceARP struct {
+	DLPRSResourceID          PRSResourceID            `mandatory`
+	DLPRSResourceARPLocation DLPRSResourceARPLocation `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[DLPRSResourceARP] `optional,extension`
+}
+
+func (ie *DLPRSResourceARP) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *DLPRSResourceARP) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.DLPRSResourceID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode DLPRSResourceID", err)
+		return
+	}
+	if err = ie.DLPRSResourceARPLocation.encode(w); err != nil {
+		err = utils.WrapError("Encode DLPRSResourceARPLocation", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *DLPRSResourceARP) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *DLPRSResourceARP) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.DLPRSResourceID.Decode(r.AperReader); err != nil {
+		err = readError("DLPRSResourceID", err)
+		return
+	}
+	if err = ie.DLPRSResourceARPLocation.decode(r); err != nil {
+		err = readError("DLPRSResourceARPLocation", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *DLPRSResourceARP) Validate() error {
+	var v validator
+	v.ie("DLPRSResourceID", &ie.DLPRSResourceID)
+	v.ie("DLPRSResourceARPLocation", &ie.DLPRSResourceARPLocation)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
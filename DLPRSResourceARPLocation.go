@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	DLPRSResourceARPLocationPresentNothing uint64 = iota
+	DLPRSResourceARPLocationPresentRelativeGeodeticLocation
+	DLPRSResourceARPLocationPresentRelativeCartesianLocation
+	DLPRSResourceARPLocationPresentChoiceExtension
+)
+
+type DLPRSResourceARPLocation struct {
+	Choice                    uint64
+	RelativeGeodeticLocation  *RelativeGeodeticLocation
+	RelativeCartesianLocation *RelativeCartesianLocation
+	ChoiceExtension           *RawIE
+}
+
+func (ie *DLPRSResourceARPLocation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *DLPRSResourceARPLocation) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case DLPRSResourceARPLocationPresentRelativeGeodeticLocation:
+		if err = ie.RelativeGeodeticLocation.encode(w); err != nil {
+			err = utils.WrapError("Encode RelativeGeodeticLocation", err)
+			return
+		}
+	case DLPRSResourceARPLocationPresentRelativeCartesianLocation:
+		if err = ie.RelativeCartesianLocation.encode(w); err != nil {
+			err = utils.WrapError("Encode RelativeCartesianLocation", err)
+			return
+		}
+	case DLPRSResourceARPLocationPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *DLPRSResourceARPLocation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *DLPRSResourceARPLocation) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case DLPRSResourceARPLocationPresentRelativeGeodeticLocation:
+		tmp := new(RelativeGeodeticLocation)
+		if err = tmp.decode(r); err != nil {
+			err = readError("RelativeGeodeticLocation", err)
+			return
+		}
+		ie.RelativeGeodeticLocation = tmp
+	case DLPRSResourceARPLocationPresentRelativeCartesianLocation:
+		tmp := new(RelativeCartesianLocation)
+		if err = tmp.decode(r); err != nil {
+			err = readError("RelativeCartesianLocation", err)
+			return
+		}
+		ie.RelativeCartesianLocation = tmp
+	case DLPRSResourceARPLocationPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *DLPRSResourceARPLocation) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case DLPRSResourceARPLocationPresentRelativeGeodeticLocation:
+		if ie.RelativeGeodeticLocation == nil {
+			v.absent("RelativeGeodeticLocation")
+		} else {
+			v.ie("RelativeGeodeticLocation", ie.RelativeGeodeticLocation)
+		}
+	case DLPRSResourceARPLocationPresentRelativeCartesianLocation:
+		if ie.RelativeCartesianLocation == nil {
+			v.absent("RelativeCartesianLocation")
+		} else {
+			v.ie("RelativeCartesianLocation", ie.RelativeCartesianLocation)
+		}
+	case DLPRSResourceARPLocationPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
@@ -0,0 +1,92 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type DLPRSResourceCoordinates struct {
+	ListofDLPRSResourceSetARP []DLPRSResourceSetARP `lb:1,ub:maxnoofPRSresourceSets,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[DLPRSResourceCoordinates] `optional,extension`
+}
+
+func (ie *DLPRSResourceCoordinates) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *DLPRSResourceCoordinates) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_ListofDLPRSResourceSetARP := sequenceOf[*DLPRSResourceSetARP]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofPRSresourceSets},
+		ext: false,
+	}
+	for k := range ie.ListofDLPRSResourceSetARP {
+		tmp_ListofDLPRSResourceSetARP.Value = append(tmp_ListofDLPRSResourceSetARP.Value, &ie.ListofDLPRSResourceSetARP[k])
+	}
+	if err = tmp_ListofDLPRSResourceSetARP.encode(w); err != nil {
+		err = utils.WrapError("Encode ListofDLPRSResourceSetARP", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *DLPRSResourceCoordinates) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *DLPRSResourceCoordinates) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	var tmp_ListofDLPRSResourceSetARP []*DLPRSResourceSetARP
+	if tmp_ListofDLPRSResourceSetARP, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofPRSresourceSets}, false, func() *DLPRSResourceSetARP { return new(DLPRSResourceSetARP) }); err != nil {
+		err = readError("ListofDLPRSResourceSetARP", err)
+		return
+	}
+	ie.ListofDLPRSResourceSetARP = make([]DLPRSResourceSetARP, len(tmp_ListofDLPRSResourceSetARP))
+	for k, i := range tmp_ListofDLPRSResourceSetARP {
+		ie.ListofDLPRSResourceSetARP[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *DLPRSResourceCoordinates) Validate() error {
+	var v validator
+	v.size("ListofDLPRSResourceSetARP", len(ie.ListofDLPRSResourceSetARP), 1, maxnoofPRSresourceSets)
+	for i := range ie.ListofDLPRSResourceSetARP {
+		v.item("ListofDLPRSResourceSetARP", i, &ie.ListofDLPRSResourceSetARP[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
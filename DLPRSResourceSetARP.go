@@ -0,0 +1,112 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type DLPRSResourceSetARP struct {
+	DLPRSResourceSetID          PRSResourceSetID            `mandatory`
+	DLPRSResourceSetARPLocation DLPRSResourceSetARPLocation `mandatory`
+	ListofDLPRSResourceARP      []DLPRSResourceARP          `lb:1,ub:maxnoofPRSResourcesPerSet,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[DLPRSResourceSetARP] `optional,extension`
+}
+
+func (ie *DLPRSResourceSetARP) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *DLPRSResourceSetARP) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.DLPRSResourceSetID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode DLPRSResourceSetID", err)
+		return
+	}
+	if err = ie.DLPRSResourceSetARPLocation.encode(w); err != nil {
+		err = utils.WrapError("Encode DLPRSResourceSetARPLocation", err)
+		return
+	}
+	tmp_ListofDLPRSResourceARP := sequenceOf[*DLPRSResourceARP]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofPRSResourcesPerSet},
+		ext: false,
+	}
+	for k := range ie.ListofDLPRSResourceARP {
+		tmp_ListofDLPRSResourceARP.Value = append(tmp_ListofDLPRSResourceARP.Value, &ie.ListofDLPRSResourceARP[k])
+	}
+	if err = tmp_ListofDLPRSResourceARP.encode(w); err != nil {
+		err = utils.WrapError("Encode ListofDLPRSResourceARP", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *DLPRSResourceSetARP) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *DLPRSResourceSetARP) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.DLPRSResourceSetID.Decode(r.AperReader); err != nil {
+		err = readError("DLPRSResourceSetID", err)
+		return
+	}
+	if err = ie.DLPRSResourceSetARPLocation.decode(r); err != nil {
+		err = readError("DLPRSResourceSetARPLocation", err)
+		return
+	}
+	var tmp_ListofDLPRSResourceARP []*DLPRSResourceARP
+	if tmp_ListofDLPRSResourceARP, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofPRSResourcesPerSet}, false, func() *DLPRSResourceARP { return new(DLPRSResourceARP) }); err != nil {
+		err = readError("ListofDLPRSResourceARP", err)
+		return
+	}
+	ie.ListofDLPRSResourceARP = make([]DLPRSResourceARP, len(tmp_ListofDLPRSResourceARP))
+	for k, i := range tmp_ListofDLPRSResourceARP {
+		ie.ListofDLPRSResourceARP[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *DLPRSResourceSetARP) Validate() error {
+	var v validator
+	v.ie("DLPRSResourceSetID", &ie.DLPRSResourceSetID)
+	v.ie("DLPRSResourceSetARPLocation", &ie.DLPRSResourceSetARPLocation)
+	v.size("ListofDLPRSResourceARP", len(ie.ListofDLPRSResourceARP), 1, maxnoofPRSResourcesPerSet)
+	for i := range ie.ListofDLPRSResourceARP {
+		v.item("ListofDLPRSResourceARP", i, &ie.ListofDLPRSResourceARP[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
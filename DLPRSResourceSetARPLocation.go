@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	DLPRSResourceSetARPLocationPresentNothing uint64 = iota
+	DLPRSResourceSetARPLocationPresentRelativeGeodeticLocation
+	DLPRSResourceSetARPLocationPresentRelativeCartesianLocation
+	DLPRSResourceSetARPLocationPresentChoiceExtension
+)
+
+type DLPRSResourceSetARPLocation struct {
+	Choice                    uint64
+	RelativeGeodeticLocation  *RelativeGeodeticLocation
+	RelativeCartesianLocation *RelativeCartesianLocation
+	ChoiceExtension           *RawIE
+}
+
+func (ie *DLPRSResourceSetARPLocation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *DLPRSResourceSetARPLocation) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case DLPRSResourceSetARPLocationPresentRelativeGeodeticLocation:
+		if err = ie.RelativeGeodeticLocation.encode(w); err != nil {
+			err = utils.WrapError("Encode RelativeGeodeticLocation", err)
+			return
+		}
+	case DLPRSResourceSetARPLocationPresentRelativeCartesianLocation:
+		if err = ie.RelativeCartesianLocation.encode(w); err != nil {
+			err = utils.WrapError("Encode RelativeCartesianLocation", err)
+			return
+		}
+	case DLPRSResourceSetARPLocationPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *DLPRSResourceSetARPLocation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *DLPRSResourceSetARPLocation) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case DLPRSResourceSetARPLocationPresentRelativeGeodeticLocation:
+		tmp := new(RelativeGeodeticLocation)
+		if err = tmp.decode(r); err != nil {
+			err = readError("RelativeGeodeticLocation", err)
+			return
+		}
+		ie.RelativeGeodeticLocation = tmp
+	case DLPRSResourceSetARPLocationPresentRelativeCartesianLocation:
+		tmp := new(RelativeCartesianLocation)
+		if err = tmp.decode(r); err != nil {
+			err = readError("RelativeCartesianLocation", err)
+			return
+		}
+		ie.RelativeCartesianLocation = tmp
+	case DLPRSResourceSetARPLocationPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *DLPRSResourceSetARPLocation) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case DLPRSResourceSetARPLocationPresentRelativeGeodeticLocation:
+		if ie.RelativeGeodeticLocation == nil {
+			v.absent("RelativeGeodeticLocation")
+		} else {
+			v.ie("RelativeGeodeticLocation", ie.RelativeGeodeticLocation)
+		}
+	case DLPRSResourceSetARPLocationPresentRelativeCartesianLocation:
+		if ie.RelativeCartesianLocation == nil {
+			v.absent("RelativeCartesianLocation")
+		} else {
+			v.ie("RelativeCartesianLocation", ie.RelativeCartesianLocation)
+		}
+	case DLPRSResourceSetARPLocationPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
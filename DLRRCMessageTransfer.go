@@ -0,0 +1,316 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type DLRRCMessageTransfer struct {
+	GNBCUUEF1APID                   GNBCUUEF1APID                    `mandatory,reject`
+	GNBDUUEF1APID                   GNBDUUEF1APID                    `mandatory,reject`
+	OldGNBDUUEF1APID                *GNBDUUEF1APID                   `optional,reject`
+	SRBID                           SRBID                            `mandatory,reject`
+	ExecuteDuplication              *ExecuteDuplication              `optional,ignore`
+	RRCContainer                    RRCContainer                     `mandatory,reject`
+	RATFrequencyPriorityInformation *RATFrequencyPriorityInformation `optional,reject`
+	RRCDeliveryStatusRequest        *RRCDeliveryStatusRequest        `optional,ignore`
+	UEContextNotRetrievable         *UEContextNotRetrievable         `optional,reject`
+	RedirectedRRCMessage            []byte                           `optional,reject`
+	PLMNAssistanceInfoForNetShar    *PLMNIdentity                    `optional,ignore`
+	NewGNBCUUEF1APID                *GNBCUUEF1APID                   `optional,reject`
+	AdditionalRRMPriorityIndex      *AdditionalRRMPriorityIndex      `optional,ignore`
+	UnknownIEs                      []RawIE
+}
+
+func (msg *DLRRCMessageTransfer) ProcedureCode() int64 {
+	return ProcedureCode_DLRRCMessageTransfer
+}
+
+func (msg *DLRRCMessageTransfer) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *DLRRCMessageTransfer) Criticality() aper.Enumerated {
+	return Criticality_PresentIgnore
+}
+
+func (msg *DLRRCMessageTransfer) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("DLRRCMessageTransfer"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *DLRRCMessageTransfer) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("DLRRCMessageTransfer"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *DLRRCMessageTransfer) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	if msg.OldGNBDUUEF1APID != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_oldgNBDUUEF1APID},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.OldGNBDUUEF1APID,
+		})
+	}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_SRBID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.SRBID,
+	})
+	if msg.ExecuteDuplication != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ExecuteDuplication},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.ExecuteDuplication,
+		})
+	}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_RRCContainer},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.RRCContainer,
+	})
+	if msg.RATFrequencyPriorityInformation != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RATFrequencyPriorityInformation},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.RATFrequencyPriorityInformation,
+		})
+	}
+	if msg.RRCDeliveryStatusRequest != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RRCDeliveryStatusRequest},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.RRCDeliveryStatusRequest,
+		})
+	}
+	if msg.UEContextNotRetrievable != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_UEContextNotRetrievable},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.UEContextNotRetrievable,
+		})
+	}
+	if msg.RedirectedRRCMessage != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RedirectedRRCMessage},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value: &OCTETSTRING{
+				c:     aper.Constraint{Lb: 0, Ub: 0},
+				ext:   false,
+				Value: msg.RedirectedRRCMessage,
+			},
+		})
+	}
+	if msg.PLMNAssistanceInfoForNetShar != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_PLMNAssistanceInfoForNetShar},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.PLMNAssistanceInfoForNetShar,
+		})
+	}
+	if msg.NewGNBCUUEF1APID != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_NewGNBCUUEF1APID},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.NewGNBCUUEF1APID,
+		})
+	}
+	if msg.AdditionalRRMPriorityIndex != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_AdditionalRRMPriorityIndex},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.AdditionalRRMPriorityIndex,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *DLRRCMessageTransfer) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *DLRRCMessageTransfer) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *DLRRCMessageTransfer) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("DLRRCMessageTransfer", wire, limits, dlrrcMessageTransferIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var dlrrcMessageTransferIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_oldgNBDUUEF1APID, Criticality_PresentReject, false},
+	{ProtocolIEID_SRBID, Criticality_PresentReject, true},
+	{ProtocolIEID_ExecuteDuplication, Criticality_PresentIgnore, false},
+	{ProtocolIEID_RRCContainer, Criticality_PresentReject, true},
+	{ProtocolIEID_RATFrequencyPriorityInformation, Criticality_PresentReject, false},
+	{ProtocolIEID_RRCDeliveryStatusRequest, Criticality_PresentIgnore, false},
+	{ProtocolIEID_UEContextNotRetrievable, Criticality_PresentReject, false},
+	{ProtocolIEID_RedirectedRRCMessage, Criticality_PresentReject, false},
+	{ProtocolIEID_PLMNAssistanceInfoForNetShar, Criticality_PresentIgnore, false},
+	{ProtocolIEID_NewGNBCUUEF1APID, Criticality_PresentReject, false},
+	{ProtocolIEID_AdditionalRRMPriorityIndex, Criticality_PresentIgnore, false},
+}
+
+func (msg *DLRRCMessageTransfer) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_oldgNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("OldGNBDUUEF1APID", err)
+			return
+		}
+		msg.OldGNBDUUEF1APID = &tmp
+
+	case ProtocolIEID_SRBID:
+		var tmp SRBID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("SRBID", err)
+			return
+		}
+		msg.SRBID = tmp
+
+	case ProtocolIEID_ExecuteDuplication:
+		var tmp ExecuteDuplication
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("ExecuteDuplication", err)
+			return
+		}
+		msg.ExecuteDuplication = &tmp
+
+	case ProtocolIEID_RRCContainer:
+		var tmp RRCContainer
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RRCContainer", err)
+			return
+		}
+		msg.RRCContainer = tmp
+
+	case ProtocolIEID_RATFrequencyPriorityInformation:
+		var tmp RATFrequencyPriorityInformation
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RATFrequencyPriorityInformation", err)
+			return
+		}
+		msg.RATFrequencyPriorityInformation = &tmp
+
+	case ProtocolIEID_RRCDeliveryStatusRequest:
+		var tmp RRCDeliveryStatusRequest
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RRCDeliveryStatusRequest", err)
+			return
+		}
+		msg.RRCDeliveryStatusRequest = &tmp
+
+	case ProtocolIEID_UEContextNotRetrievable:
+		var tmp UEContextNotRetrievable
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("UEContextNotRetrievable", err)
+			return
+		}
+		msg.UEContextNotRetrievable = &tmp
+
+	case ProtocolIEID_RedirectedRRCMessage:
+		if msg.RedirectedRRCMessage, err = readOctetString(ieR, nil, false); err != nil {
+			err = readError("RedirectedRRCMessage", err)
+			return
+		}
+
+	case ProtocolIEID_PLMNAssistanceInfoForNetShar:
+		var tmp PLMNIdentity
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("PLMNAssistanceInfoForNetShar", err)
+			return
+		}
+		msg.PLMNAssistanceInfoForNetShar = &tmp
+
+	case ProtocolIEID_NewGNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("NewGNBCUUEF1APID", err)
+			return
+		}
+		msg.NewGNBCUUEF1APID = &tmp
+
+	case ProtocolIEID_AdditionalRRMPriorityIndex:
+		var tmp AdditionalRRMPriorityIndex
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("AdditionalRRMPriorityIndex", err)
+			return
+		}
+		msg.AdditionalRRMPriorityIndex = &tmp
+
+	}
+	return
+}
+
+func (msg *DLRRCMessageTransfer) Validate() error {
+	var v validator
+	v.integer("GNBCUUEF1APID", int64(msg.GNBCUUEF1APID.Value), 0, 4294967295)
+	v.integer("GNBDUUEF1APID", int64(msg.GNBDUUEF1APID.Value), 0, 4294967295)
+	if msg.OldGNBDUUEF1APID != nil {
+		v.integer("OldGNBDUUEF1APID", int64(msg.OldGNBDUUEF1APID.Value), 0, 4294967295)
+	}
+	v.integer("SRBID", int64(msg.SRBID.Value), 0, 3)
+	if msg.ExecuteDuplication != nil {
+		v.enumerated("ExecuteDuplication", msg.ExecuteDuplication.Value, 1)
+	}
+	if msg.RRCDeliveryStatusRequest != nil {
+		v.enumerated("RRCDeliveryStatusRequest", msg.RRCDeliveryStatusRequest.Value, 1)
+	}
+	if msg.UEContextNotRetrievable != nil {
+		v.enumerated("UEContextNotRetrievable", msg.UEContextNotRetrievable.Value, 1)
+	}
+	if msg.PLMNAssistanceInfoForNetShar != nil {
+		v.size("PLMNAssistanceInfoForNetShar", len(msg.PLMNAssistanceInfoForNetShar.Value), 3, 3)
+	}
+	if msg.NewGNBCUUEF1APID != nil {
+		v.integer("NewGNBCUUEF1APID", int64(msg.NewGNBCUUEF1APID.Value), 0, 4294967295)
+	}
+	if msg.AdditionalRRMPriorityIndex != nil {
+		v.size("AdditionalRRMPriorityIndex", int(msg.AdditionalRRMPriorityIndex.Value.NumBits), 32, 32)
+	}
+	return v.messageErr("DLRRCMessageTransfer")
+}
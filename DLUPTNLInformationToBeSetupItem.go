@@ -0,0 +1,77 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type DLUPTNLInformationToBeSetupItem struct {
+	DLUPTNLInformation UPTransportLayerInformation `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[DLUPTNLInformationToBeSetupItem] `optional,extension`
+}
+
+func (ie *DLUPTNLInformationToBeSetupItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *DLUPTNLInformationToBeSetupItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.DLUPTNLInformation.encode(w); err != nil {
+		err = utils.WrapError("Encode DLUPTNLInformation", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *DLUPTNLInformationToBeSetupItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *DLUPTNLInformationToBeSetupItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.DLUPTNLInformation.decode(r); err != nil {
+		err = readError("DLUPTNLInformation", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *DLUPTNLInformationToBeSetupItem) Validate() error {
+	var v validator
+	v.ie("DLUPTNLInformation", &ie.DLUPTNLInformation)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
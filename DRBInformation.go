@@ -0,0 +1,133 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type DRBInformation struct {
+	DRBQoS               QoSFlowLevelQoSParameters `mandatory`
+	SNSSAI               SNSSAI                    `mandatory`
+	NotificationControl  *NotificationControl      `optional`
+	FlowsMappedToDRBList []FlowsMappedToDRBItem    `lb:1,ub:maxnoofQoSFlows,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[DRBInformation] `optional,extension`
+}
+
+func (ie *DRBInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *DRBInformation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.NotificationControl != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	if err = ie.DRBQoS.encode(w); err != nil {
+		err = utils.WrapError("Encode DRBQoS", err)
+		return
+	}
+	if err = ie.SNSSAI.encode(w); err != nil {
+		err = utils.WrapError("Encode SNSSAI", err)
+		return
+	}
+	if ie.NotificationControl != nil {
+		if err = ie.NotificationControl.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode NotificationControl", err)
+			return
+		}
+	}
+	tmp_FlowsMappedToDRBList := sequenceOf[*FlowsMappedToDRBItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofQoSFlows},
+		ext: false,
+	}
+	for k := range ie.FlowsMappedToDRBList {
+		tmp_FlowsMappedToDRBList.Value = append(tmp_FlowsMappedToDRBList.Value, &ie.FlowsMappedToDRBList[k])
+	}
+	if err = tmp_FlowsMappedToDRBList.encode(w); err != nil {
+		err = utils.WrapError("Encode FlowsMappedToDRBList", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *DRBInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *DRBInformation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	if err = ie.DRBQoS.decode(r); err != nil {
+		err = readError("DRBQoS", err)
+		return
+	}
+	if err = ie.SNSSAI.decode(r); err != nil {
+		err = readError("SNSSAI", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(NotificationControl)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("NotificationControl", err)
+			return
+		}
+		ie.NotificationControl = tmp
+	}
+	var tmp_FlowsMappedToDRBList []*FlowsMappedToDRBItem
+	if tmp_FlowsMappedToDRBList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofQoSFlows}, false, func() *FlowsMappedToDRBItem { return new(FlowsMappedToDRBItem) }); err != nil {
+		err = readError("FlowsMappedToDRBList", err)
+		return
+	}
+	ie.FlowsMappedToDRBList = make([]FlowsMappedToDRBItem, len(tmp_FlowsMappedToDRBList))
+	for k, i := range tmp_FlowsMappedToDRBList {
+		ie.FlowsMappedToDRBList[k] = *i
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *DRBInformation) Validate() error {
+	var v validator
+	v.ie("DRBQoS", &ie.DRBQoS)
+	v.ie("SNSSAI", &ie.SNSSAI)
+	if ie.NotificationControl != nil {
+		v.ie("NotificationControl", ie.NotificationControl)
+	}
+	v.size("FlowsMappedToDRBList", len(ie.FlowsMappedToDRBList), 1, maxnoofQoSFlows)
+	for i := range ie.FlowsMappedToDRBList {
+		v.item("FlowsMappedToDRBList", i, &ie.FlowsMappedToDRBList[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
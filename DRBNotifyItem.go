@@ -0,0 +1,118 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type DRBNotifyItem struct {
+	DRBID             DRBID             `mandatory`
+	NotificationCause NotificationCause `mandatory`
+	// IEExtensions
+	CurrentQoSParaSetIndex *QoSParaSetNotifyIndex                    `optional,ignore,extension`
+	Extensions             ProtocolExtensionContainer[DRBNotifyItem] `optional,extension`
+}
+
+func (ie *DRBNotifyItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *DRBNotifyItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(ie.extensions())
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.DRBID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode DRBID", err)
+		return
+	}
+	if err = ie.NotificationCause.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode NotificationCause", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *DRBNotifyItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *DRBNotifyItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.DRBID.Decode(r.AperReader); err != nil {
+		err = readError("DRBID", err)
+		return
+	}
+	if err = ie.NotificationCause.Decode(r.AperReader); err != nil {
+		err = readError("NotificationCause", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, ie.decodeExtension); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *DRBNotifyItem) extensions() (exts []F1apMessageIE) {
+	if ie.CurrentQoSParaSetIndex != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CurrentQoSParaSetIndex},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.CurrentQoSParaSetIndex,
+		})
+	}
+	return
+}
+
+func (ie *DRBNotifyItem) decodeExtension(id aper.Integer, r *decoder) (ok bool, err error) {
+	ok = true
+	switch id {
+	case ProtocolIEID_CurrentQoSParaSetIndex:
+		tmp := new(QoSParaSetNotifyIndex)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("CurrentQoSParaSetIndex", err)
+			return
+		}
+		ie.CurrentQoSParaSetIndex = tmp
+	default:
+		ok = false
+	}
+	return
+}
+
+func (ie *DRBNotifyItem) Validate() error {
+	var v validator
+	v.ie("DRBID", &ie.DRBID)
+	v.ie("NotificationCause", &ie.NotificationCause)
+	if ie.CurrentQoSParaSetIndex != nil {
+		v.ie("CurrentQoSParaSetIndex", ie.CurrentQoSParaSetIndex)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,200 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type DRBsRequiredToBeModifiedItem struct {
+	DRBID                           DRBID                             `mandatory`
+	DLUPTNLInformationToBeSetupList []DLUPTNLInformationToBeSetupItem `lb:1,ub:maxnoofDLUPTNLInformation,mandatory`
+	// IEExtensions
+	RLCStatus                        *RLCStatus                                               `optional,ignore,extension`
+	AdditionalPDCPDuplicationTNLList []AdditionalPDCPDuplicationTNLItem                       `lb:1,ub:maxnoofAdditionalPDCPDuplicationTNL,optional,ignore,extension`
+	RLCDuplicationInformation        *RLCDuplicationInformation                               `optional,ignore,extension`
+	AdditionalDuplicationIndication  *AdditionalDuplicationIndication                         `optional,ignore,extension`
+	Extensions                       ProtocolExtensionContainer[DRBsRequiredToBeModifiedItem] `optional,extension`
+}
+
+func (ie *DRBsRequiredToBeModifiedItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *DRBsRequiredToBeModifiedItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(ie.extensions())
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.DRBID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode DRBID", err)
+		return
+	}
+	tmp_DLUPTNLInformationToBeSetupList := sequenceOf[*DLUPTNLInformationToBeSetupItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofDLUPTNLInformation},
+		ext: false,
+	}
+	for k := range ie.DLUPTNLInformationToBeSetupList {
+		tmp_DLUPTNLInformationToBeSetupList.Value = append(tmp_DLUPTNLInformationToBeSetupList.Value, &ie.DLUPTNLInformationToBeSetupList[k])
+	}
+	if err = tmp_DLUPTNLInformationToBeSetupList.encode(w); err != nil {
+		err = utils.WrapError("Encode DLUPTNLInformationToBeSetupList", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *DRBsRequiredToBeModifiedItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *DRBsRequiredToBeModifiedItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.DRBID.Decode(r.AperReader); err != nil {
+		err = readError("DRBID", err)
+		return
+	}
+	var tmp_DLUPTNLInformationToBeSetupList []*DLUPTNLInformationToBeSetupItem
+	if tmp_DLUPTNLInformationToBeSetupList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofDLUPTNLInformation}, false, func() *DLUPTNLInformationToBeSetupItem { return new(DLUPTNLInformationToBeSetupItem) }); err != nil {
+		err = readError("DLUPTNLInformationToBeSetupList", err)
+		return
+	}
+	ie.DLUPTNLInformationToBeSetupList = make([]DLUPTNLInformationToBeSetupItem, len(tmp_DLUPTNLInformationToBeSetupList))
+	for k, i := range tmp_DLUPTNLInformationToBeSetupList {
+		ie.DLUPTNLInformationToBeSetupList[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, ie.decodeExtension); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *DRBsRequiredToBeModifiedItem) extensions() (exts []F1apMessageIE) {
+	if ie.RLCStatus != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RLCStatus},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.RLCStatus,
+		})
+	}
+	if len(ie.AdditionalPDCPDuplicationTNLList) > 0 {
+		tmp_AdditionalPDCPDuplicationTNLList := sequenceOf[*AdditionalPDCPDuplicationTNLItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofAdditionalPDCPDuplicationTNL},
+			ext: false,
+		}
+		for k := range ie.AdditionalPDCPDuplicationTNLList {
+			tmp_AdditionalPDCPDuplicationTNLList.Value = append(tmp_AdditionalPDCPDuplicationTNLList.Value, &ie.AdditionalPDCPDuplicationTNLList[k])
+		}
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_AdditionalPDCPDuplicationTNLList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_AdditionalPDCPDuplicationTNLList,
+		})
+	}
+	if ie.RLCDuplicationInformation != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RLCDuplicationInformation},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.RLCDuplicationInformation,
+		})
+	}
+	if ie.AdditionalDuplicationIndication != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_AdditionalDuplicationIndication},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.AdditionalDuplicationIndication,
+		})
+	}
+	return
+}
+
+func (ie *DRBsRequiredToBeModifiedItem) decodeExtension(id aper.Integer, r *decoder) (ok bool, err error) {
+	ok = true
+	switch id {
+	case ProtocolIEID_RLCStatus:
+		tmp := new(RLCStatus)
+		if err = tmp.decode(r); err != nil {
+			err = readError("RLCStatus", err)
+			return
+		}
+		ie.RLCStatus = tmp
+	case ProtocolIEID_AdditionalPDCPDuplicationTNLList:
+		var tmp []*AdditionalPDCPDuplicationTNLItem
+		if tmp, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofAdditionalPDCPDuplicationTNL}, false, func() *AdditionalPDCPDuplicationTNLItem { return new(AdditionalPDCPDuplicationTNLItem) }); err != nil {
+			err = readError("AdditionalPDCPDuplicationTNLList", err)
+			return
+		}
+		ie.AdditionalPDCPDuplicationTNLList = make([]AdditionalPDCPDuplicationTNLItem, len(tmp))
+		for k, i := range tmp {
+			ie.AdditionalPDCPDuplicationTNLList[k] = *i
+		}
+	case ProtocolIEID_RLCDuplicationInformation:
+		tmp := new(RLCDuplicationInformation)
+		if err = tmp.decode(r); err != nil {
+			err = readError("RLCDuplicationInformation", err)
+			return
+		}
+		ie.RLCDuplicationInformation = tmp
+	case ProtocolIEID_AdditionalDuplicationIndication:
+		tmp := new(AdditionalDuplicationIndication)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("AdditionalDuplicationIndication", err)
+			return
+		}
+		ie.AdditionalDuplicationIndication = tmp
+	default:
+		ok = false
+	}
+	return
+}
+
+func (ie *DRBsRequiredToBeModifiedItem) Validate() error {
+	var v validator
+	v.ie("DRBID", &ie.DRBID)
+	v.size("DLUPTNLInformationToBeSetupList", len(ie.DLUPTNLInformationToBeSetupList), 1, maxnoofDLUPTNLInformation)
+	for i := range ie.DLUPTNLInformationToBeSetupList {
+		v.item("DLUPTNLInformationToBeSetupList", i, &ie.DLUPTNLInformationToBeSetupList[i])
+	}
+	if ie.RLCStatus != nil {
+		v.ie("RLCStatus", ie.RLCStatus)
+	}
+	if len(ie.AdditionalPDCPDuplicationTNLList) > 0 {
+		v.size("AdditionalPDCPDuplicationTNLList", len(ie.AdditionalPDCPDuplicationTNLList), 1, maxnoofAdditionalPDCPDuplicationTNL)
+		for i := range ie.AdditionalPDCPDuplicationTNLList {
+			v.item("AdditionalPDCPDuplicationTNLList", i, &ie.AdditionalPDCPDuplicationTNLList[i])
+		}
+	}
+	if ie.RLCDuplicationInformation != nil {
+		v.ie("RLCDuplicationInformation", ie.RLCDuplicationInformation)
+	}
+	if ie.AdditionalDuplicationIndication != nil {
+		v.ie("AdditionalDuplicationIndication", ie.AdditionalDuplicationIndication)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
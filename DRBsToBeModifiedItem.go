@@ -0,0 +1,341 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type DRBsToBeModifiedItem struct {
+	DRBID                           DRBID                             `mandatory`
+	QoSInformation                  *QoSInformation                   `optional`
+	ULUPTNLInformationToBeSetupList []ULUPTNLInformationToBeSetupItem `lb:1,ub:maxnoofULUPTNLInformation,mandatory`
+	ULConfiguration                 *ULConfiguration                  `optional`
+	// IEExtensions
+	DLPDCPSNLength                   *PDCPSNLength                                    `optional,ignore,extension`
+	ULPDCPSNLength                   *PDCPSNLength                                    `optional,ignore,extension`
+	BearerTypeChange                 *BearerTypeChange                                `optional,ignore,extension`
+	RLCMode                          *RLCMode                                         `optional,ignore,extension`
+	DuplicationActivation            *DuplicationActivation                           `optional,reject,extension`
+	DCBasedDuplicationConfigured     *DCBasedDuplicationConfigured                    `optional,reject,extension`
+	DCBasedDuplicationActivation     *DuplicationActivation                           `optional,reject,extension`
+	AdditionalPDCPDuplicationTNLList []AdditionalPDCPDuplicationTNLItem               `lb:1,ub:maxnoofAdditionalPDCPDuplicationTNL,optional,ignore,extension`
+	RLCDuplicationInformation        *RLCDuplicationInformation                       `optional,ignore,extension`
+	Extensions                       ProtocolExtensionContainer[DRBsToBeModifiedItem] `optional,extension`
+}
+
+func (ie *DRBsToBeModifiedItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *DRBsToBeModifiedItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(ie.extensions())
+	optionals := []byte{0x0}
+	if ie.QoSInformation != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if ie.ULConfiguration != nil {
+		aper.SetBit(optionals, 2)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 3)
+	}
+	if err = w.WriteBits(optionals, 3); err != nil {
+		return
+	}
+	if err = ie.DRBID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode DRBID", err)
+		return
+	}
+	if ie.QoSInformation != nil {
+		if err = ie.QoSInformation.encode(w); err != nil {
+			err = utils.WrapError("Encode QoSInformation", err)
+			return
+		}
+	}
+	tmp_ULUPTNLInformationToBeSetupList := sequenceOf[*ULUPTNLInformationToBeSetupItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofULUPTNLInformation},
+		ext: false,
+	}
+	for k := range ie.ULUPTNLInformationToBeSetupList {
+		tmp_ULUPTNLInformationToBeSetupList.Value = append(tmp_ULUPTNLInformationToBeSetupList.Value, &ie.ULUPTNLInformationToBeSetupList[k])
+	}
+	if err = tmp_ULUPTNLInformationToBeSetupList.encode(w); err != nil {
+		err = utils.WrapError("Encode ULUPTNLInformationToBeSetupList", err)
+		return
+	}
+	if ie.ULConfiguration != nil {
+		if err = ie.ULConfiguration.encode(w); err != nil {
+			err = utils.WrapError("Encode ULConfiguration", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *DRBsToBeModifiedItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *DRBsToBeModifiedItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(3); err != nil {
+		return
+	}
+	if err = ie.DRBID.Decode(r.AperReader); err != nil {
+		err = readError("DRBID", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(QoSInformation)
+		if err = tmp.decode(r); err != nil {
+			err = readError("QoSInformation", err)
+			return
+		}
+		ie.QoSInformation = tmp
+	}
+	var tmp_ULUPTNLInformationToBeSetupList []*ULUPTNLInformationToBeSetupItem
+	if tmp_ULUPTNLInformationToBeSetupList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofULUPTNLInformation}, false, func() *ULUPTNLInformationToBeSetupItem { return new(ULUPTNLInformationToBeSetupItem) }); err != nil {
+		err = readError("ULUPTNLInformationToBeSetupList", err)
+		return
+	}
+	ie.ULUPTNLInformationToBeSetupList = make([]ULUPTNLInformationToBeSetupItem, len(tmp_ULUPTNLInformationToBeSetupList))
+	for k, i := range tmp_ULUPTNLInformationToBeSetupList {
+		ie.ULUPTNLInformationToBeSetupList[k] = *i
+	}
+	if aper.IsBitSet(optionals, 2) {
+		tmp := new(ULConfiguration)
+		if err = tmp.decode(r); err != nil {
+			err = readError("ULConfiguration", err)
+			return
+		}
+		ie.ULConfiguration = tmp
+	}
+	if aper.IsBitSet(optionals, 3) {
+		if err = ie.Extensions.decode(r, ie.decodeExtension); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *DRBsToBeModifiedItem) extensions() (exts []F1apMessageIE) {
+	if ie.DLPDCPSNLength != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DLPDCPSNLength},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.DLPDCPSNLength,
+		})
+	}
+	if ie.ULPDCPSNLength != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ULPDCPSNLength},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.ULPDCPSNLength,
+		})
+	}
+	if ie.BearerTypeChange != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_BearerTypeChange},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.BearerTypeChange,
+		})
+	}
+	if ie.RLCMode != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RLCMode},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.RLCMode,
+		})
+	}
+	if ie.DuplicationActivation != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DuplicationActivation},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       ie.DuplicationActivation,
+		})
+	}
+	if ie.DCBasedDuplicationConfigured != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DCBasedDuplicationConfigured},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       ie.DCBasedDuplicationConfigured,
+		})
+	}
+	if ie.DCBasedDuplicationActivation != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DCBasedDuplicationActivation},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       ie.DCBasedDuplicationActivation,
+		})
+	}
+	if len(ie.AdditionalPDCPDuplicationTNLList) > 0 {
+		tmp_AdditionalPDCPDuplicationTNLList := sequenceOf[*AdditionalPDCPDuplicationTNLItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofAdditionalPDCPDuplicationTNL},
+			ext: false,
+		}
+		for k := range ie.AdditionalPDCPDuplicationTNLList {
+			tmp_AdditionalPDCPDuplicationTNLList.Value = append(tmp_AdditionalPDCPDuplicationTNLList.Value, &ie.AdditionalPDCPDuplicationTNLList[k])
+		}
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_AdditionalPDCPDuplicationTNLList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_AdditionalPDCPDuplicationTNLList,
+		})
+	}
+	if ie.RLCDuplicationInformation != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RLCDuplicationInformation},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.RLCDuplicationInformation,
+		})
+	}
+	return
+}
+
+func (ie *DRBsToBeModifiedItem) decodeExtension(id aper.Integer, r *decoder) (ok bool, err error) {
+	ok = true
+	switch id {
+	case ProtocolIEID_DLPDCPSNLength:
+		tmp := new(PDCPSNLength)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("DLPDCPSNLength", err)
+			return
+		}
+		ie.DLPDCPSNLength = tmp
+	case ProtocolIEID_ULPDCPSNLength:
+		tmp := new(PDCPSNLength)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("ULPDCPSNLength", err)
+			return
+		}
+		ie.ULPDCPSNLength = tmp
+	case ProtocolIEID_BearerTypeChange:
+		tmp := new(BearerTypeChange)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("BearerTypeChange", err)
+			return
+		}
+		ie.BearerTypeChange = tmp
+	case ProtocolIEID_RLCMode:
+		tmp := new(RLCMode)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("RLCMode", err)
+			return
+		}
+		ie.RLCMode = tmp
+	case ProtocolIEID_DuplicationActivation:
+		tmp := new(DuplicationActivation)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("DuplicationActivation", err)
+			return
+		}
+		ie.DuplicationActivation = tmp
+	case ProtocolIEID_DCBasedDuplicationConfigured:
+		tmp := new(DCBasedDuplicationConfigured)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("DCBasedDuplicationConfigured", err)
+			return
+		}
+		ie.DCBasedDuplicationConfigured = tmp
+	case ProtocolIEID_DCBasedDuplicationActivation:
+		tmp := new(DuplicationActivation)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("DCBasedDuplicationActivation", err)
+			return
+		}
+		ie.DCBasedDuplicationActivation = tmp
+	case ProtocolIEID_AdditionalPDCPDuplicationTNLList:
+		var tmp []*AdditionalPDCPDuplicationTNLItem
+		if tmp, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofAdditionalPDCPDuplicationTNL}, false, func() *AdditionalPDCPDuplicationTNLItem { return new(AdditionalPDCPDuplicationTNLItem) }); err != nil {
+			err = readError("AdditionalPDCPDuplicationTNLList", err)
+			return
+		}
+		ie.AdditionalPDCPDuplicationTNLList = make([]AdditionalPDCPDuplicationTNLItem, len(tmp))
+		for k, i := range tmp {
+			ie.AdditionalPDCPDuplicationTNLList[k] = *i
+		}
+	case ProtocolIEID_RLCDuplicationInformation:
+		tmp := new(RLCDuplicationInformation)
+		if err = tmp.decode(r); err != nil {
+			err = readError("RLCDuplicationInformation", err)
+			return
+		}
+		ie.RLCDuplicationInformation = tmp
+	default:
+		ok = false
+	}
+	return
+}
+
+func (ie *DRBsToBeModifiedItem) Validate() error {
+	var v validator
+	v.ie("DRBID", &ie.DRBID)
+	if ie.QoSInformation != nil {
+		v.ie("QoSInformation", ie.QoSInformation)
+	}
+	v.size("ULUPTNLInformationToBeSetupList", len(ie.ULUPTNLInformationToBeSetupList), 1, maxnoofULUPTNLInformation)
+	for i := range ie.ULUPTNLInformationToBeSetupList {
+		v.item("ULUPTNLInformationToBeSetupList", i, &ie.ULUPTNLInformationToBeSetupList[i])
+	}
+	if ie.ULConfiguration != nil {
+		v.ie("ULConfiguration", ie.ULConfiguration)
+	}
+	if ie.DLPDCPSNLength != nil {
+		v.ie("DLPDCPSNLength", ie.DLPDCPSNLength)
+	}
+	if ie.ULPDCPSNLength != nil {
+		v.ie("ULPDCPSNLength", ie.ULPDCPSNLength)
+	}
+	if ie.BearerTypeChange != nil {
+		v.ie("BearerTypeChange", ie.BearerTypeChange)
+	}
+	if ie.RLCMode != nil {
+		v.ie("RLCMode", ie.RLCMode)
+	}
+	if ie.DuplicationActivation != nil {
+		v.ie("DuplicationActivation", ie.DuplicationActivation)
+	}
+	if ie.DCBasedDuplicationConfigured != nil {
+		v.ie("DCBasedDuplicationConfigured", ie.DCBasedDuplicationConfigured)
+	}
+	if ie.DCBasedDuplicationActivation != nil {
+		v.ie("DCBasedDuplicationActivation", ie.DCBasedDuplicationActivation)
+	}
+	if len(ie.AdditionalPDCPDuplicationTNLList) > 0 {
+		v.size("AdditionalPDCPDuplicationTNLList", len(ie.AdditionalPDCPDuplicationTNLList), 1, maxnoofAdditionalPDCPDuplicationTNL)
+		for i := range ie.AdditionalPDCPDuplicationTNLList {
+			v.item("AdditionalPDCPDuplicationTNLList", i, &ie.AdditionalPDCPDuplicationTNLList[i])
+		}
+	}
+	if ie.RLCDuplicationInformation != nil {
+		v.ie("RLCDuplicationInformation", ie.RLCDuplicationInformation)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	// DC Based Duplication Activation is present when DC Based Duplication
+	// Configured is present and set to true (C-ifDCBasedDuplicationConfigured)
+	if c := ie.DCBasedDuplicationConfigured; c != nil && c.Value == DCBasedDuplicationConfiguredTrue {
+		if ie.DCBasedDuplicationActivation == nil {
+			v.absent("DCBasedDuplicationActivation")
+		}
+	} else if ie.DCBasedDuplicationActivation != nil {
+		v.violation("DCBasedDuplicationActivation", ie.DCBasedDuplicationActivation.Value)
+	}
+	return v.err()
+}
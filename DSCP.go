@@ -0,0 +1,35 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type DSCP struct {
+	Value aper.BitString `aper:"sizeLB:6,sizeUB:6"`
+}
+
+func (ie *DSCP) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteBitString(ie.Value.Bytes, uint(ie.Value.NumBits), &aper.Constraint{Lb: 6, Ub: 6}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *DSCP) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *DSCP) decode(r *decoder) error {
+	if v, n, err := readBitString(r, &aper.Constraint{Lb: 6, Ub: 6}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.BitString{Bytes: v, NumBits: uint64(n)}
+	}
+	return nil
+}
+
+func (ie *DSCP) Validate() error {
+	var v validator
+	v.size("Value", int(ie.Value.NumBits), 6, 6)
+	return v.err()
+}
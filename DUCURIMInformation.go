@@ -0,0 +1,112 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type DUCURIMInformation struct {
+	VictimgNBSetID       GNBSetID                `mandatory`
+	RIMRSDetectionStatus RIMRSDetectionStatus    `mandatory`
+	AggressorCellList    []AggressorCellListItem `lb:1,ub:maxCellingNBDU,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[DUCURIMInformation] `optional,extension`
+}
+
+func (ie *DUCURIMInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *DUCURIMInformation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.VictimgNBSetID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode VictimgNBSetID", err)
+		return
+	}
+	if err = ie.RIMRSDetectionStatus.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode RIMRSDetectionStatus", err)
+		return
+	}
+	tmp_AggressorCellList := sequenceOf[*AggressorCellListItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxCellingNBDU},
+		ext: false,
+	}
+	for k := range ie.AggressorCellList {
+		tmp_AggressorCellList.Value = append(tmp_AggressorCellList.Value, &ie.AggressorCellList[k])
+	}
+	if err = tmp_AggressorCellList.encode(w); err != nil {
+		err = utils.WrapError("Encode AggressorCellList", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *DUCURIMInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *DUCURIMInformation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.VictimgNBSetID.decode(r); err != nil {
+		err = readError("VictimgNBSetID", err)
+		return
+	}
+	if err = ie.RIMRSDetectionStatus.Decode(r.AperReader); err != nil {
+		err = readError("RIMRSDetectionStatus", err)
+		return
+	}
+	var tmp_AggressorCellList []*AggressorCellListItem
+	if tmp_AggressorCellList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxCellingNBDU}, false, func() *AggressorCellListItem { return new(AggressorCellListItem) }); err != nil {
+		err = readError("AggressorCellList", err)
+		return
+	}
+	ie.AggressorCellList = make([]AggressorCellListItem, len(tmp_AggressorCellList))
+	for k, i := range tmp_AggressorCellList {
+		ie.AggressorCellList[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *DUCURIMInformation) Validate() error {
+	var v validator
+	v.ie("VictimgNBSetID", &ie.VictimgNBSetID)
+	v.ie("RIMRSDetectionStatus", &ie.RIMRSDetectionStatus)
+	v.size("AggressorCellList", len(ie.AggressorCellList), 1, maxCellingNBDU)
+	for i := range ie.AggressorCellList {
+		v.item("AggressorCellList", i, &ie.AggressorCellList[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
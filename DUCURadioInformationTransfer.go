@@ -0,0 +1,109 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type DUCURadioInformationTransfer struct {
+	TransactionID            TransactionID            `mandatory,reject`
+	DUCURadioInformationType DUCURadioInformationType `mandatory,ignore`
+	UnknownIEs               []RawIE
+}
+
+func (msg *DUCURadioInformationTransfer) ProcedureCode() int64 {
+	return ProcedureCode_DUCURadioInformationTransfer
+}
+
+func (msg *DUCURadioInformationTransfer) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *DUCURadioInformationTransfer) Criticality() aper.Enumerated {
+	return Criticality_PresentIgnore
+}
+
+func (msg *DUCURadioInformationTransfer) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("DUCURadioInformationTransfer"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *DUCURadioInformationTransfer) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("DUCURadioInformationTransfer"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *DUCURadioInformationTransfer) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_TransactionID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.TransactionID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_DUCURadioInformationType},
+		Criticality: Criticality{Value: Criticality_PresentIgnore},
+		Value:       &msg.DUCURadioInformationType,
+	})
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *DUCURadioInformationTransfer) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *DUCURadioInformationTransfer) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *DUCURadioInformationTransfer) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("DUCURadioInformationTransfer", wire, limits, ducuRadioInformationTransferIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var ducuRadioInformationTransferIEs = []messageIE{
+	{ProtocolIEID_TransactionID, Criticality_PresentReject, true},
+	{ProtocolIEID_DUCURadioInformationType, Criticality_PresentIgnore, true},
+}
+
+func (msg *DUCURadioInformationTransfer) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_TransactionID:
+		var tmp TransactionID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("TransactionID", err)
+			return
+		}
+		msg.TransactionID = tmp
+
+	case ProtocolIEID_DUCURadioInformationType:
+		var tmp DUCURadioInformationType
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("DUCURadioInformationType", err)
+			return
+		}
+		msg.DUCURadioInformationType = tmp
+
+	}
+	return
+}
+
+func (msg *DUCURadioInformationTransfer) Validate() error {
+	var v validator
+	v.integer("TransactionID", int64(msg.TransactionID.Value), 0, 255)
+	v.ie("DUCURadioInformationType", &msg.DUCURadioInformationType)
+	return v.messageErr("DUCURadioInformationTransfer")
+}
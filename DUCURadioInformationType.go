@@ -0,0 +1,87 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	DUCURadioInformationTypePresentNothing uint64 = iota
+	DUCURadioInformationTypePresentRIM
+	DUCURadioInformationTypePresentChoiceExtension
+)
+
+type DUCURadioInformationType struct {
+	Choice          uint64
+	RIM             *DUCURIMInformation
+	ChoiceExtension *RawIE
+}
+
+func (ie *DUCURadioInformationType) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *DUCURadioInformationType) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 1, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case DUCURadioInformationTypePresentRIM:
+		if err = ie.RIM.encode(w); err != nil {
+			err = utils.WrapError("Encode RIM", err)
+			return
+		}
+	case DUCURadioInformationTypePresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *DUCURadioInformationType) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *DUCURadioInformationType) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(1, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case DUCURadioInformationTypePresentRIM:
+		tmp := new(DUCURIMInformation)
+		if err = tmp.decode(r); err != nil {
+			err = readError("RIM", err)
+			return
+		}
+		ie.RIM = tmp
+	case DUCURadioInformationTypePresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *DUCURadioInformationType) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case DUCURadioInformationTypePresentRIM:
+		if ie.RIM == nil {
+			v.absent("RIM")
+		} else {
+			v.ie("RIM", ie.RIM)
+		}
+	case DUCURadioInformationTypePresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
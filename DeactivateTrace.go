@@ -0,0 +1,125 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type DeactivateTrace struct {
+	GNBCUUEF1APID GNBCUUEF1APID `mandatory,reject`
+	GNBDUUEF1APID GNBDUUEF1APID `mandatory,reject`
+	TraceID       TraceID       `mandatory,ignore`
+	UnknownIEs    []RawIE
+}
+
+func (msg *DeactivateTrace) ProcedureCode() int64 {
+	return ProcedureCode_DeactivateTrace
+}
+
+func (msg *DeactivateTrace) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *DeactivateTrace) Criticality() aper.Enumerated {
+	return Criticality_PresentIgnore
+}
+
+func (msg *DeactivateTrace) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("DeactivateTrace"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *DeactivateTrace) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("DeactivateTrace"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *DeactivateTrace) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_TraceID},
+		Criticality: Criticality{Value: Criticality_PresentIgnore},
+		Value:       &msg.TraceID,
+	})
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *DeactivateTrace) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *DeactivateTrace) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *DeactivateTrace) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("DeactivateTrace", wire, limits, deactivateTraceIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var deactivateTraceIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_TraceID, Criticality_PresentIgnore, true},
+}
+
+func (msg *DeactivateTrace) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_TraceID:
+		var tmp TraceID
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("TraceID", err)
+			return
+		}
+		msg.TraceID = tmp
+
+	}
+	return
+}
+
+func (msg *DeactivateTrace) Validate() error {
+	var v validator
+	v.integer("GNBCUUEF1APID", int64(msg.GNBCUUEF1APID.Value), 0, 4294967295)
+	v.integer("GNBDUUEF1APID", int64(msg.GNBDUUEF1APID.Value), 0, 4294967295)
+	v.ie("TraceID", &msg.TraceID)
+	return v.messageErr("DeactivateTrace")
+}
@@ -0,0 +1,156 @@
+package ies
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+// categories of decode errors, matched by errors.Is; use errors.As with the
+// error types for the details
+var (
+	ErrTransferSyntax      = errors.New("transfer syntax error")
+	ErrAbstractSyntax      = errors.New("abstract syntax error")
+	ErrMissingMandatoryIE  = errors.New("missing mandatory IE")
+	ErrDuplicateIE         = errors.New("duplicate IE")
+	ErrConstraintViolation = errors.New("constraint violation")
+	ErrLimitExceeded       = errors.New("decode limit exceeded")
+)
+
+// subtypes of AbstractSyntaxError, one per abstract syntax error value of
+// CauseProtocol
+const (
+	AbstractSyntaxErrorReject             uint8 = iota + 1 // IE not comprehended, criticality reject
+	AbstractSyntaxErrorIgnoreAndNotify                     // IE not comprehended, criticality notify
+	AbstractSyntaxErrorFalselyConstructed                  // IEs in wrong order or with too many occurrences
+)
+
+// ErrorPath locates a decode error: the message and the IEs leading to the
+// failing one, outermost first. Message is empty when an IE is decoded on its
+// own.
+type ErrorPath struct {
+	Message string
+	Path    []string
+}
+
+func (p *ErrorPath) String() string {
+	names := p.Path
+	if p.Message != "" {
+		names = append([]string{p.Message}, p.Path...)
+	}
+	return strings.Join(names, "/")
+}
+
+func (p *ErrorPath) errorPath() *ErrorPath {
+	return p
+}
+
+// the APER encoding cannot be read; Err is the error of the reader
+type TransferSyntaxError struct {
+	ErrorPath
+	Err error
+}
+
+func (e *TransferSyntaxError) Error() string {
+	return fmt.Sprintf("%s: %v: %v", e.String(), ErrTransferSyntax, e.Err)
+}
+
+func (e *TransferSyntaxError) Unwrap() error        { return e.Err }
+func (e *TransferSyntaxError) Is(target error) bool { return target == ErrTransferSyntax }
+
+// an IE is not comprehended or the message is falsely constructed
+type AbstractSyntaxError struct {
+	ErrorPath
+	Subtype uint8
+	ID      aper.Integer
+}
+
+func (e *AbstractSyntaxError) Error() string {
+	var s string
+	switch e.Subtype {
+	case AbstractSyntaxErrorReject:
+		s = "reject"
+	case AbstractSyntaxErrorIgnoreAndNotify:
+		s = "ignore and notify"
+	case AbstractSyntaxErrorFalselyConstructed:
+		s = "falsely constructed message"
+	}
+	return fmt.Sprintf("%s: %v (%s): IE %d", e.String(), ErrAbstractSyntax, s, e.ID)
+}
+
+func (e *AbstractSyntaxError) Is(target error) bool { return target == ErrAbstractSyntax }
+
+// a mandatory IE of a message is absent
+type MissingMandatoryIE struct {
+	ErrorPath
+	ID aper.Integer
+}
+
+func (e *MissingMandatoryIE) Error() string {
+	return fmt.Sprintf("%s: %v %d (%s)", e.String(), ErrMissingMandatoryIE, e.ID, protocolIEIDNames[e.ID])
+}
+
+func (e *MissingMandatoryIE) Is(target error) bool { return target == ErrMissingMandatoryIE }
+
+// an IE is received more than once in a message
+type DuplicateIE struct {
+	ErrorPath
+	ID aper.Integer
+}
+
+func (e *DuplicateIE) Error() string {
+	return fmt.Sprintf("%s: %v %d (%s)", e.String(), ErrDuplicateIE, e.ID, protocolIEIDNames[e.ID])
+}
+
+func (e *DuplicateIE) Is(target error) bool { return target == ErrDuplicateIE }
+
+// a value is out of the range or size allowed for Field
+type ConstraintViolation struct {
+	ErrorPath
+	Field string
+	Value any
+}
+
+func (e *ConstraintViolation) Error() string {
+	return fmt.Sprintf("%s: %v: %s = %v", e.String(), ErrConstraintViolation, e.Field, e.Value)
+}
+
+func (e *ConstraintViolation) Is(target error) bool { return target == ErrConstraintViolation }
+
+// a PDU exceeds a decode limit; Limit names the field of DecodeLimits and
+// Value is the size found
+type LimitError struct {
+	ErrorPath
+	Limit string
+	Value int
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%s: %v: %s = %d", e.String(), ErrLimitExceeded, e.Limit, e.Value)
+}
+
+func (e *LimitError) Is(target error) bool { return target == ErrLimitExceeded }
+
+// prefix the path of a decode error with the name of the IE being read;
+// other errors come from the APER reader and become transfer syntax errors
+func readError(name string, err error) error {
+	var pe interface{ errorPath() *ErrorPath }
+	if errors.As(err, &pe) {
+		p := pe.errorPath()
+		p.Path = append([]string{name}, p.Path...)
+		return err
+	}
+	return &TransferSyntaxError{ErrorPath: ErrorPath{Path: []string{name}}, Err: err}
+}
+
+// set the message of a decode error
+func messageError(name string, err error) error {
+	var pe interface{ errorPath() *ErrorPath }
+	if errors.As(err, &pe) {
+		pe.errorPath().Message = name
+		return err
+	}
+	return &TransferSyntaxError{ErrorPath: ErrorPath{Message: name}, Err: err}
+}
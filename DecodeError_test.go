@@ -0,0 +1,72 @@
+package ies
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeErrorTypes(t *testing.T) {
+	var msg Notify
+	_, err := msg.DecodeWithReport(messageValue(notifyCU, notifyDRBs))
+	var missing *MissingMandatoryIE
+	if !errors.Is(err, ErrMissingMandatoryIE) || !errors.As(err, &missing) ||
+		missing.Message != "Notify" || missing.ID != ProtocolIEID_gNBDUUEF1APID {
+		t.Fatalf("missing IE: %v", err)
+	}
+
+	_, err = msg.DecodeWithReport(messageValue(notifyCU, notifyDU, notifyDU, notifyDRBs))
+	var duplicate *DuplicateIE
+	if !errors.Is(err, ErrDuplicateIE) || !errors.As(err, &duplicate) || duplicate.ID != ProtocolIEID_gNBDUUEF1APID {
+		t.Fatalf("duplicate IE: %v", err)
+	}
+	if errors.Is(err, ErrMissingMandatoryIE) || errors.Is(err, ErrTransferSyntax) {
+		t.Fatalf("duplicate IE matches other categories: %v", err)
+	}
+
+	_, err = msg.DecodeWithReport(messageValue(notifyDU, notifyCU, notifyDRBs))
+	var abstract *AbstractSyntaxError
+	if !errors.Is(err, ErrAbstractSyntax) || !errors.As(err, &abstract) ||
+		abstract.Subtype != AbstractSyntaxErrorFalselyConstructed || abstract.ID != ProtocolIEID_gNBCUUEF1APID {
+		t.Fatalf("IEs out of order: %v", err)
+	}
+
+	_, err = msg.DecodeWithReport(messageValue(notifyCU, notifyDU, notifyDRBs, unknownIE(0x00)))
+	if !errors.As(err, &abstract) || abstract.Subtype != AbstractSyntaxErrorReject || abstract.ID != 9999 {
+		t.Fatalf("IE not comprehended: %v", err)
+	}
+
+	// two missing IEs are both reported
+	_, err = msg.DecodeWithReport(messageValue(notifyDRBs))
+	var rep *DecodeReport
+	if !errors.As(err, &rep) || len(rep.Unwrap()) != 2 {
+		t.Fatalf("missing IEs: %v", err)
+	}
+}
+
+func TestDecodeErrorTransferSyntax(t *testing.T) {
+	wire := messageValue(notifyCU, notifyDU, notifyDRBs)
+	var msg Notify
+	_, err := msg.DecodeWithReport(wire[:len(wire)-3])
+	var ts *TransferSyntaxError
+	if !errors.Is(err, ErrTransferSyntax) || !errors.As(err, &ts) || ts.Message != "Notify" || ts.Err == nil {
+		t.Fatalf("truncated message: %v", err)
+	}
+	if errors.Is(err, ErrAbstractSyntax) {
+		t.Fatalf("truncated message is an abstract syntax error: %v", err)
+	}
+
+	// a DRB notify item without notification cause
+	drbs := []byte{0x00, 0x89, 0x00, 0x06, 0x00, 0x00, 0x88, 0x00, 0x01, 0x04}
+	_, err = msg.DecodeWithReport(messageValue(notifyCU, notifyDU, drbs))
+	if !errors.As(err, &ts) {
+		t.Fatalf("truncated IE: %v", err)
+	}
+	want := ErrorPath{Message: "Notify", Path: []string{"DRBNotifyList", "NotificationCause"}}
+	if !reflect.DeepEqual(ts.ErrorPath, want) {
+		t.Fatalf("error path %+v, want %+v", ts.ErrorPath, want)
+	}
+	if s := ts.ErrorPath.String(); s != "Notify/DRBNotifyList/NotificationCause" {
+		t.Fatalf("error path %s", s)
+	}
+}
@@ -0,0 +1,248 @@
+package ies
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+// DecodeLimits bounds the resources spent on decoding a received PDU, so that
+// a hostile or faulty peer cannot make the decoder allocate without bound. A
+// zero field leaves the resource unbounded.
+type DecodeLimits struct {
+	MaxPDUSize    int // octets of a PDU or message value
+	MaxIEs        int // IEs in a protocol IE or extension container
+	MaxListLength int // items of a list
+	MaxDepth      int // nesting of open types in a PDU
+	MaxAllocation int // octets of the open type values read from a PDU
+}
+
+// DefaultDecodeLimits returns the limits of the decoding functions and
+// methods that take none, and of IEs decoded with a reader this package did
+// not create
+func DefaultDecodeLimits() DecodeLimits {
+	return DecodeLimits{
+		MaxPDUSize:    1 << 20,
+		MaxIEs:        256,
+		MaxListLength: maxnoofMappingEntries, // the largest list bound of TS 38.473
+		MaxDepth:      16,
+		MaxAllocation: 4 << 20,
+	}
+}
+
+// the resources spent on decoding a PDU
+type decodeBudget struct {
+	limits    DecodeLimits
+	allocated int
+}
+
+// a reader over a PDU or an open type value in a PDU, with the budget of the
+// PDU and the nesting of the open type
+type decoder struct {
+	*aper.AperReader
+	budget *decodeBudget
+	depth  int
+}
+
+// a value decoded within the limits of a decoder
+type limitedDecoder interface {
+	decode(r *decoder) error
+}
+
+func checkLimit(limit string, value, max int) error {
+	if max > 0 && value > max {
+		return &LimitError{Limit: limit, Value: value}
+	}
+	return nil
+}
+
+// return a decoder over a PDU or message value with a new budget
+func newPduDecoder(wire []byte, limits DecodeLimits) (*decoder, error) {
+	if err := checkLimit("MaxPDUSize", len(wire), limits.MaxPDUSize); err != nil {
+		return nil, err
+	}
+	return &decoder{
+		AperReader: aper.NewReader(bytes.NewReader(wire)),
+		budget:     &decodeBudget{limits: limits},
+	}, nil
+}
+
+// return a decoder over a reader this package did not create, with a new
+// budget of the default limits
+func newDecoder(r *aper.AperReader) *decoder {
+	return &decoder{
+		AperReader: r,
+		budget:     &decodeBudget{limits: DefaultDecodeLimits()},
+	}
+}
+
+// return a decoder over an open type value read with r, one level deeper in
+// the PDU and sharing its budget
+func newOpenTypeReader(r *decoder, buf []byte) (*decoder, error) {
+	if err := checkLimit("MaxDepth", r.depth+1, r.budget.limits.MaxDepth); err != nil {
+		return nil, err
+	}
+	return &decoder{
+		AperReader: aper.NewReader(bytes.NewReader(buf)),
+		budget:     r.budget,
+		depth:      r.depth + 1,
+	}, nil
+}
+
+// decode v with r; a value of this package is decoded within the limits of r
+func decodeValue(r *decoder, v ieCodec) error {
+	if d, ok := v.(limitedDecoder); ok {
+		return d.decode(r)
+	}
+	return v.Decode(r.AperReader)
+}
+
+// DecodeValue decodes an IE value on its own within the default limits
+func DecodeValue(wire []byte, v ieCodec) error {
+	return DecodeValueWithLimits(wire, v, DefaultDecodeLimits())
+}
+
+// DecodeValueWithLimits decodes an IE value on its own within limits
+func DecodeValueWithLimits(wire []byte, v ieCodec, limits DecodeLimits) error {
+	r, err := newPduDecoder(wire, limits)
+	if err != nil {
+		return err
+	}
+	return decodeValue(r, v)
+}
+
+// account n octets about to be read with r
+func allocate(r *decoder, n int) error {
+	b := r.budget
+	b.allocated += n
+	return checkLimit("MaxAllocation", b.allocated, b.limits.MaxAllocation)
+}
+
+// check the number of IEs read with r in a container
+func checkIEs(r *decoder, n int) error {
+	return checkLimit("MaxIEs", n, r.budget.limits.MaxIEs)
+}
+
+// check the number of items read with r in a list
+func checkListLength(r *decoder, n int) error {
+	return checkLimit("MaxListLength", n, r.budget.limits.MaxListLength)
+}
+
+// read an unconstrained length determinant (X.691 10.9); frag is set when a
+// fragment of n octets or bits follows and another length comes after it
+func readLength(r *decoder) (n int, frag bool, err error) {
+	octet := &aper.Constraint{Lb: 0, Ub: 255}
+	var v, lo int64
+	if v, err = r.ReadInteger(octet, false); err != nil {
+		return
+	}
+	switch {
+	case v&0xc0 == 0xc0:
+		if v&0x3f < 1 || v&0x3f > 4 {
+			err = fmt.Errorf("invalid fragment length %#x", v)
+			return
+		}
+		return int(v&0x3f) * 16384, true, nil
+	case v&0x80 != 0:
+		if lo, err = r.ReadInteger(octet, false); err != nil {
+			return
+		}
+		n = int(v&0x3f)<<8 | int(lo)
+	default:
+		n = int(v)
+	}
+	return
+}
+
+// read an open type value; its length is accounted before the value is read
+func readOpenType(r *decoder) (buf []byte, err error) {
+	buf = []byte{}
+	for {
+		var n int
+		var frag bool
+		if n, frag, err = readLength(r); err != nil {
+			return
+		}
+		if err = allocate(r, n); err != nil {
+			return
+		}
+		var b []byte
+		if b, err = r.ReadBits(uint(n) * 8); err != nil {
+			return
+		}
+		buf = append(buf, b[:n]...)
+		if !frag {
+			return
+		}
+	}
+}
+
+// whether the length of a string with the size constraint c has no upper
+// bound below 64K, so it is encoded with an unconstrained length determinant
+func unboundedSize(c *aper.Constraint) bool {
+	return c == nil || c.Lb == 0 && c.Ub == 0 || c.Ub >= aper.POW_16
+}
+
+// read an OCTET STRING; a length not bounded by the size constraint c is
+// accounted before the octets are read
+func readOctetString(r *decoder, c *aper.Constraint, ext bool) (b []byte, err error) {
+	if ext {
+		var extended bool
+		if extended, err = r.ReadBool(); err != nil || extended {
+			if err == nil {
+				b, err = readOpenType(r)
+			}
+			return
+		}
+	}
+	if unboundedSize(c) {
+		// same form as an open type
+		return readOpenType(r)
+	}
+	if b, err = r.ReadOctetString(c, false); err == nil {
+		err = allocate(r, len(b))
+	}
+	return
+}
+
+// read a BIT STRING; a length not bounded by the size constraint c is
+// accounted before the bits are read
+func readBitString(r *decoder, c *aper.Constraint, ext bool) (b []byte, nbits uint, err error) {
+	if ext {
+		var extended bool
+		if extended, err = r.ReadBool(); err != nil {
+			return
+		}
+		if extended {
+			c = nil
+		}
+	}
+	if !unboundedSize(c) {
+		if b, nbits, err = r.ReadBitString(c, false); err == nil {
+			err = allocate(r, len(b))
+		}
+		return
+	}
+	b = []byte{}
+	for {
+		var n int
+		var frag bool
+		if n, frag, err = readLength(r); err != nil {
+			return
+		}
+		if err = allocate(r, (n+7)/8); err != nil {
+			return
+		}
+		var bits []byte
+		if bits, err = r.ReadBits(uint(n)); err != nil {
+			return
+		}
+		// fragments hold a multiple of 8 bits
+		b = append(b, bits...)
+		nbits += uint(n)
+		if !frag {
+			return
+		}
+	}
+}
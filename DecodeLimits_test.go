@@ -0,0 +1,144 @@
+package ies
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+// return the LimitError of err
+func limitError(t *testing.T, err error) *LimitError {
+	t.Helper()
+	var le *LimitError
+	if !errors.Is(err, ErrLimitExceeded) || !errors.As(err, &le) {
+		t.Fatalf("error %v", err)
+	}
+	return le
+}
+
+func TestDecodeLimitsNotify(t *testing.T) {
+	tests := []struct {
+		name   string
+		limits DecodeLimits
+		limit  string
+		value  int
+		id     aper.Integer // IE reported as exceeding the limit
+	}{{
+		name:   "PDU size",
+		limits: DecodeLimits{MaxPDUSize: 20},
+		limit:  "MaxPDUSize",
+		value:  32,
+	}, {
+		name:   "IEs",
+		limits: DecodeLimits{MaxIEs: 2},
+		limit:  "MaxIEs",
+		value:  3,
+		id:     ProtocolIEID_DRBNotifyList,
+	}, {
+		name:   "list length",
+		limits: DecodeLimits{MaxListLength: 1},
+		limit:  "MaxListLength",
+		value:  2,
+		id:     ProtocolIEID_DRBNotifyList,
+	}, {
+		name:   "depth",
+		limits: DecodeLimits{MaxDepth: 1},
+		limit:  "MaxDepth",
+		value:  2,
+		id:     ProtocolIEID_DRBNotifyList,
+	}, {
+		name:   "allocation",
+		limits: DecodeLimits{MaxAllocation: 10},
+		limit:  "MaxAllocation",
+		value:  17,
+		id:     ProtocolIEID_DRBNotifyList,
+	}}
+	drbs := []byte{
+		0x00, 0x89, 0x00, 0x0d, 0x04, // DRB-Notify-List of 2 items
+		0x00, 0x88, 0x00, 0x02, 0x04, 0x00, // DRB 5 fulfilled
+		0x00, 0x88, 0x00, 0x02, 0x05, 0x00, // DRB 6 fulfilled
+	}
+	wire := messageValue(notifyCU, notifyDU, drbs)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var msg Notify
+			rep, err := msg.DecodeWithLimits(wire, tt.limits)
+			le := limitError(t, err)
+			if le.Message != "Notify" || le.Limit != tt.limit || le.Value != tt.value {
+				t.Fatalf("error %+v", le)
+			}
+			if rep.Action != ActionReject {
+				t.Fatalf("action %d", rep.Action)
+			}
+			if tt.id != 0 && (len(rep.Errors) != 1 || rep.Errors[0].Id != tt.id || rep.Errors[0].Kind != IEErrorLimitExceeded) {
+				t.Fatalf("errors %+v", rep.Errors)
+			}
+		})
+	}
+
+	var msg Notify
+	if _, err := msg.DecodeWithLimits(wire, DecodeLimits{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(msg.DRBNotifyList) != 2 {
+		t.Fatalf("decoded %+v", msg)
+	}
+}
+
+func TestDecodeLimitsOctetString(t *testing.T) {
+	wire := []byte{0x05, 0x01, 0x02, 0x03, 0x04, 0x05}
+	var ie PosAssistanceInformation
+	if le := limitError(t, DecodeValueWithLimits(wire, &ie, DecodeLimits{MaxAllocation: 4})); le.Limit != "MaxAllocation" || le.Value != 5 {
+		t.Fatalf("error %+v", le)
+	}
+	if ie.Value != nil {
+		t.Fatalf("decoded %x", ie.Value)
+	}
+
+	if err := DecodeValueWithLimits(wire, &ie, DecodeLimits{MaxAllocation: 5}); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(ie.Value, wire[1:]) {
+		t.Fatalf("decoded %x", ie.Value)
+	}
+}
+
+func TestDecodeLimitsForeignReader(t *testing.T) {
+	wire := []byte{0x05, 0x01, 0x02, 0x03, 0x04, 0x05}
+	var ie PosAssistanceInformation
+	if err := ie.Decode(aper.NewReader(bytes.NewReader(wire))); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(ie.Value, wire[1:]) {
+		t.Fatalf("decoded %x", ie.Value)
+	}
+
+	// one octet over the default MaxAllocation: 64 fragments of 64K octets
+	// and a last octet
+	fragment := append([]byte{0xc4}, make([]byte, 4*16384)...)
+	wire = append(bytes.Repeat(fragment, 64), 0x01, 0x00)
+	max := DefaultDecodeLimits().MaxAllocation
+	err := ie.Decode(aper.NewReader(bytes.NewReader(wire)))
+	if le := limitError(t, err); le.Limit != "MaxAllocation" || le.Value != max+1 {
+		t.Fatalf("error %+v", le)
+	}
+	if err = DecodeValueWithLimits(wire, &ie, DecodeLimits{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(ie.Value) != max+1 {
+		t.Fatalf("decoded %d octets", len(ie.Value))
+	}
+}
+
+func TestDecodeLazyLimits(t *testing.T) {
+	_, err := DecodeLazyWithLimits(notifyPdu, DecodeLimits{MaxIEs: 3})
+	if le := limitError(t, err); le.Limit != "MaxIEs" || le.Value != 4 {
+		t.Fatalf("error %+v", le)
+	}
+	_, err = DecodeIDsWithLimits(notifyPdu, DecodeLimits{MaxPDUSize: len(notifyPdu) - 1})
+	if le := limitError(t, err); le.Limit != "MaxPDUSize" || le.Value != len(notifyPdu) {
+		t.Fatalf("error %+v", le)
+	}
+}
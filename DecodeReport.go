@@ -0,0 +1,260 @@
+package ies
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+// kinds of errors found in the IEs of a received message (TS 38.473 clause 10)
+const (
+	IEErrorNotComprehended  uint8 = iota + 1 // IE id unknown to the receiver
+	IEErrorUnexpected                        // known IE id not defined for the message
+	IEErrorMissing                           // mandatory IE absent
+	IEErrorDuplicated                        // IE received more than once
+	IEErrorOutOfOrder                        // IE received after an IE that follows it in the message definition
+	IEErrorWrongCriticality                  // criticality differs from the message definition
+	IEErrorLimitExceeded                     // IE not decoded as it exceeds a decode limit
+)
+
+// actions of the receiver on a decoded message, in increasing severity
+const (
+	ActionProceed          uint8 = iota // no error, or errors to ignore silently
+	ActionProceedAndNotify              // go on with the procedure and report Diagnostics to the sender
+	ActionReject                        // abort the procedure and report Diagnostics to the sender
+)
+
+// an error found in an IE of a received message; Criticality is the one
+// the error is handled with: the received one for a present IE, the one of
+// the message definition for a missing IE
+type IEError struct {
+	Id          aper.Integer
+	Criticality aper.Enumerated
+	Kind        uint8
+}
+
+// Action returns the action required by the error (TS 38.473 clause 10.3).
+// IEs in wrong order or with too many occurrences make an abstract syntax
+// error (falsely constructed message) and always reject the procedure, as do
+// IEs left undecoded because of a decode limit.
+func (e IEError) Action() uint8 {
+	switch e.Kind {
+	case IEErrorDuplicated, IEErrorOutOfOrder, IEErrorLimitExceeded:
+		return ActionReject
+	case IEErrorWrongCriticality:
+		return ActionProceed
+	}
+	switch e.Criticality {
+	case Criticality_PresentReject:
+		return ActionReject
+	case Criticality_PresentNotify:
+		return ActionProceedAndNotify
+	}
+	return ActionProceed
+}
+
+func (e IEError) String() string {
+	name, ok := protocolIEIDNames[e.Id]
+	if !ok {
+		name = "unknown"
+	}
+	var kind string
+	switch e.Kind {
+	case IEErrorNotComprehended:
+		kind = "not comprehended"
+	case IEErrorUnexpected:
+		kind = "unexpected"
+	case IEErrorMissing:
+		kind = "missing"
+	case IEErrorDuplicated:
+		kind = "duplicated"
+	case IEErrorOutOfOrder:
+		kind = "out of order"
+	case IEErrorWrongCriticality:
+		kind = "wrong criticality"
+	case IEErrorLimitExceeded:
+		kind = "exceeding a decode limit"
+	}
+	return fmt.Sprintf("IE %d (%s) %s", e.Id, name, kind)
+}
+
+// DecodeReport is the outcome of the IE checks on a received message. It is
+// returned as the decoding error when Action is ActionReject; the node then
+// answers with the failure message of a class 1 procedure, or an Error
+// Indication otherwise.
+type DecodeReport struct {
+	Message string
+	Action  uint8
+	Errors  []IEError
+}
+
+func (rep *DecodeReport) add(e IEError) {
+	rep.Errors = append(rep.Errors, e)
+	if a := e.Action(); a > rep.Action {
+		rep.Action = a
+	}
+}
+
+func (rep *DecodeReport) Error() string {
+	items := make([]string, len(rep.Errors))
+	for i, e := range rep.Errors {
+		items[i] = e.String()
+	}
+	return rep.Message + ": procedure rejected: " + strings.Join(items, ", ")
+}
+
+// Unwrap returns the errors that reject the procedure as MissingMandatoryIE,
+// DuplicateIE or AbstractSyntaxError
+func (rep *DecodeReport) Unwrap() (errs []error) {
+	path := ErrorPath{Message: rep.Message}
+	for _, e := range rep.Errors {
+		if e.Action() != ActionReject {
+			continue
+		}
+		switch e.Kind {
+		case IEErrorLimitExceeded:
+			// the LimitError is returned instead of the report
+		case IEErrorMissing:
+			errs = append(errs, &MissingMandatoryIE{ErrorPath: path, ID: e.Id})
+		case IEErrorDuplicated:
+			errs = append(errs, &DuplicateIE{ErrorPath: path, ID: e.Id})
+		case IEErrorOutOfOrder:
+			errs = append(errs, &AbstractSyntaxError{ErrorPath: path, Subtype: AbstractSyntaxErrorFalselyConstructed, ID: e.Id})
+		default:
+			errs = append(errs, &AbstractSyntaxError{ErrorPath: path, Subtype: AbstractSyntaxErrorReject, ID: e.Id})
+		}
+	}
+	return
+}
+
+// Diagnostics returns the IEs to report to the sender in Criticality
+// Diagnostics; errors handled silently are left out
+func (rep *DecodeReport) Diagnostics() (diagList []CriticalityDiagnosticsIEItem) {
+	for _, e := range rep.Errors {
+		var typeOfError aper.Enumerated
+		switch e.Kind {
+		case IEErrorNotComprehended, IEErrorUnexpected, IEErrorLimitExceeded:
+			typeOfError = TypeOfErrorNotunderstood
+		case IEErrorMissing:
+			typeOfError = TypeOfErrorMissing
+		default:
+			continue
+		}
+		if e.Criticality == Criticality_PresentIgnore && e.Kind != IEErrorLimitExceeded {
+			continue
+		}
+		diagList = append(diagList, CriticalityDiagnosticsIEItem{
+			IECriticality: Criticality{Value: e.Criticality},
+			IEID:          ProtocolIEID{Value: e.Id},
+			TypeOfError:   TypeOfError{Value: typeOfError},
+		})
+	}
+	return
+}
+
+// describe an IE of a message, listed in the order of the message definition
+type messageIE struct {
+	id          aper.Integer
+	criticality aper.Enumerated
+	mandatory   bool
+}
+
+// decode the IE container of a message; decode is called for each IE
+// defined in ies, other IEs are checked and reported per TS 38.473 clause 10
+// and the unknown, unexpected and duplicated ones are kept in unknown. The
+// extension additions of the message SEQUENCE are skipped. err is the report
+// itself when the procedure must be rejected, or a *LimitError when the
+// message exceeds a decode limit; the report then holds the IE that does.
+func decodeMessage(name string, wire []byte, limits DecodeLimits, ies []messageIE, decode func(aper.Integer, *decoder) error, unknown *[]RawIE) (rep *DecodeReport, err error) {
+	rep = &DecodeReport{Message: name}
+	*unknown = nil
+	seen := make(map[aper.Integer]bool)
+	last := -1
+	pos := -1
+	var r *decoder
+	if r, err = newPduDecoder(wire, limits); err != nil {
+		rep.Action = ActionReject
+		err = messageError(name, err)
+		return
+	}
+	decodeItem := func(*aper.AperReader) (ie *F1apMessageIE, err error) {
+		var buf []byte
+		pos++
+		if ie, buf, err = readContainerIE(r, pos+1); ie == nil {
+			return
+		}
+		id, c := ie.Id.Value, ie.Criticality.Value
+		defer func() {
+			var le *LimitError
+			if errors.As(err, &le) {
+				rep.add(IEError{Id: id, Criticality: c, Kind: IEErrorLimitExceeded})
+			}
+		}()
+		if err != nil {
+			return
+		}
+		idx := -1
+		for i := range ies {
+			if ies[i].id == id {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			kind := IEErrorUnexpected
+			if _, ok := protocolIEIDNames[id]; !ok {
+				kind = IEErrorNotComprehended
+			}
+			rep.add(IEError{Id: id, Criticality: c, Kind: kind})
+			*unknown = append(*unknown, RawIE{Id: id, Criticality: c, Value: buf, Position: pos})
+			return
+		}
+		if seen[id] {
+			rep.add(IEError{Id: id, Criticality: c, Kind: IEErrorDuplicated})
+			*unknown = append(*unknown, RawIE{Id: id, Criticality: c, Value: buf, Position: pos})
+			return
+		}
+		seen[id] = true
+		if idx < last {
+			rep.add(IEError{Id: id, Criticality: c, Kind: IEErrorOutOfOrder})
+		} else {
+			last = idx
+		}
+		if c != ies[idx].criticality {
+			rep.add(IEError{Id: id, Criticality: c, Kind: IEErrorWrongCriticality})
+		}
+		var ieR *decoder
+		if ieR, err = newOpenTypeReader(r, buf); err != nil {
+			return
+		}
+		err = decode(id, ieR)
+		return
+	}
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		rep.Action = ActionReject
+		err = messageError(name, err)
+		return
+	}
+	if _, err = aper.ReadSequenceOf[F1apMessageIE](decodeItem, r.AperReader, &aper.Constraint{Lb: 0, Ub: int64(aper.POW_16 - 1)}, false); err != nil {
+		err = messageError(name, err)
+		return
+	}
+	if extended {
+		if err = skipExtensions(r); err != nil {
+			err = messageError(name, err)
+			return
+		}
+	}
+	for _, ie := range ies {
+		if ie.mandatory && !seen[ie.id] {
+			rep.add(IEError{Id: ie.id, Criticality: ie.criticality, Kind: IEErrorMissing})
+		}
+	}
+	if rep.Action == ActionReject {
+		err = rep
+	}
+	return
+}
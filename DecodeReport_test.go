@@ -0,0 +1,226 @@
+package ies
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+// IEs of a Notify message value
+var (
+	notifyCU   = []byte{0x00, 0x28, 0x00, 0x02, 0x00, 0x01}                               // gNB-CU-UE-F1AP-ID 1
+	notifyDU   = []byte{0x00, 0x29, 0x00, 0x02, 0x00, 0x02}                               // gNB-DU-UE-F1AP-ID 2
+	notifyDRBs = []byte{0x00, 0x89, 0x00, 0x07, 0x00, 0x00, 0x88, 0x00, 0x02, 0x04, 0x00} // DRB 5 fulfilled
+)
+
+// encode a message value of IEs
+func messageValue(ies ...[]byte) []byte {
+	v := []byte{0x00, 0x00, byte(len(ies))}
+	for _, ie := range ies {
+		v = append(v, ie...)
+	}
+	return v
+}
+
+// an IE of id 9999, unknown to the receiver
+func unknownIE(c byte) []byte {
+	return []byte{0x27, 0x0f, c, 0x01, 0x01}
+}
+
+func TestDecodeReportActions(t *testing.T) {
+	tests := []struct {
+		name   string
+		wire   []byte
+		action uint8
+		errors []IEError
+		diag   []CriticalityDiagnosticsIEItem
+	}{{
+		name: "valid",
+		wire: messageValue(notifyCU, notifyDU, notifyDRBs),
+	}, {
+		name:   "not comprehended, ignore",
+		wire:   messageValue(notifyCU, notifyDU, notifyDRBs, unknownIE(0x40)),
+		errors: []IEError{{Id: 9999, Criticality: Criticality_PresentIgnore, Kind: IEErrorNotComprehended}},
+	}, {
+		name:   "not comprehended, ignore and notify",
+		wire:   messageValue(notifyCU, unknownIE(0x80), notifyDU, notifyDRBs),
+		action: ActionProceedAndNotify,
+		errors: []IEError{{Id: 9999, Criticality: Criticality_PresentNotify, Kind: IEErrorNotComprehended}},
+		diag: []CriticalityDiagnosticsIEItem{{
+			IECriticality: Criticality{Value: Criticality_PresentNotify},
+			IEID:          ProtocolIEID{Value: 9999},
+			TypeOfError:   TypeOfError{Value: TypeOfErrorNotunderstood},
+		}},
+	}, {
+		name:   "not comprehended, reject",
+		wire:   messageValue(notifyCU, notifyDU, notifyDRBs, unknownIE(0x00)),
+		action: ActionReject,
+		errors: []IEError{{Id: 9999, Criticality: Criticality_PresentReject, Kind: IEErrorNotComprehended}},
+		diag: []CriticalityDiagnosticsIEItem{{
+			IECriticality: Criticality{Value: Criticality_PresentReject},
+			IEID:          ProtocolIEID{Value: 9999},
+			TypeOfError:   TypeOfError{Value: TypeOfErrorNotunderstood},
+		}},
+	}, {
+		name:   "unexpected, reject",
+		wire:   messageValue(notifyCU, notifyDU, notifyDRBs, []byte{0x00, 0x4e, 0x00, 0x02, 0x00, 0x01}),
+		action: ActionReject,
+		errors: []IEError{{Id: ProtocolIEID_TransactionID, Criticality: Criticality_PresentReject, Kind: IEErrorUnexpected}},
+		diag: []CriticalityDiagnosticsIEItem{{
+			IECriticality: Criticality{Value: Criticality_PresentReject},
+			IEID:          ProtocolIEID{Value: ProtocolIEID_TransactionID},
+			TypeOfError:   TypeOfError{Value: TypeOfErrorNotunderstood},
+		}},
+	}, {
+		name:   "missing",
+		wire:   messageValue(notifyCU, notifyDRBs),
+		action: ActionReject,
+		errors: []IEError{{Id: ProtocolIEID_gNBDUUEF1APID, Criticality: Criticality_PresentReject, Kind: IEErrorMissing}},
+		diag: []CriticalityDiagnosticsIEItem{{
+			IECriticality: Criticality{Value: Criticality_PresentReject},
+			IEID:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+			TypeOfError:   TypeOfError{Value: TypeOfErrorMissing},
+		}},
+	}, {
+		name:   "duplicated",
+		wire:   messageValue(notifyCU, notifyDU, notifyDU, notifyDRBs),
+		action: ActionReject,
+		errors: []IEError{{Id: ProtocolIEID_gNBDUUEF1APID, Criticality: Criticality_PresentReject, Kind: IEErrorDuplicated}},
+	}, {
+		name:   "out of order",
+		wire:   messageValue(notifyDU, notifyCU, notifyDRBs),
+		action: ActionReject,
+		errors: []IEError{{Id: ProtocolIEID_gNBCUUEF1APID, Criticality: Criticality_PresentReject, Kind: IEErrorOutOfOrder}},
+	}, {
+		name:   "wrong criticality",
+		wire:   messageValue([]byte{0x00, 0x28, 0x40, 0x02, 0x00, 0x01}, notifyDU, notifyDRBs),
+		errors: []IEError{{Id: ProtocolIEID_gNBCUUEF1APID, Criticality: Criticality_PresentIgnore, Kind: IEErrorWrongCriticality}},
+	}}
+	for _, tt := range tests {
+		var msg Notify
+		rep, err := msg.DecodeWithReport(tt.wire)
+		if rep == nil {
+			t.Fatalf("%s: no report, error %v", tt.name, err)
+		}
+		if tt.action == ActionReject {
+			if err != rep {
+				t.Fatalf("%s: error %v, want the report", tt.name, err)
+			}
+		} else if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if rep.Message != "Notify" || rep.Action != tt.action || !reflect.DeepEqual(rep.Errors, tt.errors) {
+			t.Fatalf("%s: report %+v", tt.name, rep)
+		}
+		if diag := rep.Diagnostics(); !reflect.DeepEqual(diag, tt.diag) {
+			t.Fatalf("%s: diagnostics %+v, want %+v", tt.name, diag, tt.diag)
+		}
+		err, diag := new(Notify).Decode(tt.wire)
+		if (err != nil) != (tt.action == ActionReject) || !reflect.DeepEqual(diag, tt.diag) {
+			t.Fatalf("%s: Decode error %v, diagnostics %+v", tt.name, err, diag)
+		}
+	}
+}
+
+func TestDecodeReportKeepsUnknownIEs(t *testing.T) {
+	var msg Notify
+	if _, err := msg.DecodeWithReport(messageValue(notifyCU, unknownIE(0x80), notifyDU, notifyDRBs)); err != nil {
+		t.Fatal(err)
+	}
+	want := []RawIE{{Id: 9999, Criticality: Criticality_PresentNotify, Value: []byte{0x01}, Position: 1}}
+	if !reflect.DeepEqual(msg.UnknownIEs, want) {
+		t.Fatalf("unknown IEs %+v, want %+v", msg.UnknownIEs, want)
+	}
+	if msg.GNBCUUEF1APID.Value != 1 || msg.GNBDUUEF1APID.Value != 2 || len(msg.DRBNotifyList) != 1 {
+		t.Fatalf("decoded %+v", msg)
+	}
+}
+
+func TestDecodeReportKeepsDuplicatedIEs(t *testing.T) {
+	duplicate := []byte{0x00, 0x29, 0x00, 0x02, 0x00, 0x03} // gNB-DU-UE-F1AP-ID 3
+	wire := messageValue(notifyCU, notifyDU, duplicate, notifyDRBs)
+	var msg Notify
+	if _, err := msg.DecodeWithReport(wire); err == nil {
+		t.Fatal("decoded a duplicated IE without error")
+	}
+	want := []RawIE{{Id: ProtocolIEID_gNBDUUEF1APID, Criticality: Criticality_PresentReject, Value: []byte{0x00, 0x03}, Position: 2}}
+	if !reflect.DeepEqual(msg.UnknownIEs, want) {
+		t.Fatalf("unknown IEs %+v, want %+v", msg.UnknownIEs, want)
+	}
+	if msg.GNBDUUEF1APID.Value != 2 {
+		t.Fatalf("decoded %+v", msg)
+	}
+	pdu, err := msg.AppendEncode(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := pduValue(t, pdu); !bytes.Equal(got, wire) {
+		t.Fatalf("encoded %x, want %x", got, wire)
+	}
+}
+
+func TestDecodeSkipsExtensionAdditions(t *testing.T) {
+	wire := messageValue(notifyCU, notifyDU, notifyDRBs)
+	wire[0] |= 0x80
+	// one addition present: its value is an open type of one octet
+	wire = append(wire, 0x01, 0x01, 0xff)
+	var msg Notify
+	if _, err := msg.DecodeWithReport(wire); err != nil {
+		t.Fatal(err)
+	}
+	if msg.GNBCUUEF1APID.Value != 1 || msg.GNBDUUEF1APID.Value != 2 || len(msg.DRBNotifyList) != 1 {
+		t.Fatalf("decoded %+v", msg)
+	}
+	if _, err := msg.DecodeWithReport(wire[:len(wire)-1]); err == nil {
+		t.Fatal("decoded truncated extension additions without error")
+	}
+}
+
+func TestF1SetupRequestDecodeDiagnostics(t *testing.T) {
+	wire := messageValue(
+		[]byte{0x00, 0x4e, 0x00, 0x02, 0x00, 0x01}, // Transaction ID 1
+		[]byte{0x00, 0x2a, 0x00, 0x02, 0x00, 0x01}, // gNB-DU ID 1
+	)
+	var msg F1SetupRequest
+	err, diag := msg.Decode(wire)
+	if err == nil {
+		t.Fatal("decoded without gNB-DU RRC Version")
+	}
+	want := []CriticalityDiagnosticsIEItem{{
+		IECriticality: Criticality{Value: Criticality_PresentReject},
+		IEID:          ProtocolIEID{Value: ProtocolIEID_GNBDURRCVersion},
+		TypeOfError:   TypeOfError{Value: TypeOfErrorMissing},
+	}}
+	if !reflect.DeepEqual(diag, want) {
+		t.Fatalf("diagnostics %+v, want %+v", diag, want)
+	}
+	if msg.TransactionID.Value != 1 || msg.GNBDUID.Value != 1 {
+		t.Fatalf("decoded %+v", msg)
+	}
+}
+
+func TestIEErrorAction(t *testing.T) {
+	for _, tt := range []struct {
+		kind        uint8
+		criticality aper.Enumerated
+		action      uint8
+	}{
+		{IEErrorNotComprehended, Criticality_PresentIgnore, ActionProceed},
+		{IEErrorNotComprehended, Criticality_PresentNotify, ActionProceedAndNotify},
+		{IEErrorNotComprehended, Criticality_PresentReject, ActionReject},
+		{IEErrorUnexpected, Criticality_PresentNotify, ActionProceedAndNotify},
+		{IEErrorMissing, Criticality_PresentIgnore, ActionProceed},
+		{IEErrorMissing, Criticality_PresentReject, ActionReject},
+		{IEErrorDuplicated, Criticality_PresentIgnore, ActionReject},
+		{IEErrorOutOfOrder, Criticality_PresentIgnore, ActionReject},
+		{IEErrorWrongCriticality, Criticality_PresentReject, ActionProceed},
+		{IEErrorLimitExceeded, Criticality_PresentIgnore, ActionReject},
+	} {
+		e := IEError{Id: 1, Criticality: tt.criticality, Kind: tt.kind}
+		if a := e.Action(); a != tt.action {
+			t.Fatalf("%v with criticality %d: action %d, want %d", e, tt.criticality, a, tt.action)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type Dynamic5QIDescriptor struct {
+	QoSPriorityLevel   QoSPriorityLevel    `mandatory`
+	PacketDelayBudget  PacketDelayBudget   `mandatory`
+	PacketErrorRate    PacketErrorRate     `mandatory`
+	FiveQI             *FiveQI             `optional`
+	DelayCritical      *DelayCritical      `optional`
+	AveragingWindow    *AveragingWindow    `optional`
+	MaxDataBurstVolume *MaxDataBurstVolume `optional`
+	// IEExtensions
+	ExtendedPacketDelayBudget   *ExtendedPacketDelayBudget                       `optional,ignore,extension`
+	CNPacketDelayBudgetDownlink *ExtendedPacketDelayBudget                       `optional,ignore,extension`
+	CNPacketDelayBudgetUplink   *ExtendedPacketDelayBudget                       `optional,ignore,extension`
+	Extensions                  ProtocolExtensionContainer[Dynamic5QIDescriptor] `optional,extension`
+}
+
+func (ie *Dynamic5QIDescriptor) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *Dynamic5QIDescriptor) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(ie.extensions())
+	optionals := []byte{0x0}
+	if ie.FiveQI != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if ie.DelayCritical != nil {
+		aper.SetBit(optionals, 2)
+	}
+	if ie.AveragingWindow != nil {
+		aper.SetBit(optionals, 3)
+	}
+	if ie.MaxDataBurstVolume != nil {
+		aper.SetBit(optionals, 4)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 5)
+	}
+	if err = w.WriteBits(optionals, 5); err != nil {
+		return
+	}
+	if err = ie.QoSPriorityLevel.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode QoSPriorityLevel", err)
+		return
+	}
+	if err = ie.PacketDelayBudget.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PacketDelayBudget", err)
+		return
+	}
+	if err = ie.PacketErrorRate.encode(w); err != nil {
+		err = utils.WrapError("Encode PacketErrorRate", err)
+		return
+	}
+	if ie.FiveQI != nil {
+		if err = ie.FiveQI.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode FiveQI", err)
+			return
+		}
+	}
+	if ie.DelayCritical != nil {
+		if err = ie.DelayCritical.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode DelayCritical", err)
+			return
+		}
+	}
+	if ie.AveragingWindow != nil {
+		if err = ie.AveragingWindow.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode AveragingWindow", err)
+			return
+		}
+	}
+	if ie.MaxDataBurstVolume != nil {
+		if err = ie.MaxDataBurstVolume.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode MaxDataBurstVolume", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *Dynamic5QIDescriptor) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *Dynamic5QIDescriptor) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(5); err != nil {
+		return
+	}
+	if err = ie.QoSPriorityLevel.Decode(r.AperReader); err != nil {
+		err = readError("QoSPriorityLevel", err)
+		return
+	}
+	if err = ie.PacketDelayBudget.Decode(r.AperReader); err != nil {
+		err = readError("PacketDelayBudget", err)
+		return
+	}
+	if err = ie.PacketErrorRate.decode(r); err != nil {
+		err = readError("PacketErrorRate", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(FiveQI)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("FiveQI", err)
+			return
+		}
+		ie.FiveQI = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		tmp := new(DelayCritical)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("DelayCritical", err)
+			return
+		}
+		ie.DelayCritical = tmp
+	}
+	if aper.IsBitSet(optionals, 3) {
+		tmp := new(AveragingWindow)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("AveragingWindow", err)
+			return
+		}
+		ie.AveragingWindow = tmp
+	}
+	if aper.IsBitSet(optionals, 4) {
+		tmp := new(MaxDataBurstVolume)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("MaxDataBurstVolume", err)
+			return
+		}
+		ie.MaxDataBurstVolume = tmp
+	}
+	if aper.IsBitSet(optionals, 5) {
+		if err = ie.Extensions.decode(r, ie.decodeExtension); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *Dynamic5QIDescriptor) extensions() (exts []F1apMessageIE) {
+	if ie.ExtendedPacketDelayBudget != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ExtendedPacketDelayBudget},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.ExtendedPacketDelayBudget,
+		})
+	}
+	if ie.CNPacketDelayBudgetDownlink != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CNPacketDelayBudgetDownlink},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.CNPacketDelayBudgetDownlink,
+		})
+	}
+	if ie.CNPacketDelayBudgetUplink != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CNPacketDelayBudgetUplink},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.CNPacketDelayBudgetUplink,
+		})
+	}
+	return
+}
+
+func (ie *Dynamic5QIDescriptor) decodeExtension(id aper.Integer, r *decoder) (ok bool, err error) {
+	ok = true
+	switch id {
+	case ProtocolIEID_ExtendedPacketDelayBudget:
+		tmp := new(ExtendedPacketDelayBudget)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("ExtendedPacketDelayBudget", err)
+			return
+		}
+		ie.ExtendedPacketDelayBudget = tmp
+	case ProtocolIEID_CNPacketDelayBudgetDownlink:
+		tmp := new(ExtendedPacketDelayBudget)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("CNPacketDelayBudgetDownlink", err)
+			return
+		}
+		ie.CNPacketDelayBudgetDownlink = tmp
+	case ProtocolIEID_CNPacketDelayBudgetUplink:
+		tmp := new(ExtendedPacketDelayBudget)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("CNPacketDelayBudgetUplink", err)
+			return
+		}
+		ie.CNPacketDelayBudgetUplink = tmp
+	default:
+		ok = false
+	}
+	return
+}
+
+func (ie *Dynamic5QIDescriptor) Validate() error {
+	var v validator
+	v.ie("QoSPriorityLevel", &ie.QoSPriorityLevel)
+	v.ie("PacketDelayBudget", &ie.PacketDelayBudget)
+	v.ie("PacketErrorRate", &ie.PacketErrorRate)
+	if ie.FiveQI != nil {
+		v.ie("FiveQI", ie.FiveQI)
+	}
+	if ie.DelayCritical != nil {
+		v.ie("DelayCritical", ie.DelayCritical)
+	}
+	if ie.AveragingWindow != nil {
+		v.ie("AveragingWindow", ie.AveragingWindow)
+	}
+	if ie.MaxDataBurstVolume != nil {
+		v.ie("MaxDataBurstVolume", ie.MaxDataBurstVolume)
+	}
+	if ie.ExtendedPacketDelayBudget != nil {
+		v.ie("ExtendedPacketDelayBudget", ie.ExtendedPacketDelayBudget)
+	}
+	if ie.CNPacketDelayBudgetDownlink != nil {
+		v.ie("CNPacketDelayBudgetDownlink", ie.CNPacketDelayBudgetDownlink)
+	}
+	if ie.CNPacketDelayBudgetUplink != nil {
+		v.ie("CNPacketDelayBudgetUplink", ie.CNPacketDelayBudgetUplink)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,202 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type DynamicPQIDescriptor struct {
+	ResourceType       *DynamicPQIDescriptorResourceType `optional`
+	QoSPriorityLevel   int64                             `lb:1,ub:8,valueExt,mandatory`
+	PacketDelayBudget  PacketDelayBudget                 `mandatory`
+	PacketErrorRate    PacketErrorRate                   `mandatory`
+	AveragingWindow    *AveragingWindow                  `optional`
+	MaxDataBurstVolume *MaxDataBurstVolume               `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[DynamicPQIDescriptor] `optional,extension`
+}
+
+func (ie *DynamicPQIDescriptor) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *DynamicPQIDescriptor) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.ResourceType != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if ie.AveragingWindow != nil {
+		aper.SetBit(optionals, 2)
+	}
+	if ie.MaxDataBurstVolume != nil {
+		aper.SetBit(optionals, 3)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 4)
+	}
+	if err = w.WriteBits(optionals, 4); err != nil {
+		return
+	}
+	if ie.ResourceType != nil {
+		if err = ie.ResourceType.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode ResourceType", err)
+			return
+		}
+	}
+	tmp_QoSPriorityLevel := INTEGER{
+		c:     aper.Constraint{Lb: 1, Ub: 8},
+		ext:   true,
+		Value: aper.Integer(ie.QoSPriorityLevel),
+	}
+	if err = tmp_QoSPriorityLevel.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode QoSPriorityLevel", err)
+		return
+	}
+	if err = ie.PacketDelayBudget.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PacketDelayBudget", err)
+		return
+	}
+	if err = ie.PacketErrorRate.encode(w); err != nil {
+		err = utils.WrapError("Encode PacketErrorRate", err)
+		return
+	}
+	if ie.AveragingWindow != nil {
+		if err = ie.AveragingWindow.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode AveragingWindow", err)
+			return
+		}
+	}
+	if ie.MaxDataBurstVolume != nil {
+		if err = ie.MaxDataBurstVolume.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode MaxDataBurstVolume", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *DynamicPQIDescriptor) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *DynamicPQIDescriptor) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(4); err != nil {
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(DynamicPQIDescriptorResourceType)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("ResourceType", err)
+			return
+		}
+		ie.ResourceType = tmp
+	}
+	tmp_QoSPriorityLevel := INTEGER{
+		c:   aper.Constraint{Lb: 1, Ub: 8},
+		ext: true,
+	}
+	if err = tmp_QoSPriorityLevel.Decode(r.AperReader); err != nil {
+		err = readError("QoSPriorityLevel", err)
+		return
+	}
+	ie.QoSPriorityLevel = int64(tmp_QoSPriorityLevel.Value)
+	if err = ie.PacketDelayBudget.Decode(r.AperReader); err != nil {
+		err = readError("PacketDelayBudget", err)
+		return
+	}
+	if err = ie.PacketErrorRate.decode(r); err != nil {
+		err = readError("PacketErrorRate", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 2) {
+		tmp := new(AveragingWindow)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("AveragingWindow", err)
+			return
+		}
+		ie.AveragingWindow = tmp
+	}
+	if aper.IsBitSet(optionals, 3) {
+		tmp := new(MaxDataBurstVolume)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("MaxDataBurstVolume", err)
+			return
+		}
+		ie.MaxDataBurstVolume = tmp
+	}
+	if aper.IsBitSet(optionals, 4) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *DynamicPQIDescriptor) Validate() error {
+	var v validator
+	if ie.ResourceType != nil {
+		v.ie("ResourceType", ie.ResourceType)
+	}
+	v.integer("QoSPriorityLevel", ie.QoSPriorityLevel, 1, 8)
+	v.ie("PacketDelayBudget", &ie.PacketDelayBudget)
+	v.ie("PacketErrorRate", &ie.PacketErrorRate)
+	if ie.AveragingWindow != nil {
+		v.ie("AveragingWindow", ie.AveragingWindow)
+	}
+	if ie.MaxDataBurstVolume != nil {
+		v.ie("MaxDataBurstVolume", ie.MaxDataBurstVolume)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
+
+const (
+	DynamicPQIDescriptorResourceTypeGbr              aper.Enumerated = 0
+	DynamicPQIDescriptorResourceTypeNonGBR           aper.Enumerated = 1
+	DynamicPQIDescriptorResourceTypeDelaycriticalGBR aper.Enumerated = 2
+)
+
+type DynamicPQIDescriptorResourceType struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:2,valueExt"`
+}
+
+func (ie *DynamicPQIDescriptorResourceType) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 2}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *DynamicPQIDescriptorResourceType) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 2}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *DynamicPQIDescriptorResourceType) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 3)
+	return v.err()
+}
@@ -0,0 +1,91 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	ECIDMeasuredResultsValuePresentNothing uint64 = iota
+	ECIDMeasuredResultsValuePresentValueAngleofArrivalNR
+	ECIDMeasuredResultsValuePresentChoiceExtension
+)
+
+// a result measured by the gNB-DU. F1AP defines the uplink angle of arrival
+// only: the NR RSRP and RSRQ of E-CID are measured by the UE and reach the
+// gNB-CU in RRC measurement reports, not over F1. A value added by a later
+// release arrives in the choice-extension and is kept in ChoiceExtension.
+type ECIDMeasuredResultsValue struct {
+	Choice                uint64
+	ValueAngleofArrivalNR *ULAoA
+	ChoiceExtension       *RawIE
+}
+
+func (ie *ECIDMeasuredResultsValue) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ECIDMeasuredResultsValue) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 1, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case ECIDMeasuredResultsValuePresentValueAngleofArrivalNR:
+		if err = ie.ValueAngleofArrivalNR.encode(w); err != nil {
+			err = utils.WrapError("Encode ValueAngleofArrivalNR", err)
+			return
+		}
+	case ECIDMeasuredResultsValuePresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ECIDMeasuredResultsValue) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ECIDMeasuredResultsValue) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(1, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case ECIDMeasuredResultsValuePresentValueAngleofArrivalNR:
+		tmp := new(ULAoA)
+		if err = tmp.decode(r); err != nil {
+			err = readError("ValueAngleofArrivalNR", err)
+			return
+		}
+		ie.ValueAngleofArrivalNR = tmp
+	case ECIDMeasuredResultsValuePresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *ECIDMeasuredResultsValue) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case ECIDMeasuredResultsValuePresentValueAngleofArrivalNR:
+		if ie.ValueAngleofArrivalNR == nil {
+			v.absent("ValueAngleofArrivalNR")
+		} else {
+			v.ie("ValueAngleofArrivalNR", ie.ValueAngleofArrivalNR)
+		}
+	case ECIDMeasuredResultsValuePresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
@@ -0,0 +1,154 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type ECIDMeasurementFailureIndication struct {
+	GNBCUUEF1APID      GNBCUUEF1APID      `mandatory,reject`
+	GNBDUUEF1APID      GNBDUUEF1APID      `mandatory,reject`
+	LMFUEMeasurementID LMFUEMeasurementID `mandatory,reject`
+	RANUEMeasurementID RANUEMeasurementID `mandatory,reject`
+	Cause              Cause              `mandatory,ignore`
+	UnknownIEs         []RawIE
+}
+
+func (msg *ECIDMeasurementFailureIndication) ProcedureCode() int64 {
+	return ProcedureCode_ECIDMeasurementFailureIndication
+}
+
+func (msg *ECIDMeasurementFailureIndication) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *ECIDMeasurementFailureIndication) Criticality() aper.Enumerated {
+	return Criticality_PresentIgnore
+}
+
+func (msg *ECIDMeasurementFailureIndication) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("ECIDMeasurementFailureIndication"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *ECIDMeasurementFailureIndication) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("ECIDMeasurementFailureIndication"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *ECIDMeasurementFailureIndication) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_LMFUEMeasurementID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.LMFUEMeasurementID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_RANUEMeasurementID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.RANUEMeasurementID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_Cause},
+		Criticality: Criticality{Value: Criticality_PresentIgnore},
+		Value:       &msg.Cause,
+	})
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *ECIDMeasurementFailureIndication) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *ECIDMeasurementFailureIndication) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *ECIDMeasurementFailureIndication) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("ECIDMeasurementFailureIndication", wire, limits, ecidMeasurementFailureIndicationIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var ecidMeasurementFailureIndicationIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_LMFUEMeasurementID, Criticality_PresentReject, true},
+	{ProtocolIEID_RANUEMeasurementID, Criticality_PresentReject, true},
+	{ProtocolIEID_Cause, Criticality_PresentIgnore, true},
+}
+
+func (msg *ECIDMeasurementFailureIndication) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_LMFUEMeasurementID:
+		var tmp LMFUEMeasurementID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("LMFUEMeasurementID", err)
+			return
+		}
+		msg.LMFUEMeasurementID = tmp
+
+	case ProtocolIEID_RANUEMeasurementID:
+		var tmp RANUEMeasurementID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RANUEMeasurementID", err)
+			return
+		}
+		msg.RANUEMeasurementID = tmp
+
+	case ProtocolIEID_Cause:
+		var tmp Cause
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("Cause", err)
+			return
+		}
+		msg.Cause = tmp
+
+	}
+	return
+}
+
+func (msg *ECIDMeasurementFailureIndication) Validate() error {
+	var v validator
+	v.ie("LMFUEMeasurementID", &msg.LMFUEMeasurementID)
+	v.ie("RANUEMeasurementID", &msg.RANUEMeasurementID)
+	return v.messageErr("ECIDMeasurementFailureIndication")
+}
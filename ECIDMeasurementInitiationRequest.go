@@ -0,0 +1,222 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type ECIDMeasurementInitiationRequest struct {
+	GNBCUUEF1APID                         GNBCUUEF1APID                   `mandatory,reject`
+	GNBDUUEF1APID                         GNBDUUEF1APID                   `mandatory,reject`
+	LMFUEMeasurementID                    LMFUEMeasurementID              `mandatory,reject`
+	RANUEMeasurementID                    RANUEMeasurementID              `mandatory,reject`
+	ECIDReportCharacteristics             ECIDReportCharacteristics       `mandatory,reject`
+	ECIDMeasurementPeriodicity            *ECIDMeasurementPeriodicity     `optional,reject`
+	ECIDMeasurementQuantities             []ECIDMeasurementQuantitiesItem `mandatory,reject`
+	ECIDMeasurementQuantitiesUnknownItems []RawIE
+	UnknownIEs                            []RawIE
+}
+
+func (msg *ECIDMeasurementInitiationRequest) ProcedureCode() int64 {
+	return ProcedureCode_ECIDMeasurementInitiation
+}
+
+func (msg *ECIDMeasurementInitiationRequest) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *ECIDMeasurementInitiationRequest) Criticality() aper.Enumerated {
+	return Criticality_PresentReject
+}
+
+func (msg *ECIDMeasurementInitiationRequest) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("ECIDMeasurementInitiationRequest"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *ECIDMeasurementInitiationRequest) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("ECIDMeasurementInitiationRequest"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *ECIDMeasurementInitiationRequest) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_LMFUEMeasurementID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.LMFUEMeasurementID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_RANUEMeasurementID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.RANUEMeasurementID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_ECIDReportCharacteristics},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.ECIDReportCharacteristics,
+	})
+	if msg.ECIDMeasurementPeriodicity != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ECIDMeasurementPeriodicity},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.ECIDMeasurementPeriodicity,
+		})
+	}
+	if len(msg.ECIDMeasurementQuantities) > 0 || len(msg.ECIDMeasurementQuantitiesUnknownItems) > 0 {
+		tmp_ECIDMeasurementQuantities := SingleContainerList[*ECIDMeasurementQuantitiesItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofMeasECID},
+			id:          ProtocolIEID_ECIDMeasurementQuantitiesItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.ECIDMeasurementQuantitiesUnknownItems,
+		}
+		for k := range msg.ECIDMeasurementQuantities {
+			tmp_ECIDMeasurementQuantities.Value = append(tmp_ECIDMeasurementQuantities.Value, &msg.ECIDMeasurementQuantities[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ECIDMeasurementQuantities},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_ECIDMeasurementQuantities,
+		})
+	} else {
+		err = fmt.Errorf("ECIDMeasurementQuantities is nil")
+		return
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *ECIDMeasurementInitiationRequest) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *ECIDMeasurementInitiationRequest) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *ECIDMeasurementInitiationRequest) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("ECIDMeasurementInitiationRequest", wire, limits, ecidMeasurementInitiationRequestIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var ecidMeasurementInitiationRequestIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_LMFUEMeasurementID, Criticality_PresentReject, true},
+	{ProtocolIEID_RANUEMeasurementID, Criticality_PresentReject, true},
+	{ProtocolIEID_ECIDReportCharacteristics, Criticality_PresentReject, true},
+	{ProtocolIEID_ECIDMeasurementPeriodicity, Criticality_PresentReject, false},
+	{ProtocolIEID_ECIDMeasurementQuantities, Criticality_PresentReject, true},
+}
+
+func (msg *ECIDMeasurementInitiationRequest) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_LMFUEMeasurementID:
+		var tmp LMFUEMeasurementID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("LMFUEMeasurementID", err)
+			return
+		}
+		msg.LMFUEMeasurementID = tmp
+
+	case ProtocolIEID_RANUEMeasurementID:
+		var tmp RANUEMeasurementID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RANUEMeasurementID", err)
+			return
+		}
+		msg.RANUEMeasurementID = tmp
+
+	case ProtocolIEID_ECIDReportCharacteristics:
+		var tmp ECIDReportCharacteristics
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("ECIDReportCharacteristics", err)
+			return
+		}
+		msg.ECIDReportCharacteristics = tmp
+
+	case ProtocolIEID_ECIDMeasurementPeriodicity:
+		var tmp ECIDMeasurementPeriodicity
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("ECIDMeasurementPeriodicity", err)
+			return
+		}
+		msg.ECIDMeasurementPeriodicity = &tmp
+
+	case ProtocolIEID_ECIDMeasurementQuantities:
+		tmp := SingleContainerList[*ECIDMeasurementQuantitiesItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofMeasECID},
+			id:          ProtocolIEID_ECIDMeasurementQuantitiesItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *ECIDMeasurementQuantitiesItem { return new(ECIDMeasurementQuantitiesItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("ECIDMeasurementQuantities", err)
+			return
+		}
+		msg.ECIDMeasurementQuantities = []ECIDMeasurementQuantitiesItem{}
+		for _, i := range tmp.Value {
+			msg.ECIDMeasurementQuantities = append(msg.ECIDMeasurementQuantities, *i)
+		}
+		msg.ECIDMeasurementQuantitiesUnknownItems = tmp.Unknown
+
+	}
+	return
+}
+
+func (msg *ECIDMeasurementInitiationRequest) Validate() error {
+	var v validator
+	v.ie("LMFUEMeasurementID", &msg.LMFUEMeasurementID)
+	v.ie("RANUEMeasurementID", &msg.RANUEMeasurementID)
+	v.ie("ECIDReportCharacteristics", &msg.ECIDReportCharacteristics)
+	if msg.ECIDMeasurementPeriodicity != nil {
+		v.ie("ECIDMeasurementPeriodicity", msg.ECIDMeasurementPeriodicity)
+	}
+	if len(msg.ECIDMeasurementQuantities) == 0 {
+		v.missing(ProtocolIEID_ECIDMeasurementQuantities)
+	} else {
+		v.size("ECIDMeasurementQuantities", len(msg.ECIDMeasurementQuantities), 1, maxnoofMeasECID)
+		for i := range msg.ECIDMeasurementQuantities {
+			v.item("ECIDMeasurementQuantities", i, &msg.ECIDMeasurementQuantities[i])
+		}
+	}
+	return v.messageErr("ECIDMeasurementInitiationRequest")
+}
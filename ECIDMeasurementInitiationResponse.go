@@ -0,0 +1,196 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type ECIDMeasurementInitiationResponse struct {
+	GNBCUUEF1APID          GNBCUUEF1APID           `mandatory,reject`
+	GNBDUUEF1APID          GNBDUUEF1APID           `mandatory,reject`
+	LMFUEMeasurementID     LMFUEMeasurementID      `mandatory,reject`
+	RANUEMeasurementID     RANUEMeasurementID      `mandatory,reject`
+	ECIDMeasurementResult  *ECIDMeasurementResult  `optional,ignore`
+	CellPortionID          *CellPortionID          `optional,ignore`
+	CriticalityDiagnostics *CriticalityDiagnostics `optional,ignore`
+	UnknownIEs             []RawIE
+}
+
+func (msg *ECIDMeasurementInitiationResponse) ProcedureCode() int64 {
+	return ProcedureCode_ECIDMeasurementInitiation
+}
+
+func (msg *ECIDMeasurementInitiationResponse) Present() uint8 {
+	return F1apPduSuccessfulOutcome
+}
+
+func (msg *ECIDMeasurementInitiationResponse) Criticality() aper.Enumerated {
+	return Criticality_PresentReject
+}
+
+func (msg *ECIDMeasurementInitiationResponse) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("ECIDMeasurementInitiationResponse"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *ECIDMeasurementInitiationResponse) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("ECIDMeasurementInitiationResponse"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *ECIDMeasurementInitiationResponse) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_LMFUEMeasurementID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.LMFUEMeasurementID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_RANUEMeasurementID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.RANUEMeasurementID,
+	})
+	if msg.ECIDMeasurementResult != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ECIDMeasurementResult},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.ECIDMeasurementResult,
+		})
+	}
+	if msg.CellPortionID != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CellPortionID},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.CellPortionID,
+		})
+	}
+	if msg.CriticalityDiagnostics != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CriticalityDiagnostics},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.CriticalityDiagnostics,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *ECIDMeasurementInitiationResponse) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *ECIDMeasurementInitiationResponse) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *ECIDMeasurementInitiationResponse) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("ECIDMeasurementInitiationResponse", wire, limits, ecidMeasurementInitiationResponseIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var ecidMeasurementInitiationResponseIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_LMFUEMeasurementID, Criticality_PresentReject, true},
+	{ProtocolIEID_RANUEMeasurementID, Criticality_PresentReject, true},
+	{ProtocolIEID_ECIDMeasurementResult, Criticality_PresentIgnore, false},
+	{ProtocolIEID_CellPortionID, Criticality_PresentIgnore, false},
+	{ProtocolIEID_CriticalityDiagnostics, Criticality_PresentIgnore, false},
+}
+
+func (msg *ECIDMeasurementInitiationResponse) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_LMFUEMeasurementID:
+		var tmp LMFUEMeasurementID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("LMFUEMeasurementID", err)
+			return
+		}
+		msg.LMFUEMeasurementID = tmp
+
+	case ProtocolIEID_RANUEMeasurementID:
+		var tmp RANUEMeasurementID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RANUEMeasurementID", err)
+			return
+		}
+		msg.RANUEMeasurementID = tmp
+
+	case ProtocolIEID_ECIDMeasurementResult:
+		var tmp ECIDMeasurementResult
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("ECIDMeasurementResult", err)
+			return
+		}
+		msg.ECIDMeasurementResult = &tmp
+
+	case ProtocolIEID_CellPortionID:
+		var tmp CellPortionID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("CellPortionID", err)
+			return
+		}
+		msg.CellPortionID = &tmp
+
+	case ProtocolIEID_CriticalityDiagnostics:
+		var tmp CriticalityDiagnostics
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("CriticalityDiagnostics", err)
+			return
+		}
+		msg.CriticalityDiagnostics = &tmp
+
+	}
+	return
+}
+
+func (msg *ECIDMeasurementInitiationResponse) Validate() error {
+	var v validator
+	v.ie("LMFUEMeasurementID", &msg.LMFUEMeasurementID)
+	v.ie("RANUEMeasurementID", &msg.RANUEMeasurementID)
+	if msg.ECIDMeasurementResult != nil {
+		v.ie("ECIDMeasurementResult", msg.ECIDMeasurementResult)
+	}
+	if msg.CellPortionID != nil {
+		v.ie("CellPortionID", msg.CellPortionID)
+	}
+	return v.messageErr("ECIDMeasurementInitiationResponse")
+}
@@ -0,0 +1,47 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+const (
+	ECIDMeasurementPeriodicityMs120   aper.Enumerated = 0
+	ECIDMeasurementPeriodicityMs240   aper.Enumerated = 1
+	ECIDMeasurementPeriodicityMs480   aper.Enumerated = 2
+	ECIDMeasurementPeriodicityMs640   aper.Enumerated = 3
+	ECIDMeasurementPeriodicityMs1024  aper.Enumerated = 4
+	ECIDMeasurementPeriodicityMs2048  aper.Enumerated = 5
+	ECIDMeasurementPeriodicityMs5120  aper.Enumerated = 6
+	ECIDMeasurementPeriodicityMs10240 aper.Enumerated = 7
+	ECIDMeasurementPeriodicityMin1    aper.Enumerated = 8
+	ECIDMeasurementPeriodicityMin6    aper.Enumerated = 9
+	ECIDMeasurementPeriodicityMin12   aper.Enumerated = 10
+	ECIDMeasurementPeriodicityMin30   aper.Enumerated = 11
+	ECIDMeasurementPeriodicityMin60   aper.Enumerated = 12
+)
+
+type ECIDMeasurementPeriodicity struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:12,valueExt"`
+}
+
+func (ie *ECIDMeasurementPeriodicity) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 12}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *ECIDMeasurementPeriodicity) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 12}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *ECIDMeasurementPeriodicity) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 13)
+	return v.err()
+}
@@ -0,0 +1,175 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type ECIDMeasurementReport struct {
+	GNBCUUEF1APID         GNBCUUEF1APID         `mandatory,reject`
+	GNBDUUEF1APID         GNBDUUEF1APID         `mandatory,reject`
+	LMFUEMeasurementID    LMFUEMeasurementID    `mandatory,reject`
+	RANUEMeasurementID    RANUEMeasurementID    `mandatory,reject`
+	ECIDMeasurementResult ECIDMeasurementResult `mandatory,ignore`
+	CellPortionID         *CellPortionID        `optional,ignore`
+	UnknownIEs            []RawIE
+}
+
+func (msg *ECIDMeasurementReport) ProcedureCode() int64 {
+	return ProcedureCode_ECIDMeasurementReport
+}
+
+func (msg *ECIDMeasurementReport) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *ECIDMeasurementReport) Criticality() aper.Enumerated {
+	return Criticality_PresentIgnore
+}
+
+func (msg *ECIDMeasurementReport) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("ECIDMeasurementReport"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *ECIDMeasurementReport) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("ECIDMeasurementReport"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *ECIDMeasurementReport) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_LMFUEMeasurementID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.LMFUEMeasurementID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_RANUEMeasurementID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.RANUEMeasurementID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_ECIDMeasurementResult},
+		Criticality: Criticality{Value: Criticality_PresentIgnore},
+		Value:       &msg.ECIDMeasurementResult,
+	})
+	if msg.CellPortionID != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CellPortionID},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.CellPortionID,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *ECIDMeasurementReport) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *ECIDMeasurementReport) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *ECIDMeasurementReport) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("ECIDMeasurementReport", wire, limits, ecidMeasurementReportIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var ecidMeasurementReportIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_LMFUEMeasurementID, Criticality_PresentReject, true},
+	{ProtocolIEID_RANUEMeasurementID, Criticality_PresentReject, true},
+	{ProtocolIEID_ECIDMeasurementResult, Criticality_PresentIgnore, true},
+	{ProtocolIEID_CellPortionID, Criticality_PresentIgnore, false},
+}
+
+func (msg *ECIDMeasurementReport) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_LMFUEMeasurementID:
+		var tmp LMFUEMeasurementID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("LMFUEMeasurementID", err)
+			return
+		}
+		msg.LMFUEMeasurementID = tmp
+
+	case ProtocolIEID_RANUEMeasurementID:
+		var tmp RANUEMeasurementID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RANUEMeasurementID", err)
+			return
+		}
+		msg.RANUEMeasurementID = tmp
+
+	case ProtocolIEID_ECIDMeasurementResult:
+		var tmp ECIDMeasurementResult
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("ECIDMeasurementResult", err)
+			return
+		}
+		msg.ECIDMeasurementResult = tmp
+
+	case ProtocolIEID_CellPortionID:
+		var tmp CellPortionID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("CellPortionID", err)
+			return
+		}
+		msg.CellPortionID = &tmp
+
+	}
+	return
+}
+
+func (msg *ECIDMeasurementReport) Validate() error {
+	var v validator
+	v.ie("LMFUEMeasurementID", &msg.LMFUEMeasurementID)
+	v.ie("RANUEMeasurementID", &msg.RANUEMeasurementID)
+	v.ie("ECIDMeasurementResult", &msg.ECIDMeasurementResult)
+	if msg.CellPortionID != nil {
+		v.ie("CellPortionID", msg.CellPortionID)
+	}
+	return v.messageErr("ECIDMeasurementReport")
+}
@@ -0,0 +1,33 @@
+package ies
+
+import "testing"
+
+func TestECIDMeasurementReportWire(t *testing.T) {
+	zenith := int64(450)
+	m := &ECIDMeasurementReport{
+		GNBCUUEF1APID:      GNBCUUEF1APID{Value: 1},
+		GNBDUUEF1APID:      GNBDUUEF1APID{Value: 2},
+		LMFUEMeasurementID: LMFUEMeasurementID{Value: 1},
+		RANUEMeasurementID: RANUEMeasurementID{Value: 2},
+		ECIDMeasurementResult: ECIDMeasurementResult{
+			MeasuredResultsList: []ECIDMeasuredResultsItem{{
+				ECIDMeasuredResultsValue: ECIDMeasuredResultsValue{
+					Choice:                ECIDMeasuredResultsValuePresentValueAngleofArrivalNR,
+					ValueAngleofArrivalNR: &ULAoA{AzimuthAoA: 900, ZenithAoA: &zenith},
+				},
+			}},
+		},
+	}
+	want := []byte{
+		0x00, 0x36, 0x40, 0x26, // initiatingMessage, e-CIDMeasurementReport, ignore, length
+		0x00, 0x00, 0x05, // extension bit, 5 IEs
+		0x00, 0x28, 0x00, 0x02, 0x00, 0x01, // gNB-CU-UE-F1AP-ID 1
+		0x00, 0x29, 0x00, 0x02, 0x00, 0x02, // gNB-DU-UE-F1AP-ID 2
+		0x01, 0x9c, 0x00, 0x02, 0x00, 0x00, // LMF-UE-MeasurementID 1
+		0x01, 0x9d, 0x00, 0x02, 0x00, 0x01, // RAN-UE-MeasurementID 2
+		0x01, 0xa1, 0x40, 0x07, // E-CID-MeasurementResult
+		0x20, 0x02, 0x00, // measured results of 1 item, angle of arrival with zenith
+		0x03, 0x84, 0x01, 0xc2, // azimuth 900, zenith 450
+	}
+	checkMessage(t, m, new(ECIDMeasurementReport), want)
+}
@@ -0,0 +1,122 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type ECIDMeasurementResult struct {
+	GeographicalCoordinates *GeographicalCoordinates  `optional`
+	MeasuredResultsList     []ECIDMeasuredResultsItem `lb:1,ub:maxnoofMeasECID,optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[ECIDMeasurementResult] `optional,extension`
+}
+
+func (ie *ECIDMeasurementResult) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ECIDMeasurementResult) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.GeographicalCoordinates != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(ie.MeasuredResultsList) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 3)
+	}
+	if err = w.WriteBits(optionals, 3); err != nil {
+		return
+	}
+	if ie.GeographicalCoordinates != nil {
+		if err = ie.GeographicalCoordinates.encode(w); err != nil {
+			err = utils.WrapError("Encode GeographicalCoordinates", err)
+			return
+		}
+	}
+	if len(ie.MeasuredResultsList) > 0 {
+		tmp_MeasuredResultsList := sequenceOf[*ECIDMeasuredResultsItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofMeasECID},
+			ext: false,
+		}
+		for k := range ie.MeasuredResultsList {
+			tmp_MeasuredResultsList.Value = append(tmp_MeasuredResultsList.Value, &ie.MeasuredResultsList[k])
+		}
+		if err = tmp_MeasuredResultsList.encode(w); err != nil {
+			err = utils.WrapError("Encode MeasuredResultsList", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ECIDMeasurementResult) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ECIDMeasurementResult) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(3); err != nil {
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(GeographicalCoordinates)
+		if err = tmp.decode(r); err != nil {
+			err = readError("GeographicalCoordinates", err)
+			return
+		}
+		ie.GeographicalCoordinates = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		var tmp_MeasuredResultsList []*ECIDMeasuredResultsItem
+		if tmp_MeasuredResultsList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofMeasECID}, false, func() *ECIDMeasuredResultsItem { return new(ECIDMeasuredResultsItem) }); err != nil {
+			err = readError("MeasuredResultsList", err)
+			return
+		}
+		ie.MeasuredResultsList = make([]ECIDMeasuredResultsItem, len(tmp_MeasuredResultsList))
+		for k, i := range tmp_MeasuredResultsList {
+			ie.MeasuredResultsList[k] = *i
+		}
+	}
+	if aper.IsBitSet(optionals, 3) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *ECIDMeasurementResult) Validate() error {
+	var v validator
+	if ie.GeographicalCoordinates != nil {
+		v.ie("GeographicalCoordinates", ie.GeographicalCoordinates)
+	}
+	if len(ie.MeasuredResultsList) > 0 {
+		v.size("MeasuredResultsList", len(ie.MeasuredResultsList), 1, maxnoofMeasECID)
+		for i := range ie.MeasuredResultsList {
+			v.item("MeasuredResultsList", i, &ie.MeasuredResultsList[i])
+		}
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
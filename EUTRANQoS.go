@@ -0,0 +1,108 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type EUTRANQoS struct {
+	QCI                            QCI                            `mandatory`
+	AllocationAndRetentionPriority AllocationAndRetentionPriority `mandatory`
+	GbrQosInformation              *GBRQosInformation             `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[EUTRANQoS] `optional,extension`
+}
+
+func (ie *EUTRANQoS) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *EUTRANQoS) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.GbrQosInformation != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	if err = ie.QCI.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode QCI", err)
+		return
+	}
+	if err = ie.AllocationAndRetentionPriority.encode(w); err != nil {
+		err = utils.WrapError("Encode AllocationAndRetentionPriority", err)
+		return
+	}
+	if ie.GbrQosInformation != nil {
+		if err = ie.GbrQosInformation.encode(w); err != nil {
+			err = utils.WrapError("Encode GbrQosInformation", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *EUTRANQoS) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *EUTRANQoS) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	if err = ie.QCI.Decode(r.AperReader); err != nil {
+		err = readError("QCI", err)
+		return
+	}
+	if err = ie.AllocationAndRetentionPriority.decode(r); err != nil {
+		err = readError("AllocationAndRetentionPriority", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(GBRQosInformation)
+		if err = tmp.decode(r); err != nil {
+			err = readError("GbrQosInformation", err)
+			return
+		}
+		ie.GbrQosInformation = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *EUTRANQoS) Validate() error {
+	var v validator
+	v.ie("QCI", &ie.QCI)
+	v.ie("AllocationAndRetentionPriority", &ie.AllocationAndRetentionPriority)
+	if ie.GbrQosInformation != nil {
+		v.ie("GbrQosInformation", ie.GbrQosInformation)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
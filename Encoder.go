@@ -0,0 +1,273 @@
+package ies
+
+import (
+	"io"
+	"sync"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+// an output buffer for the encoding of a PDU. Open type values, from the
+// message value down to the IEs of lists of single containers, extension
+// containers and choice-extensions, are written in place after a one octet
+// length determinant, which is patched once the value is written, so that a
+// message is encoded in a single pass without a buffer per open type. The
+// runs of bits between open types are written with w, closed before the
+// buffer is written directly; closing pads the bits to the octet boundary an
+// open type is aligned on.
+type encoder struct {
+	buf []byte
+	w   *aper.AperWriter // writer of the bits to buf
+}
+
+func (e *encoder) Write(p []byte) (int, error) {
+	e.buf = append(e.buf, p...)
+	return len(p), nil
+}
+
+// larger buffers are not kept by the pool
+const maxPooledBuffer = 64 * 1024
+
+// encoders with their writer
+var encoders = sync.Pool{
+	New: func() any {
+		e := new(encoder)
+		e.w = aper.NewWriter(e)
+		return e
+	},
+}
+
+func getEncoder() *encoder {
+	return encoders.Get().(*encoder)
+}
+
+func putEncoder(e *encoder) {
+	// drop the bits left by an encoding that failed
+	e.w.Close()
+	if cap(e.buf) > maxPooledBuffer {
+		return
+	}
+	e.buf = e.buf[:0]
+	encoders.Put(e)
+}
+
+// a writer of the bits of a value; its open types are written in place in
+// the buffer of e, or each in a buffer of its own when the value is encoded
+// with a writer this package did not create and e is nil
+type writer struct {
+	*aper.AperWriter
+	e *encoder
+}
+
+// a value whose open types are written with a writer
+type openTypeEncoder interface {
+	encode(w *writer) error
+}
+
+// return a writer over a writer this package did not create
+func newWriter(w *aper.AperWriter) *writer {
+	return &writer{AperWriter: w}
+}
+
+// encode v with w; a value of this package writes its open types with w
+func encodeValue(w *writer, v aper.AperMarshaller) error {
+	if x, ok := v.(openTypeEncoder); ok {
+		return x.encode(w)
+	}
+	return v.Encode(w.AperWriter)
+}
+
+// write v as an open type
+func (w *writer) writeOpenType(v aper.AperMarshaller) (err error) {
+	if w.e == nil {
+		e := getEncoder()
+		defer putEncoder(e)
+		if err = e.encode(v); err != nil {
+			return
+		}
+		return w.WriteOpenType(e.buf)
+	}
+	if err = w.Close(); err != nil {
+		return
+	}
+	return w.e.writeOpenType(func() error { return w.e.encode(v) })
+}
+
+// write v with the writer of e
+func (e *encoder) encode(v aper.AperMarshaller) (err error) {
+	if err = encodeValue(&writer{AperWriter: e.w, e: e}, v); err != nil {
+		return
+	}
+	return e.w.Close()
+}
+
+// write an open type whose value is written by fn
+func (e *encoder) writeOpenType(fn func() error) (err error) {
+	start := len(e.buf)
+	e.buf = append(e.buf, 0)
+	if err = fn(); err != nil {
+		return
+	}
+	e.patchLength(start)
+	return
+}
+
+// append a length determinant of n < 16384 (X.691 clause 11.9.3.6 and
+// 11.9.3.7)
+func appendLength(buf []byte, n int) []byte {
+	if n < 128 {
+		return append(buf, byte(n))
+	}
+	return append(buf, 0x80|byte(n>>8), byte(n))
+}
+
+// set the length determinant of the open type value written after the octet
+// at start, moving the value when the length takes more than one octet
+func (e *encoder) patchLength(start int) {
+	n := len(e.buf) - start - 1
+	switch {
+	case n < 128:
+		e.buf[start] = byte(n)
+	case n < 16384:
+		e.buf = append(e.buf, 0)
+		copy(e.buf[start+2:], e.buf[start+1:start+1+n])
+		e.buf[start] = 0x80 | byte(n>>8)
+		e.buf[start+1] = byte(n)
+	default:
+		// fragmented in blocks of 16K octets (X.691 clause 11.9.3.8)
+		value := append([]byte(nil), e.buf[start+1:]...)
+		e.buf = e.buf[:start]
+		for len(value) >= 16384 {
+			m := min(len(value)/16384, 4)
+			e.buf = append(e.buf, 0xc0|byte(m))
+			e.buf = append(e.buf, value[:m*16384]...)
+			value = value[m*16384:]
+		}
+		e.buf = appendLength(e.buf, len(value))
+		e.buf = append(e.buf, value...)
+	}
+}
+
+// write a SEQUENCE OF with the size constraint c, the items with w so that
+// their open types are written in place. A list whose length is out of c, or
+// is fragmented, is written by aper.WriteSequenceOf.
+func writeList[T aper.AperMarshaller](w *writer, items []T, c aper.Constraint, ext bool) (err error) {
+	n := int64(len(items))
+	switch {
+	case n < c.Lb || n > c.Ub:
+		return aper.WriteSequenceOf[T](items, w.AperWriter, &c, ext)
+	case c.Ub < int64(aper.POW_16):
+		// a constrained whole number (X.691 clause 11.9.4.1)
+		if ext {
+			if err = w.WriteBool(aper.Zero); err != nil {
+				return
+			}
+		}
+		if err = w.WriteInteger(n, &c, false); err != nil {
+			return
+		}
+	case w.e != nil && n < 16384:
+		// an unconstrained length determinant, aligned on an octet
+		if ext {
+			if err = w.WriteBool(aper.Zero); err != nil {
+				return
+			}
+		}
+		if err = w.Close(); err != nil {
+			return
+		}
+		w.e.buf = appendLength(w.e.buf, int(n))
+	default:
+		return aper.WriteSequenceOf[T](items, w.AperWriter, &c, ext)
+	}
+	for _, item := range items {
+		if err = encodeValue(w, item); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// a SEQUENCE OF whose items write their open types with the writer of the
+// list
+type sequenceOf[T aper.AperMarshaller] struct {
+	c     aper.Constraint
+	ext   bool
+	Value []T
+}
+
+func (l *sequenceOf[T]) Encode(w *aper.AperWriter) error {
+	return l.encode(newWriter(w))
+}
+
+func (l *sequenceOf[T]) encode(w *writer) error {
+	return writeList(w, l.Value, l.c, l.ext)
+}
+
+// write a protocol IE container with the size constraint c as the value of a
+// message
+func writeContainer[T aper.AperMarshaller](e *encoder, ies []T, c aper.Constraint) (err error) {
+	w := &writer{AperWriter: e.w, e: e}
+	// extension bit of the message SEQUENCE
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	if err = writeList(w, ies, c, false); err != nil {
+		return
+	}
+	return w.Close()
+}
+
+// write the F1AP-PDU header then the message value as an open type;
+// writeIes writes the IE container of the message
+func (e *encoder) encodePdu(present uint8, procedureCode int64, criticality aper.Enumerated, writeIes func(*encoder) error) (err error) {
+	aw := e.w
+	if err = aw.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	if err = aw.WriteChoice(uint64(present), 2, true); err != nil {
+		return
+	}
+	pCode := ProcedureCode{
+		Value: aper.Integer(procedureCode),
+	}
+	if err = pCode.Encode(aw); err != nil {
+		return
+	}
+	cr := Criticality{
+		Value: criticality,
+	}
+	if err = cr.Encode(aw); err != nil {
+		return
+	}
+	if err = aw.Close(); err != nil {
+		return
+	}
+	return e.writeOpenType(func() error { return writeIes(e) })
+}
+
+// write the F1AP-PDU of a message to w
+func writePdu(w io.Writer, present uint8, procedureCode int64, criticality aper.Enumerated, writeIes func(*encoder) error) (err error) {
+	e := getEncoder()
+	defer putEncoder(e)
+	if err = e.encodePdu(present, procedureCode, criticality, writeIes); err != nil {
+		return
+	}
+	_, err = w.Write(e.buf)
+	return
+}
+
+// append the F1AP-PDU of a message to dst
+func appendPdu(dst []byte, present uint8, procedureCode int64, criticality aper.Enumerated, writeIes func(*encoder) error) ([]byte, error) {
+	e := getEncoder()
+	buf := e.buf
+	defer func() {
+		e.buf = buf
+		putEncoder(e)
+	}()
+	e.buf = dst
+	if err := e.encodePdu(present, procedureCode, criticality, writeIes); err != nil {
+		return dst, err
+	}
+	return e.buf, nil
+}
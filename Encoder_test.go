@@ -0,0 +1,219 @@
+package ies
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+// encode a PDU the way it was encoded before the single pass encoder: each
+// open type value in its own buffer, then written with WriteOpenType
+func encodeBuffered(t testing.TB, m F1apMessage, writeIes func(*aper.AperWriter) error) []byte {
+	var out, value bytes.Buffer
+	aw := aper.NewWriter(&out)
+	aw.WriteBool(aper.Zero)
+	aw.WriteChoice(uint64(m.Present()), 2, true)
+	if err := (&ProcedureCode{Value: aper.Integer(m.ProcedureCode())}).Encode(aw); err != nil {
+		t.Fatal(err)
+	}
+	if err := (&Criticality{Value: m.Criticality()}).Encode(aw); err != nil {
+		t.Fatal(err)
+	}
+	cW := aper.NewWriter(&value)
+	cW.WriteBool(aper.Zero)
+	if err := writeIes(cW); err != nil {
+		t.Fatal(err)
+	}
+	cW.Close()
+	if err := aw.WriteOpenType(value.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	aw.Close()
+	return out.Bytes()
+}
+
+func encodeMessageBuffered(t testing.TB, m F1apMessage, ies []F1apMessageIE) []byte {
+	return encodeBuffered(t, m, func(w *aper.AperWriter) error {
+		return aper.WriteSequenceOf[F1apMessageIE](ies, w, &aper.Constraint{Lb: 0, Ub: int64(aper.POW_16 - 1)}, false)
+	})
+}
+
+// check the encodings of m by Encode and AppendEncode against want
+func checkEncoding(t *testing.T, m F1apMessage, want []byte) {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := m.Encode(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Fatalf("Encode:\n got %x\nwant %x", head(buf.Bytes()), head(want))
+	}
+	prefix := []byte{0xde, 0xad}
+	got, err := m.AppendEncode(prefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got[:2], prefix) || !bytes.Equal(got[2:], want) {
+		t.Fatalf("AppendEncode:\n got %x\nwant %x", head(got[2:]), head(want))
+	}
+}
+
+func head(b []byte) []byte {
+	return b[:min(len(b), 32)]
+}
+
+// open type lengths around the bounds of the length determinant forms:
+// one octet, two octets and fragments of 16K octets
+var openTypeLengths = []int{0, 1, 127, 128, 129, 16383, 16384, 16385, 65535, 65536, 65537, 70000, 4*16384 + 127, 4*16384 + 128}
+
+func TestEncodePrivateIELengths(t *testing.T) {
+	local := int64(1)
+	for _, n := range openTypeLengths {
+		m := &PrivateMessage{PrivateIEs: []PrivateIE{{
+			Id:          PrivateIEID{Choice: PrivateIEIDPresentLocal, Local: &local},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &PrivateIERaw{Value: bytes.Repeat([]byte{0xa5}, n)},
+		}}}
+		want := encodeBuffered(t, m, func(w *aper.AperWriter) error {
+			return aper.WriteSequenceOf[PrivateIE](m.PrivateIEs, w, &aper.Constraint{Lb: 1, Ub: maxPrivateIEs}, false)
+		})
+		checkEncoding(t, m, want)
+	}
+}
+
+func TestEncodeMessageLengths(t *testing.T) {
+	for _, n := range openTypeLengths {
+		m := &PositioningAssistanceInformationControl{
+			TransactionID:            TransactionID{Value: 7},
+			PosAssistanceInformation: &PosAssistanceInformation{Value: bytes.Repeat([]byte{0x5a}, n)},
+		}
+		ies, err := m.toIes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := encodeMessageBuffered(t, m, ies)
+		checkEncoding(t, m, want)
+
+		var out PositioningAssistanceInformationControl
+		if err, _ = out.Decode(stripPduHeader(t, want)); err != nil {
+			t.Fatalf("%d: %v", n, err)
+		}
+		if out.TransactionID.Value != 7 || out.PosAssistanceInformation == nil || !bytes.Equal(out.PosAssistanceInformation.Value, m.PosAssistanceInformation.Value) {
+			t.Fatalf("%d: decoded %+v", n, out)
+		}
+	}
+}
+
+// the encoding of b as an open type or an unconstrained OCTET STRING: a
+// length determinant then b, fragmented in blocks of 16K octets
+func openType(b []byte) (out []byte) {
+	for len(b) >= 16384 {
+		m := min(len(b)/16384, 4)
+		out = append(out, 0xc0|byte(m))
+		out = append(out, b[:m*16384]...)
+		b = b[m*16384:]
+	}
+	if len(b) < 128 {
+		out = append(out, byte(len(b)))
+	} else {
+		out = append(out, 0x80|byte(len(b)>>8), byte(len(b)))
+	}
+	return append(out, b...)
+}
+
+// open types nested in a private IE, an extension container and an OCTET
+// STRING are written in place at each level
+func TestEncodeNestedLengths(t *testing.T) {
+	local := int64(1)
+	for _, n := range openTypeLengths {
+		sysInfo := &GNBDUSystemInformation{
+			MIBMessage:   MIBMessage{Value: aper.OctetString{0x01, 0x02}},
+			SIB1Message:  SIB1Message{Value: aper.OctetString{0x03}},
+			SIB12Message: &SIB12Message{Value: bytes.Repeat([]byte{0x3c}, n)},
+		}
+		value := []byte{
+			0x40,             // extensions present
+			0x02, 0x01, 0x02, // MIB
+			0x01, 0x03, // SIB1
+			0x00, 0x00, // 1 extension
+			0x01, 0x36, 0x40, // SIB12
+		}
+		value = append(value, openType(openType(sysInfo.SIB12Message.Value))...)
+
+		var buf bytes.Buffer
+		aw := aper.NewWriter(&buf)
+		if err := sysInfo.Encode(aw); err != nil {
+			t.Fatal(err)
+		}
+		aw.Close()
+		if !bytes.Equal(buf.Bytes(), value) {
+			t.Fatalf("%d: encoded %x", n, head(buf.Bytes()))
+		}
+
+		m := &PrivateMessage{PrivateIEs: []PrivateIE{{
+			Id:          PrivateIEID{Choice: PrivateIEIDPresentLocal, Local: &local},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       sysInfo,
+		}}}
+		want := encodeBuffered(t, m, func(w *aper.AperWriter) error {
+			raw := []PrivateIE{m.PrivateIEs[0]}
+			raw[0].Value = &PrivateIERaw{Value: value}
+			return aper.WriteSequenceOf[PrivateIE](raw, w, &aper.Constraint{Lb: 1, Ub: maxPrivateIEs}, false)
+		})
+		checkEncoding(t, m, want)
+	}
+}
+
+// return the message value of an F1AP-PDU
+func stripPduHeader(t testing.TB, pdu []byte) []byte {
+	lazy, err := DecodeLazy(pdu)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return lazy.wire
+}
+
+func benchmarkMessage() *PositioningAssistanceInformationControl {
+	return &PositioningAssistanceInformationControl{
+		TransactionID:            TransactionID{Value: 7},
+		PosAssistanceInformation: &PosAssistanceInformation{Value: bytes.Repeat([]byte{0x5a}, 1000)},
+		RoutingID:                &RoutingID{Value: []byte{1, 2, 3, 4}},
+	}
+}
+
+func BenchmarkEncode(b *testing.B) {
+	m := benchmarkMessage()
+	var buf bytes.Buffer
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		if err := m.Encode(&buf); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAppendEncode(b *testing.B) {
+	m := benchmarkMessage()
+	dst := make([]byte, 0, 2048)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var err error
+		if dst, err = m.AppendEncode(dst[:0]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEncodeBuffered(b *testing.B) {
+	m := benchmarkMessage()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ies, err := m.toIes()
+		if err != nil {
+			b.Fatal(err)
+		}
+		encodeMessageBuffered(b, m, ies)
+	}
+}
@@ -0,0 +1,37 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+const (
+	EventTypeOndemand aper.Enumerated = 0
+	EventTypePeriodic aper.Enumerated = 1
+	EventTypeStop     aper.Enumerated = 2
+)
+
+type EventType struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:2,valueExt"`
+}
+
+func (ie *EventType) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 2}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *EventType) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 2}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *EventType) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 3)
+	return v.err()
+}
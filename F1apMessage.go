@@ -0,0 +1,363 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+// F1apMessage is implemented by every F1AP message
+type F1apMessage interface {
+	ProcedureCode() int64         // elementary procedure of the message
+	Present() uint8               // F1AP-PDU type: initiating, successful or unsuccessful
+	Criticality() aper.Enumerated // procedure criticality sent in the PDU header
+	Encode(io.Writer) error
+	AppendEncode(dst []byte) ([]byte, error) // append the encoded message to dst
+	Decode([]byte) (error, []CriticalityDiagnosticsIEItem)
+	DecodeWithReport([]byte) (*DecodeReport, error)               // Decode with the TS 38.473 clause 10 checks of the IEs
+	DecodeWithLimits([]byte, DecodeLimits) (*DecodeReport, error) // DecodeWithReport within limits instead of the default ones
+	Validate() error                                              // check constraints and presence of the IEs before encoding
+}
+
+// elementary procedure classes (TS 38.473 clause 8.1)
+const (
+	ProcedureClass1 uint8 = iota + 1 // with a response
+	ProcedureClass2                  // without a response
+)
+
+// describe an elementary procedure; a nil constructor means the message does
+// not exist for the procedure or is not implemented
+type F1apProcedure struct {
+	Code         int64
+	Name         string
+	Class        uint8
+	Initiating   func() F1apMessage
+	Successful   func() F1apMessage
+	Unsuccessful func() F1apMessage
+}
+
+// NewMessage returns a new message of the procedure for a PDU type
+func (p *F1apProcedure) NewMessage(present uint8) (msg F1apMessage, err error) {
+	var fn func() F1apMessage
+	switch present {
+	case F1apPduInitiatingMessage:
+		fn = p.Initiating
+	case F1apPduSuccessfulOutcome:
+		fn = p.Successful
+	case F1apPduUnsuccessfulOutcome:
+		fn = p.Unsuccessful
+	default:
+		err = fmt.Errorf("invalid F1AP-PDU type %d", present)
+		return
+	}
+	if fn == nil {
+		err = fmt.Errorf("%s has no message for F1AP-PDU type %d", p.Name, present)
+		return
+	}
+	msg = fn()
+	return
+}
+
+// LookupProcedure returns the elementary procedure with a procedure code
+func LookupProcedure(code int64) (p *F1apProcedure, ok bool) {
+	p, ok = procedures[code]
+	return
+}
+
+// NewF1apMessage returns a new message for a procedure code and PDU type
+func NewF1apMessage(code int64, present uint8) (msg F1apMessage, err error) {
+	p, ok := procedures[code]
+	if !ok {
+		err = fmt.Errorf("unknown procedure code %d", code)
+		return
+	}
+	return p.NewMessage(present)
+}
+
+var procedures = map[int64]*F1apProcedure{
+	ProcedureCode_Reset: {
+		Name:  "Reset",
+		Class: ProcedureClass1,
+	},
+	ProcedureCode_F1Setup: {
+		Name:       "F1Setup",
+		Class:      ProcedureClass1,
+		Initiating: func() F1apMessage { return new(F1SetupRequest) },
+	},
+	ProcedureCode_ErrorIndication: {
+		Name:  "ErrorIndication",
+		Class: ProcedureClass2,
+	},
+	ProcedureCode_gNBDUConfigurationUpdate: {
+		Name:       "gNBDUConfigurationUpdate",
+		Class:      ProcedureClass1,
+		Initiating: func() F1apMessage { return new(GNBDUConfigurationUpdate) },
+	},
+	ProcedureCode_gNBCUConfigurationUpdate: {
+		Name:  "gNBCUConfigurationUpdate",
+		Class: ProcedureClass1,
+	},
+	ProcedureCode_UEContextSetup: {
+		Name:       "UEContextSetup",
+		Class:      ProcedureClass1,
+		Initiating: func() F1apMessage { return new(UEContextSetupRequest) },
+		Successful: func() F1apMessage { return new(UEContextSetupResponse) },
+	},
+	ProcedureCode_UEContextRelease: {
+		Name:  "UEContextRelease",
+		Class: ProcedureClass1,
+	},
+	ProcedureCode_UEContextModification: {
+		Name:       "UEContextModification",
+		Class:      ProcedureClass1,
+		Initiating: func() F1apMessage { return new(UEContextModificationRequest) },
+		Successful: func() F1apMessage { return new(UEContextModificationResponse) },
+	},
+	ProcedureCode_UEContextModificationRequired: {
+		Name:       "UEContextModificationRequired",
+		Class:      ProcedureClass1,
+		Initiating: func() F1apMessage { return new(UEContextModificationRequired) },
+		Successful: func() F1apMessage { return new(UEContextModificationConfirm) },
+	},
+	ProcedureCode_UEMobilityCommand: {
+		Name:  "UEMobilityCommand",
+		Class: ProcedureClass2,
+	},
+	ProcedureCode_UEContextReleaseRequest: {
+		Name:       "UEContextReleaseRequest",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(UEContextReleaseRequest) },
+	},
+	ProcedureCode_InitialULRRCMessageTransfer: {
+		Name:       "InitialULRRCMessageTransfer",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(InitialULRRCMessageTransfer) },
+	},
+	ProcedureCode_DLRRCMessageTransfer: {
+		Name:       "DLRRCMessageTransfer",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(DLRRCMessageTransfer) },
+	},
+	ProcedureCode_ULRRCMessageTransfer: {
+		Name:       "ULRRCMessageTransfer",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(ULRRCMessageTransfer) },
+	},
+	ProcedureCode_PrivateMessage: {
+		Name:       "PrivateMessage",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(PrivateMessage) },
+	},
+	ProcedureCode_UEInactivityNotification: {
+		Name:  "UEInactivityNotification",
+		Class: ProcedureClass2,
+	},
+	ProcedureCode_gNBDUResourceCoordination: {
+		Name:  "gNBDUResourceCoordination",
+		Class: ProcedureClass1,
+	},
+	ProcedureCode_SystemInformationDeliveryCommand: {
+		Name:       "SystemInformationDeliveryCommand",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(SystemInformationDeliveryCommand) },
+	},
+	ProcedureCode_Paging: {
+		Name:  "Paging",
+		Class: ProcedureClass2,
+	},
+	ProcedureCode_Notify: {
+		Name:       "Notify",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(Notify) },
+	},
+	ProcedureCode_WriteReplaceWarning: {
+		Name:  "WriteReplaceWarning",
+		Class: ProcedureClass1,
+	},
+	ProcedureCode_PWSCancel: {
+		Name:  "PWSCancel",
+		Class: ProcedureClass1,
+	},
+	ProcedureCode_PWSRestartIndication: {
+		Name:  "PWSRestartIndication",
+		Class: ProcedureClass2,
+	},
+	ProcedureCode_PWSFailureIndication: {
+		Name:  "PWSFailureIndication",
+		Class: ProcedureClass2,
+	},
+	ProcedureCode_gNBDUStatusIndication: {
+		Name:  "gNBDUStatusIndication",
+		Class: ProcedureClass2,
+	},
+	ProcedureCode_RRCDeliveryReport: {
+		Name:  "RRCDeliveryReport",
+		Class: ProcedureClass2,
+	},
+	ProcedureCode_F1Removal: {
+		Name:  "F1Removal",
+		Class: ProcedureClass1,
+	},
+	ProcedureCode_NetworkAccessRateReduction: {
+		Name:       "NetworkAccessRateReduction",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(NetworkAccessRateReduction) },
+	},
+	ProcedureCode_TraceStart: {
+		Name:       "TraceStart",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(TraceStart) },
+	},
+	ProcedureCode_DeactivateTrace: {
+		Name:       "DeactivateTrace",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(DeactivateTrace) },
+	},
+	ProcedureCode_DUCURadioInformationTransfer: {
+		Name:       "DUCURadioInformationTransfer",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(DUCURadioInformationTransfer) },
+	},
+	ProcedureCode_CUDURadioInformationTransfer: {
+		Name:       "CUDURadioInformationTransfer",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(CUDURadioInformationTransfer) },
+	},
+	ProcedureCode_BAPMappingConfiguration: {
+		Name:  "BAPMappingConfiguration",
+		Class: ProcedureClass1,
+	},
+	ProcedureCode_gNBDUResourceConfiguration: {
+		Name:  "gNBDUResourceConfiguration",
+		Class: ProcedureClass1,
+	},
+	ProcedureCode_IABTNLAddressAllocation: {
+		Name:  "IABTNLAddressAllocation",
+		Class: ProcedureClass1,
+	},
+	ProcedureCode_IABUPConfigurationUpdate: {
+		Name:  "IABUPConfigurationUpdate",
+		Class: ProcedureClass1,
+	},
+	ProcedureCode_ResourceStatusReportingInitiation: {
+		Name:  "ResourceStatusReportingInitiation",
+		Class: ProcedureClass1,
+	},
+	ProcedureCode_ResourceStatusReporting: {
+		Name:  "ResourceStatusReporting",
+		Class: ProcedureClass2,
+	},
+	ProcedureCode_AccessAndMobilityIndication: {
+		Name:  "AccessAndMobilityIndication",
+		Class: ProcedureClass2,
+	},
+	ProcedureCode_AccessSuccess: {
+		Name:  "AccessSuccess",
+		Class: ProcedureClass2,
+	},
+	ProcedureCode_CellTrafficTrace: {
+		Name:       "CellTrafficTrace",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(CellTrafficTrace) },
+	},
+	ProcedureCode_PositioningMeasurementExchange: {
+		Name:  "PositioningMeasurementExchange",
+		Class: ProcedureClass1,
+	},
+	ProcedureCode_PositioningAssistanceInformationControl: {
+		Name:       "PositioningAssistanceInformationControl",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(PositioningAssistanceInformationControl) },
+	},
+	ProcedureCode_PositioningAssistanceInformationFeedback: {
+		Name:       "PositioningAssistanceInformationFeedback",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(PositioningAssistanceInformationFeedback) },
+	},
+	ProcedureCode_PositioningMeasurementReport: {
+		Name:  "PositioningMeasurementReport",
+		Class: ProcedureClass2,
+	},
+	ProcedureCode_PositioningMeasurementAbort: {
+		Name:  "PositioningMeasurementAbort",
+		Class: ProcedureClass2,
+	},
+	ProcedureCode_PositioningMeasurementFailureIndication: {
+		Name:  "PositioningMeasurementFailureIndication",
+		Class: ProcedureClass2,
+	},
+	ProcedureCode_PositioningMeasurementUpdate: {
+		Name:  "PositioningMeasurementUpdate",
+		Class: ProcedureClass2,
+	},
+	ProcedureCode_TRPInformationExchange: {
+		Name:         "TRPInformationExchange",
+		Class:        ProcedureClass1,
+		Initiating:   func() F1apMessage { return new(TRPInformationRequest) },
+		Successful:   func() F1apMessage { return new(TRPInformationResponse) },
+		Unsuccessful: func() F1apMessage { return new(TRPInformationFailure) },
+	},
+	ProcedureCode_PositioningInformationExchange: {
+		Name:         "PositioningInformationExchange",
+		Class:        ProcedureClass1,
+		Initiating:   func() F1apMessage { return new(PositioningInformationRequest) },
+		Successful:   func() F1apMessage { return new(PositioningInformationResponse) },
+		Unsuccessful: func() F1apMessage { return new(PositioningInformationFailure) },
+	},
+	ProcedureCode_PositioningActivation: {
+		Name:         "PositioningActivation",
+		Class:        ProcedureClass1,
+		Initiating:   func() F1apMessage { return new(PositioningActivationRequest) },
+		Successful:   func() F1apMessage { return new(PositioningActivationResponse) },
+		Unsuccessful: func() F1apMessage { return new(PositioningActivationFailure) },
+	},
+	ProcedureCode_PositioningDeactivation: {
+		Name:       "PositioningDeactivation",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(PositioningDeactivation) },
+	},
+	ProcedureCode_ECIDMeasurementInitiation: {
+		Name:         "ECIDMeasurementInitiation",
+		Class:        ProcedureClass1,
+		Initiating:   func() F1apMessage { return new(ECIDMeasurementInitiationRequest) },
+		Successful:   func() F1apMessage { return new(ECIDMeasurementInitiationResponse) },
+		Unsuccessful: func() F1apMessage { return new(ECIDMeasurementInitiationFailure) },
+	},
+	ProcedureCode_ECIDMeasurementFailureIndication: {
+		Name:       "ECIDMeasurementFailureIndication",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(ECIDMeasurementFailureIndication) },
+	},
+	ProcedureCode_ECIDMeasurementReport: {
+		Name:       "ECIDMeasurementReport",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(ECIDMeasurementReport) },
+	},
+	ProcedureCode_ECIDMeasurementTermination: {
+		Name:       "ECIDMeasurementTermination",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(ECIDMeasurementTerminationCommand) },
+	},
+	ProcedureCode_PositioningInformationUpdate: {
+		Name:       "PositioningInformationUpdate",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(PositioningInformationUpdate) },
+	},
+	ProcedureCode_ReferenceTimeInformationReport: {
+		Name:       "ReferenceTimeInformationReport",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(ReferenceTimeInformationReport) },
+	},
+	ProcedureCode_ReferenceTimeInformationReportingControl: {
+		Name:       "ReferenceTimeInformationReportingControl",
+		Class:      ProcedureClass2,
+		Initiating: func() F1apMessage { return new(ReferenceTimeInformationReportingControl) },
+	},
+}
+
+func init() {
+	for code, p := range procedures {
+		p.Code = code
+	}
+}
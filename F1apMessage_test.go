@@ -0,0 +1,81 @@
+package ies
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestProcedureRegistry(t *testing.T) {
+	for code, p := range procedures {
+		if lp, ok := LookupProcedure(code); !ok || lp != p || p.Code != code {
+			t.Fatalf("procedure %d: %+v", code, lp)
+		}
+		if p.Class != ProcedureClass1 && p.Class != ProcedureClass2 {
+			t.Fatalf("%s: class %d", p.Name, p.Class)
+		}
+		for present, fn := range map[uint8]func() F1apMessage{
+			F1apPduInitiatingMessage:   p.Initiating,
+			F1apPduSuccessfulOutcome:   p.Successful,
+			F1apPduUnsuccessfulOutcome: p.Unsuccessful,
+		} {
+			msg, err := NewF1apMessage(code, present)
+			if fn == nil {
+				if err == nil {
+					t.Fatalf("%s: message %T for PDU type %d", p.Name, msg, present)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("%s: %v", p.Name, err)
+			}
+			if msg.ProcedureCode() != code || msg.Present() != present {
+				t.Fatalf("%s: %T is procedure %d PDU type %d", p.Name, msg, msg.ProcedureCode(), msg.Present())
+			}
+		}
+		if p.Class == ProcedureClass2 && (p.Successful != nil || p.Unsuccessful != nil) {
+			t.Fatalf("%s: class 2 procedure with a response", p.Name)
+		}
+	}
+	if _, ok := LookupProcedure(255); ok {
+		t.Fatal("procedure 255 found")
+	}
+	if _, err := NewF1apMessage(255, F1apPduInitiatingMessage); err == nil {
+		t.Fatal("message for procedure 255")
+	}
+	if _, err := NewF1apMessage(ProcedureCode_F1Setup, 3); err == nil {
+		t.Fatal("message for PDU type 3")
+	}
+}
+
+func TestDecodeByRegistry(t *testing.T) {
+	wire := []byte{
+		0x00, 0x39, 0x40, 0x16, // initiatingMessage, referenceTimeInformationReport, ignore, length
+		0x00, 0x00, 0x02, // extension bit, 2 IEs
+		0x00, 0x4e, 0x40, 0x02, 0x00, 0x02, // TransactionID 2
+		0x01, 0x6e, 0x40, 0x09, // TimeReferenceInformation
+		0x00, 0x02, 0x01, 0x02, 0x02, 0x00, 0x00, 0x00, 0x0a,
+	}
+	lazy, err := DecodeLazy(wire)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := NewF1apMessage(lazy.ProcedureCode, lazy.Present)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err, _ := msg.Decode(lazy.wire); err != nil {
+		t.Fatal(err)
+	}
+	m, ok := msg.(*ReferenceTimeInformationReport)
+	if !ok {
+		t.Fatalf("decoded %T", msg)
+	}
+	want := TimeReferenceInformation{
+		ReferenceTime: ReferenceTime{Value: []byte{0x01, 0x02}},
+		ReferenceSFN:  ReferenceSFN{Value: 512},
+		Uncertainty:   Uncertainty{Value: 10},
+	}
+	if m.TransactionID.Value != 2 || !reflect.DeepEqual(m.TimeReferenceInformation, want) {
+		t.Fatalf("decoded %+v", m)
+	}
+}
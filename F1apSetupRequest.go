@@ -1,23 +1,35 @@
 package ies
 
 import (
-	"bytes"
 	"fmt"
 	"io"
 
 	"github.com/lvdund/ngap/aper"
-	"github.com/reogac/utils"
 )
 
 type F1SetupRequest struct {
-	TransactionID             TransactionID              `mandatory,reject`
-	GNBDUID                   GNBDUID                    `mandatory,reject`
-	GNBDUName                 []byte                     `optional,ignore,valueExt`
-	GNBDUServedCellsList      []GNBDUServedCellItem      `optional,ignore`
-	GNBDURRCVersion           RRCVersion                 `mandatory,reject`
-	TransportLayerAddressInfo *TransportLayerAddressInfo `optional,ignore`
-	BAPAddress                *BAPAddress                `optional,ignore`
-	ExtendedGNBCUName         *ExtendedGNBCUName         `optional,ignore`
+	TransactionID                    TransactionID              `mandatory,reject`
+	GNBDUID                          GNBDUID                    `mandatory,reject`
+	GNBDUName                        []byte                     `optional,ignore,valueExt`
+	GNBDUServedCellsList             []GNBDUServedCellItem      `optional,ignore`
+	GNBDURRCVersion                  RRCVersion                 `mandatory,reject`
+	TransportLayerAddressInfo        *TransportLayerAddressInfo `optional,ignore`
+	BAPAddress                       *BAPAddress                `optional,ignore`
+	ExtendedGNBCUName                *ExtendedGNBCUName         `optional,ignore`
+	GNBDUServedCellsListUnknownItems []RawIE
+	UnknownIEs                       []RawIE
+}
+
+func (msg *F1SetupRequest) ProcedureCode() int64 {
+	return ProcedureCode_F1Setup
+}
+
+func (msg *F1SetupRequest) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *F1SetupRequest) Criticality() aper.Enumerated {
+	return Criticality_PresentReject
 }
 
 func (msg *F1SetupRequest) Encode(w io.Writer) (err error) {
@@ -26,7 +38,15 @@ func (msg *F1SetupRequest) Encode(w io.Writer) (err error) {
 		err = msgErrors(fmt.Errorf("F1SetupRequest"), err)
 		return
 	}
-	return encodeMessage(w, F1apPduInitiatingMessage, ProcedureCode_F1Setup, Criticality_PresentReject, ies)
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *F1SetupRequest) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("F1SetupRequest"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
 }
 
 func (msg *F1SetupRequest) toIes() (ies []F1apMessageIE, err error) {
@@ -51,13 +71,15 @@ func (msg *F1SetupRequest) toIes() (ies []F1apMessageIE, err error) {
 				Value: msg.GNBDUName,
 			}})
 	}
-	if len(msg.GNBDUServedCellsList) > 0 {
-		tmp_GNBDUServedCellsList := Sequence[*GNBDUServedCellItem]{
-			c:   aper.Constraint{Lb: 1, Ub: maxCellingNBDU},
-			ext: false,
+	if len(msg.GNBDUServedCellsList) > 0 || len(msg.GNBDUServedCellsListUnknownItems) > 0 {
+		tmp_GNBDUServedCellsList := SingleContainerList[*GNBDUServedCellItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxCellingNBDU},
+			id:          ProtocolIEID_gNBDUServedCellsItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.GNBDUServedCellsListUnknownItems,
 		}
-		for _, i := range msg.GNBDUServedCellsList {
-			tmp_GNBDUServedCellsList.Value = append(tmp_GNBDUServedCellsList.Value, &i)
+		for k := range msg.GNBDUServedCellsList {
+			tmp_GNBDUServedCellsList.Value = append(tmp_GNBDUServedCellsList.Value, &msg.GNBDUServedCellsList[k])
 		}
 		ies = append(ies, F1apMessageIE{
 			Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUServedCellsList},
@@ -65,7 +87,7 @@ func (msg *F1SetupRequest) toIes() (ies []F1apMessageIE, err error) {
 			Value:       &tmp_GNBDUServedCellsList,
 		})
 	} else {
-		err = utils.WrapError("GNBDUServedCellsList is nil", err)
+		err = fmt.Errorf("GNBDUServedCellsList is nil")
 		return
 	}
 
@@ -98,104 +120,68 @@ func (msg *F1SetupRequest) toIes() (ies []F1apMessageIE, err error) {
 			Value:       msg.ExtendedGNBCUName,
 		})
 	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
 	return
 }
 
 func (msg *F1SetupRequest) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
-	defer func() {
-		if err != nil {
-			err = msgErrors(fmt.Errorf("F1SetupRequest"), err)
-		}
-	}()
-	r := aper.NewReader(bytes.NewReader(wire))
-	r.ReadBool()
-	decoder := F1SetupRequestDecoder{
-		msg:  msg,
-		list: make(map[aper.Integer]*F1apMessageIE),
-	}
-	if _, err = aper.ReadSequenceOf[F1apMessageIE](decoder.decodeIE, r, &aper.Constraint{Lb: 0, Ub: int64(aper.POW_16 - 1)}, false); err != nil {
-		return
-	}
-	if _, ok := decoder.list[ProtocolIEID_TransactionID]; !ok {
-		err = fmt.Errorf("Mandatory field TransactionID is missing")
-		decoder.diagList = append(decoder.diagList, CriticalityDiagnosticsIEItem{
-			IECriticality: Criticality{Value: Criticality_PresentReject},
-			IEID:          ProtocolIEID{Value: ProtocolIEID_TransactionID},
-			TypeOfError:   TypeOfError{Value: TypeOfErrorMissing},
-		})
-		return
-	}
-	if _, ok := decoder.list[ProtocolIEID_gNBDUID]; !ok {
-		err = fmt.Errorf("Mandatory field GNBDUID is missing")
-		decoder.diagList = append(decoder.diagList, CriticalityDiagnosticsIEItem{
-			IECriticality: Criticality{Value: Criticality_PresentReject},
-			IEID:          ProtocolIEID{Value: ProtocolIEID_gNBDUID},
-			TypeOfError:   TypeOfError{Value: TypeOfErrorMissing},
-		})
-		return
-	}
-	if _, ok := decoder.list[ProtocolIEID_GNBDURRCVersion]; !ok {
-		err = fmt.Errorf("Mandatory field GNBDURRCVersion is missing")
-		decoder.diagList = append(decoder.diagList, CriticalityDiagnosticsIEItem{
-			IECriticality: Criticality{Value: Criticality_PresentReject},
-			IEID:          ProtocolIEID{Value: ProtocolIEID_GNBDURRCVersion},
-			TypeOfError:   TypeOfError{Value: TypeOfErrorMissing},
-		})
-		return
-	}
-	return
+	decoder := F1SetupRequestDecoder{msg: msg}
+	err = decoder.decode(wire)
+	return err, decoder.diagList
 }
 
+// F1SetupRequestDecoder decodes the value of an F1SetupRequest into msg and
+// keeps the criticality diagnostics of its erroneous IEs.
+//
+// Deprecated: F1SetupRequest.DecodeWithReport decodes the message and reports
+// its erroneous IEs.
 type F1SetupRequestDecoder struct {
 	msg      *F1SetupRequest
 	diagList []CriticalityDiagnosticsIEItem
-	list     map[aper.Integer]*F1apMessageIE
 }
 
-func (decoder *F1SetupRequestDecoder) decodeIE(r *aper.AperReader) (msgIe *F1apMessageIE, err error) {
-	var id int64
-	var c uint64
-	var buf []byte
-
-	if id, err = r.ReadInteger(&aper.Constraint{Lb: 0, Ub: int64(aper.POW_16) - 1}, false); err != nil {
-		return
-	}
-	msgIe = new(F1apMessageIE)
-	msgIe.Id.Value = aper.Integer(id)
-
-	if c, err = r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 2}, false); err != nil {
-		return
+func (decoder *F1SetupRequestDecoder) decode(wire []byte) (err error) {
+	var rep *DecodeReport
+	msg := decoder.msg
+	if rep, err = decodeMessage("F1SetupRequest", wire, DefaultDecodeLimits(), f1SetupRequestIEs, msg.decodeIE, &msg.UnknownIEs); rep != nil {
+		decoder.diagList = rep.Diagnostics()
 	}
-	msgIe.Criticality.Value = aper.Enumerated(c)
+	return
+}
 
-	if buf, err = r.ReadOpenType(); err != nil {
-		return
-	}
+func (msg *F1SetupRequest) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
 
-	ieId := msgIe.Id.Value
-	if _, ok := decoder.list[ieId]; ok {
-		err = fmt.Errorf("Duplicated protocol IEID[%d] found", ieId)
-		return
-	}
-	decoder.list[ieId] = msgIe
+func (msg *F1SetupRequest) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("F1SetupRequest", wire, limits, f1SetupRequestIEs, msg.decodeIE, &msg.UnknownIEs)
+}
 
-	ieR := aper.NewReader(bytes.NewReader(buf))
-	msg := decoder.msg
+var f1SetupRequestIEs = []messageIE{
+	{ProtocolIEID_TransactionID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUName, Criticality_PresentIgnore, false},
+	{ProtocolIEID_gNBDUServedCellsList, Criticality_PresentReject, false},
+	{ProtocolIEID_GNBDURRCVersion, Criticality_PresentReject, true},
+	{ProtocolIEID_TransportLayerAddressInfo, Criticality_PresentIgnore, false},
+	{ProtocolIEID_BAPAddress, Criticality_PresentIgnore, false},
+	{ProtocolIEID_ExtendedGNBCUName, Criticality_PresentIgnore, false},
+}
 
-	// decode each IE
-	switch msgIe.Id.Value {
+func (msg *F1SetupRequest) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
 	case ProtocolIEID_TransactionID:
 		var tmp TransactionID
-		if err = tmp.Decode(ieR); err != nil {
-			err = utils.WrapError("Read TransactionID", err)
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("TransactionID", err)
 			return
 		}
 		msg.TransactionID = tmp
 
 	case ProtocolIEID_gNBDUID:
 		var tmp GNBDUID
-		if err = tmp.Decode(ieR); err != nil {
-			err = utils.WrapError("Read GNBDUID", err)
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUID", err)
 			return
 		}
 		msg.GNBDUID = tmp
@@ -205,76 +191,80 @@ func (decoder *F1SetupRequestDecoder) decodeIE(r *aper.AperReader) (msgIe *F1apM
 			c:   aper.Constraint{Lb: 1, Ub: 150},
 			ext: true,
 		}
-		if err = tmp.Decode(ieR); err != nil {
-			err = utils.WrapError("Read GNBDUName", err)
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUName", err)
 			return
 		}
 		msg.GNBDUName = tmp.Value
 
 	case ProtocolIEID_gNBDUServedCellsList:
-		tmp := Sequence[*GNBDUServedCellItem]{
-			c:   aper.Constraint{Lb: 1, Ub: maxCellingNBDU},
-			ext: false,
+		tmp := SingleContainerList[*GNBDUServedCellItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxCellingNBDU},
+			id:          ProtocolIEID_gNBDUServedCellsItem,
+			criticality: Criticality_PresentReject,
 		}
 		fn := func() *GNBDUServedCellItem { return new(GNBDUServedCellItem) }
-		if err = tmp.Decode(ieR, fn); err != nil {
-			err = utils.WrapError("Read GNBDUServedCellsList", err)
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("GNBDUServedCellsList", err)
 			return
 		}
 		msg.GNBDUServedCellsList = []GNBDUServedCellItem{}
 		for _, i := range tmp.Value {
 			msg.GNBDUServedCellsList = append(msg.GNBDUServedCellsList, *i)
 		}
+		msg.GNBDUServedCellsListUnknownItems = tmp.Unknown
 
 	case ProtocolIEID_GNBDURRCVersion:
 		var tmp RRCVersion
-		if err = tmp.Decode(ieR); err != nil {
-			err = utils.WrapError("Read GNBDURRCVersion", err)
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDURRCVersion", err)
 			return
 		}
 		msg.GNBDURRCVersion = tmp
 
 	case ProtocolIEID_TransportLayerAddressInfo:
 		var tmp TransportLayerAddressInfo
-		if err = tmp.Decode(ieR); err != nil {
-			err = utils.WrapError("Read TransportLayerAddressInfo", err)
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("TransportLayerAddressInfo", err)
 			return
 		}
 		msg.TransportLayerAddressInfo = &tmp
 
 	case ProtocolIEID_BAPAddress:
 		var tmp BAPAddress
-		if err = tmp.Decode(ieR); err != nil {
-			err = utils.WrapError("Read BAPAddress", err)
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("BAPAddress", err)
 			return
 		}
 		msg.BAPAddress = &tmp
 
 	case ProtocolIEID_ExtendedGNBCUName:
 		var tmp ExtendedGNBCUName
-		if err = tmp.Decode(ieR); err != nil {
-			err = utils.WrapError("Read ExtendedGNBCUName", err)
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("ExtendedGNBCUName", err)
 			return
 		}
 		msg.ExtendedGNBCUName = &tmp
+	}
+	return
+}
 
-	default:
-		switch msgIe.Criticality.Value {
-		case Criticality_PresentReject:
-			fmt.Errorf("Not comprehended IE ID 0x%04x (criticality: reject)", msgIe.Id.Value)
-		case Criticality_PresentIgnore:
-			fmt.Errorf("Not comprehended IE ID 0x%04x (criticality: ignore)", msgIe.Id.Value)
-		case Criticality_PresentNotify:
-			fmt.Errorf("Not comprehended IE ID 0x%04x (criticality: notify)", msgIe.Id.Value)
-		}
-		if msgIe.Criticality.Value != Criticality_PresentIgnore {
-			decoder.diagList = append(decoder.diagList, CriticalityDiagnosticsIEItem{
-				IECriticality: msgIe.Criticality,
-				IEID:          msgIe.Id,
-				TypeOfError:   TypeOfError{Value: TypeOfErrorNotunderstood},
-			})
+func (msg *F1SetupRequest) Validate() error {
+	var v validator
+	v.integer("TransactionID", int64(msg.TransactionID.Value), 0, 255)
+	v.integer("GNBDUID", int64(msg.GNBDUID.Value), 0, maxGNBDUID)
+	if msg.GNBDUName != nil {
+		v.size("GNBDUName", len(msg.GNBDUName), 1, 150)
+	}
+	if len(msg.GNBDUServedCellsList) > 0 {
+		v.size("GNBDUServedCellsList", len(msg.GNBDUServedCellsList), 1, maxCellingNBDU)
+		for i := range msg.GNBDUServedCellsList {
+			v.item("GNBDUServedCellsList", i, &msg.GNBDUServedCellsList[i])
 		}
 	}
-
-	return
+	v.size("GNBDURRCVersion", int(msg.GNBDURRCVersion.LatestRRCVersion.NumBits), 3, 3)
+	if msg.BAPAddress != nil {
+		v.ie("BAPAddress", msg.BAPAddress)
+	}
+	return v.messageErr("F1SetupRequest")
 }
@@ -0,0 +1,182 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type FDDInfo struct {
+	ULNRFreqInfo            NRFreqInfo            `mandatory`
+	DLNRFreqInfo            NRFreqInfo            `mandatory`
+	ULTransmissionBandwidth TransmissionBandwidth `mandatory`
+	DLTransmissionBandwidth TransmissionBandwidth `mandatory`
+	// IEExtensions
+	ULCarrierList []NRCarrierItem                     `lb:1,ub:maxnoofNrCellBands,optional,ignore,extension`
+	DLCarrierList []NRCarrierItem                     `lb:1,ub:maxnoofNrCellBands,optional,ignore,extension`
+	Extensions    ProtocolExtensionContainer[FDDInfo] `optional,extension`
+}
+
+func (ie *FDDInfo) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *FDDInfo) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(ie.extensions())
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.ULNRFreqInfo.encode(w); err != nil {
+		err = utils.WrapError("Encode ULNRFreqInfo", err)
+		return
+	}
+	if err = ie.DLNRFreqInfo.encode(w); err != nil {
+		err = utils.WrapError("Encode DLNRFreqInfo", err)
+		return
+	}
+	if err = ie.ULTransmissionBandwidth.encode(w); err != nil {
+		err = utils.WrapError("Encode ULTransmissionBandwidth", err)
+		return
+	}
+	if err = ie.DLTransmissionBandwidth.encode(w); err != nil {
+		err = utils.WrapError("Encode DLTransmissionBandwidth", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *FDDInfo) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *FDDInfo) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.ULNRFreqInfo.decode(r); err != nil {
+		err = readError("ULNRFreqInfo", err)
+		return
+	}
+	if err = ie.DLNRFreqInfo.decode(r); err != nil {
+		err = readError("DLNRFreqInfo", err)
+		return
+	}
+	if err = ie.ULTransmissionBandwidth.decode(r); err != nil {
+		err = readError("ULTransmissionBandwidth", err)
+		return
+	}
+	if err = ie.DLTransmissionBandwidth.decode(r); err != nil {
+		err = readError("DLTransmissionBandwidth", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, ie.decodeExtension); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *FDDInfo) extensions() (exts []F1apMessageIE) {
+	if len(ie.ULCarrierList) > 0 {
+		tmp_ULCarrierList := sequenceOf[*NRCarrierItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofNrCellBands},
+			ext: false,
+		}
+		for k := range ie.ULCarrierList {
+			tmp_ULCarrierList.Value = append(tmp_ULCarrierList.Value, &ie.ULCarrierList[k])
+		}
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ULCarrierList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_ULCarrierList,
+		})
+	}
+	if len(ie.DLCarrierList) > 0 {
+		tmp_DLCarrierList := sequenceOf[*NRCarrierItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofNrCellBands},
+			ext: false,
+		}
+		for k := range ie.DLCarrierList {
+			tmp_DLCarrierList.Value = append(tmp_DLCarrierList.Value, &ie.DLCarrierList[k])
+		}
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DLCarrierList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_DLCarrierList,
+		})
+	}
+	return
+}
+
+func (ie *FDDInfo) decodeExtension(id aper.Integer, r *decoder) (ok bool, err error) {
+	ok = true
+	switch id {
+	case ProtocolIEID_ULCarrierList:
+		var tmp []*NRCarrierItem
+		if tmp, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofNrCellBands}, false, func() *NRCarrierItem { return new(NRCarrierItem) }); err != nil {
+			err = readError("ULCarrierList", err)
+			return
+		}
+		ie.ULCarrierList = make([]NRCarrierItem, len(tmp))
+		for k, i := range tmp {
+			ie.ULCarrierList[k] = *i
+		}
+	case ProtocolIEID_DLCarrierList:
+		var tmp []*NRCarrierItem
+		if tmp, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofNrCellBands}, false, func() *NRCarrierItem { return new(NRCarrierItem) }); err != nil {
+			err = readError("DLCarrierList", err)
+			return
+		}
+		ie.DLCarrierList = make([]NRCarrierItem, len(tmp))
+		for k, i := range tmp {
+			ie.DLCarrierList[k] = *i
+		}
+	default:
+		ok = false
+	}
+	return
+}
+
+func (ie *FDDInfo) Validate() error {
+	var v validator
+	v.ie("ULNRFreqInfo", &ie.ULNRFreqInfo)
+	v.ie("DLNRFreqInfo", &ie.DLNRFreqInfo)
+	v.ie("ULTransmissionBandwidth", &ie.ULTransmissionBandwidth)
+	v.ie("DLTransmissionBandwidth", &ie.DLTransmissionBandwidth)
+	if len(ie.ULCarrierList) > 0 {
+		v.size("ULCarrierList", len(ie.ULCarrierList), 1, maxnoofNrCellBands)
+		for i := range ie.ULCarrierList {
+			v.item("ULCarrierList", i, &ie.ULCarrierList[i])
+		}
+	}
+	if len(ie.DLCarrierList) > 0 {
+		v.size("DLCarrierList", len(ie.DLCarrierList), 1, maxnoofNrCellBands)
+		for i := range ie.DLCarrierList {
+			v.item("DLCarrierList", i, &ie.DLCarrierList[i])
+		}
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
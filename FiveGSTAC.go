@@ -0,0 +1,35 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type FiveGSTAC struct {
+	Value aper.OctetString `aper:"sizeLB:3,sizeUB:3"`
+}
+
+func (ie *FiveGSTAC) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteOctetString([]byte(ie.Value), &aper.Constraint{Lb: 3, Ub: 3}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *FiveGSTAC) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *FiveGSTAC) decode(r *decoder) error {
+	if v, err := readOctetString(r, &aper.Constraint{Lb: 3, Ub: 3}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.OctetString(v)
+	}
+	return nil
+}
+
+func (ie *FiveGSTAC) Validate() error {
+	var v validator
+	v.size("Value", len(ie.Value), 3, 3)
+	return v.err()
+}
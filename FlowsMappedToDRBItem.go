@@ -0,0 +1,136 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type FlowsMappedToDRBItem struct {
+	QoSFlowIdentifier         QoSFlowIdentifier         `mandatory`
+	QoSFlowLevelQoSParameters QoSFlowLevelQoSParameters `mandatory`
+	// IEExtensions
+	QoSFlowMappingIndication  *QoSFlowMappingIndication                        `optional,ignore,extension`
+	TSCTrafficCharacteristics *TSCTrafficCharacteristics                       `optional,ignore,extension`
+	Extensions                ProtocolExtensionContainer[FlowsMappedToDRBItem] `optional,extension`
+}
+
+func (ie *FlowsMappedToDRBItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *FlowsMappedToDRBItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(ie.extensions())
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.QoSFlowIdentifier.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode QoSFlowIdentifier", err)
+		return
+	}
+	if err = ie.QoSFlowLevelQoSParameters.encode(w); err != nil {
+		err = utils.WrapError("Encode QoSFlowLevelQoSParameters", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *FlowsMappedToDRBItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *FlowsMappedToDRBItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.QoSFlowIdentifier.Decode(r.AperReader); err != nil {
+		err = readError("QoSFlowIdentifier", err)
+		return
+	}
+	if err = ie.QoSFlowLevelQoSParameters.decode(r); err != nil {
+		err = readError("QoSFlowLevelQoSParameters", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, ie.decodeExtension); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *FlowsMappedToDRBItem) extensions() (exts []F1apMessageIE) {
+	if ie.QoSFlowMappingIndication != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_QoSFlowMappingIndication},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.QoSFlowMappingIndication,
+		})
+	}
+	if ie.TSCTrafficCharacteristics != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_TSCTrafficCharacteristics},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.TSCTrafficCharacteristics,
+		})
+	}
+	return
+}
+
+func (ie *FlowsMappedToDRBItem) decodeExtension(id aper.Integer, r *decoder) (ok bool, err error) {
+	ok = true
+	switch id {
+	case ProtocolIEID_QoSFlowMappingIndication:
+		tmp := new(QoSFlowMappingIndication)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("QoSFlowMappingIndication", err)
+			return
+		}
+		ie.QoSFlowMappingIndication = tmp
+	case ProtocolIEID_TSCTrafficCharacteristics:
+		tmp := new(TSCTrafficCharacteristics)
+		if err = tmp.decode(r); err != nil {
+			err = readError("TSCTrafficCharacteristics", err)
+			return
+		}
+		ie.TSCTrafficCharacteristics = tmp
+	default:
+		ok = false
+	}
+	return
+}
+
+func (ie *FlowsMappedToDRBItem) Validate() error {
+	var v validator
+	v.ie("QoSFlowIdentifier", &ie.QoSFlowIdentifier)
+	v.ie("QoSFlowLevelQoSParameters", &ie.QoSFlowLevelQoSParameters)
+	if ie.QoSFlowMappingIndication != nil {
+		v.ie("QoSFlowMappingIndication", ie.QoSFlowMappingIndication)
+	}
+	if ie.TSCTrafficCharacteristics != nil {
+		v.ie("TSCTrafficCharacteristics", ie.TSCTrafficCharacteristics)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,112 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type FreqBandNrItem struct {
+	FreqBandIndicatorNr  int64                      `lb:1,ub:1024,valueExt,mandatory`
+	SupportedSULBandList []SupportedSULFreqBandItem `lb:0,ub:maxnoofNrCellBands,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[FreqBandNrItem] `optional,extension`
+}
+
+func (ie *FreqBandNrItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *FreqBandNrItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_FreqBandIndicatorNr := INTEGER{
+		c:     aper.Constraint{Lb: 1, Ub: 1024},
+		ext:   true,
+		Value: aper.Integer(ie.FreqBandIndicatorNr),
+	}
+	if err = tmp_FreqBandIndicatorNr.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode FreqBandIndicatorNr", err)
+		return
+	}
+	tmp_SupportedSULBandList := sequenceOf[*SupportedSULFreqBandItem]{
+		c:   aper.Constraint{Lb: 0, Ub: maxnoofNrCellBands},
+		ext: false,
+	}
+	for k := range ie.SupportedSULBandList {
+		tmp_SupportedSULBandList.Value = append(tmp_SupportedSULBandList.Value, &ie.SupportedSULBandList[k])
+	}
+	if err = tmp_SupportedSULBandList.encode(w); err != nil {
+		err = utils.WrapError("Encode SupportedSULBandList", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *FreqBandNrItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *FreqBandNrItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	tmp_FreqBandIndicatorNr := INTEGER{
+		c:   aper.Constraint{Lb: 1, Ub: 1024},
+		ext: true,
+	}
+	if err = tmp_FreqBandIndicatorNr.Decode(r.AperReader); err != nil {
+		err = readError("FreqBandIndicatorNr", err)
+		return
+	}
+	ie.FreqBandIndicatorNr = int64(tmp_FreqBandIndicatorNr.Value)
+	var tmp_SupportedSULBandList []*SupportedSULFreqBandItem
+	if tmp_SupportedSULBandList, err = readList(r, aper.Constraint{Lb: 0, Ub: maxnoofNrCellBands}, false, func() *SupportedSULFreqBandItem { return new(SupportedSULFreqBandItem) }); err != nil {
+		err = readError("SupportedSULBandList", err)
+		return
+	}
+	ie.SupportedSULBandList = make([]SupportedSULFreqBandItem, len(tmp_SupportedSULBandList))
+	for k, i := range tmp_SupportedSULBandList {
+		ie.SupportedSULBandList[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *FreqBandNrItem) Validate() error {
+	var v validator
+	v.integer("FreqBandIndicatorNr", ie.FreqBandIndicatorNr, 1, 1024)
+	v.size("SupportedSULBandList", len(ie.SupportedSULBandList), 0, maxnoofNrCellBands)
+	for i := range ie.SupportedSULBandList {
+		v.item("SupportedSULBandList", i, &ie.SupportedSULBandList[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	FreqDomainLengthPresentNothing uint64 = iota
+	FreqDomainLengthPresentL839
+	FreqDomainLengthPresentL139
+	FreqDomainLengthPresentChoiceExtension
+)
+
+type FreqDomainLength struct {
+	Choice          uint64
+	L839            *L839Info
+	L139            *L139Info
+	ChoiceExtension *RawIE
+}
+
+func (ie *FreqDomainLength) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *FreqDomainLength) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case FreqDomainLengthPresentL839:
+		if err = ie.L839.encode(w); err != nil {
+			err = utils.WrapError("Encode L839", err)
+			return
+		}
+	case FreqDomainLengthPresentL139:
+		if err = ie.L139.encode(w); err != nil {
+			err = utils.WrapError("Encode L139", err)
+			return
+		}
+	case FreqDomainLengthPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *FreqDomainLength) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *FreqDomainLength) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case FreqDomainLengthPresentL839:
+		tmp := new(L839Info)
+		if err = tmp.decode(r); err != nil {
+			err = readError("L839", err)
+			return
+		}
+		ie.L839 = tmp
+	case FreqDomainLengthPresentL139:
+		tmp := new(L139Info)
+		if err = tmp.decode(r); err != nil {
+			err = readError("L139", err)
+			return
+		}
+		ie.L139 = tmp
+	case FreqDomainLengthPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *FreqDomainLength) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case FreqDomainLengthPresentL839:
+		if ie.L839 == nil {
+			v.absent("L839")
+		} else {
+			v.ie("L839", ie.L839)
+		}
+	case FreqDomainLengthPresentL139:
+		if ie.L139 == nil {
+			v.absent("L139")
+		} else {
+			v.ie("L139", ie.L139)
+		}
+	case FreqDomainLengthPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
@@ -0,0 +1,193 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type GBRQoSFlowInformation struct {
+	MaxFlowBitRateDownlink        BitRate            `mandatory`
+	MaxFlowBitRateUplink          BitRate            `mandatory`
+	GuaranteedFlowBitRateDownlink BitRate            `mandatory`
+	GuaranteedFlowBitRateUplink   BitRate            `mandatory`
+	MaxPacketLossRateDownlink     *MaxPacketLossRate `optional`
+	MaxPacketLossRateUplink       *MaxPacketLossRate `optional`
+	// IEExtensions
+	AlternativeQoSParaSetList []AlternativeQoSParaSetItem                       `lb:1,ub:maxnoofQoSParaSets,optional,ignore,extension`
+	Extensions                ProtocolExtensionContainer[GBRQoSFlowInformation] `optional,extension`
+}
+
+func (ie *GBRQoSFlowInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *GBRQoSFlowInformation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(ie.extensions())
+	optionals := []byte{0x0}
+	if ie.MaxPacketLossRateDownlink != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if ie.MaxPacketLossRateUplink != nil {
+		aper.SetBit(optionals, 2)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 3)
+	}
+	if err = w.WriteBits(optionals, 3); err != nil {
+		return
+	}
+	if err = ie.MaxFlowBitRateDownlink.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode MaxFlowBitRateDownlink", err)
+		return
+	}
+	if err = ie.MaxFlowBitRateUplink.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode MaxFlowBitRateUplink", err)
+		return
+	}
+	if err = ie.GuaranteedFlowBitRateDownlink.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode GuaranteedFlowBitRateDownlink", err)
+		return
+	}
+	if err = ie.GuaranteedFlowBitRateUplink.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode GuaranteedFlowBitRateUplink", err)
+		return
+	}
+	if ie.MaxPacketLossRateDownlink != nil {
+		if err = ie.MaxPacketLossRateDownlink.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode MaxPacketLossRateDownlink", err)
+			return
+		}
+	}
+	if ie.MaxPacketLossRateUplink != nil {
+		if err = ie.MaxPacketLossRateUplink.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode MaxPacketLossRateUplink", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *GBRQoSFlowInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *GBRQoSFlowInformation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(3); err != nil {
+		return
+	}
+	if err = ie.MaxFlowBitRateDownlink.Decode(r.AperReader); err != nil {
+		err = readError("MaxFlowBitRateDownlink", err)
+		return
+	}
+	if err = ie.MaxFlowBitRateUplink.Decode(r.AperReader); err != nil {
+		err = readError("MaxFlowBitRateUplink", err)
+		return
+	}
+	if err = ie.GuaranteedFlowBitRateDownlink.Decode(r.AperReader); err != nil {
+		err = readError("GuaranteedFlowBitRateDownlink", err)
+		return
+	}
+	if err = ie.GuaranteedFlowBitRateUplink.Decode(r.AperReader); err != nil {
+		err = readError("GuaranteedFlowBitRateUplink", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(MaxPacketLossRate)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("MaxPacketLossRateDownlink", err)
+			return
+		}
+		ie.MaxPacketLossRateDownlink = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		tmp := new(MaxPacketLossRate)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("MaxPacketLossRateUplink", err)
+			return
+		}
+		ie.MaxPacketLossRateUplink = tmp
+	}
+	if aper.IsBitSet(optionals, 3) {
+		if err = ie.Extensions.decode(r, ie.decodeExtension); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *GBRQoSFlowInformation) extensions() (exts []F1apMessageIE) {
+	if len(ie.AlternativeQoSParaSetList) > 0 {
+		tmp_AlternativeQoSParaSetList := sequenceOf[*AlternativeQoSParaSetItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofQoSParaSets},
+			ext: false,
+		}
+		for k := range ie.AlternativeQoSParaSetList {
+			tmp_AlternativeQoSParaSetList.Value = append(tmp_AlternativeQoSParaSetList.Value, &ie.AlternativeQoSParaSetList[k])
+		}
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_AlternativeQoSParaSetList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_AlternativeQoSParaSetList,
+		})
+	}
+	return
+}
+
+func (ie *GBRQoSFlowInformation) decodeExtension(id aper.Integer, r *decoder) (ok bool, err error) {
+	ok = true
+	switch id {
+	case ProtocolIEID_AlternativeQoSParaSetList:
+		var tmp []*AlternativeQoSParaSetItem
+		if tmp, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofQoSParaSets}, false, func() *AlternativeQoSParaSetItem { return new(AlternativeQoSParaSetItem) }); err != nil {
+			err = readError("AlternativeQoSParaSetList", err)
+			return
+		}
+		ie.AlternativeQoSParaSetList = make([]AlternativeQoSParaSetItem, len(tmp))
+		for k, i := range tmp {
+			ie.AlternativeQoSParaSetList[k] = *i
+		}
+	default:
+		ok = false
+	}
+	return
+}
+
+func (ie *GBRQoSFlowInformation) Validate() error {
+	var v validator
+	v.ie("MaxFlowBitRateDownlink", &ie.MaxFlowBitRateDownlink)
+	v.ie("MaxFlowBitRateUplink", &ie.MaxFlowBitRateUplink)
+	v.ie("GuaranteedFlowBitRateDownlink", &ie.GuaranteedFlowBitRateDownlink)
+	v.ie("GuaranteedFlowBitRateUplink", &ie.GuaranteedFlowBitRateUplink)
+	if ie.MaxPacketLossRateDownlink != nil {
+		v.ie("MaxPacketLossRateDownlink", ie.MaxPacketLossRateDownlink)
+	}
+	if ie.MaxPacketLossRateUplink != nil {
+		v.ie("MaxPacketLossRateUplink", ie.MaxPacketLossRateUplink)
+	}
+	if len(ie.AlternativeQoSParaSetList) > 0 {
+		v.size("AlternativeQoSParaSetList", len(ie.AlternativeQoSParaSetList), 1, maxnoofQoSParaSets)
+		for i := range ie.AlternativeQoSParaSetList {
+			v.item("AlternativeQoSParaSetList", i, &ie.AlternativeQoSParaSetList[i])
+		}
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
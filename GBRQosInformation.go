@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type GBRQosInformation struct {
+	ERABMaximumBitrateDL    BitRate `mandatory`
+	ERABMaximumBitrateUL    BitRate `mandatory`
+	ERABGuaranteedBitrateDL BitRate `mandatory`
+	ERABGuaranteedBitrateUL BitRate `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[GBRQosInformation] `optional,extension`
+}
+
+func (ie *GBRQosInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *GBRQosInformation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.ERABMaximumBitrateDL.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode ERABMaximumBitrateDL", err)
+		return
+	}
+	if err = ie.ERABMaximumBitrateUL.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode ERABMaximumBitrateUL", err)
+		return
+	}
+	if err = ie.ERABGuaranteedBitrateDL.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode ERABGuaranteedBitrateDL", err)
+		return
+	}
+	if err = ie.ERABGuaranteedBitrateUL.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode ERABGuaranteedBitrateUL", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *GBRQosInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *GBRQosInformation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.ERABMaximumBitrateDL.Decode(r.AperReader); err != nil {
+		err = readError("ERABMaximumBitrateDL", err)
+		return
+	}
+	if err = ie.ERABMaximumBitrateUL.Decode(r.AperReader); err != nil {
+		err = readError("ERABMaximumBitrateUL", err)
+		return
+	}
+	if err = ie.ERABGuaranteedBitrateDL.Decode(r.AperReader); err != nil {
+		err = readError("ERABGuaranteedBitrateDL", err)
+		return
+	}
+	if err = ie.ERABGuaranteedBitrateUL.Decode(r.AperReader); err != nil {
+		err = readError("ERABGuaranteedBitrateUL", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *GBRQosInformation) Validate() error {
+	var v validator
+	v.ie("ERABMaximumBitrateDL", &ie.ERABMaximumBitrateDL)
+	v.ie("ERABMaximumBitrateUL", &ie.ERABMaximumBitrateUL)
+	v.ie("ERABGuaranteedBitrateDL", &ie.ERABGuaranteedBitrateDL)
+	v.ie("ERABGuaranteedBitrateUL", &ie.ERABGuaranteedBitrateUL)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,123 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type GNBCUSystemInformation struct {
+	Sibtypetobeupdatedlist []SibtypetobeupdatedListItem `lb:1,ub:maxnoofSIBTypes,mandatory`
+	// IEExtensions
+	SystemInformationAreaID *SystemInformationAreaID                           `optional,ignore,extension`
+	Extensions              ProtocolExtensionContainer[GNBCUSystemInformation] `optional,extension`
+}
+
+func (ie *GNBCUSystemInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *GNBCUSystemInformation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(ie.extensions())
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_Sibtypetobeupdatedlist := sequenceOf[*SibtypetobeupdatedListItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofSIBTypes},
+		ext: false,
+	}
+	for k := range ie.Sibtypetobeupdatedlist {
+		tmp_Sibtypetobeupdatedlist.Value = append(tmp_Sibtypetobeupdatedlist.Value, &ie.Sibtypetobeupdatedlist[k])
+	}
+	if err = tmp_Sibtypetobeupdatedlist.encode(w); err != nil {
+		err = utils.WrapError("Encode Sibtypetobeupdatedlist", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *GNBCUSystemInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *GNBCUSystemInformation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	var tmp_Sibtypetobeupdatedlist []*SibtypetobeupdatedListItem
+	if tmp_Sibtypetobeupdatedlist, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofSIBTypes}, false, func() *SibtypetobeupdatedListItem { return new(SibtypetobeupdatedListItem) }); err != nil {
+		err = readError("Sibtypetobeupdatedlist", err)
+		return
+	}
+	ie.Sibtypetobeupdatedlist = make([]SibtypetobeupdatedListItem, len(tmp_Sibtypetobeupdatedlist))
+	for k, i := range tmp_Sibtypetobeupdatedlist {
+		ie.Sibtypetobeupdatedlist[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, ie.decodeExtension); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *GNBCUSystemInformation) extensions() (exts []F1apMessageIE) {
+	if ie.SystemInformationAreaID != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SystemInformationAreaID},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.SystemInformationAreaID,
+		})
+	}
+	return
+}
+
+func (ie *GNBCUSystemInformation) decodeExtension(id aper.Integer, r *decoder) (ok bool, err error) {
+	ok = true
+	switch id {
+	case ProtocolIEID_SystemInformationAreaID:
+		tmp := new(SystemInformationAreaID)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SystemInformationAreaID", err)
+			return
+		}
+		ie.SystemInformationAreaID = tmp
+	default:
+		ok = false
+	}
+	return
+}
+
+func (ie *GNBCUSystemInformation) Validate() error {
+	var v validator
+	v.size("Sibtypetobeupdatedlist", len(ie.Sibtypetobeupdatedlist), 1, maxnoofSIBTypes)
+	for i := range ie.Sibtypetobeupdatedlist {
+		v.item("Sibtypetobeupdatedlist", i, &ie.Sibtypetobeupdatedlist[i])
+	}
+	if ie.SystemInformationAreaID != nil {
+		v.ie("SystemInformationAreaID", ie.SystemInformationAreaID)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
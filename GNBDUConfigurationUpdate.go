@@ -0,0 +1,373 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type GNBDUConfigurationUpdate struct {
+	TransactionID                               TransactionID                     `mandatory,reject`
+	ServedCellsToAddList                        []ServedCellsToAddItem            `optional,reject`
+	ServedCellsToModifyList                     []ServedCellsToModifyItem         `optional,reject`
+	ServedCellsToDeleteList                     []ServedCellsToDeleteItem         `optional,reject`
+	CellsStatusList                             []CellsStatusItem                 `optional,reject`
+	DedicatedSIDeliveryNeededUEList             []DedicatedSIDeliveryNeededUEItem `optional,ignore`
+	GNBDUID                                     *GNBDUID                          `optional,reject`
+	GNBDUTNLAssociationToRemoveList             []GNBDUTNLAssociationToRemoveItem `optional,reject`
+	TransportLayerAddressInfo                   *TransportLayerAddressInfo        `optional,ignore`
+	ServedCellsToAddListUnknownItems            []RawIE
+	ServedCellsToModifyListUnknownItems         []RawIE
+	ServedCellsToDeleteListUnknownItems         []RawIE
+	CellsStatusListUnknownItems                 []RawIE
+	DedicatedSIDeliveryNeededUEListUnknownItems []RawIE
+	GNBDUTNLAssociationToRemoveListUnknownItems []RawIE
+	UnknownIEs                                  []RawIE
+}
+
+func (msg *GNBDUConfigurationUpdate) ProcedureCode() int64 {
+	return ProcedureCode_gNBDUConfigurationUpdate
+}
+
+func (msg *GNBDUConfigurationUpdate) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *GNBDUConfigurationUpdate) Criticality() aper.Enumerated {
+	return Criticality_PresentReject
+}
+
+func (msg *GNBDUConfigurationUpdate) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("GNBDUConfigurationUpdate"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *GNBDUConfigurationUpdate) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("GNBDUConfigurationUpdate"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *GNBDUConfigurationUpdate) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_TransactionID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.TransactionID,
+	})
+	if len(msg.ServedCellsToAddList) > 0 || len(msg.ServedCellsToAddListUnknownItems) > 0 {
+		tmp_ServedCellsToAddList := SingleContainerList[*ServedCellsToAddItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxCellingNBDU},
+			id:          ProtocolIEID_ServedCellsToAddItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.ServedCellsToAddListUnknownItems,
+		}
+		for k := range msg.ServedCellsToAddList {
+			tmp_ServedCellsToAddList.Value = append(tmp_ServedCellsToAddList.Value, &msg.ServedCellsToAddList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ServedCellsToAddList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_ServedCellsToAddList,
+		})
+	}
+	if len(msg.ServedCellsToModifyList) > 0 || len(msg.ServedCellsToModifyListUnknownItems) > 0 {
+		tmp_ServedCellsToModifyList := SingleContainerList[*ServedCellsToModifyItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxCellingNBDU},
+			id:          ProtocolIEID_ServedCellsToModifyItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.ServedCellsToModifyListUnknownItems,
+		}
+		for k := range msg.ServedCellsToModifyList {
+			tmp_ServedCellsToModifyList.Value = append(tmp_ServedCellsToModifyList.Value, &msg.ServedCellsToModifyList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ServedCellsToModifyList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_ServedCellsToModifyList,
+		})
+	}
+	if len(msg.ServedCellsToDeleteList) > 0 || len(msg.ServedCellsToDeleteListUnknownItems) > 0 {
+		tmp_ServedCellsToDeleteList := SingleContainerList[*ServedCellsToDeleteItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxCellingNBDU},
+			id:          ProtocolIEID_ServedCellsToDeleteItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.ServedCellsToDeleteListUnknownItems,
+		}
+		for k := range msg.ServedCellsToDeleteList {
+			tmp_ServedCellsToDeleteList.Value = append(tmp_ServedCellsToDeleteList.Value, &msg.ServedCellsToDeleteList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ServedCellsToDeleteList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_ServedCellsToDeleteList,
+		})
+	}
+	if len(msg.CellsStatusList) > 0 || len(msg.CellsStatusListUnknownItems) > 0 {
+		tmp_CellsStatusList := SingleContainerList[*CellsStatusItem]{
+			c:           aper.Constraint{Lb: 0, Ub: maxCellingNBDU},
+			id:          ProtocolIEID_CellsStatusItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.CellsStatusListUnknownItems,
+		}
+		for k := range msg.CellsStatusList {
+			tmp_CellsStatusList.Value = append(tmp_CellsStatusList.Value, &msg.CellsStatusList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CellsStatusList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_CellsStatusList,
+		})
+	}
+	if len(msg.DedicatedSIDeliveryNeededUEList) > 0 || len(msg.DedicatedSIDeliveryNeededUEListUnknownItems) > 0 {
+		tmp_DedicatedSIDeliveryNeededUEList := SingleContainerList[*DedicatedSIDeliveryNeededUEItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofUEIDs},
+			id:          ProtocolIEID_DedicatedSIDeliveryNeededUEItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.DedicatedSIDeliveryNeededUEListUnknownItems,
+		}
+		for k := range msg.DedicatedSIDeliveryNeededUEList {
+			tmp_DedicatedSIDeliveryNeededUEList.Value = append(tmp_DedicatedSIDeliveryNeededUEList.Value, &msg.DedicatedSIDeliveryNeededUEList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DedicatedSIDeliveryNeededUEList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_DedicatedSIDeliveryNeededUEList,
+		})
+	}
+	if msg.GNBDUID != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUID},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.GNBDUID,
+		})
+	}
+	if len(msg.GNBDUTNLAssociationToRemoveList) > 0 || len(msg.GNBDUTNLAssociationToRemoveListUnknownItems) > 0 {
+		tmp_GNBDUTNLAssociationToRemoveList := SingleContainerList[*GNBDUTNLAssociationToRemoveItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofTNLAssociations},
+			id:          ProtocolIEID_GNBDUTNLAssociationToRemoveItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.GNBDUTNLAssociationToRemoveListUnknownItems,
+		}
+		for k := range msg.GNBDUTNLAssociationToRemoveList {
+			tmp_GNBDUTNLAssociationToRemoveList.Value = append(tmp_GNBDUTNLAssociationToRemoveList.Value, &msg.GNBDUTNLAssociationToRemoveList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_GNBDUTNLAssociationToRemoveList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_GNBDUTNLAssociationToRemoveList,
+		})
+	}
+	if msg.TransportLayerAddressInfo != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_TransportLayerAddressInfo},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.TransportLayerAddressInfo,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *GNBDUConfigurationUpdate) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *GNBDUConfigurationUpdate) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *GNBDUConfigurationUpdate) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("GNBDUConfigurationUpdate", wire, limits, gnbduConfigurationUpdateIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var gnbduConfigurationUpdateIEs = []messageIE{
+	{ProtocolIEID_TransactionID, Criticality_PresentReject, true},
+	{ProtocolIEID_ServedCellsToAddList, Criticality_PresentReject, false},
+	{ProtocolIEID_ServedCellsToModifyList, Criticality_PresentReject, false},
+	{ProtocolIEID_ServedCellsToDeleteList, Criticality_PresentReject, false},
+	{ProtocolIEID_CellsStatusList, Criticality_PresentReject, false},
+	{ProtocolIEID_DedicatedSIDeliveryNeededUEList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_gNBDUID, Criticality_PresentReject, false},
+	{ProtocolIEID_GNBDUTNLAssociationToRemoveList, Criticality_PresentReject, false},
+	{ProtocolIEID_TransportLayerAddressInfo, Criticality_PresentIgnore, false},
+}
+
+func (msg *GNBDUConfigurationUpdate) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_TransactionID:
+		var tmp TransactionID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("TransactionID", err)
+			return
+		}
+		msg.TransactionID = tmp
+
+	case ProtocolIEID_ServedCellsToAddList:
+		tmp := SingleContainerList[*ServedCellsToAddItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxCellingNBDU},
+			id:          ProtocolIEID_ServedCellsToAddItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *ServedCellsToAddItem { return new(ServedCellsToAddItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("ServedCellsToAddList", err)
+			return
+		}
+		msg.ServedCellsToAddList = []ServedCellsToAddItem{}
+		for _, i := range tmp.Value {
+			msg.ServedCellsToAddList = append(msg.ServedCellsToAddList, *i)
+		}
+		msg.ServedCellsToAddListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_ServedCellsToModifyList:
+		tmp := SingleContainerList[*ServedCellsToModifyItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxCellingNBDU},
+			id:          ProtocolIEID_ServedCellsToModifyItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *ServedCellsToModifyItem { return new(ServedCellsToModifyItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("ServedCellsToModifyList", err)
+			return
+		}
+		msg.ServedCellsToModifyList = []ServedCellsToModifyItem{}
+		for _, i := range tmp.Value {
+			msg.ServedCellsToModifyList = append(msg.ServedCellsToModifyList, *i)
+		}
+		msg.ServedCellsToModifyListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_ServedCellsToDeleteList:
+		tmp := SingleContainerList[*ServedCellsToDeleteItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxCellingNBDU},
+			id:          ProtocolIEID_ServedCellsToDeleteItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *ServedCellsToDeleteItem { return new(ServedCellsToDeleteItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("ServedCellsToDeleteList", err)
+			return
+		}
+		msg.ServedCellsToDeleteList = []ServedCellsToDeleteItem{}
+		for _, i := range tmp.Value {
+			msg.ServedCellsToDeleteList = append(msg.ServedCellsToDeleteList, *i)
+		}
+		msg.ServedCellsToDeleteListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_CellsStatusList:
+		tmp := SingleContainerList[*CellsStatusItem]{
+			c:           aper.Constraint{Lb: 0, Ub: maxCellingNBDU},
+			id:          ProtocolIEID_CellsStatusItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *CellsStatusItem { return new(CellsStatusItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("CellsStatusList", err)
+			return
+		}
+		msg.CellsStatusList = []CellsStatusItem{}
+		for _, i := range tmp.Value {
+			msg.CellsStatusList = append(msg.CellsStatusList, *i)
+		}
+		msg.CellsStatusListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_DedicatedSIDeliveryNeededUEList:
+		tmp := SingleContainerList[*DedicatedSIDeliveryNeededUEItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofUEIDs},
+			id:          ProtocolIEID_DedicatedSIDeliveryNeededUEItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *DedicatedSIDeliveryNeededUEItem { return new(DedicatedSIDeliveryNeededUEItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("DedicatedSIDeliveryNeededUEList", err)
+			return
+		}
+		msg.DedicatedSIDeliveryNeededUEList = []DedicatedSIDeliveryNeededUEItem{}
+		for _, i := range tmp.Value {
+			msg.DedicatedSIDeliveryNeededUEList = append(msg.DedicatedSIDeliveryNeededUEList, *i)
+		}
+		msg.DedicatedSIDeliveryNeededUEListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_gNBDUID:
+		var tmp GNBDUID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUID", err)
+			return
+		}
+		msg.GNBDUID = &tmp
+
+	case ProtocolIEID_GNBDUTNLAssociationToRemoveList:
+		tmp := SingleContainerList[*GNBDUTNLAssociationToRemoveItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofTNLAssociations},
+			id:          ProtocolIEID_GNBDUTNLAssociationToRemoveItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *GNBDUTNLAssociationToRemoveItem { return new(GNBDUTNLAssociationToRemoveItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("GNBDUTNLAssociationToRemoveList", err)
+			return
+		}
+		msg.GNBDUTNLAssociationToRemoveList = []GNBDUTNLAssociationToRemoveItem{}
+		for _, i := range tmp.Value {
+			msg.GNBDUTNLAssociationToRemoveList = append(msg.GNBDUTNLAssociationToRemoveList, *i)
+		}
+		msg.GNBDUTNLAssociationToRemoveListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_TransportLayerAddressInfo:
+		var tmp TransportLayerAddressInfo
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("TransportLayerAddressInfo", err)
+			return
+		}
+		msg.TransportLayerAddressInfo = &tmp
+
+	}
+	return
+}
+
+func (msg *GNBDUConfigurationUpdate) Validate() error {
+	var v validator
+	v.integer("TransactionID", int64(msg.TransactionID.Value), 0, 255)
+	if len(msg.ServedCellsToAddList) > 0 {
+		v.size("ServedCellsToAddList", len(msg.ServedCellsToAddList), 1, maxCellingNBDU)
+		for i := range msg.ServedCellsToAddList {
+			v.item("ServedCellsToAddList", i, &msg.ServedCellsToAddList[i])
+		}
+	}
+	if len(msg.ServedCellsToModifyList) > 0 {
+		v.size("ServedCellsToModifyList", len(msg.ServedCellsToModifyList), 1, maxCellingNBDU)
+		for i := range msg.ServedCellsToModifyList {
+			v.item("ServedCellsToModifyList", i, &msg.ServedCellsToModifyList[i])
+		}
+	}
+	if len(msg.ServedCellsToDeleteList) > 0 {
+		v.size("ServedCellsToDeleteList", len(msg.ServedCellsToDeleteList), 1, maxCellingNBDU)
+		for i := range msg.ServedCellsToDeleteList {
+			v.item("ServedCellsToDeleteList", i, &msg.ServedCellsToDeleteList[i])
+		}
+	}
+	if len(msg.CellsStatusList) > 0 {
+		v.size("CellsStatusList", len(msg.CellsStatusList), 0, maxCellingNBDU)
+	}
+	if len(msg.DedicatedSIDeliveryNeededUEList) > 0 {
+		v.size("DedicatedSIDeliveryNeededUEList", len(msg.DedicatedSIDeliveryNeededUEList), 1, maxnoofUEIDs)
+	}
+	if msg.GNBDUID != nil {
+		v.integer("GNBDUID", int64(msg.GNBDUID.Value), 0, maxGNBDUID)
+	}
+	if len(msg.GNBDUTNLAssociationToRemoveList) > 0 {
+		v.size("GNBDUTNLAssociationToRemoveList", len(msg.GNBDUTNLAssociationToRemoveList), 1, maxnoofTNLAssociations)
+	}
+	return v.messageErr("GNBDUConfigurationUpdate")
+}
@@ -0,0 +1,172 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type GNBDUSystemInformation struct {
+	MIBMessage  MIBMessage  `mandatory`
+	SIB1Message SIB1Message `mandatory`
+	// IEExtensions
+	SIB12Message *SIB12Message                                      `optional,ignore,extension`
+	SIB13Message *SIB13Message                                      `optional,ignore,extension`
+	SIB14Message *SIB14Message                                      `optional,ignore,extension`
+	SIB10Message *SIB10Message                                      `optional,ignore,extension`
+	Extensions   ProtocolExtensionContainer[GNBDUSystemInformation] `optional,extension`
+}
+
+func (ie *GNBDUSystemInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *GNBDUSystemInformation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(ie.extensions())
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.MIBMessage.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode MIBMessage", err)
+		return
+	}
+	if err = ie.SIB1Message.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SIB1Message", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *GNBDUSystemInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *GNBDUSystemInformation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.MIBMessage.decode(r); err != nil {
+		err = readError("MIBMessage", err)
+		return
+	}
+	if err = ie.SIB1Message.decode(r); err != nil {
+		err = readError("SIB1Message", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, ie.decodeExtension); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *GNBDUSystemInformation) extensions() (exts []F1apMessageIE) {
+	if ie.SIB12Message != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SIB12Message},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.SIB12Message,
+		})
+	}
+	if ie.SIB13Message != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SIB13Message},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.SIB13Message,
+		})
+	}
+	if ie.SIB14Message != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SIB14Message},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.SIB14Message,
+		})
+	}
+	if ie.SIB10Message != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SIB10Message},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.SIB10Message,
+		})
+	}
+	return
+}
+
+func (ie *GNBDUSystemInformation) decodeExtension(id aper.Integer, r *decoder) (ok bool, err error) {
+	ok = true
+	switch id {
+	case ProtocolIEID_SIB12Message:
+		tmp := new(SIB12Message)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SIB12Message", err)
+			return
+		}
+		ie.SIB12Message = tmp
+	case ProtocolIEID_SIB13Message:
+		tmp := new(SIB13Message)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SIB13Message", err)
+			return
+		}
+		ie.SIB13Message = tmp
+	case ProtocolIEID_SIB14Message:
+		tmp := new(SIB14Message)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SIB14Message", err)
+			return
+		}
+		ie.SIB14Message = tmp
+	case ProtocolIEID_SIB10Message:
+		tmp := new(SIB10Message)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SIB10Message", err)
+			return
+		}
+		ie.SIB10Message = tmp
+	default:
+		ok = false
+	}
+	return
+}
+
+func (ie *GNBDUSystemInformation) Validate() error {
+	var v validator
+	v.ie("MIBMessage", &ie.MIBMessage)
+	v.ie("SIB1Message", &ie.SIB1Message)
+	if ie.SIB12Message != nil {
+		v.ie("SIB12Message", ie.SIB12Message)
+	}
+	if ie.SIB13Message != nil {
+		v.ie("SIB13Message", ie.SIB13Message)
+	}
+	if ie.SIB14Message != nil {
+		v.ie("SIB14Message", ie.SIB14Message)
+	}
+	if ie.SIB10Message != nil {
+		v.ie("SIB10Message", ie.SIB10Message)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,47 @@
+package ies
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+func TestGNBDUSystemInformationWire(t *testing.T) {
+	in := GNBDUSystemInformation{
+		MIBMessage:   MIBMessage{Value: aper.OctetString{0x01, 0x02}},
+		SIB1Message:  SIB1Message{Value: aper.OctetString{0x03}},
+		SIB12Message: &SIB12Message{Value: aper.OctetString{0x0c}},
+		SIB10Message: &SIB10Message{Value: aper.OctetString{0x0a, 0x0b}},
+	}
+	want := []byte{
+		0x40,             // extensions present
+		0x02, 0x01, 0x02, // MIB
+		0x01, 0x03, // SIB1
+		0x00, 0x01, // 2 extensions
+		0x01, 0x36, 0x40, 0x02, 0x01, 0x0c, // SIB12
+		0x01, 0x83, 0x40, 0x03, 0x02, 0x0a, 0x0b, // SIB10
+	}
+	checkIE(t, &in, new(GNBDUSystemInformation), want)
+}
+
+func TestGNBDUSystemInformationExtensionAdditions(t *testing.T) {
+	wire := []byte{
+		0x80,             // extended
+		0x02, 0x01, 0x02, // MIB
+		0x01, 0x03, // SIB1
+		0x01,       // one addition, present
+		0x01, 0xff, // its value
+	}
+	var out GNBDUSystemInformation
+	if err := DecodeValue(wire, &out); err != nil {
+		t.Fatal(err)
+	}
+	want := GNBDUSystemInformation{
+		MIBMessage:  MIBMessage{Value: aper.OctetString{0x01, 0x02}},
+		SIB1Message: SIB1Message{Value: aper.OctetString{0x03}},
+	}
+	if !reflect.DeepEqual(out, want) {
+		t.Fatalf("decoded %+v", out)
+	}
+}
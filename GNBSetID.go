@@ -0,0 +1,35 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type GNBSetID struct {
+	Value aper.BitString `aper:"sizeLB:1,sizeUB:22"`
+}
+
+func (ie *GNBSetID) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteBitString(ie.Value.Bytes, uint(ie.Value.NumBits), &aper.Constraint{Lb: 1, Ub: 22}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *GNBSetID) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *GNBSetID) decode(r *decoder) error {
+	if v, n, err := readBitString(r, &aper.Constraint{Lb: 1, Ub: 22}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.BitString{Bytes: v, NumBits: uint64(n)}
+	}
+	return nil
+}
+
+func (ie *GNBSetID) Validate() error {
+	var v validator
+	v.size("Value", int(ie.Value.NumBits), 1, 22)
+	return v.err()
+}
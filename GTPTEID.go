@@ -0,0 +1,35 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type GTPTEID struct {
+	Value aper.OctetString `aper:"sizeLB:4,sizeUB:4"`
+}
+
+func (ie *GTPTEID) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteOctetString([]byte(ie.Value), &aper.Constraint{Lb: 4, Ub: 4}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *GTPTEID) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *GTPTEID) decode(r *decoder) error {
+	if v, err := readOctetString(r, &aper.Constraint{Lb: 4, Ub: 4}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.OctetString(v)
+	}
+	return nil
+}
+
+func (ie *GTPTEID) Validate() error {
+	var v validator
+	v.size("Value", len(ie.Value), 4, 4)
+	return v.err()
+}
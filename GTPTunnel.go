@@ -0,0 +1,87 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type GTPTunnel struct {
+	TransportLayerAddress TransportLayerAddress `mandatory`
+	GTPTEID               GTPTEID               `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[GTPTunnel] `optional,extension`
+}
+
+func (ie *GTPTunnel) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *GTPTunnel) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.TransportLayerAddress.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode TransportLayerAddress", err)
+		return
+	}
+	if err = ie.GTPTEID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode GTPTEID", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *GTPTunnel) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *GTPTunnel) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.TransportLayerAddress.decode(r); err != nil {
+		err = readError("TransportLayerAddress", err)
+		return
+	}
+	if err = ie.GTPTEID.decode(r); err != nil {
+		err = readError("GTPTEID", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *GTPTunnel) Validate() error {
+	var v validator
+	v.ie("TransportLayerAddress", &ie.TransportLayerAddress)
+	v.ie("GTPTEID", &ie.GTPTEID)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
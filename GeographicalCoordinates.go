@@ -0,0 +1,98 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type GeographicalCoordinates struct {
+	TRPPositionDefinitionType TRPPositionDefinitionType `mandatory`
+	DLPRSResourceCoordinates  *DLPRSResourceCoordinates `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[GeographicalCoordinates] `optional,extension`
+}
+
+func (ie *GeographicalCoordinates) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *GeographicalCoordinates) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.DLPRSResourceCoordinates != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	if err = ie.TRPPositionDefinitionType.encode(w); err != nil {
+		err = utils.WrapError("Encode TRPPositionDefinitionType", err)
+		return
+	}
+	if ie.DLPRSResourceCoordinates != nil {
+		if err = ie.DLPRSResourceCoordinates.encode(w); err != nil {
+			err = utils.WrapError("Encode DLPRSResourceCoordinates", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *GeographicalCoordinates) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *GeographicalCoordinates) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	if err = ie.TRPPositionDefinitionType.decode(r); err != nil {
+		err = readError("TRPPositionDefinitionType", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(DLPRSResourceCoordinates)
+		if err = tmp.decode(r); err != nil {
+			err = readError("DLPRSResourceCoordinates", err)
+			return
+		}
+		ie.DLPRSResourceCoordinates = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *GeographicalCoordinates) Validate() error {
+	var v validator
+	v.ie("TRPPositionDefinitionType", &ie.TRPPositionDefinitionType)
+	if ie.DLPRSResourceCoordinates != nil {
+		v.ie("DLPRSResourceCoordinates", ie.DLPRSResourceCoordinates)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,154 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	IABTNLAddressPresentNothing uint64 = iota
+	IABTNLAddressPresentIPv4Address
+	IABTNLAddressPresentIPv6Address
+	IABTNLAddressPresentIPv6Prefix
+	IABTNLAddressPresentChoiceExtension
+)
+
+type IABTNLAddress struct {
+	Choice          uint64
+	IPv4Address     *aper.BitString `lb:32,ub:32`
+	IPv6Address     *aper.BitString `lb:128,ub:128`
+	IPv6Prefix      *aper.BitString `lb:64,ub:64`
+	ChoiceExtension *RawIE
+}
+
+func (ie *IABTNLAddress) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *IABTNLAddress) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 3, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case IABTNLAddressPresentIPv4Address:
+		tmp_IPv4Address := BITSTRING{
+			c:     aper.Constraint{Lb: 32, Ub: 32},
+			ext:   false,
+			Value: *ie.IPv4Address,
+		}
+		if err = tmp_IPv4Address.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode IPv4Address", err)
+			return
+		}
+	case IABTNLAddressPresentIPv6Address:
+		tmp_IPv6Address := BITSTRING{
+			c:     aper.Constraint{Lb: 128, Ub: 128},
+			ext:   false,
+			Value: *ie.IPv6Address,
+		}
+		if err = tmp_IPv6Address.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode IPv6Address", err)
+			return
+		}
+	case IABTNLAddressPresentIPv6Prefix:
+		tmp_IPv6Prefix := BITSTRING{
+			c:     aper.Constraint{Lb: 64, Ub: 64},
+			ext:   false,
+			Value: *ie.IPv6Prefix,
+		}
+		if err = tmp_IPv6Prefix.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode IPv6Prefix", err)
+			return
+		}
+	case IABTNLAddressPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *IABTNLAddress) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *IABTNLAddress) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(3, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case IABTNLAddressPresentIPv4Address:
+		tmp_IPv4Address := BITSTRING{
+			c:   aper.Constraint{Lb: 32, Ub: 32},
+			ext: false,
+		}
+		if err = tmp_IPv4Address.Decode(r.AperReader); err != nil {
+			err = readError("IPv4Address", err)
+			return
+		}
+		tmp := tmp_IPv4Address.Value
+		ie.IPv4Address = &tmp
+	case IABTNLAddressPresentIPv6Address:
+		tmp_IPv6Address := BITSTRING{
+			c:   aper.Constraint{Lb: 128, Ub: 128},
+			ext: false,
+		}
+		if err = tmp_IPv6Address.Decode(r.AperReader); err != nil {
+			err = readError("IPv6Address", err)
+			return
+		}
+		tmp := tmp_IPv6Address.Value
+		ie.IPv6Address = &tmp
+	case IABTNLAddressPresentIPv6Prefix:
+		tmp_IPv6Prefix := BITSTRING{
+			c:   aper.Constraint{Lb: 64, Ub: 64},
+			ext: false,
+		}
+		if err = tmp_IPv6Prefix.Decode(r.AperReader); err != nil {
+			err = readError("IPv6Prefix", err)
+			return
+		}
+		tmp := tmp_IPv6Prefix.Value
+		ie.IPv6Prefix = &tmp
+	case IABTNLAddressPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *IABTNLAddress) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case IABTNLAddressPresentIPv4Address:
+		if ie.IPv4Address == nil {
+			v.absent("IPv4Address")
+		} else {
+			v.size("IPv4Address", int(ie.IPv4Address.NumBits), 32, 32)
+		}
+	case IABTNLAddressPresentIPv6Address:
+		if ie.IPv6Address == nil {
+			v.absent("IPv6Address")
+		} else {
+			v.size("IPv6Address", int(ie.IPv6Address.NumBits), 128, 128)
+		}
+	case IABTNLAddressPresentIPv6Prefix:
+		if ie.IPv6Prefix == nil {
+			v.absent("IPv6Prefix")
+		} else {
+			v.size("IPv6Prefix", int(ie.IPv6Prefix.NumBits), 64, 64)
+		}
+	case IABTNLAddressPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
@@ -0,0 +1,141 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type IPHeaderInformation struct {
+	DestinationIABTNLAddress IABTNLAddress   `mandatory`
+	DsInformationList        []DSCP          `lb:0,ub:maxnoofDSInfo,optional`
+	IPv6FlowLabel            *aper.BitString `lb:20,ub:20,optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[IPHeaderInformation] `optional,extension`
+}
+
+func (ie *IPHeaderInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *IPHeaderInformation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(ie.DsInformationList) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if ie.IPv6FlowLabel != nil {
+		aper.SetBit(optionals, 2)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 3)
+	}
+	if err = w.WriteBits(optionals, 3); err != nil {
+		return
+	}
+	if err = ie.DestinationIABTNLAddress.encode(w); err != nil {
+		err = utils.WrapError("Encode DestinationIABTNLAddress", err)
+		return
+	}
+	if len(ie.DsInformationList) > 0 {
+		tmp_DsInformationList := sequenceOf[*DSCP]{
+			c:   aper.Constraint{Lb: 0, Ub: maxnoofDSInfo},
+			ext: false,
+		}
+		for k := range ie.DsInformationList {
+			tmp_DsInformationList.Value = append(tmp_DsInformationList.Value, &ie.DsInformationList[k])
+		}
+		if err = tmp_DsInformationList.encode(w); err != nil {
+			err = utils.WrapError("Encode DsInformationList", err)
+			return
+		}
+	}
+	if ie.IPv6FlowLabel != nil {
+		tmp_IPv6FlowLabel := BITSTRING{
+			c:     aper.Constraint{Lb: 20, Ub: 20},
+			ext:   false,
+			Value: *ie.IPv6FlowLabel,
+		}
+		if err = tmp_IPv6FlowLabel.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode IPv6FlowLabel", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *IPHeaderInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *IPHeaderInformation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(3); err != nil {
+		return
+	}
+	if err = ie.DestinationIABTNLAddress.decode(r); err != nil {
+		err = readError("DestinationIABTNLAddress", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		var tmp_DsInformationList []*DSCP
+		if tmp_DsInformationList, err = readList(r, aper.Constraint{Lb: 0, Ub: maxnoofDSInfo}, false, func() *DSCP { return new(DSCP) }); err != nil {
+			err = readError("DsInformationList", err)
+			return
+		}
+		ie.DsInformationList = make([]DSCP, len(tmp_DsInformationList))
+		for k, i := range tmp_DsInformationList {
+			ie.DsInformationList[k] = *i
+		}
+	}
+	if aper.IsBitSet(optionals, 2) {
+		tmp_IPv6FlowLabel := BITSTRING{
+			c:   aper.Constraint{Lb: 20, Ub: 20},
+			ext: false,
+		}
+		if err = tmp_IPv6FlowLabel.Decode(r.AperReader); err != nil {
+			err = readError("IPv6FlowLabel", err)
+			return
+		}
+		tmp := tmp_IPv6FlowLabel.Value
+		ie.IPv6FlowLabel = &tmp
+	}
+	if aper.IsBitSet(optionals, 3) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *IPHeaderInformation) Validate() error {
+	var v validator
+	v.ie("DestinationIABTNLAddress", &ie.DestinationIABTNLAddress)
+	if len(ie.DsInformationList) > 0 {
+		v.size("DsInformationList", len(ie.DsInformationList), 0, maxnoofDSInfo)
+		for i := range ie.DsInformationList {
+			v.item("DsInformationList", i, &ie.DsInformationList[i])
+		}
+	}
+	if ie.IPv6FlowLabel != nil {
+		v.size("IPv6FlowLabel", int(ie.IPv6FlowLabel.NumBits), 20, 20)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,135 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type IPtolayer2TrafficMappingInfo struct {
+	IPtolayer2TrafficMappingInfoToAdd    []IPtolayer2TrafficMappingInfoItem `lb:1,ub:maxnoofMappingEntries,optional`
+	IPtolayer2TrafficMappingInfoToRemove []MappingInformationIndex          `lb:1,ub:maxnoofMappingEntries,optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[IPtolayer2TrafficMappingInfo] `optional,extension`
+}
+
+func (ie *IPtolayer2TrafficMappingInfo) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *IPtolayer2TrafficMappingInfo) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(ie.IPtolayer2TrafficMappingInfoToAdd) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if len(ie.IPtolayer2TrafficMappingInfoToRemove) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 3)
+	}
+	if err = w.WriteBits(optionals, 3); err != nil {
+		return
+	}
+	if len(ie.IPtolayer2TrafficMappingInfoToAdd) > 0 {
+		tmp_IPtolayer2TrafficMappingInfoToAdd := sequenceOf[*IPtolayer2TrafficMappingInfoItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofMappingEntries},
+			ext: false,
+		}
+		for k := range ie.IPtolayer2TrafficMappingInfoToAdd {
+			tmp_IPtolayer2TrafficMappingInfoToAdd.Value = append(tmp_IPtolayer2TrafficMappingInfoToAdd.Value, &ie.IPtolayer2TrafficMappingInfoToAdd[k])
+		}
+		if err = tmp_IPtolayer2TrafficMappingInfoToAdd.encode(w); err != nil {
+			err = utils.WrapError("Encode IPtolayer2TrafficMappingInfoToAdd", err)
+			return
+		}
+	}
+	if len(ie.IPtolayer2TrafficMappingInfoToRemove) > 0 {
+		tmp_IPtolayer2TrafficMappingInfoToRemove := sequenceOf[*MappingInformationIndex]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofMappingEntries},
+			ext: false,
+		}
+		for k := range ie.IPtolayer2TrafficMappingInfoToRemove {
+			tmp_IPtolayer2TrafficMappingInfoToRemove.Value = append(tmp_IPtolayer2TrafficMappingInfoToRemove.Value, &ie.IPtolayer2TrafficMappingInfoToRemove[k])
+		}
+		if err = tmp_IPtolayer2TrafficMappingInfoToRemove.encode(w); err != nil {
+			err = utils.WrapError("Encode IPtolayer2TrafficMappingInfoToRemove", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *IPtolayer2TrafficMappingInfo) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *IPtolayer2TrafficMappingInfo) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(3); err != nil {
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		var tmp_IPtolayer2TrafficMappingInfoToAdd []*IPtolayer2TrafficMappingInfoItem
+		if tmp_IPtolayer2TrafficMappingInfoToAdd, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofMappingEntries}, false, func() *IPtolayer2TrafficMappingInfoItem { return new(IPtolayer2TrafficMappingInfoItem) }); err != nil {
+			err = readError("IPtolayer2TrafficMappingInfoToAdd", err)
+			return
+		}
+		ie.IPtolayer2TrafficMappingInfoToAdd = make([]IPtolayer2TrafficMappingInfoItem, len(tmp_IPtolayer2TrafficMappingInfoToAdd))
+		for k, i := range tmp_IPtolayer2TrafficMappingInfoToAdd {
+			ie.IPtolayer2TrafficMappingInfoToAdd[k] = *i
+		}
+	}
+	if aper.IsBitSet(optionals, 2) {
+		var tmp_IPtolayer2TrafficMappingInfoToRemove []*MappingInformationIndex
+		if tmp_IPtolayer2TrafficMappingInfoToRemove, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofMappingEntries}, false, func() *MappingInformationIndex { return new(MappingInformationIndex) }); err != nil {
+			err = readError("IPtolayer2TrafficMappingInfoToRemove", err)
+			return
+		}
+		ie.IPtolayer2TrafficMappingInfoToRemove = make([]MappingInformationIndex, len(tmp_IPtolayer2TrafficMappingInfoToRemove))
+		for k, i := range tmp_IPtolayer2TrafficMappingInfoToRemove {
+			ie.IPtolayer2TrafficMappingInfoToRemove[k] = *i
+		}
+	}
+	if aper.IsBitSet(optionals, 3) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *IPtolayer2TrafficMappingInfo) Validate() error {
+	var v validator
+	if len(ie.IPtolayer2TrafficMappingInfoToAdd) > 0 {
+		v.size("IPtolayer2TrafficMappingInfoToAdd", len(ie.IPtolayer2TrafficMappingInfoToAdd), 1, maxnoofMappingEntries)
+		for i := range ie.IPtolayer2TrafficMappingInfoToAdd {
+			v.item("IPtolayer2TrafficMappingInfoToAdd", i, &ie.IPtolayer2TrafficMappingInfoToAdd[i])
+		}
+	}
+	if len(ie.IPtolayer2TrafficMappingInfoToRemove) > 0 {
+		v.size("IPtolayer2TrafficMappingInfoToRemove", len(ie.IPtolayer2TrafficMappingInfoToRemove), 1, maxnoofMappingEntries)
+		for i := range ie.IPtolayer2TrafficMappingInfoToRemove {
+			v.item("IPtolayer2TrafficMappingInfoToRemove", i, &ie.IPtolayer2TrafficMappingInfoToRemove[i])
+		}
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
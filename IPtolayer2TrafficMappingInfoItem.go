@@ -0,0 +1,97 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type IPtolayer2TrafficMappingInfoItem struct {
+	MappingInformationIndex MappingInformationIndex `mandatory`
+	IPHeaderInformation     IPHeaderInformation     `mandatory`
+	BHInfo                  BHInfo                  `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[IPtolayer2TrafficMappingInfoItem] `optional,extension`
+}
+
+func (ie *IPtolayer2TrafficMappingInfoItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *IPtolayer2TrafficMappingInfoItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.MappingInformationIndex.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode MappingInformationIndex", err)
+		return
+	}
+	if err = ie.IPHeaderInformation.encode(w); err != nil {
+		err = utils.WrapError("Encode IPHeaderInformation", err)
+		return
+	}
+	if err = ie.BHInfo.encode(w); err != nil {
+		err = utils.WrapError("Encode BHInfo", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *IPtolayer2TrafficMappingInfoItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *IPtolayer2TrafficMappingInfoItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.MappingInformationIndex.decode(r); err != nil {
+		err = readError("MappingInformationIndex", err)
+		return
+	}
+	if err = ie.IPHeaderInformation.decode(r); err != nil {
+		err = readError("IPHeaderInformation", err)
+		return
+	}
+	if err = ie.BHInfo.decode(r); err != nil {
+		err = readError("BHInfo", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *IPtolayer2TrafficMappingInfoItem) Validate() error {
+	var v validator
+	v.ie("MappingInformationIndex", &ie.MappingInformationIndex)
+	v.ie("IPHeaderInformation", &ie.IPHeaderInformation)
+	v.ie("BHInfo", &ie.BHInfo)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,229 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type InitialULRRCMessageTransfer struct {
+	GNBDUUEF1APID                GNBDUUEF1APID                 `mandatory,reject`
+	NRCGI                        NRCGI                         `mandatory,reject`
+	CRNTI                        CRNTI                         `mandatory,reject`
+	RRCContainer                 RRCContainer                  `mandatory,reject`
+	DUtoCURRCContainer           *DUtoCURRCContainer           `optional,reject`
+	SULAccessIndication          *SULAccessIndication          `optional,ignore`
+	TransactionID                TransactionID                 `mandatory,ignore`
+	RANUEID                      *RANUEID                      `optional,ignore`
+	RRCContainerRRCSetupComplete *RRCContainerRRCSetupComplete `optional,ignore`
+	UnknownIEs                   []RawIE
+}
+
+func (msg *InitialULRRCMessageTransfer) ProcedureCode() int64 {
+	return ProcedureCode_InitialULRRCMessageTransfer
+}
+
+func (msg *InitialULRRCMessageTransfer) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *InitialULRRCMessageTransfer) Criticality() aper.Enumerated {
+	return Criticality_PresentIgnore
+}
+
+func (msg *InitialULRRCMessageTransfer) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("InitialULRRCMessageTransfer"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *InitialULRRCMessageTransfer) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("InitialULRRCMessageTransfer"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *InitialULRRCMessageTransfer) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_NRCGI},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.NRCGI,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_CRNTI},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.CRNTI,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_RRCContainer},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.RRCContainer,
+	})
+	if msg.DUtoCURRCContainer != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DUtoCURRCContainer},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.DUtoCURRCContainer,
+		})
+	}
+	if msg.SULAccessIndication != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SULAccessIndication},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.SULAccessIndication,
+		})
+	}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_TransactionID},
+		Criticality: Criticality{Value: Criticality_PresentIgnore},
+		Value:       &msg.TransactionID,
+	})
+	if msg.RANUEID != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RANUEID},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.RANUEID,
+		})
+	}
+	if msg.RRCContainerRRCSetupComplete != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RRCContainerRRCSetupComplete},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.RRCContainerRRCSetupComplete,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *InitialULRRCMessageTransfer) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *InitialULRRCMessageTransfer) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *InitialULRRCMessageTransfer) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("InitialULRRCMessageTransfer", wire, limits, initialULRRCMessageTransferIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var initialULRRCMessageTransferIEs = []messageIE{
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_NRCGI, Criticality_PresentReject, true},
+	{ProtocolIEID_CRNTI, Criticality_PresentReject, true},
+	{ProtocolIEID_RRCContainer, Criticality_PresentReject, true},
+	{ProtocolIEID_DUtoCURRCContainer, Criticality_PresentReject, false},
+	{ProtocolIEID_SULAccessIndication, Criticality_PresentIgnore, false},
+	{ProtocolIEID_TransactionID, Criticality_PresentIgnore, true},
+	{ProtocolIEID_RANUEID, Criticality_PresentIgnore, false},
+	{ProtocolIEID_RRCContainerRRCSetupComplete, Criticality_PresentIgnore, false},
+}
+
+func (msg *InitialULRRCMessageTransfer) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_NRCGI:
+		var tmp NRCGI
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("NRCGI", err)
+			return
+		}
+		msg.NRCGI = tmp
+
+	case ProtocolIEID_CRNTI:
+		var tmp CRNTI
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("CRNTI", err)
+			return
+		}
+		msg.CRNTI = tmp
+
+	case ProtocolIEID_RRCContainer:
+		var tmp RRCContainer
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RRCContainer", err)
+			return
+		}
+		msg.RRCContainer = tmp
+
+	case ProtocolIEID_DUtoCURRCContainer:
+		var tmp DUtoCURRCContainer
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("DUtoCURRCContainer", err)
+			return
+		}
+		msg.DUtoCURRCContainer = &tmp
+
+	case ProtocolIEID_SULAccessIndication:
+		var tmp SULAccessIndication
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("SULAccessIndication", err)
+			return
+		}
+		msg.SULAccessIndication = &tmp
+
+	case ProtocolIEID_TransactionID:
+		var tmp TransactionID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("TransactionID", err)
+			return
+		}
+		msg.TransactionID = tmp
+
+	case ProtocolIEID_RANUEID:
+		var tmp RANUEID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RANUEID", err)
+			return
+		}
+		msg.RANUEID = &tmp
+
+	case ProtocolIEID_RRCContainerRRCSetupComplete:
+		var tmp RRCContainerRRCSetupComplete
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RRCContainerRRCSetupComplete", err)
+			return
+		}
+		msg.RRCContainerRRCSetupComplete = &tmp
+
+	}
+	return
+}
+
+func (msg *InitialULRRCMessageTransfer) Validate() error {
+	var v validator
+	v.integer("GNBDUUEF1APID", int64(msg.GNBDUUEF1APID.Value), 0, 4294967295)
+	v.integer("CRNTI", int64(msg.CRNTI.Value), 0, 65535)
+	if msg.SULAccessIndication != nil {
+		v.enumerated("SULAccessIndication", msg.SULAccessIndication.Value, 1)
+	}
+	v.integer("TransactionID", int64(msg.TransactionID.Value), 0, 255)
+	if msg.RANUEID != nil {
+		v.size("RANUEID", len(msg.RANUEID.Value), 8, 8)
+	}
+	return v.messageErr("InitialULRRCMessageTransfer")
+}
@@ -0,0 +1,233 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type IntendedTDDDLULConfig struct {
+	NRSCS                 IntendedTDDDLULConfigNRSCS               `mandatory`
+	NRCP                  IntendedTDDDLULConfigNRCP                `mandatory`
+	NRDLULTxPeriodicity   IntendedTDDDLULConfigNRDLULTxPeriodicity `mandatory`
+	SlotConfigurationList []SlotConfigurationItem                  `lb:1,ub:maxnoofslots,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[IntendedTDDDLULConfig] `optional,extension`
+}
+
+func (ie *IntendedTDDDLULConfig) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *IntendedTDDDLULConfig) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.NRSCS.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode NRSCS", err)
+		return
+	}
+	if err = ie.NRCP.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode NRCP", err)
+		return
+	}
+	if err = ie.NRDLULTxPeriodicity.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode NRDLULTxPeriodicity", err)
+		return
+	}
+	tmp_SlotConfigurationList := sequenceOf[*SlotConfigurationItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofslots},
+		ext: false,
+	}
+	for k := range ie.SlotConfigurationList {
+		tmp_SlotConfigurationList.Value = append(tmp_SlotConfigurationList.Value, &ie.SlotConfigurationList[k])
+	}
+	if err = tmp_SlotConfigurationList.encode(w); err != nil {
+		err = utils.WrapError("Encode SlotConfigurationList", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *IntendedTDDDLULConfig) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *IntendedTDDDLULConfig) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.NRSCS.Decode(r.AperReader); err != nil {
+		err = readError("NRSCS", err)
+		return
+	}
+	if err = ie.NRCP.Decode(r.AperReader); err != nil {
+		err = readError("NRCP", err)
+		return
+	}
+	if err = ie.NRDLULTxPeriodicity.Decode(r.AperReader); err != nil {
+		err = readError("NRDLULTxPeriodicity", err)
+		return
+	}
+	var tmp_SlotConfigurationList []*SlotConfigurationItem
+	if tmp_SlotConfigurationList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofslots}, false, func() *SlotConfigurationItem { return new(SlotConfigurationItem) }); err != nil {
+		err = readError("SlotConfigurationList", err)
+		return
+	}
+	ie.SlotConfigurationList = make([]SlotConfigurationItem, len(tmp_SlotConfigurationList))
+	for k, i := range tmp_SlotConfigurationList {
+		ie.SlotConfigurationList[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *IntendedTDDDLULConfig) Validate() error {
+	var v validator
+	v.ie("NRSCS", &ie.NRSCS)
+	v.ie("NRCP", &ie.NRCP)
+	v.ie("NRDLULTxPeriodicity", &ie.NRDLULTxPeriodicity)
+	v.size("SlotConfigurationList", len(ie.SlotConfigurationList), 1, maxnoofslots)
+	for i := range ie.SlotConfigurationList {
+		v.item("SlotConfigurationList", i, &ie.SlotConfigurationList[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
+
+const (
+	IntendedTDDDLULConfigNRSCSScs15  aper.Enumerated = 0
+	IntendedTDDDLULConfigNRSCSScs30  aper.Enumerated = 1
+	IntendedTDDDLULConfigNRSCSScs60  aper.Enumerated = 2
+	IntendedTDDDLULConfigNRSCSScs120 aper.Enumerated = 3
+)
+
+type IntendedTDDDLULConfigNRSCS struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:3,valueExt"`
+}
+
+func (ie *IntendedTDDDLULConfigNRSCS) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *IntendedTDDDLULConfigNRSCS) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *IntendedTDDDLULConfigNRSCS) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 4)
+	return v.err()
+}
+
+const (
+	IntendedTDDDLULConfigNRCPNormal   aper.Enumerated = 0
+	IntendedTDDDLULConfigNRCPExtended aper.Enumerated = 1
+)
+
+type IntendedTDDDLULConfigNRCP struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:1,valueExt"`
+}
+
+func (ie *IntendedTDDDLULConfigNRCP) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 1}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *IntendedTDDDLULConfigNRCP) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 1}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *IntendedTDDDLULConfigNRCP) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 2)
+	return v.err()
+}
+
+const (
+	IntendedTDDDLULConfigNRDLULTxPeriodicityMs0p5   aper.Enumerated = 0
+	IntendedTDDDLULConfigNRDLULTxPeriodicityMs0p625 aper.Enumerated = 1
+	IntendedTDDDLULConfigNRDLULTxPeriodicityMs1     aper.Enumerated = 2
+	IntendedTDDDLULConfigNRDLULTxPeriodicityMs1p25  aper.Enumerated = 3
+	IntendedTDDDLULConfigNRDLULTxPeriodicityMs2     aper.Enumerated = 4
+	IntendedTDDDLULConfigNRDLULTxPeriodicityMs2p5   aper.Enumerated = 5
+	IntendedTDDDLULConfigNRDLULTxPeriodicityMs3     aper.Enumerated = 6
+	IntendedTDDDLULConfigNRDLULTxPeriodicityMs4     aper.Enumerated = 7
+	IntendedTDDDLULConfigNRDLULTxPeriodicityMs5     aper.Enumerated = 8
+	IntendedTDDDLULConfigNRDLULTxPeriodicityMs10    aper.Enumerated = 9
+	IntendedTDDDLULConfigNRDLULTxPeriodicityMs20    aper.Enumerated = 10
+	IntendedTDDDLULConfigNRDLULTxPeriodicityMs40    aper.Enumerated = 11
+	IntendedTDDDLULConfigNRDLULTxPeriodicityMs60    aper.Enumerated = 12
+	IntendedTDDDLULConfigNRDLULTxPeriodicityMs80    aper.Enumerated = 13
+	IntendedTDDDLULConfigNRDLULTxPeriodicityMs100   aper.Enumerated = 14
+	IntendedTDDDLULConfigNRDLULTxPeriodicityMs120   aper.Enumerated = 15
+	IntendedTDDDLULConfigNRDLULTxPeriodicityMs140   aper.Enumerated = 16
+	IntendedTDDDLULConfigNRDLULTxPeriodicityMs160   aper.Enumerated = 17
+)
+
+type IntendedTDDDLULConfigNRDLULTxPeriodicity struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:17,valueExt"`
+}
+
+func (ie *IntendedTDDDLULConfigNRDLULTxPeriodicity) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 17}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *IntendedTDDDLULConfigNRDLULTxPeriodicity) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 17}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *IntendedTDDDLULConfigNRDLULTxPeriodicity) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 18)
+	return v.err()
+}
@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type L139Info struct {
+	PrachSCS          PRACHSCS `mandatory`
+	RootSequenceIndex *int64   `lb:0,ub:137,optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[L139Info] `optional,extension`
+}
+
+func (ie *L139Info) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *L139Info) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.RootSequenceIndex != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	if err = ie.PrachSCS.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PrachSCS", err)
+		return
+	}
+	if ie.RootSequenceIndex != nil {
+		tmp_RootSequenceIndex := INTEGER{
+			c:     aper.Constraint{Lb: 0, Ub: 137},
+			ext:   false,
+			Value: aper.Integer(*ie.RootSequenceIndex),
+		}
+		if err = tmp_RootSequenceIndex.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode RootSequenceIndex", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *L139Info) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *L139Info) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	if err = ie.PrachSCS.Decode(r.AperReader); err != nil {
+		err = readError("PrachSCS", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp_RootSequenceIndex := INTEGER{
+			c:   aper.Constraint{Lb: 0, Ub: 137},
+			ext: false,
+		}
+		if err = tmp_RootSequenceIndex.Decode(r.AperReader); err != nil {
+			err = readError("RootSequenceIndex", err)
+			return
+		}
+		tmp := int64(tmp_RootSequenceIndex.Value)
+		ie.RootSequenceIndex = &tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *L139Info) Validate() error {
+	var v validator
+	v.ie("PrachSCS", &ie.PrachSCS)
+	if ie.RootSequenceIndex != nil {
+		v.integer("RootSequenceIndex", *ie.RootSequenceIndex, 0, 137)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
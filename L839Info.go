@@ -0,0 +1,97 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type L839Info struct {
+	RootSequenceIndex   int64               `lb:0,ub:837,mandatory`
+	RestrictedSetConfig RestrictedSetConfig `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[L839Info] `optional,extension`
+}
+
+func (ie *L839Info) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *L839Info) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_RootSequenceIndex := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 837},
+		ext:   false,
+		Value: aper.Integer(ie.RootSequenceIndex),
+	}
+	if err = tmp_RootSequenceIndex.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode RootSequenceIndex", err)
+		return
+	}
+	if err = ie.RestrictedSetConfig.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode RestrictedSetConfig", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *L839Info) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *L839Info) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	tmp_RootSequenceIndex := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 837},
+		ext: false,
+	}
+	if err = tmp_RootSequenceIndex.Decode(r.AperReader); err != nil {
+		err = readError("RootSequenceIndex", err)
+		return
+	}
+	ie.RootSequenceIndex = int64(tmp_RootSequenceIndex.Value)
+	if err = ie.RestrictedSetConfig.Decode(r.AperReader); err != nil {
+		err = readError("RestrictedSetConfig", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *L839Info) Validate() error {
+	var v validator
+	v.integer("RootSequenceIndex", ie.RootSequenceIndex, 0, 837)
+	v.ie("RestrictedSetConfig", &ie.RestrictedSetConfig)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,127 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type LCSToGCSTranslationAoA struct {
+	Alpha int64 `lb:0,ub:3599,mandatory`
+	Beta  int64 `lb:0,ub:3599,mandatory`
+	Gamma int64 `lb:0,ub:3599,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[LCSToGCSTranslationAoA] `optional,extension`
+}
+
+func (ie *LCSToGCSTranslationAoA) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *LCSToGCSTranslationAoA) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_Alpha := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 3599},
+		ext:   false,
+		Value: aper.Integer(ie.Alpha),
+	}
+	if err = tmp_Alpha.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Alpha", err)
+		return
+	}
+	tmp_Beta := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 3599},
+		ext:   false,
+		Value: aper.Integer(ie.Beta),
+	}
+	if err = tmp_Beta.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Beta", err)
+		return
+	}
+	tmp_Gamma := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 3599},
+		ext:   false,
+		Value: aper.Integer(ie.Gamma),
+	}
+	if err = tmp_Gamma.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Gamma", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *LCSToGCSTranslationAoA) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *LCSToGCSTranslationAoA) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	tmp_Alpha := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 3599},
+		ext: false,
+	}
+	if err = tmp_Alpha.Decode(r.AperReader); err != nil {
+		err = readError("Alpha", err)
+		return
+	}
+	ie.Alpha = int64(tmp_Alpha.Value)
+	tmp_Beta := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 3599},
+		ext: false,
+	}
+	if err = tmp_Beta.Decode(r.AperReader); err != nil {
+		err = readError("Beta", err)
+		return
+	}
+	ie.Beta = int64(tmp_Beta.Value)
+	tmp_Gamma := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 3599},
+		ext: false,
+	}
+	if err = tmp_Gamma.Decode(r.AperReader); err != nil {
+		err = readError("Gamma", err)
+		return
+	}
+	ie.Gamma = int64(tmp_Gamma.Value)
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *LCSToGCSTranslationAoA) Validate() error {
+	var v validator
+	v.integer("Alpha", ie.Alpha, 0, 3599)
+	v.integer("Beta", ie.Beta, 0, 3599)
+	v.integer("Gamma", ie.Gamma, 0, 3599)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,217 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type LCStoGCSTranslation struct {
+	Alpha     int64  `lb:0,ub:359,mandatory`
+	AlphaFine *int64 `lb:0,ub:9,optional`
+	Beta      int64  `lb:0,ub:359,mandatory`
+	BetaFine  *int64 `lb:0,ub:9,optional`
+	Gamma     int64  `lb:0,ub:359,mandatory`
+	GammaFine *int64 `lb:0,ub:9,optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[LCStoGCSTranslation] `optional,extension`
+}
+
+func (ie *LCStoGCSTranslation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *LCStoGCSTranslation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.AlphaFine != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if ie.BetaFine != nil {
+		aper.SetBit(optionals, 2)
+	}
+	if ie.GammaFine != nil {
+		aper.SetBit(optionals, 3)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 4)
+	}
+	if err = w.WriteBits(optionals, 4); err != nil {
+		return
+	}
+	tmp_Alpha := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 359},
+		ext:   false,
+		Value: aper.Integer(ie.Alpha),
+	}
+	if err = tmp_Alpha.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Alpha", err)
+		return
+	}
+	if ie.AlphaFine != nil {
+		tmp_AlphaFine := INTEGER{
+			c:     aper.Constraint{Lb: 0, Ub: 9},
+			ext:   false,
+			Value: aper.Integer(*ie.AlphaFine),
+		}
+		if err = tmp_AlphaFine.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode AlphaFine", err)
+			return
+		}
+	}
+	tmp_Beta := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 359},
+		ext:   false,
+		Value: aper.Integer(ie.Beta),
+	}
+	if err = tmp_Beta.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Beta", err)
+		return
+	}
+	if ie.BetaFine != nil {
+		tmp_BetaFine := INTEGER{
+			c:     aper.Constraint{Lb: 0, Ub: 9},
+			ext:   false,
+			Value: aper.Integer(*ie.BetaFine),
+		}
+		if err = tmp_BetaFine.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode BetaFine", err)
+			return
+		}
+	}
+	tmp_Gamma := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 359},
+		ext:   false,
+		Value: aper.Integer(ie.Gamma),
+	}
+	if err = tmp_Gamma.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Gamma", err)
+		return
+	}
+	if ie.GammaFine != nil {
+		tmp_GammaFine := INTEGER{
+			c:     aper.Constraint{Lb: 0, Ub: 9},
+			ext:   false,
+			Value: aper.Integer(*ie.GammaFine),
+		}
+		if err = tmp_GammaFine.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode GammaFine", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *LCStoGCSTranslation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *LCStoGCSTranslation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(4); err != nil {
+		return
+	}
+	tmp_Alpha := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 359},
+		ext: false,
+	}
+	if err = tmp_Alpha.Decode(r.AperReader); err != nil {
+		err = readError("Alpha", err)
+		return
+	}
+	ie.Alpha = int64(tmp_Alpha.Value)
+	if aper.IsBitSet(optionals, 1) {
+		tmp_AlphaFine := INTEGER{
+			c:   aper.Constraint{Lb: 0, Ub: 9},
+			ext: false,
+		}
+		if err = tmp_AlphaFine.Decode(r.AperReader); err != nil {
+			err = readError("AlphaFine", err)
+			return
+		}
+		tmp := int64(tmp_AlphaFine.Value)
+		ie.AlphaFine = &tmp
+	}
+	tmp_Beta := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 359},
+		ext: false,
+	}
+	if err = tmp_Beta.Decode(r.AperReader); err != nil {
+		err = readError("Beta", err)
+		return
+	}
+	ie.Beta = int64(tmp_Beta.Value)
+	if aper.IsBitSet(optionals, 2) {
+		tmp_BetaFine := INTEGER{
+			c:   aper.Constraint{Lb: 0, Ub: 9},
+			ext: false,
+		}
+		if err = tmp_BetaFine.Decode(r.AperReader); err != nil {
+			err = readError("BetaFine", err)
+			return
+		}
+		tmp := int64(tmp_BetaFine.Value)
+		ie.BetaFine = &tmp
+	}
+	tmp_Gamma := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 359},
+		ext: false,
+	}
+	if err = tmp_Gamma.Decode(r.AperReader); err != nil {
+		err = readError("Gamma", err)
+		return
+	}
+	ie.Gamma = int64(tmp_Gamma.Value)
+	if aper.IsBitSet(optionals, 3) {
+		tmp_GammaFine := INTEGER{
+			c:   aper.Constraint{Lb: 0, Ub: 9},
+			ext: false,
+		}
+		if err = tmp_GammaFine.Decode(r.AperReader); err != nil {
+			err = readError("GammaFine", err)
+			return
+		}
+		tmp := int64(tmp_GammaFine.Value)
+		ie.GammaFine = &tmp
+	}
+	if aper.IsBitSet(optionals, 4) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *LCStoGCSTranslation) Validate() error {
+	var v validator
+	v.integer("Alpha", ie.Alpha, 0, 359)
+	if ie.AlphaFine != nil {
+		v.integer("AlphaFine", *ie.AlphaFine, 0, 9)
+	}
+	v.integer("Beta", ie.Beta, 0, 359)
+	if ie.BetaFine != nil {
+		v.integer("BetaFine", *ie.BetaFine, 0, 9)
+	}
+	v.integer("Gamma", ie.Gamma, 0, 359)
+	if ie.GammaFine != nil {
+		v.integer("GammaFine", *ie.GammaFine, 0, 9)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
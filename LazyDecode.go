@@ -0,0 +1,235 @@
+package ies
+
+import (
+	"errors"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+// LazyMessage is a received F1AP-PDU decoded up to the id, criticality and
+// value of its IEs; IE values are decoded on demand
+type LazyMessage struct {
+	Present       uint8
+	ProcedureCode int64
+	Criticality   aper.Enumerated
+	IEs           []LazyIE
+	wire          []byte       // message value
+	limits        DecodeLimits // limits the message was decoded within
+}
+
+// an IE of a LazyMessage
+type LazyIE struct {
+	Id          aper.Integer
+	Criticality aper.Enumerated
+	Value       []byte // contents of the open type
+}
+
+// read the F1AP-PDU header and return the message value
+func readPduHeader(r *decoder) (present uint8, procedureCode int64, criticality aper.Enumerated, wire []byte, err error) {
+	if _, err = r.ReadBool(); err != nil {
+		return
+	}
+	var choice uint64
+	if choice, err = r.ReadChoice(2, true); err != nil {
+		return
+	}
+	present = uint8(choice)
+	var pCode ProcedureCode
+	if err = pCode.Decode(r.AperReader); err != nil {
+		err = readError("ProcedureCode", err)
+		return
+	}
+	procedureCode = int64(pCode.Value)
+	var c uint64
+	if c, err = r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 2}, false); err != nil {
+		err = readError("Criticality", err)
+		return
+	}
+	criticality = aper.Enumerated(c)
+	if wire, err = readOpenType(r); err != nil {
+		err = readError("Value", err)
+	}
+	return
+}
+
+// read the IE headers of the container of a message value read with pduR;
+// fn is called with the reader of the container for each IE and stops the
+// reading with errStopReading
+func readIEHeaders(pduR *decoder, wire []byte, fn func(r *decoder, ie *F1apMessageIE, value []byte) error) (err error) {
+	var r *decoder
+	if r, err = newOpenTypeReader(pduR, wire); err != nil {
+		return
+	}
+	n := 0
+	decodeItem := func(*aper.AperReader) (ie *F1apMessageIE, err error) {
+		var buf []byte
+		n++
+		if ie, buf, err = readContainerIE(r, n); err != nil {
+			return
+		}
+		err = fn(r, ie, buf)
+		return
+	}
+	if _, err = r.ReadBool(); err != nil {
+		return
+	}
+	_, err = aper.ReadSequenceOf[F1apMessageIE](decodeItem, r.AperReader, &aper.Constraint{Lb: 0, Ub: int64(aper.POW_16 - 1)}, false)
+	if errors.Is(err, errStopReading) {
+		err = nil
+	}
+	return
+}
+
+var errStopReading = errors.New("stop reading")
+
+// DecodeLazy decodes the header of an F1AP-PDU and the id and criticality of
+// the IEs of its message, leaving the IE values undecoded, within the default
+// limits
+func DecodeLazy(pdu []byte) (*LazyMessage, error) {
+	return DecodeLazyWithLimits(pdu, DefaultDecodeLimits())
+}
+
+// DecodeLazyWithLimits is DecodeLazy within limits, which also apply to the
+// IE values decoded later from the message
+func DecodeLazyWithLimits(pdu []byte, limits DecodeLimits) (msg *LazyMessage, err error) {
+	msg = &LazyMessage{limits: limits}
+	var r *decoder
+	if r, err = newPduDecoder(pdu, limits); err != nil {
+		return
+	}
+	if msg.Present, msg.ProcedureCode, msg.Criticality, msg.wire, err = readPduHeader(r); err != nil {
+		err = messageError("", err)
+		return
+	}
+	err = readIEHeaders(r, msg.wire, func(_ *decoder, ie *F1apMessageIE, value []byte) error {
+		msg.IEs = append(msg.IEs, LazyIE{
+			Id:          ie.Id.Value,
+			Criticality: ie.Criticality.Value,
+			Value:       value,
+		})
+		return nil
+	})
+	if err != nil {
+		err = procedureError(msg.ProcedureCode, err)
+	}
+	return
+}
+
+// set the message of a decode error to the name of the procedure of a PDU;
+// other errors become transfer syntax errors
+func procedureError(code int64, err error) error {
+	var name string
+	if p, found := procedures[code]; found {
+		name = p.Name
+	}
+	return messageError(name, err)
+}
+
+// IE returns the first IE with an id, nil if the message has none
+func (msg *LazyMessage) IE(id aper.Integer) *LazyIE {
+	for i := range msg.IEs {
+		if msg.IEs[i].Id == id {
+			return &msg.IEs[i]
+		}
+	}
+	return nil
+}
+
+// DecodeIE decodes the value of the IE with an id into v; ok is false when
+// the message has no such IE
+func (msg *LazyMessage) DecodeIE(id aper.Integer, v ieCodec) (ok bool, err error) {
+	ie := msg.IE(id)
+	if ie == nil {
+		return
+	}
+	ok = true
+	var r *decoder
+	if r, err = newPduDecoder(ie.Value, msg.limits); err == nil {
+		err = decodeValue(r, v)
+	}
+	if err != nil {
+		err = procedureError(msg.ProcedureCode, readError(protocolIEIDNames[id], err))
+	}
+	return
+}
+
+// Decode decodes the whole message with the TS 38.473 clause 10 checks of
+// its IEs
+func (msg *LazyMessage) Decode() (m F1apMessage, rep *DecodeReport, err error) {
+	if m, err = NewF1apMessage(msg.ProcedureCode, msg.Present); err != nil {
+		return
+	}
+	rep, err = m.DecodeWithLimits(msg.wire, msg.limits)
+	return
+}
+
+// the UE and transaction identities of a message, to route it without
+// decoding it
+type MessageIDs struct {
+	Present          uint8
+	ProcedureCode    int64
+	GNBCUUEF1APID    int64
+	HasGNBCUUEF1APID bool
+	GNBDUUEF1APID    int64
+	HasGNBDUUEF1APID bool
+	TransactionID    int64
+	HasTransactionID bool
+}
+
+// DecodeIDs decodes the header of an F1AP-PDU and the gNB-CU UE F1AP ID,
+// gNB-DU UE F1AP ID and Transaction ID of its message. Other IE values are
+// skipped; the reading stops at the UE F1AP IDs of a UE-associated message or
+// at the Transaction ID of a non UE-associated one.
+func DecodeIDs(pdu []byte) (MessageIDs, error) {
+	return DecodeIDsWithLimits(pdu, DefaultDecodeLimits())
+}
+
+// DecodeIDsWithLimits is DecodeIDs within limits
+func DecodeIDsWithLimits(pdu []byte, limits DecodeLimits) (ids MessageIDs, err error) {
+	var r *decoder
+	if r, err = newPduDecoder(pdu, limits); err != nil {
+		return
+	}
+	var wire []byte
+	if ids.Present, ids.ProcedureCode, _, wire, err = readPduHeader(r); err != nil {
+		err = messageError("", err)
+		return
+	}
+	decodeID := func(r *decoder, value []byte, v ieCodec) (err error) {
+		var ieR *decoder
+		if ieR, err = newOpenTypeReader(r, value); err != nil {
+			return
+		}
+		return decodeValue(ieR, v)
+	}
+	err = readIEHeaders(r, wire, func(r *decoder, ie *F1apMessageIE, value []byte) (err error) {
+		switch ie.Id.Value {
+		case ProtocolIEID_gNBCUUEF1APID:
+			var tmp GNBCUUEF1APID
+			if err = decodeID(r, value, &tmp); err != nil {
+				return readError("GNBCUUEF1APID", err)
+			}
+			ids.GNBCUUEF1APID, ids.HasGNBCUUEF1APID = int64(tmp.Value), true
+		case ProtocolIEID_gNBDUUEF1APID:
+			var tmp GNBDUUEF1APID
+			if err = decodeID(r, value, &tmp); err != nil {
+				return readError("GNBDUUEF1APID", err)
+			}
+			ids.GNBDUUEF1APID, ids.HasGNBDUUEF1APID = int64(tmp.Value), true
+		case ProtocolIEID_TransactionID:
+			var tmp TransactionID
+			if err = decodeID(r, value, &tmp); err != nil {
+				return readError("TransactionID", err)
+			}
+			ids.TransactionID, ids.HasTransactionID = int64(tmp.Value), true
+		}
+		if ids.HasGNBCUUEF1APID && ids.HasGNBDUUEF1APID || ids.HasTransactionID {
+			return errStopReading
+		}
+		return
+	})
+	if err != nil {
+		err = procedureError(ids.ProcedureCode, err)
+	}
+	return
+}
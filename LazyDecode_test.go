@@ -0,0 +1,114 @@
+package ies
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// a Notify PDU with the IE 9999 of criticality ignore after the gNB-CU UE F1AP ID
+var notifyPdu = append([]byte{0x00, 0x13, 0x40, 0x1f}, // initiatingMessage, notify, ignore, length
+	messageValue(notifyCU, unknownIE(0x40), notifyDU, notifyDRBs)...)
+
+func TestDecodeLazy(t *testing.T) {
+	msg, err := DecodeLazy(notifyPdu)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Present != F1apPduInitiatingMessage || msg.ProcedureCode != ProcedureCode_Notify || msg.Criticality != Criticality_PresentIgnore {
+		t.Fatalf("header %d %d %d", msg.Present, msg.ProcedureCode, msg.Criticality)
+	}
+	want := []LazyIE{
+		{Id: ProtocolIEID_gNBCUUEF1APID, Criticality: Criticality_PresentReject, Value: []byte{0x00, 0x01}},
+		{Id: 9999, Criticality: Criticality_PresentIgnore, Value: []byte{0x01}},
+		{Id: ProtocolIEID_gNBDUUEF1APID, Criticality: Criticality_PresentReject, Value: []byte{0x00, 0x02}},
+		{Id: ProtocolIEID_DRBNotifyList, Criticality: Criticality_PresentReject, Value: notifyDRBs[4:]},
+	}
+	if !reflect.DeepEqual(msg.IEs, want) {
+		t.Fatalf("IEs %+v, want %+v", msg.IEs, want)
+	}
+
+	var du GNBDUUEF1APID
+	if ok, err := msg.DecodeIE(ProtocolIEID_gNBDUUEF1APID, &du); !ok || err != nil || du.Value != 2 {
+		t.Fatalf("gNB-DU UE F1AP ID %d: %v %v", du.Value, ok, err)
+	}
+	var tid TransactionID
+	if ok, err := msg.DecodeIE(ProtocolIEID_TransactionID, &tid); ok || err != nil {
+		t.Fatalf("Transaction ID found: %v", err)
+	}
+
+	m, rep, err := msg.Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	notify, ok := m.(*Notify)
+	if !ok {
+		t.Fatalf("decoded %T", m)
+	}
+	if rep.Action != ActionProceed || len(rep.Errors) != 1 || rep.Errors[0].Id != 9999 {
+		t.Fatalf("report %+v", rep)
+	}
+	if notify.GNBCUUEF1APID.Value != 1 || notify.GNBDUUEF1APID.Value != 2 ||
+		len(notify.DRBNotifyList) != 1 || len(notify.UnknownIEs) != 1 {
+		t.Fatalf("decoded %+v", notify)
+	}
+}
+
+func TestDecodeLazyIEError(t *testing.T) {
+	pdu := append([]byte{0x00, 0x13, 0x40, 0x18}, // initiatingMessage, notify, ignore, length
+		messageValue(notifyCU, []byte{0x00, 0x29, 0x00, 0x00}, notifyDRBs)...) // empty gNB-DU UE F1AP ID
+	msg, err := DecodeLazy(pdu)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var du GNBDUUEF1APID
+	ok, err := msg.DecodeIE(ProtocolIEID_gNBDUUEF1APID, &du)
+	var ts *TransferSyntaxError
+	if !ok || !errors.Is(err, ErrTransferSyntax) || !errors.As(err, &ts) || ts.Message != "Notify" {
+		t.Fatalf("error %v", err)
+	}
+	if _, err = DecodeIDs(pdu); !errors.Is(err, ErrTransferSyntax) {
+		t.Fatalf("error %v", err)
+	}
+}
+
+func TestDecodeIDs(t *testing.T) {
+	ids, err := DecodeIDs(notifyPdu)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := MessageIDs{
+		Present:          F1apPduInitiatingMessage,
+		ProcedureCode:    ProcedureCode_Notify,
+		GNBCUUEF1APID:    1,
+		HasGNBCUUEF1APID: true,
+		GNBDUUEF1APID:    2,
+		HasGNBDUUEF1APID: true,
+	}
+	if ids != want {
+		t.Fatalf("IDs %+v, want %+v", ids, want)
+	}
+
+	// the IEs after the Transaction ID are not read
+	pdu := []byte{
+		0x00, 0x39, 0x40, 0x0d, // initiatingMessage, referenceTimeInformationReport, ignore, length
+		0x00, 0x00, 0x02, // extension bit, 2 IEs
+		0x00, 0x4e, 0x40, 0x02, 0x00, 0x02, // TransactionID 2
+		0x01, 0x6e, 0x40, 0x00, // empty TimeReferenceInformation
+	}
+	want = MessageIDs{
+		Present:          F1apPduInitiatingMessage,
+		ProcedureCode:    ProcedureCode_ReferenceTimeInformationReport,
+		TransactionID:    2,
+		HasTransactionID: true,
+	}
+	if ids, err = DecodeIDs(pdu); err != nil || ids != want {
+		t.Fatalf("IDs %+v, want %+v: %v", ids, want, err)
+	}
+
+	for _, n := range []int{0, 3, 10} {
+		if _, err = DecodeIDs(notifyPdu[:n]); !errors.Is(err, ErrTransferSyntax) {
+			t.Fatalf("PDU of %d octets: error %v", n, err)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type LocationUncertainty struct {
+	HorizontalUncertainty int64 `lb:0,ub:255,mandatory`
+	HorizontalConfidence  int64 `lb:0,ub:100,mandatory`
+	VerticalUncertainty   int64 `lb:0,ub:255,mandatory`
+	VerticalConfidence    int64 `lb:0,ub:100,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[LocationUncertainty] `optional,extension`
+}
+
+func (ie *LocationUncertainty) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *LocationUncertainty) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_HorizontalUncertainty := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 255},
+		ext:   false,
+		Value: aper.Integer(ie.HorizontalUncertainty),
+	}
+	if err = tmp_HorizontalUncertainty.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode HorizontalUncertainty", err)
+		return
+	}
+	tmp_HorizontalConfidence := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 100},
+		ext:   false,
+		Value: aper.Integer(ie.HorizontalConfidence),
+	}
+	if err = tmp_HorizontalConfidence.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode HorizontalConfidence", err)
+		return
+	}
+	tmp_VerticalUncertainty := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 255},
+		ext:   false,
+		Value: aper.Integer(ie.VerticalUncertainty),
+	}
+	if err = tmp_VerticalUncertainty.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode VerticalUncertainty", err)
+		return
+	}
+	tmp_VerticalConfidence := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 100},
+		ext:   false,
+		Value: aper.Integer(ie.VerticalConfidence),
+	}
+	if err = tmp_VerticalConfidence.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode VerticalConfidence", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *LocationUncertainty) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *LocationUncertainty) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	tmp_HorizontalUncertainty := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 255},
+		ext: false,
+	}
+	if err = tmp_HorizontalUncertainty.Decode(r.AperReader); err != nil {
+		err = readError("HorizontalUncertainty", err)
+		return
+	}
+	ie.HorizontalUncertainty = int64(tmp_HorizontalUncertainty.Value)
+	tmp_HorizontalConfidence := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 100},
+		ext: false,
+	}
+	if err = tmp_HorizontalConfidence.Decode(r.AperReader); err != nil {
+		err = readError("HorizontalConfidence", err)
+		return
+	}
+	ie.HorizontalConfidence = int64(tmp_HorizontalConfidence.Value)
+	tmp_VerticalUncertainty := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 255},
+		ext: false,
+	}
+	if err = tmp_VerticalUncertainty.Decode(r.AperReader); err != nil {
+		err = readError("VerticalUncertainty", err)
+		return
+	}
+	ie.VerticalUncertainty = int64(tmp_VerticalUncertainty.Value)
+	tmp_VerticalConfidence := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 100},
+		ext: false,
+	}
+	if err = tmp_VerticalConfidence.Decode(r.AperReader); err != nil {
+		err = readError("VerticalConfidence", err)
+		return
+	}
+	ie.VerticalConfidence = int64(tmp_VerticalConfidence.Value)
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *LocationUncertainty) Validate() error {
+	var v validator
+	v.integer("HorizontalUncertainty", ie.HorizontalUncertainty, 0, 255)
+	v.integer("HorizontalConfidence", ie.HorizontalConfidence, 0, 100)
+	v.integer("VerticalUncertainty", ie.VerticalUncertainty, 0, 255)
+	v.integer("VerticalConfidence", ie.VerticalConfidence, 0, 100)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
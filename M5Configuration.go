@@ -0,0 +1,87 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type M5Configuration struct {
+	M5period     M5period     `mandatory`
+	M5LinksToLog M5LinksToLog `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[M5Configuration] `optional,extension`
+}
+
+func (ie *M5Configuration) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *M5Configuration) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.M5period.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode M5period", err)
+		return
+	}
+	if err = ie.M5LinksToLog.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode M5LinksToLog", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *M5Configuration) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *M5Configuration) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.M5period.Decode(r.AperReader); err != nil {
+		err = readError("M5period", err)
+		return
+	}
+	if err = ie.M5LinksToLog.Decode(r.AperReader); err != nil {
+		err = readError("M5LinksToLog", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *M5Configuration) Validate() error {
+	var v validator
+	v.ie("M5period", &ie.M5period)
+	v.ie("M5LinksToLog", &ie.M5LinksToLog)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,87 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type M6Configuration struct {
+	M6reportInterval M6reportInterval `mandatory`
+	M6LinksToLog     M6LinksToLog     `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[M6Configuration] `optional,extension`
+}
+
+func (ie *M6Configuration) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *M6Configuration) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.M6reportInterval.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode M6reportInterval", err)
+		return
+	}
+	if err = ie.M6LinksToLog.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode M6LinksToLog", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *M6Configuration) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *M6Configuration) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.M6reportInterval.Decode(r.AperReader); err != nil {
+		err = readError("M6reportInterval", err)
+		return
+	}
+	if err = ie.M6LinksToLog.Decode(r.AperReader); err != nil {
+		err = readError("M6LinksToLog", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *M6Configuration) Validate() error {
+	var v validator
+	v.ie("M6reportInterval", &ie.M6reportInterval)
+	v.ie("M6LinksToLog", &ie.M6LinksToLog)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,37 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+const (
+	M6LinksToLogUplink                aper.Enumerated = 0
+	M6LinksToLogDownlink              aper.Enumerated = 1
+	M6LinksToLogBothuplinkanddownlink aper.Enumerated = 2
+)
+
+type M6LinksToLog struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:2,valueExt"`
+}
+
+func (ie *M6LinksToLog) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 2}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *M6LinksToLog) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 2}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *M6LinksToLog) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 3)
+	return v.err()
+}
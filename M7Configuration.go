@@ -0,0 +1,87 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type M7Configuration struct {
+	M7period     M7period     `mandatory`
+	M7LinksToLog M7LinksToLog `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[M7Configuration] `optional,extension`
+}
+
+func (ie *M7Configuration) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *M7Configuration) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.M7period.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode M7period", err)
+		return
+	}
+	if err = ie.M7LinksToLog.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode M7LinksToLog", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *M7Configuration) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *M7Configuration) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.M7period.Decode(r.AperReader); err != nil {
+		err = readError("M7period", err)
+		return
+	}
+	if err = ie.M7LinksToLog.Decode(r.AperReader); err != nil {
+		err = readError("M7LinksToLog", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *M7Configuration) Validate() error {
+	var v validator
+	v.ie("M7period", &ie.M7period)
+	v.ie("M7LinksToLog", &ie.M7LinksToLog)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
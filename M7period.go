@@ -0,0 +1,31 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type M7period struct {
+	Value aper.Integer `aper:"valueLB:1,valueUB:60,valueExt"`
+}
+
+func (ie *M7period) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteInteger(int64(ie.Value), &aper.Constraint{Lb: 1, Ub: 60}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *M7period) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadInteger(&aper.Constraint{Lb: 1, Ub: 60}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Integer(v)
+	}
+	return nil
+}
+
+func (ie *M7period) Validate() error {
+	var v validator
+	v.integer("Value", int64(ie.Value), 1, 60)
+	return v.err()
+}
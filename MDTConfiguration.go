@@ -0,0 +1,171 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type MDTConfiguration struct {
+	MdtActivation          MDTActivation          `mandatory`
+	MeasurementsToActivate MeasurementsToActivate `mandatory`
+	M2Configuration        *M2Configuration       `optional`
+	M5Configuration        *M5Configuration       `optional`
+	M6Configuration        *M6Configuration       `optional`
+	M7Configuration        *M7Configuration       `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[MDTConfiguration] `optional,extension`
+}
+
+func (ie *MDTConfiguration) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *MDTConfiguration) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.M2Configuration != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if ie.M5Configuration != nil {
+		aper.SetBit(optionals, 2)
+	}
+	if ie.M6Configuration != nil {
+		aper.SetBit(optionals, 3)
+	}
+	if ie.M7Configuration != nil {
+		aper.SetBit(optionals, 4)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 5)
+	}
+	if err = w.WriteBits(optionals, 5); err != nil {
+		return
+	}
+	if err = ie.MdtActivation.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode MdtActivation", err)
+		return
+	}
+	if err = ie.MeasurementsToActivate.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode MeasurementsToActivate", err)
+		return
+	}
+	if ie.M2Configuration != nil {
+		if err = ie.M2Configuration.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode M2Configuration", err)
+			return
+		}
+	}
+	if ie.M5Configuration != nil {
+		if err = ie.M5Configuration.encode(w); err != nil {
+			err = utils.WrapError("Encode M5Configuration", err)
+			return
+		}
+	}
+	if ie.M6Configuration != nil {
+		if err = ie.M6Configuration.encode(w); err != nil {
+			err = utils.WrapError("Encode M6Configuration", err)
+			return
+		}
+	}
+	if ie.M7Configuration != nil {
+		if err = ie.M7Configuration.encode(w); err != nil {
+			err = utils.WrapError("Encode M7Configuration", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *MDTConfiguration) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *MDTConfiguration) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(5); err != nil {
+		return
+	}
+	if err = ie.MdtActivation.Decode(r.AperReader); err != nil {
+		err = readError("MdtActivation", err)
+		return
+	}
+	if err = ie.MeasurementsToActivate.decode(r); err != nil {
+		err = readError("MeasurementsToActivate", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(M2Configuration)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("M2Configuration", err)
+			return
+		}
+		ie.M2Configuration = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		tmp := new(M5Configuration)
+		if err = tmp.decode(r); err != nil {
+			err = readError("M5Configuration", err)
+			return
+		}
+		ie.M5Configuration = tmp
+	}
+	if aper.IsBitSet(optionals, 3) {
+		tmp := new(M6Configuration)
+		if err = tmp.decode(r); err != nil {
+			err = readError("M6Configuration", err)
+			return
+		}
+		ie.M6Configuration = tmp
+	}
+	if aper.IsBitSet(optionals, 4) {
+		tmp := new(M7Configuration)
+		if err = tmp.decode(r); err != nil {
+			err = readError("M7Configuration", err)
+			return
+		}
+		ie.M7Configuration = tmp
+	}
+	if aper.IsBitSet(optionals, 5) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *MDTConfiguration) Validate() error {
+	var v validator
+	v.ie("MdtActivation", &ie.MdtActivation)
+	v.ie("MeasurementsToActivate", &ie.MeasurementsToActivate)
+	if ie.M2Configuration != nil {
+		v.ie("M2Configuration", ie.M2Configuration)
+	}
+	if ie.M5Configuration != nil {
+		v.ie("M5Configuration", ie.M5Configuration)
+	}
+	if ie.M6Configuration != nil {
+		v.ie("M6Configuration", ie.M6Configuration)
+	}
+	if ie.M7Configuration != nil {
+		v.ie("M7Configuration", ie.M7Configuration)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,35 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type MappingInformationIndex struct {
+	Value aper.BitString `aper:"sizeLB:26,sizeUB:26"`
+}
+
+func (ie *MappingInformationIndex) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteBitString(ie.Value.Bytes, uint(ie.Value.NumBits), &aper.Constraint{Lb: 26, Ub: 26}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *MappingInformationIndex) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *MappingInformationIndex) decode(r *decoder) error {
+	if v, n, err := readBitString(r, &aper.Constraint{Lb: 26, Ub: 26}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.BitString{Bytes: v, NumBits: uint64(n)}
+	}
+	return nil
+}
+
+func (ie *MappingInformationIndex) Validate() error {
+	var v validator
+	v.size("Value", int(ie.Value.NumBits), 26, 26)
+	return v.err()
+}
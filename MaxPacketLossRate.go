@@ -0,0 +1,31 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type MaxPacketLossRate struct {
+	Value aper.Integer `aper:"valueLB:0,valueUB:1000"`
+}
+
+func (ie *MaxPacketLossRate) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteInteger(int64(ie.Value), &aper.Constraint{Lb: 0, Ub: 1000}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *MaxPacketLossRate) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadInteger(&aper.Constraint{Lb: 0, Ub: 1000}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Integer(v)
+	}
+	return nil
+}
+
+func (ie *MaxPacketLossRate) Validate() error {
+	var v validator
+	v.integer("Value", int64(ie.Value), 0, 1000)
+	return v.err()
+}
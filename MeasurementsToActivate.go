@@ -0,0 +1,35 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type MeasurementsToActivate struct {
+	Value aper.BitString `aper:"sizeLB:8,sizeUB:8"`
+}
+
+func (ie *MeasurementsToActivate) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteBitString(ie.Value.Bytes, uint(ie.Value.NumBits), &aper.Constraint{Lb: 8, Ub: 8}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *MeasurementsToActivate) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *MeasurementsToActivate) decode(r *decoder) error {
+	if v, n, err := readBitString(r, &aper.Constraint{Lb: 8, Ub: 8}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.BitString{Bytes: v, NumBits: uint64(n)}
+	}
+	return nil
+}
+
+func (ie *MeasurementsToActivate) Validate() error {
+	var v validator
+	v.size("Value", int(ie.Value.NumBits), 8, 8)
+	return v.err()
+}
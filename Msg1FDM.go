@@ -0,0 +1,38 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+const (
+	Msg1FDMOne   aper.Enumerated = 0
+	Msg1FDMTwo   aper.Enumerated = 1
+	Msg1FDMFour  aper.Enumerated = 2
+	Msg1FDMEight aper.Enumerated = 3
+)
+
+type Msg1FDM struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:3,valueExt"`
+}
+
+func (ie *Msg1FDM) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *Msg1FDM) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *Msg1FDM) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 4)
+	return v.err()
+}
@@ -0,0 +1,247 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type NGRANHighAccuracyAccessPointPosition struct {
+	Latitude               int64 `lb:-2147483648,ub:2147483647,mandatory`
+	Longitude              int64 `lb:-2147483648,ub:2147483647,mandatory`
+	Altitude               int64 `lb:-64000,ub:1280000,mandatory`
+	UncertaintySemiMajor   int64 `lb:0,ub:255,mandatory`
+	UncertaintySemiMinor   int64 `lb:0,ub:255,mandatory`
+	OrientationOfMajorAxis int64 `lb:0,ub:179,mandatory`
+	HorizontalConfidence   int64 `lb:0,ub:100,mandatory`
+	UncertaintyAltitude    int64 `lb:0,ub:255,mandatory`
+	VerticalConfidence     int64 `lb:0,ub:100,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[NGRANHighAccuracyAccessPointPosition] `optional,extension`
+}
+
+func (ie *NGRANHighAccuracyAccessPointPosition) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *NGRANHighAccuracyAccessPointPosition) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_Latitude := INTEGER{
+		c:     aper.Constraint{Lb: -2147483648, Ub: 2147483647},
+		ext:   false,
+		Value: aper.Integer(ie.Latitude),
+	}
+	if err = tmp_Latitude.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Latitude", err)
+		return
+	}
+	tmp_Longitude := INTEGER{
+		c:     aper.Constraint{Lb: -2147483648, Ub: 2147483647},
+		ext:   false,
+		Value: aper.Integer(ie.Longitude),
+	}
+	if err = tmp_Longitude.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Longitude", err)
+		return
+	}
+	tmp_Altitude := INTEGER{
+		c:     aper.Constraint{Lb: -64000, Ub: 1280000},
+		ext:   false,
+		Value: aper.Integer(ie.Altitude),
+	}
+	if err = tmp_Altitude.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Altitude", err)
+		return
+	}
+	tmp_UncertaintySemiMajor := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 255},
+		ext:   false,
+		Value: aper.Integer(ie.UncertaintySemiMajor),
+	}
+	if err = tmp_UncertaintySemiMajor.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode UncertaintySemiMajor", err)
+		return
+	}
+	tmp_UncertaintySemiMinor := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 255},
+		ext:   false,
+		Value: aper.Integer(ie.UncertaintySemiMinor),
+	}
+	if err = tmp_UncertaintySemiMinor.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode UncertaintySemiMinor", err)
+		return
+	}
+	tmp_OrientationOfMajorAxis := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 179},
+		ext:   false,
+		Value: aper.Integer(ie.OrientationOfMajorAxis),
+	}
+	if err = tmp_OrientationOfMajorAxis.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode OrientationOfMajorAxis", err)
+		return
+	}
+	tmp_HorizontalConfidence := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 100},
+		ext:   false,
+		Value: aper.Integer(ie.HorizontalConfidence),
+	}
+	if err = tmp_HorizontalConfidence.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode HorizontalConfidence", err)
+		return
+	}
+	tmp_UncertaintyAltitude := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 255},
+		ext:   false,
+		Value: aper.Integer(ie.UncertaintyAltitude),
+	}
+	if err = tmp_UncertaintyAltitude.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode UncertaintyAltitude", err)
+		return
+	}
+	tmp_VerticalConfidence := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 100},
+		ext:   false,
+		Value: aper.Integer(ie.VerticalConfidence),
+	}
+	if err = tmp_VerticalConfidence.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode VerticalConfidence", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *NGRANHighAccuracyAccessPointPosition) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *NGRANHighAccuracyAccessPointPosition) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	tmp_Latitude := INTEGER{
+		c:   aper.Constraint{Lb: -2147483648, Ub: 2147483647},
+		ext: false,
+	}
+	if err = tmp_Latitude.Decode(r.AperReader); err != nil {
+		err = readError("Latitude", err)
+		return
+	}
+	ie.Latitude = int64(tmp_Latitude.Value)
+	tmp_Longitude := INTEGER{
+		c:   aper.Constraint{Lb: -2147483648, Ub: 2147483647},
+		ext: false,
+	}
+	if err = tmp_Longitude.Decode(r.AperReader); err != nil {
+		err = readError("Longitude", err)
+		return
+	}
+	ie.Longitude = int64(tmp_Longitude.Value)
+	tmp_Altitude := INTEGER{
+		c:   aper.Constraint{Lb: -64000, Ub: 1280000},
+		ext: false,
+	}
+	if err = tmp_Altitude.Decode(r.AperReader); err != nil {
+		err = readError("Altitude", err)
+		return
+	}
+	ie.Altitude = int64(tmp_Altitude.Value)
+	tmp_UncertaintySemiMajor := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 255},
+		ext: false,
+	}
+	if err = tmp_UncertaintySemiMajor.Decode(r.AperReader); err != nil {
+		err = readError("UncertaintySemiMajor", err)
+		return
+	}
+	ie.UncertaintySemiMajor = int64(tmp_UncertaintySemiMajor.Value)
+	tmp_UncertaintySemiMinor := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 255},
+		ext: false,
+	}
+	if err = tmp_UncertaintySemiMinor.Decode(r.AperReader); err != nil {
+		err = readError("UncertaintySemiMinor", err)
+		return
+	}
+	ie.UncertaintySemiMinor = int64(tmp_UncertaintySemiMinor.Value)
+	tmp_OrientationOfMajorAxis := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 179},
+		ext: false,
+	}
+	if err = tmp_OrientationOfMajorAxis.Decode(r.AperReader); err != nil {
+		err = readError("OrientationOfMajorAxis", err)
+		return
+	}
+	ie.OrientationOfMajorAxis = int64(tmp_OrientationOfMajorAxis.Value)
+	tmp_HorizontalConfidence := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 100},
+		ext: false,
+	}
+	if err = tmp_HorizontalConfidence.Decode(r.AperReader); err != nil {
+		err = readError("HorizontalConfidence", err)
+		return
+	}
+	ie.HorizontalConfidence = int64(tmp_HorizontalConfidence.Value)
+	tmp_UncertaintyAltitude := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 255},
+		ext: false,
+	}
+	if err = tmp_UncertaintyAltitude.Decode(r.AperReader); err != nil {
+		err = readError("UncertaintyAltitude", err)
+		return
+	}
+	ie.UncertaintyAltitude = int64(tmp_UncertaintyAltitude.Value)
+	tmp_VerticalConfidence := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 100},
+		ext: false,
+	}
+	if err = tmp_VerticalConfidence.Decode(r.AperReader); err != nil {
+		err = readError("VerticalConfidence", err)
+		return
+	}
+	ie.VerticalConfidence = int64(tmp_VerticalConfidence.Value)
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *NGRANHighAccuracyAccessPointPosition) Validate() error {
+	var v validator
+	v.integer("Latitude", ie.Latitude, -2147483648, 2147483647)
+	v.integer("Longitude", ie.Longitude, -2147483648, 2147483647)
+	v.integer("Altitude", ie.Altitude, -64000, 1280000)
+	v.integer("UncertaintySemiMajor", ie.UncertaintySemiMajor, 0, 255)
+	v.integer("UncertaintySemiMinor", ie.UncertaintySemiMinor, 0, 255)
+	v.integer("OrientationOfMajorAxis", ie.OrientationOfMajorAxis, 0, 179)
+	v.integer("HorizontalConfidence", ie.HorizontalConfidence, 0, 100)
+	v.integer("UncertaintyAltitude", ie.UncertaintyAltitude, 0, 255)
+	v.integer("VerticalConfidence", ie.VerticalConfidence, 0, 100)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,35 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type NID struct {
+	Value aper.BitString `aper:"sizeLB:44,sizeUB:44"`
+}
+
+func (ie *NID) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteBitString(ie.Value.Bytes, uint(ie.Value.NumBits), &aper.Constraint{Lb: 44, Ub: 44}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *NID) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *NID) decode(r *decoder) error {
+	if v, n, err := readBitString(r, &aper.Constraint{Lb: 44, Ub: 44}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.BitString{Bytes: v, NumBits: uint64(n)}
+	}
+	return nil
+}
+
+func (ie *NID) Validate() error {
+	var v validator
+	v.size("Value", int(ie.Value.NumBits), 44, 44)
+	return v.err()
+}
@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	NPNBroadcastInformationPresentNothing uint64 = iota
+	NPNBroadcastInformationPresentSNPNBroadcastInformation
+	NPNBroadcastInformationPresentPNINPNBroadcastInformation
+	NPNBroadcastInformationPresentChoiceExtension
+)
+
+type NPNBroadcastInformation struct {
+	Choice                     uint64
+	SNPNBroadcastInformation   *NPNBroadcastInformationSNPN
+	PNINPNBroadcastInformation *NPNBroadcastInformationPNINPN
+	ChoiceExtension            *RawIE
+}
+
+func (ie *NPNBroadcastInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *NPNBroadcastInformation) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case NPNBroadcastInformationPresentSNPNBroadcastInformation:
+		if err = ie.SNPNBroadcastInformation.encode(w); err != nil {
+			err = utils.WrapError("Encode SNPNBroadcastInformation", err)
+			return
+		}
+	case NPNBroadcastInformationPresentPNINPNBroadcastInformation:
+		if err = ie.PNINPNBroadcastInformation.encode(w); err != nil {
+			err = utils.WrapError("Encode PNINPNBroadcastInformation", err)
+			return
+		}
+	case NPNBroadcastInformationPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *NPNBroadcastInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *NPNBroadcastInformation) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case NPNBroadcastInformationPresentSNPNBroadcastInformation:
+		tmp := new(NPNBroadcastInformationSNPN)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SNPNBroadcastInformation", err)
+			return
+		}
+		ie.SNPNBroadcastInformation = tmp
+	case NPNBroadcastInformationPresentPNINPNBroadcastInformation:
+		tmp := new(NPNBroadcastInformationPNINPN)
+		if err = tmp.decode(r); err != nil {
+			err = readError("PNINPNBroadcastInformation", err)
+			return
+		}
+		ie.PNINPNBroadcastInformation = tmp
+	case NPNBroadcastInformationPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *NPNBroadcastInformation) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case NPNBroadcastInformationPresentSNPNBroadcastInformation:
+		if ie.SNPNBroadcastInformation == nil {
+			v.absent("SNPNBroadcastInformation")
+		} else {
+			v.ie("SNPNBroadcastInformation", ie.SNPNBroadcastInformation)
+		}
+	case NPNBroadcastInformationPresentPNINPNBroadcastInformation:
+		if ie.PNINPNBroadcastInformation == nil {
+			v.absent("PNINPNBroadcastInformation")
+		} else {
+			v.ie("PNINPNBroadcastInformation", ie.PNINPNBroadcastInformation)
+		}
+	case NPNBroadcastInformationPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
@@ -0,0 +1,92 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type NPNBroadcastInformationPNINPN struct {
+	BroadcastPNINPNIDInformation []BroadcastPNINPNIDListItem `lb:1,ub:maxnoofBPLMNsNR,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[NPNBroadcastInformationPNINPN] `optional,extension`
+}
+
+func (ie *NPNBroadcastInformationPNINPN) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *NPNBroadcastInformationPNINPN) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_BroadcastPNINPNIDInformation := sequenceOf[*BroadcastPNINPNIDListItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofBPLMNsNR},
+		ext: false,
+	}
+	for k := range ie.BroadcastPNINPNIDInformation {
+		tmp_BroadcastPNINPNIDInformation.Value = append(tmp_BroadcastPNINPNIDInformation.Value, &ie.BroadcastPNINPNIDInformation[k])
+	}
+	if err = tmp_BroadcastPNINPNIDInformation.encode(w); err != nil {
+		err = utils.WrapError("Encode BroadcastPNINPNIDInformation", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *NPNBroadcastInformationPNINPN) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *NPNBroadcastInformationPNINPN) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	var tmp_BroadcastPNINPNIDInformation []*BroadcastPNINPNIDListItem
+	if tmp_BroadcastPNINPNIDInformation, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofBPLMNsNR}, false, func() *BroadcastPNINPNIDListItem { return new(BroadcastPNINPNIDListItem) }); err != nil {
+		err = readError("BroadcastPNINPNIDInformation", err)
+		return
+	}
+	ie.BroadcastPNINPNIDInformation = make([]BroadcastPNINPNIDListItem, len(tmp_BroadcastPNINPNIDInformation))
+	for k, i := range tmp_BroadcastPNINPNIDInformation {
+		ie.BroadcastPNINPNIDInformation[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *NPNBroadcastInformationPNINPN) Validate() error {
+	var v validator
+	v.size("BroadcastPNINPNIDInformation", len(ie.BroadcastPNINPNIDInformation), 1, maxnoofBPLMNsNR)
+	for i := range ie.BroadcastPNINPNIDInformation {
+		v.item("BroadcastPNINPNIDInformation", i, &ie.BroadcastPNINPNIDInformation[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,92 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type NPNBroadcastInformationSNPN struct {
+	BroadcastSNPNIDList []BroadcastSNPNIDListItem `lb:1,ub:maxnoofBPLMNsNR,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[NPNBroadcastInformationSNPN] `optional,extension`
+}
+
+func (ie *NPNBroadcastInformationSNPN) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *NPNBroadcastInformationSNPN) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_BroadcastSNPNIDList := sequenceOf[*BroadcastSNPNIDListItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofBPLMNsNR},
+		ext: false,
+	}
+	for k := range ie.BroadcastSNPNIDList {
+		tmp_BroadcastSNPNIDList.Value = append(tmp_BroadcastSNPNIDList.Value, &ie.BroadcastSNPNIDList[k])
+	}
+	if err = tmp_BroadcastSNPNIDList.encode(w); err != nil {
+		err = utils.WrapError("Encode BroadcastSNPNIDList", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *NPNBroadcastInformationSNPN) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *NPNBroadcastInformationSNPN) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	var tmp_BroadcastSNPNIDList []*BroadcastSNPNIDListItem
+	if tmp_BroadcastSNPNIDList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofBPLMNsNR}, false, func() *BroadcastSNPNIDListItem { return new(BroadcastSNPNIDListItem) }); err != nil {
+		err = readError("BroadcastSNPNIDList", err)
+		return
+	}
+	ie.BroadcastSNPNIDList = make([]BroadcastSNPNIDListItem, len(tmp_BroadcastSNPNIDList))
+	for k, i := range tmp_BroadcastSNPNIDList {
+		ie.BroadcastSNPNIDList[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *NPNBroadcastInformationSNPN) Validate() error {
+	var v validator
+	v.size("BroadcastSNPNIDList", len(ie.BroadcastSNPNIDList), 1, maxnoofBPLMNsNR)
+	for i := range ie.BroadcastSNPNIDList {
+		v.item("BroadcastSNPNIDList", i, &ie.BroadcastSNPNIDList[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
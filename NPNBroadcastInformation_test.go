@@ -0,0 +1,68 @@
+package ies
+
+import (
+	"testing"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+func TestNPNInformationWire(t *testing.T) {
+	plmn := PLMNIdentity{Value: aper.OctetString{0x00, 0xf1, 0x10}}
+	nid := NID{Value: aper.BitString{Bytes: []byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xb0}, NumBits: 44}}
+	tests := []struct {
+		name string
+		in   testIE
+		out  testIE
+		want []byte
+	}{{
+		name: "SNPN broadcast",
+		in: &NPNBroadcastInformation{
+			Choice: NPNBroadcastInformationPresentSNPNBroadcastInformation,
+			SNPNBroadcastInformation: &NPNBroadcastInformationSNPN{
+				BroadcastSNPNIDList: []BroadcastSNPNIDListItem{{
+					PLMNIdentity:     plmn,
+					BroadcastNIDList: []BroadcastNIDListItem{{NID: nid}},
+				}},
+			},
+		},
+		out: new(NPNBroadcastInformation),
+		want: []byte{
+			0x00, 0x00, // sNPN, 1 SNPN
+			0x00, 0xf1, 0x10, // PLMN identity
+			0x00,                               // 1 NID
+			0x12, 0x34, 0x56, 0x78, 0x9a, 0xb0, // NID
+		},
+	}, {
+		name: "PNI-NPN broadcast",
+		in: &NPNBroadcastInformation{
+			Choice: NPNBroadcastInformationPresentPNINPNBroadcastInformation,
+			PNINPNBroadcastInformation: &NPNBroadcastInformationPNINPN{
+				BroadcastPNINPNIDInformation: []BroadcastPNINPNIDListItem{{
+					PLMNIdentity: plmn,
+					BroadcastCAGList: []CAGID{
+						{Value: aper.BitString{Bytes: []byte{0xde, 0xad, 0xbe, 0xef}, NumBits: 32}},
+						{Value: aper.BitString{Bytes: []byte{0xca, 0xfe, 0x00, 0x01}, NumBits: 32}},
+					},
+				}},
+			},
+		},
+		out: new(NPNBroadcastInformation),
+		want: []byte{
+			0x40, 0x00, // pNI-NPN, 1 PLMN
+			0x00, 0xf1, 0x10, // PLMN identity
+			0x10,                   // 2 CAGs
+			0xde, 0xad, 0xbe, 0xef, // CAG ID
+			0xca, 0xfe, 0x00, 0x01, // CAG ID
+		},
+	}, {
+		name: "SNPN support",
+		in:   &NPNSupportInfo{Choice: NPNSupportInfoPresentSNPNInformation, SNPNInformation: &nid},
+		out:  new(NPNSupportInfo),
+		want: []byte{0x00, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xb0},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkIE(t, tt.in, tt.out, tt.want)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	NPNSupportInfoPresentNothing uint64 = iota
+	NPNSupportInfoPresentSNPNInformation
+	NPNSupportInfoPresentChoiceExtension
+)
+
+type NPNSupportInfo struct {
+	Choice          uint64
+	SNPNInformation *NID
+	ChoiceExtension *RawIE
+}
+
+func (ie *NPNSupportInfo) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *NPNSupportInfo) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 1, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case NPNSupportInfoPresentSNPNInformation:
+		if err = ie.SNPNInformation.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode SNPNInformation", err)
+			return
+		}
+	case NPNSupportInfoPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *NPNSupportInfo) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *NPNSupportInfo) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(1, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case NPNSupportInfoPresentSNPNInformation:
+		tmp := new(NID)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SNPNInformation", err)
+			return
+		}
+		ie.SNPNInformation = tmp
+	case NPNSupportInfoPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *NPNSupportInfo) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case NPNSupportInfoPresentSNPNInformation:
+		if ie.SNPNInformation == nil {
+			v.absent("SNPNInformation")
+		} else {
+			v.ie("SNPNInformation", ie.SNPNInformation)
+		}
+	case NPNSupportInfoPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
@@ -0,0 +1,117 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type NRCarrierItem struct {
+	CarrierSCS       NRSCS `mandatory`
+	OffsetToCarrier  int64 `lb:0,ub:2199,valueExt,mandatory`
+	CarrierBandwidth int64 `lb:0,ub:maxnoofPhysicalResourceBlocks,valueExt,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[NRCarrierItem] `optional,extension`
+}
+
+func (ie *NRCarrierItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *NRCarrierItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.CarrierSCS.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode CarrierSCS", err)
+		return
+	}
+	tmp_OffsetToCarrier := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 2199},
+		ext:   true,
+		Value: aper.Integer(ie.OffsetToCarrier),
+	}
+	if err = tmp_OffsetToCarrier.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode OffsetToCarrier", err)
+		return
+	}
+	tmp_CarrierBandwidth := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: maxnoofPhysicalResourceBlocks},
+		ext:   true,
+		Value: aper.Integer(ie.CarrierBandwidth),
+	}
+	if err = tmp_CarrierBandwidth.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode CarrierBandwidth", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *NRCarrierItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *NRCarrierItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.CarrierSCS.Decode(r.AperReader); err != nil {
+		err = readError("CarrierSCS", err)
+		return
+	}
+	tmp_OffsetToCarrier := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 2199},
+		ext: true,
+	}
+	if err = tmp_OffsetToCarrier.Decode(r.AperReader); err != nil {
+		err = readError("OffsetToCarrier", err)
+		return
+	}
+	ie.OffsetToCarrier = int64(tmp_OffsetToCarrier.Value)
+	tmp_CarrierBandwidth := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: maxnoofPhysicalResourceBlocks},
+		ext: true,
+	}
+	if err = tmp_CarrierBandwidth.Decode(r.AperReader); err != nil {
+		err = readError("CarrierBandwidth", err)
+		return
+	}
+	ie.CarrierBandwidth = int64(tmp_CarrierBandwidth.Value)
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *NRCarrierItem) Validate() error {
+	var v validator
+	v.ie("CarrierSCS", &ie.CarrierSCS)
+	v.integer("OffsetToCarrier", ie.OffsetToCarrier, 0, 2199)
+	v.integer("CarrierBandwidth", ie.CarrierBandwidth, 0, maxnoofPhysicalResourceBlocks)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
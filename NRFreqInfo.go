@@ -0,0 +1,164 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type NRFreqInfo struct {
+	NRARFCN        int64            `lb:0,ub:maxNRARFCN,mandatory`
+	SulInformation *SULInformation  `optional`
+	FreqBandListNr []FreqBandNrItem `lb:1,ub:maxnoofNrCellBands,mandatory`
+	// IEExtensions
+	FrequencyShift7p5khz *FrequencyShift7p5khz                  `optional,ignore,extension`
+	Extensions           ProtocolExtensionContainer[NRFreqInfo] `optional,extension`
+}
+
+func (ie *NRFreqInfo) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *NRFreqInfo) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(ie.extensions())
+	optionals := []byte{0x0}
+	if ie.SulInformation != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	tmp_NRARFCN := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: maxNRARFCN},
+		ext:   false,
+		Value: aper.Integer(ie.NRARFCN),
+	}
+	if err = tmp_NRARFCN.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode NRARFCN", err)
+		return
+	}
+	if ie.SulInformation != nil {
+		if err = ie.SulInformation.encode(w); err != nil {
+			err = utils.WrapError("Encode SulInformation", err)
+			return
+		}
+	}
+	tmp_FreqBandListNr := sequenceOf[*FreqBandNrItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofNrCellBands},
+		ext: false,
+	}
+	for k := range ie.FreqBandListNr {
+		tmp_FreqBandListNr.Value = append(tmp_FreqBandListNr.Value, &ie.FreqBandListNr[k])
+	}
+	if err = tmp_FreqBandListNr.encode(w); err != nil {
+		err = utils.WrapError("Encode FreqBandListNr", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *NRFreqInfo) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *NRFreqInfo) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	tmp_NRARFCN := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: maxNRARFCN},
+		ext: false,
+	}
+	if err = tmp_NRARFCN.Decode(r.AperReader); err != nil {
+		err = readError("NRARFCN", err)
+		return
+	}
+	ie.NRARFCN = int64(tmp_NRARFCN.Value)
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(SULInformation)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SulInformation", err)
+			return
+		}
+		ie.SulInformation = tmp
+	}
+	var tmp_FreqBandListNr []*FreqBandNrItem
+	if tmp_FreqBandListNr, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofNrCellBands}, false, func() *FreqBandNrItem { return new(FreqBandNrItem) }); err != nil {
+		err = readError("FreqBandListNr", err)
+		return
+	}
+	ie.FreqBandListNr = make([]FreqBandNrItem, len(tmp_FreqBandListNr))
+	for k, i := range tmp_FreqBandListNr {
+		ie.FreqBandListNr[k] = *i
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, ie.decodeExtension); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *NRFreqInfo) extensions() (exts []F1apMessageIE) {
+	if ie.FrequencyShift7p5khz != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_FrequencyShift7p5khz},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.FrequencyShift7p5khz,
+		})
+	}
+	return
+}
+
+func (ie *NRFreqInfo) decodeExtension(id aper.Integer, r *decoder) (ok bool, err error) {
+	ok = true
+	switch id {
+	case ProtocolIEID_FrequencyShift7p5khz:
+		tmp := new(FrequencyShift7p5khz)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("FrequencyShift7p5khz", err)
+			return
+		}
+		ie.FrequencyShift7p5khz = tmp
+	default:
+		ok = false
+	}
+	return
+}
+
+func (ie *NRFreqInfo) Validate() error {
+	var v validator
+	v.integer("NRARFCN", ie.NRARFCN, 0, maxNRARFCN)
+	if ie.SulInformation != nil {
+		v.ie("SulInformation", ie.SulInformation)
+	}
+	v.size("FreqBandListNr", len(ie.FreqBandListNr), 1, maxnoofNrCellBands)
+	for i := range ie.FreqBandListNr {
+		v.item("FreqBandListNr", i, &ie.FreqBandListNr[i])
+	}
+	if ie.FrequencyShift7p5khz != nil {
+		v.ie("FrequencyShift7p5khz", ie.FrequencyShift7p5khz)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
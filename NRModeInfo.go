@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	NRModeInfoPresentNothing uint64 = iota
+	NRModeInfoPresentFDD
+	NRModeInfoPresentTDD
+	NRModeInfoPresentChoiceExtension
+)
+
+type NRModeInfo struct {
+	Choice          uint64
+	FDD             *FDDInfo
+	TDD             *TDDInfo
+	ChoiceExtension *RawIE
+}
+
+func (ie *NRModeInfo) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *NRModeInfo) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case NRModeInfoPresentFDD:
+		if err = ie.FDD.encode(w); err != nil {
+			err = utils.WrapError("Encode FDD", err)
+			return
+		}
+	case NRModeInfoPresentTDD:
+		if err = ie.TDD.encode(w); err != nil {
+			err = utils.WrapError("Encode TDD", err)
+			return
+		}
+	case NRModeInfoPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *NRModeInfo) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *NRModeInfo) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case NRModeInfoPresentFDD:
+		tmp := new(FDDInfo)
+		if err = tmp.decode(r); err != nil {
+			err = readError("FDD", err)
+			return
+		}
+		ie.FDD = tmp
+	case NRModeInfoPresentTDD:
+		tmp := new(TDDInfo)
+		if err = tmp.decode(r); err != nil {
+			err = readError("TDD", err)
+			return
+		}
+		ie.TDD = tmp
+	case NRModeInfoPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *NRModeInfo) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case NRModeInfoPresentFDD:
+		if ie.FDD == nil {
+			v.absent("FDD")
+		} else {
+			v.ie("FDD", ie.FDD)
+		}
+	case NRModeInfoPresentTDD:
+		if ie.TDD == nil {
+			v.absent("TDD")
+		} else {
+			v.ie("TDD", ie.TDD)
+		}
+	case NRModeInfoPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
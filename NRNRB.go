@@ -0,0 +1,63 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+const (
+	NRNRBNrb11  aper.Enumerated = 0
+	NRNRBNrb18  aper.Enumerated = 1
+	NRNRBNrb24  aper.Enumerated = 2
+	NRNRBNrb25  aper.Enumerated = 3
+	NRNRBNrb31  aper.Enumerated = 4
+	NRNRBNrb32  aper.Enumerated = 5
+	NRNRBNrb38  aper.Enumerated = 6
+	NRNRBNrb51  aper.Enumerated = 7
+	NRNRBNrb52  aper.Enumerated = 8
+	NRNRBNrb65  aper.Enumerated = 9
+	NRNRBNrb66  aper.Enumerated = 10
+	NRNRBNrb78  aper.Enumerated = 11
+	NRNRBNrb79  aper.Enumerated = 12
+	NRNRBNrb93  aper.Enumerated = 13
+	NRNRBNrb106 aper.Enumerated = 14
+	NRNRBNrb107 aper.Enumerated = 15
+	NRNRBNrb121 aper.Enumerated = 16
+	NRNRBNrb132 aper.Enumerated = 17
+	NRNRBNrb133 aper.Enumerated = 18
+	NRNRBNrb135 aper.Enumerated = 19
+	NRNRBNrb160 aper.Enumerated = 20
+	NRNRBNrb162 aper.Enumerated = 21
+	NRNRBNrb189 aper.Enumerated = 22
+	NRNRBNrb216 aper.Enumerated = 23
+	NRNRBNrb217 aper.Enumerated = 24
+	NRNRBNrb245 aper.Enumerated = 25
+	NRNRBNrb264 aper.Enumerated = 26
+	NRNRBNrb270 aper.Enumerated = 27
+	NRNRBNrb273 aper.Enumerated = 28
+)
+
+type NRNRB struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:28,valueExt"`
+}
+
+func (ie *NRNRB) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 28}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *NRNRB) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 28}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *NRNRB) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 29)
+	return v.err()
+}
@@ -0,0 +1,135 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type NRPRACHConfig struct {
+	UlPRACHConfigList  []NRPRACHConfigItem `lb:0,ub:maxnoofPRACHconfigs,optional`
+	SulPRACHConfigList []NRPRACHConfigItem `lb:0,ub:maxnoofPRACHconfigs,optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[NRPRACHConfig] `optional,extension`
+}
+
+func (ie *NRPRACHConfig) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *NRPRACHConfig) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(ie.UlPRACHConfigList) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if len(ie.SulPRACHConfigList) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 3)
+	}
+	if err = w.WriteBits(optionals, 3); err != nil {
+		return
+	}
+	if len(ie.UlPRACHConfigList) > 0 {
+		tmp_UlPRACHConfigList := sequenceOf[*NRPRACHConfigItem]{
+			c:   aper.Constraint{Lb: 0, Ub: maxnoofPRACHconfigs},
+			ext: false,
+		}
+		for k := range ie.UlPRACHConfigList {
+			tmp_UlPRACHConfigList.Value = append(tmp_UlPRACHConfigList.Value, &ie.UlPRACHConfigList[k])
+		}
+		if err = tmp_UlPRACHConfigList.encode(w); err != nil {
+			err = utils.WrapError("Encode UlPRACHConfigList", err)
+			return
+		}
+	}
+	if len(ie.SulPRACHConfigList) > 0 {
+		tmp_SulPRACHConfigList := sequenceOf[*NRPRACHConfigItem]{
+			c:   aper.Constraint{Lb: 0, Ub: maxnoofPRACHconfigs},
+			ext: false,
+		}
+		for k := range ie.SulPRACHConfigList {
+			tmp_SulPRACHConfigList.Value = append(tmp_SulPRACHConfigList.Value, &ie.SulPRACHConfigList[k])
+		}
+		if err = tmp_SulPRACHConfigList.encode(w); err != nil {
+			err = utils.WrapError("Encode SulPRACHConfigList", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *NRPRACHConfig) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *NRPRACHConfig) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(3); err != nil {
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		var tmp_UlPRACHConfigList []*NRPRACHConfigItem
+		if tmp_UlPRACHConfigList, err = readList(r, aper.Constraint{Lb: 0, Ub: maxnoofPRACHconfigs}, false, func() *NRPRACHConfigItem { return new(NRPRACHConfigItem) }); err != nil {
+			err = readError("UlPRACHConfigList", err)
+			return
+		}
+		ie.UlPRACHConfigList = make([]NRPRACHConfigItem, len(tmp_UlPRACHConfigList))
+		for k, i := range tmp_UlPRACHConfigList {
+			ie.UlPRACHConfigList[k] = *i
+		}
+	}
+	if aper.IsBitSet(optionals, 2) {
+		var tmp_SulPRACHConfigList []*NRPRACHConfigItem
+		if tmp_SulPRACHConfigList, err = readList(r, aper.Constraint{Lb: 0, Ub: maxnoofPRACHconfigs}, false, func() *NRPRACHConfigItem { return new(NRPRACHConfigItem) }); err != nil {
+			err = readError("SulPRACHConfigList", err)
+			return
+		}
+		ie.SulPRACHConfigList = make([]NRPRACHConfigItem, len(tmp_SulPRACHConfigList))
+		for k, i := range tmp_SulPRACHConfigList {
+			ie.SulPRACHConfigList[k] = *i
+		}
+	}
+	if aper.IsBitSet(optionals, 3) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *NRPRACHConfig) Validate() error {
+	var v validator
+	if len(ie.UlPRACHConfigList) > 0 {
+		v.size("UlPRACHConfigList", len(ie.UlPRACHConfigList), 0, maxnoofPRACHconfigs)
+		for i := range ie.UlPRACHConfigList {
+			v.item("UlPRACHConfigList", i, &ie.UlPRACHConfigList[i])
+		}
+	}
+	if len(ie.SulPRACHConfigList) > 0 {
+		v.size("SulPRACHConfigList", len(ie.SulPRACHConfigList), 0, maxnoofPRACHconfigs)
+		for i := range ie.SulPRACHConfigList {
+			v.item("SulPRACHConfigList", i, &ie.SulPRACHConfigList[i])
+		}
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,167 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type NRPRACHConfigItem struct {
+	NRSCS                     NRSCS              `mandatory`
+	PrachFreqStartfromCarrier int64              `lb:0,ub:274,valueExt,mandatory`
+	Msg1FDM                   Msg1FDM            `mandatory`
+	ParchConfigIndex          int64              `lb:0,ub:255,valueExt,mandatory`
+	SsbPerRACHOccasion        SSBPerRACHOccasion `mandatory`
+	FreqDomainLength          FreqDomainLength   `mandatory`
+	ZeroCorrelZoneConfig      int64              `lb:0,ub:15,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[NRPRACHConfigItem] `optional,extension`
+}
+
+func (ie *NRPRACHConfigItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *NRPRACHConfigItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.NRSCS.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode NRSCS", err)
+		return
+	}
+	tmp_PrachFreqStartfromCarrier := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 274},
+		ext:   true,
+		Value: aper.Integer(ie.PrachFreqStartfromCarrier),
+	}
+	if err = tmp_PrachFreqStartfromCarrier.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PrachFreqStartfromCarrier", err)
+		return
+	}
+	if err = ie.Msg1FDM.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Msg1FDM", err)
+		return
+	}
+	tmp_ParchConfigIndex := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 255},
+		ext:   true,
+		Value: aper.Integer(ie.ParchConfigIndex),
+	}
+	if err = tmp_ParchConfigIndex.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode ParchConfigIndex", err)
+		return
+	}
+	if err = ie.SsbPerRACHOccasion.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SsbPerRACHOccasion", err)
+		return
+	}
+	if err = ie.FreqDomainLength.encode(w); err != nil {
+		err = utils.WrapError("Encode FreqDomainLength", err)
+		return
+	}
+	tmp_ZeroCorrelZoneConfig := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 15},
+		ext:   false,
+		Value: aper.Integer(ie.ZeroCorrelZoneConfig),
+	}
+	if err = tmp_ZeroCorrelZoneConfig.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode ZeroCorrelZoneConfig", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *NRPRACHConfigItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *NRPRACHConfigItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.NRSCS.Decode(r.AperReader); err != nil {
+		err = readError("NRSCS", err)
+		return
+	}
+	tmp_PrachFreqStartfromCarrier := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 274},
+		ext: true,
+	}
+	if err = tmp_PrachFreqStartfromCarrier.Decode(r.AperReader); err != nil {
+		err = readError("PrachFreqStartfromCarrier", err)
+		return
+	}
+	ie.PrachFreqStartfromCarrier = int64(tmp_PrachFreqStartfromCarrier.Value)
+	if err = ie.Msg1FDM.Decode(r.AperReader); err != nil {
+		err = readError("Msg1FDM", err)
+		return
+	}
+	tmp_ParchConfigIndex := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 255},
+		ext: true,
+	}
+	if err = tmp_ParchConfigIndex.Decode(r.AperReader); err != nil {
+		err = readError("ParchConfigIndex", err)
+		return
+	}
+	ie.ParchConfigIndex = int64(tmp_ParchConfigIndex.Value)
+	if err = ie.SsbPerRACHOccasion.Decode(r.AperReader); err != nil {
+		err = readError("SsbPerRACHOccasion", err)
+		return
+	}
+	if err = ie.FreqDomainLength.decode(r); err != nil {
+		err = readError("FreqDomainLength", err)
+		return
+	}
+	tmp_ZeroCorrelZoneConfig := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 15},
+		ext: false,
+	}
+	if err = tmp_ZeroCorrelZoneConfig.Decode(r.AperReader); err != nil {
+		err = readError("ZeroCorrelZoneConfig", err)
+		return
+	}
+	ie.ZeroCorrelZoneConfig = int64(tmp_ZeroCorrelZoneConfig.Value)
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *NRPRACHConfigItem) Validate() error {
+	var v validator
+	v.ie("NRSCS", &ie.NRSCS)
+	v.integer("PrachFreqStartfromCarrier", ie.PrachFreqStartfromCarrier, 0, 274)
+	v.ie("Msg1FDM", &ie.Msg1FDM)
+	v.integer("ParchConfigIndex", ie.ParchConfigIndex, 0, 255)
+	v.ie("SsbPerRACHOccasion", &ie.SsbPerRACHOccasion)
+	v.ie("FreqDomainLength", &ie.FreqDomainLength)
+	v.integer("ZeroCorrelZoneConfig", ie.ZeroCorrelZoneConfig, 0, 15)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,126 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type NRPRSBeamInformation struct {
+	NRPRSBeamInformationList []NRPRSBeamInformationItem `lb:1,ub:maxnoofPRSresourceSets,mandatory`
+	LCStoGCSTranslationList  []LCStoGCSTranslation      `lb:1,ub:maxnooflcsgcstranslation,optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[NRPRSBeamInformation] `optional,extension`
+}
+
+func (ie *NRPRSBeamInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *NRPRSBeamInformation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(ie.LCStoGCSTranslationList) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	tmp_NRPRSBeamInformationList := sequenceOf[*NRPRSBeamInformationItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofPRSresourceSets},
+		ext: false,
+	}
+	for k := range ie.NRPRSBeamInformationList {
+		tmp_NRPRSBeamInformationList.Value = append(tmp_NRPRSBeamInformationList.Value, &ie.NRPRSBeamInformationList[k])
+	}
+	if err = tmp_NRPRSBeamInformationList.encode(w); err != nil {
+		err = utils.WrapError("Encode NRPRSBeamInformationList", err)
+		return
+	}
+	if len(ie.LCStoGCSTranslationList) > 0 {
+		tmp_LCStoGCSTranslationList := sequenceOf[*LCStoGCSTranslation]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnooflcsgcstranslation},
+			ext: false,
+		}
+		for k := range ie.LCStoGCSTranslationList {
+			tmp_LCStoGCSTranslationList.Value = append(tmp_LCStoGCSTranslationList.Value, &ie.LCStoGCSTranslationList[k])
+		}
+		if err = tmp_LCStoGCSTranslationList.encode(w); err != nil {
+			err = utils.WrapError("Encode LCStoGCSTranslationList", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *NRPRSBeamInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *NRPRSBeamInformation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	var tmp_NRPRSBeamInformationList []*NRPRSBeamInformationItem
+	if tmp_NRPRSBeamInformationList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofPRSresourceSets}, false, func() *NRPRSBeamInformationItem { return new(NRPRSBeamInformationItem) }); err != nil {
+		err = readError("NRPRSBeamInformationList", err)
+		return
+	}
+	ie.NRPRSBeamInformationList = make([]NRPRSBeamInformationItem, len(tmp_NRPRSBeamInformationList))
+	for k, i := range tmp_NRPRSBeamInformationList {
+		ie.NRPRSBeamInformationList[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		var tmp_LCStoGCSTranslationList []*LCStoGCSTranslation
+		if tmp_LCStoGCSTranslationList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnooflcsgcstranslation}, false, func() *LCStoGCSTranslation { return new(LCStoGCSTranslation) }); err != nil {
+			err = readError("LCStoGCSTranslationList", err)
+			return
+		}
+		ie.LCStoGCSTranslationList = make([]LCStoGCSTranslation, len(tmp_LCStoGCSTranslationList))
+		for k, i := range tmp_LCStoGCSTranslationList {
+			ie.LCStoGCSTranslationList[k] = *i
+		}
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *NRPRSBeamInformation) Validate() error {
+	var v validator
+	v.size("NRPRSBeamInformationList", len(ie.NRPRSBeamInformationList), 1, maxnoofPRSresourceSets)
+	for i := range ie.NRPRSBeamInformationList {
+		v.item("NRPRSBeamInformationList", i, &ie.NRPRSBeamInformationList[i])
+	}
+	if len(ie.LCStoGCSTranslationList) > 0 {
+		v.size("LCStoGCSTranslationList", len(ie.LCStoGCSTranslationList), 1, maxnooflcsgcstranslation)
+		for i := range ie.LCStoGCSTranslationList {
+			v.item("LCStoGCSTranslationList", i, &ie.LCStoGCSTranslationList[i])
+		}
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
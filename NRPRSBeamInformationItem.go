@@ -0,0 +1,102 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type NRPRSBeamInformationItem struct {
+	PRSResourceSetID PRSResourceSetID `mandatory`
+	PRSAngle         []PRSAngleItem   `lb:1,ub:maxnoofPRSResourcesPerSet,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[NRPRSBeamInformationItem] `optional,extension`
+}
+
+func (ie *NRPRSBeamInformationItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *NRPRSBeamInformationItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.PRSResourceSetID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PRSResourceSetID", err)
+		return
+	}
+	tmp_PRSAngle := sequenceOf[*PRSAngleItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofPRSResourcesPerSet},
+		ext: false,
+	}
+	for k := range ie.PRSAngle {
+		tmp_PRSAngle.Value = append(tmp_PRSAngle.Value, &ie.PRSAngle[k])
+	}
+	if err = tmp_PRSAngle.encode(w); err != nil {
+		err = utils.WrapError("Encode PRSAngle", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *NRPRSBeamInformationItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *NRPRSBeamInformationItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.PRSResourceSetID.Decode(r.AperReader); err != nil {
+		err = readError("PRSResourceSetID", err)
+		return
+	}
+	var tmp_PRSAngle []*PRSAngleItem
+	if tmp_PRSAngle, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofPRSResourcesPerSet}, false, func() *PRSAngleItem { return new(PRSAngleItem) }); err != nil {
+		err = readError("PRSAngle", err)
+		return
+	}
+	ie.PRSAngle = make([]PRSAngleItem, len(tmp_PRSAngle))
+	for k, i := range tmp_PRSAngle {
+		ie.PRSAngle[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *NRPRSBeamInformationItem) Validate() error {
+	var v validator
+	v.ie("PRSResourceSetID", &ie.PRSResourceSetID)
+	v.size("PRSAngle", len(ie.PRSAngle), 1, maxnoofPRSResourcesPerSet)
+	for i := range ie.PRSAngle {
+		v.item("PRSAngle", i, &ie.PRSAngle[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
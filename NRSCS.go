@@ -0,0 +1,38 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+const (
+	NRSCSScs15  aper.Enumerated = 0
+	NRSCSScs30  aper.Enumerated = 1
+	NRSCSScs60  aper.Enumerated = 2
+	NRSCSScs120 aper.Enumerated = 3
+)
+
+type NRSCS struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:3,valueExt"`
+}
+
+func (ie *NRSCS) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *NRSCS) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *NRSCS) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 4)
+	return v.err()
+}
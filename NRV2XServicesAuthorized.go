@@ -0,0 +1,109 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type NRV2XServicesAuthorized struct {
+	VehicleUE    *VehicleUE    `optional`
+	PedestrianUE *PedestrianUE `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[NRV2XServicesAuthorized] `optional,extension`
+}
+
+func (ie *NRV2XServicesAuthorized) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *NRV2XServicesAuthorized) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.VehicleUE != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if ie.PedestrianUE != nil {
+		aper.SetBit(optionals, 2)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 3)
+	}
+	if err = w.WriteBits(optionals, 3); err != nil {
+		return
+	}
+	if ie.VehicleUE != nil {
+		if err = ie.VehicleUE.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode VehicleUE", err)
+			return
+		}
+	}
+	if ie.PedestrianUE != nil {
+		if err = ie.PedestrianUE.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode PedestrianUE", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *NRV2XServicesAuthorized) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *NRV2XServicesAuthorized) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(3); err != nil {
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(VehicleUE)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("VehicleUE", err)
+			return
+		}
+		ie.VehicleUE = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		tmp := new(PedestrianUE)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("PedestrianUE", err)
+			return
+		}
+		ie.PedestrianUE = tmp
+	}
+	if aper.IsBitSet(optionals, 3) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *NRV2XServicesAuthorized) Validate() error {
+	var v validator
+	if ie.VehicleUE != nil {
+		v.ie("VehicleUE", ie.VehicleUE)
+	}
+	if ie.PedestrianUE != nil {
+		v.ie("PedestrianUE", ie.PedestrianUE)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,109 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type NetworkAccessRateReduction struct {
+	TransactionID     TransactionID     `mandatory,reject`
+	UACAssistanceInfo UACAssistanceInfo `mandatory,reject`
+	UnknownIEs        []RawIE
+}
+
+func (msg *NetworkAccessRateReduction) ProcedureCode() int64 {
+	return ProcedureCode_NetworkAccessRateReduction
+}
+
+func (msg *NetworkAccessRateReduction) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *NetworkAccessRateReduction) Criticality() aper.Enumerated {
+	return Criticality_PresentIgnore
+}
+
+func (msg *NetworkAccessRateReduction) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("NetworkAccessRateReduction"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *NetworkAccessRateReduction) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("NetworkAccessRateReduction"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *NetworkAccessRateReduction) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_TransactionID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.TransactionID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_UACAssistanceInfo},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.UACAssistanceInfo,
+	})
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *NetworkAccessRateReduction) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *NetworkAccessRateReduction) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *NetworkAccessRateReduction) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("NetworkAccessRateReduction", wire, limits, networkAccessRateReductionIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var networkAccessRateReductionIEs = []messageIE{
+	{ProtocolIEID_TransactionID, Criticality_PresentReject, true},
+	{ProtocolIEID_UACAssistanceInfo, Criticality_PresentReject, true},
+}
+
+func (msg *NetworkAccessRateReduction) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_TransactionID:
+		var tmp TransactionID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("TransactionID", err)
+			return
+		}
+		msg.TransactionID = tmp
+
+	case ProtocolIEID_UACAssistanceInfo:
+		var tmp UACAssistanceInfo
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("UACAssistanceInfo", err)
+			return
+		}
+		msg.UACAssistanceInfo = tmp
+
+	}
+	return
+}
+
+func (msg *NetworkAccessRateReduction) Validate() error {
+	var v validator
+	v.integer("TransactionID", int64(msg.TransactionID.Value), 0, 255)
+	v.ie("UACAssistanceInfo", &msg.UACAssistanceInfo)
+	return v.messageErr("NetworkAccessRateReduction")
+}
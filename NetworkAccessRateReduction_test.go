@@ -0,0 +1,48 @@
+package ies
+
+import (
+	"testing"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+func TestNetworkAccessRateReductionWire(t *testing.T) {
+	m := &NetworkAccessRateReduction{
+		TransactionID: TransactionID{Value: 1},
+		UACAssistanceInfo: UACAssistanceInfo{
+			UACPLMNList: []UACPLMNItem{{
+				PLMNIdentity: PLMNIdentity{Value: aper.OctetString{0x00, 0xf1, 0x10}},
+				UACTypeList: []UACTypeItem{{
+					UACReductionIndication: UACReductionIndication{Value: 50},
+					UACCategoryType: UACCategoryType{
+						Choice:          UACCategoryTypePresentUACstandardized,
+						UACstandardized: &UACAction{Value: UACActionRejectrrccrsignalling},
+					},
+				}, {
+					UACReductionIndication: UACReductionIndication{Value: 100},
+					UACCategoryType: UACCategoryType{
+						Choice: UACCategoryTypePresentUACOperatorDefined,
+						UACOperatorDefined: &UACOperatorDefined{
+							AccessCategory: 33,
+							AccessIdentity: aper.BitString{Bytes: []byte{0xaa}, NumBits: 7},
+						},
+					},
+				}},
+			}},
+		},
+	}
+	want := []byte{
+		0x00, 0x1b, 0x40, 0x17, // initiatingMessage, networkAccessRateReduction, ignore, length
+		0x00, 0x00, 0x02, // extension bit, 2 IEs
+		0x00, 0x4e, 0x00, 0x02, 0x00, 0x01, // TransactionID 1
+		0x00, 0xe1, 0x00, 0x0a, // UACAssistanceInfo
+		0x00,             // 1 PLMN
+		0x00, 0xf1, 0x10, // PLMN identity
+		0x04,       // 2 UAC types
+		0x64,       // reduction 50, standardized
+		0x13,       // reject RRC signalling, reduction 100
+		0x22,       // operator defined
+		0x03, 0x54, // access category 33, access identity 1010101
+	}
+	checkMessage(t, m, new(NetworkAccessRateReduction), want)
+}
@@ -0,0 +1,159 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type NonDynamicPQIDescriptor struct {
+	FiveQI             int64               `lb:0,ub:255,valueExt,mandatory`
+	QoSPriorityLevel   *int64              `lb:1,ub:8,valueExt,optional`
+	AveragingWindow    *AveragingWindow    `optional`
+	MaxDataBurstVolume *MaxDataBurstVolume `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[NonDynamicPQIDescriptor] `optional,extension`
+}
+
+func (ie *NonDynamicPQIDescriptor) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *NonDynamicPQIDescriptor) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.QoSPriorityLevel != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if ie.AveragingWindow != nil {
+		aper.SetBit(optionals, 2)
+	}
+	if ie.MaxDataBurstVolume != nil {
+		aper.SetBit(optionals, 3)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 4)
+	}
+	if err = w.WriteBits(optionals, 4); err != nil {
+		return
+	}
+	tmp_FiveQI := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 255},
+		ext:   true,
+		Value: aper.Integer(ie.FiveQI),
+	}
+	if err = tmp_FiveQI.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode FiveQI", err)
+		return
+	}
+	if ie.QoSPriorityLevel != nil {
+		tmp_QoSPriorityLevel := INTEGER{
+			c:     aper.Constraint{Lb: 1, Ub: 8},
+			ext:   true,
+			Value: aper.Integer(*ie.QoSPriorityLevel),
+		}
+		if err = tmp_QoSPriorityLevel.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode QoSPriorityLevel", err)
+			return
+		}
+	}
+	if ie.AveragingWindow != nil {
+		if err = ie.AveragingWindow.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode AveragingWindow", err)
+			return
+		}
+	}
+	if ie.MaxDataBurstVolume != nil {
+		if err = ie.MaxDataBurstVolume.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode MaxDataBurstVolume", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *NonDynamicPQIDescriptor) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *NonDynamicPQIDescriptor) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(4); err != nil {
+		return
+	}
+	tmp_FiveQI := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 255},
+		ext: true,
+	}
+	if err = tmp_FiveQI.Decode(r.AperReader); err != nil {
+		err = readError("FiveQI", err)
+		return
+	}
+	ie.FiveQI = int64(tmp_FiveQI.Value)
+	if aper.IsBitSet(optionals, 1) {
+		tmp_QoSPriorityLevel := INTEGER{
+			c:   aper.Constraint{Lb: 1, Ub: 8},
+			ext: true,
+		}
+		if err = tmp_QoSPriorityLevel.Decode(r.AperReader); err != nil {
+			err = readError("QoSPriorityLevel", err)
+			return
+		}
+		tmp := int64(tmp_QoSPriorityLevel.Value)
+		ie.QoSPriorityLevel = &tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		tmp := new(AveragingWindow)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("AveragingWindow", err)
+			return
+		}
+		ie.AveragingWindow = tmp
+	}
+	if aper.IsBitSet(optionals, 3) {
+		tmp := new(MaxDataBurstVolume)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("MaxDataBurstVolume", err)
+			return
+		}
+		ie.MaxDataBurstVolume = tmp
+	}
+	if aper.IsBitSet(optionals, 4) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *NonDynamicPQIDescriptor) Validate() error {
+	var v validator
+	v.integer("FiveQI", ie.FiveQI, 0, 255)
+	if ie.QoSPriorityLevel != nil {
+		v.integer("QoSPriorityLevel", *ie.QoSPriorityLevel, 1, 8)
+	}
+	if ie.AveragingWindow != nil {
+		v.ie("AveragingWindow", ie.AveragingWindow)
+	}
+	if ie.MaxDataBurstVolume != nil {
+		v.ie("MaxDataBurstVolume", ie.MaxDataBurstVolume)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,156 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type Notify struct {
+	GNBCUUEF1APID             GNBCUUEF1APID   `mandatory,reject`
+	GNBDUUEF1APID             GNBDUUEF1APID   `mandatory,reject`
+	DRBNotifyList             []DRBNotifyItem `mandatory,reject`
+	DRBNotifyListUnknownItems []RawIE
+	UnknownIEs                []RawIE
+}
+
+func (msg *Notify) ProcedureCode() int64 {
+	return ProcedureCode_Notify
+}
+
+func (msg *Notify) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *Notify) Criticality() aper.Enumerated {
+	return Criticality_PresentIgnore
+}
+
+func (msg *Notify) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("Notify"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *Notify) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("Notify"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *Notify) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	if len(msg.DRBNotifyList) > 0 || len(msg.DRBNotifyListUnknownItems) > 0 {
+		tmp_DRBNotifyList := SingleContainerList[*DRBNotifyItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBNotifyItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.DRBNotifyListUnknownItems,
+		}
+		for k := range msg.DRBNotifyList {
+			tmp_DRBNotifyList.Value = append(tmp_DRBNotifyList.Value, &msg.DRBNotifyList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DRBNotifyList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_DRBNotifyList,
+		})
+	} else {
+		err = fmt.Errorf("DRBNotifyList is nil")
+		return
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *Notify) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *Notify) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *Notify) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("Notify", wire, limits, notifyIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var notifyIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_DRBNotifyList, Criticality_PresentReject, true},
+}
+
+func (msg *Notify) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_DRBNotifyList:
+		tmp := SingleContainerList[*DRBNotifyItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBNotifyItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *DRBNotifyItem { return new(DRBNotifyItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("DRBNotifyList", err)
+			return
+		}
+		msg.DRBNotifyList = []DRBNotifyItem{}
+		for _, i := range tmp.Value {
+			msg.DRBNotifyList = append(msg.DRBNotifyList, *i)
+		}
+		msg.DRBNotifyListUnknownItems = tmp.Unknown
+
+	}
+	return
+}
+
+func (msg *Notify) Validate() error {
+	var v validator
+	v.integer("GNBCUUEF1APID", int64(msg.GNBCUUEF1APID.Value), 0, 4294967295)
+	v.integer("GNBDUUEF1APID", int64(msg.GNBDUUEF1APID.Value), 0, 4294967295)
+	if len(msg.DRBNotifyList) == 0 {
+		v.missing(ProtocolIEID_DRBNotifyList)
+	} else {
+		v.size("DRBNotifyList", len(msg.DRBNotifyList), 1, maxnoofDRBs)
+		for i := range msg.DRBNotifyList {
+			v.item("DRBNotifyList", i, &msg.DRBNotifyList[i])
+		}
+	}
+	return v.messageErr("Notify")
+}
@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type NumDLULSymbols struct {
+	NumDLSymbols int64 `lb:0,ub:13,valueExt,mandatory`
+	NumULSymbols int64 `lb:0,ub:13,valueExt,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[NumDLULSymbols] `optional,extension`
+}
+
+func (ie *NumDLULSymbols) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *NumDLULSymbols) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_NumDLSymbols := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 13},
+		ext:   true,
+		Value: aper.Integer(ie.NumDLSymbols),
+	}
+	if err = tmp_NumDLSymbols.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode NumDLSymbols", err)
+		return
+	}
+	tmp_NumULSymbols := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 13},
+		ext:   true,
+		Value: aper.Integer(ie.NumULSymbols),
+	}
+	if err = tmp_NumULSymbols.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode NumULSymbols", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *NumDLULSymbols) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *NumDLULSymbols) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	tmp_NumDLSymbols := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 13},
+		ext: true,
+	}
+	if err = tmp_NumDLSymbols.Decode(r.AperReader); err != nil {
+		err = readError("NumDLSymbols", err)
+		return
+	}
+	ie.NumDLSymbols = int64(tmp_NumDLSymbols.Value)
+	tmp_NumULSymbols := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 13},
+		ext: true,
+	}
+	if err = tmp_NumULSymbols.Decode(r.AperReader); err != nil {
+		err = readError("NumULSymbols", err)
+		return
+	}
+	ie.NumULSymbols = int64(tmp_NumULSymbols.Value)
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *NumDLULSymbols) Validate() error {
+	var v validator
+	v.integer("NumDLSymbols", ie.NumDLSymbols, 0, 13)
+	v.integer("NumULSymbols", ie.NumULSymbols, 0, 13)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
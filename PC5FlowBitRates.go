@@ -0,0 +1,87 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type PC5FlowBitRates struct {
+	GuaranteedFlowBitRate BitRate `mandatory`
+	MaximumFlowBitRate    BitRate `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[PC5FlowBitRates] `optional,extension`
+}
+
+func (ie *PC5FlowBitRates) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *PC5FlowBitRates) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.GuaranteedFlowBitRate.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode GuaranteedFlowBitRate", err)
+		return
+	}
+	if err = ie.MaximumFlowBitRate.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode MaximumFlowBitRate", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PC5FlowBitRates) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PC5FlowBitRates) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.GuaranteedFlowBitRate.Decode(r.AperReader); err != nil {
+		err = readError("GuaranteedFlowBitRate", err)
+		return
+	}
+	if err = ie.MaximumFlowBitRate.Decode(r.AperReader); err != nil {
+		err = readError("MaximumFlowBitRate", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *PC5FlowBitRates) Validate() error {
+	var v validator
+	v.ie("GuaranteedFlowBitRate", &ie.GuaranteedFlowBitRate)
+	v.ie("MaximumFlowBitRate", &ie.MaximumFlowBitRate)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	PC5QoSCharacteristicsPresentNothing uint64 = iota
+	PC5QoSCharacteristicsPresentNonDynamicPQI
+	PC5QoSCharacteristicsPresentDynamicPQI
+	PC5QoSCharacteristicsPresentChoiceExtension
+)
+
+type PC5QoSCharacteristics struct {
+	Choice          uint64
+	NonDynamicPQI   *NonDynamicPQIDescriptor
+	DynamicPQI      *DynamicPQIDescriptor
+	ChoiceExtension *RawIE
+}
+
+func (ie *PC5QoSCharacteristics) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *PC5QoSCharacteristics) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case PC5QoSCharacteristicsPresentNonDynamicPQI:
+		if err = ie.NonDynamicPQI.encode(w); err != nil {
+			err = utils.WrapError("Encode NonDynamicPQI", err)
+			return
+		}
+	case PC5QoSCharacteristicsPresentDynamicPQI:
+		if err = ie.DynamicPQI.encode(w); err != nil {
+			err = utils.WrapError("Encode DynamicPQI", err)
+			return
+		}
+	case PC5QoSCharacteristicsPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PC5QoSCharacteristics) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PC5QoSCharacteristics) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case PC5QoSCharacteristicsPresentNonDynamicPQI:
+		tmp := new(NonDynamicPQIDescriptor)
+		if err = tmp.decode(r); err != nil {
+			err = readError("NonDynamicPQI", err)
+			return
+		}
+		ie.NonDynamicPQI = tmp
+	case PC5QoSCharacteristicsPresentDynamicPQI:
+		tmp := new(DynamicPQIDescriptor)
+		if err = tmp.decode(r); err != nil {
+			err = readError("DynamicPQI", err)
+			return
+		}
+		ie.DynamicPQI = tmp
+	case PC5QoSCharacteristicsPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *PC5QoSCharacteristics) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case PC5QoSCharacteristicsPresentNonDynamicPQI:
+		if ie.NonDynamicPQI == nil {
+			v.absent("NonDynamicPQI")
+		} else {
+			v.ie("NonDynamicPQI", ie.NonDynamicPQI)
+		}
+	case PC5QoSCharacteristicsPresentDynamicPQI:
+		if ie.DynamicPQI == nil {
+			v.absent("DynamicPQI")
+		} else {
+			v.ie("DynamicPQI", ie.DynamicPQI)
+		}
+	case PC5QoSCharacteristicsPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
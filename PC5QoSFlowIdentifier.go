@@ -0,0 +1,31 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type PC5QoSFlowIdentifier struct {
+	Value aper.Integer `aper:"valueLB:1,valueUB:2048"`
+}
+
+func (ie *PC5QoSFlowIdentifier) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteInteger(int64(ie.Value), &aper.Constraint{Lb: 1, Ub: 2048}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *PC5QoSFlowIdentifier) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadInteger(&aper.Constraint{Lb: 1, Ub: 2048}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Integer(v)
+	}
+	return nil
+}
+
+func (ie *PC5QoSFlowIdentifier) Validate() error {
+	var v validator
+	v.integer("Value", int64(ie.Value), 1, 2048)
+	return v.err()
+}
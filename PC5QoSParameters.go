@@ -0,0 +1,98 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type PC5QoSParameters struct {
+	PC5QoSCharacteristics PC5QoSCharacteristics `mandatory`
+	PC5QoSFlowBitRates    *PC5FlowBitRates      `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[PC5QoSParameters] `optional,extension`
+}
+
+func (ie *PC5QoSParameters) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *PC5QoSParameters) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.PC5QoSFlowBitRates != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	if err = ie.PC5QoSCharacteristics.encode(w); err != nil {
+		err = utils.WrapError("Encode PC5QoSCharacteristics", err)
+		return
+	}
+	if ie.PC5QoSFlowBitRates != nil {
+		if err = ie.PC5QoSFlowBitRates.encode(w); err != nil {
+			err = utils.WrapError("Encode PC5QoSFlowBitRates", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PC5QoSParameters) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PC5QoSParameters) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	if err = ie.PC5QoSCharacteristics.decode(r); err != nil {
+		err = readError("PC5QoSCharacteristics", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(PC5FlowBitRates)
+		if err = tmp.decode(r); err != nil {
+			err = readError("PC5QoSFlowBitRates", err)
+			return
+		}
+		ie.PC5QoSFlowBitRates = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *PC5QoSParameters) Validate() error {
+	var v validator
+	v.ie("PC5QoSCharacteristics", &ie.PC5QoSCharacteristics)
+	if ie.PC5QoSFlowBitRates != nil {
+		v.ie("PC5QoSFlowBitRates", ie.PC5QoSFlowBitRates)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
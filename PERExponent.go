@@ -0,0 +1,31 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type PERExponent struct {
+	Value aper.Integer `aper:"valueLB:0,valueUB:9,valueExt"`
+}
+
+func (ie *PERExponent) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteInteger(int64(ie.Value), &aper.Constraint{Lb: 0, Ub: 9}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *PERExponent) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadInteger(&aper.Constraint{Lb: 0, Ub: 9}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Integer(v)
+	}
+	return nil
+}
+
+func (ie *PERExponent) Validate() error {
+	var v validator
+	v.integer("Value", int64(ie.Value), 0, 9)
+	return v.err()
+}
@@ -0,0 +1,177 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type PRSAngleItem struct {
+	NRPRSAzimuth       int64  `lb:0,ub:359,mandatory`
+	NRPRSAzimuthFine   *int64 `lb:0,ub:9,optional`
+	NRPRSElevation     *int64 `lb:0,ub:180,optional`
+	NRPRSElevationFine *int64 `lb:0,ub:9,optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[PRSAngleItem] `optional,extension`
+}
+
+func (ie *PRSAngleItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *PRSAngleItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.NRPRSAzimuthFine != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if ie.NRPRSElevation != nil {
+		aper.SetBit(optionals, 2)
+	}
+	if ie.NRPRSElevationFine != nil {
+		aper.SetBit(optionals, 3)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 4)
+	}
+	if err = w.WriteBits(optionals, 4); err != nil {
+		return
+	}
+	tmp_NRPRSAzimuth := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 359},
+		ext:   false,
+		Value: aper.Integer(ie.NRPRSAzimuth),
+	}
+	if err = tmp_NRPRSAzimuth.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode NRPRSAzimuth", err)
+		return
+	}
+	if ie.NRPRSAzimuthFine != nil {
+		tmp_NRPRSAzimuthFine := INTEGER{
+			c:     aper.Constraint{Lb: 0, Ub: 9},
+			ext:   false,
+			Value: aper.Integer(*ie.NRPRSAzimuthFine),
+		}
+		if err = tmp_NRPRSAzimuthFine.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode NRPRSAzimuthFine", err)
+			return
+		}
+	}
+	if ie.NRPRSElevation != nil {
+		tmp_NRPRSElevation := INTEGER{
+			c:     aper.Constraint{Lb: 0, Ub: 180},
+			ext:   false,
+			Value: aper.Integer(*ie.NRPRSElevation),
+		}
+		if err = tmp_NRPRSElevation.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode NRPRSElevation", err)
+			return
+		}
+	}
+	if ie.NRPRSElevationFine != nil {
+		tmp_NRPRSElevationFine := INTEGER{
+			c:     aper.Constraint{Lb: 0, Ub: 9},
+			ext:   false,
+			Value: aper.Integer(*ie.NRPRSElevationFine),
+		}
+		if err = tmp_NRPRSElevationFine.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode NRPRSElevationFine", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PRSAngleItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PRSAngleItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(4); err != nil {
+		return
+	}
+	tmp_NRPRSAzimuth := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 359},
+		ext: false,
+	}
+	if err = tmp_NRPRSAzimuth.Decode(r.AperReader); err != nil {
+		err = readError("NRPRSAzimuth", err)
+		return
+	}
+	ie.NRPRSAzimuth = int64(tmp_NRPRSAzimuth.Value)
+	if aper.IsBitSet(optionals, 1) {
+		tmp_NRPRSAzimuthFine := INTEGER{
+			c:   aper.Constraint{Lb: 0, Ub: 9},
+			ext: false,
+		}
+		if err = tmp_NRPRSAzimuthFine.Decode(r.AperReader); err != nil {
+			err = readError("NRPRSAzimuthFine", err)
+			return
+		}
+		tmp := int64(tmp_NRPRSAzimuthFine.Value)
+		ie.NRPRSAzimuthFine = &tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		tmp_NRPRSElevation := INTEGER{
+			c:   aper.Constraint{Lb: 0, Ub: 180},
+			ext: false,
+		}
+		if err = tmp_NRPRSElevation.Decode(r.AperReader); err != nil {
+			err = readError("NRPRSElevation", err)
+			return
+		}
+		tmp := int64(tmp_NRPRSElevation.Value)
+		ie.NRPRSElevation = &tmp
+	}
+	if aper.IsBitSet(optionals, 3) {
+		tmp_NRPRSElevationFine := INTEGER{
+			c:   aper.Constraint{Lb: 0, Ub: 9},
+			ext: false,
+		}
+		if err = tmp_NRPRSElevationFine.Decode(r.AperReader); err != nil {
+			err = readError("NRPRSElevationFine", err)
+			return
+		}
+		tmp := int64(tmp_NRPRSElevationFine.Value)
+		ie.NRPRSElevationFine = &tmp
+	}
+	if aper.IsBitSet(optionals, 4) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *PRSAngleItem) Validate() error {
+	var v validator
+	v.integer("NRPRSAzimuth", ie.NRPRSAzimuth, 0, 359)
+	if ie.NRPRSAzimuthFine != nil {
+		v.integer("NRPRSAzimuthFine", *ie.NRPRSAzimuthFine, 0, 9)
+	}
+	if ie.NRPRSElevation != nil {
+		v.integer("NRPRSElevation", *ie.NRPRSElevation, 0, 180)
+	}
+	if ie.NRPRSElevationFine != nil {
+		v.integer("NRPRSElevationFine", *ie.NRPRSElevationFine, 0, 9)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,92 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type PRSConfiguration struct {
+	PRSResourceSetList []PRSResourceSet `lb:1,ub:maxnoofPRSresourceSets,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[PRSConfiguration] `optional,extension`
+}
+
+func (ie *PRSConfiguration) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *PRSConfiguration) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_PRSResourceSetList := sequenceOf[*PRSResourceSet]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofPRSresourceSets},
+		ext: false,
+	}
+	for k := range ie.PRSResourceSetList {
+		tmp_PRSResourceSetList.Value = append(tmp_PRSResourceSetList.Value, &ie.PRSResourceSetList[k])
+	}
+	if err = tmp_PRSResourceSetList.encode(w); err != nil {
+		err = utils.WrapError("Encode PRSResourceSetList", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PRSConfiguration) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PRSConfiguration) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	var tmp_PRSResourceSetList []*PRSResourceSet
+	if tmp_PRSResourceSetList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofPRSresourceSets}, false, func() *PRSResourceSet { return new(PRSResourceSet) }); err != nil {
+		err = readError("PRSResourceSetList", err)
+		return
+	}
+	ie.PRSResourceSetList = make([]PRSResourceSet, len(tmp_PRSResourceSetList))
+	for k, i := range tmp_PRSResourceSetList {
+		ie.PRSResourceSetList[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *PRSConfiguration) Validate() error {
+	var v validator
+	v.size("PRSResourceSetList", len(ie.PRSResourceSetList), 1, maxnoofPRSresourceSets)
+	for i := range ie.PRSResourceSetList {
+		v.item("PRSResourceSetList", i, &ie.PRSResourceSetList[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,137 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type PRSInformationPos struct {
+	PRSIDPos            int64  `lb:0,ub:255,mandatory`
+	PRSResourceSetIDPos int64  `lb:0,ub:7,mandatory`
+	PRSResourceIDPos    *int64 `lb:0,ub:63,optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[PRSInformationPos] `optional,extension`
+}
+
+func (ie *PRSInformationPos) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *PRSInformationPos) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.PRSResourceIDPos != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	tmp_PRSIDPos := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 255},
+		ext:   false,
+		Value: aper.Integer(ie.PRSIDPos),
+	}
+	if err = tmp_PRSIDPos.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PRSIDPos", err)
+		return
+	}
+	tmp_PRSResourceSetIDPos := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 7},
+		ext:   false,
+		Value: aper.Integer(ie.PRSResourceSetIDPos),
+	}
+	if err = tmp_PRSResourceSetIDPos.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PRSResourceSetIDPos", err)
+		return
+	}
+	if ie.PRSResourceIDPos != nil {
+		tmp_PRSResourceIDPos := INTEGER{
+			c:     aper.Constraint{Lb: 0, Ub: 63},
+			ext:   false,
+			Value: aper.Integer(*ie.PRSResourceIDPos),
+		}
+		if err = tmp_PRSResourceIDPos.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode PRSResourceIDPos", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PRSInformationPos) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PRSInformationPos) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	tmp_PRSIDPos := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 255},
+		ext: false,
+	}
+	if err = tmp_PRSIDPos.Decode(r.AperReader); err != nil {
+		err = readError("PRSIDPos", err)
+		return
+	}
+	ie.PRSIDPos = int64(tmp_PRSIDPos.Value)
+	tmp_PRSResourceSetIDPos := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 7},
+		ext: false,
+	}
+	if err = tmp_PRSResourceSetIDPos.Decode(r.AperReader); err != nil {
+		err = readError("PRSResourceSetIDPos", err)
+		return
+	}
+	ie.PRSResourceSetIDPos = int64(tmp_PRSResourceSetIDPos.Value)
+	if aper.IsBitSet(optionals, 1) {
+		tmp_PRSResourceIDPos := INTEGER{
+			c:   aper.Constraint{Lb: 0, Ub: 63},
+			ext: false,
+		}
+		if err = tmp_PRSResourceIDPos.Decode(r.AperReader); err != nil {
+			err = readError("PRSResourceIDPos", err)
+			return
+		}
+		tmp := int64(tmp_PRSResourceIDPos.Value)
+		ie.PRSResourceIDPos = &tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *PRSInformationPos) Validate() error {
+	var v validator
+	v.integer("PRSIDPos", ie.PRSIDPos, 0, 255)
+	v.integer("PRSResourceSetIDPos", ie.PRSResourceSetIDPos, 0, 7)
+	if ie.PRSResourceIDPos != nil {
+		v.integer("PRSResourceIDPos", *ie.PRSResourceIDPos, 0, 63)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,109 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type PRSMuting struct {
+	PRSMutingOption1 *PRSMutingOption1 `optional`
+	PRSMutingOption2 *PRSMutingOption2 `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[PRSMuting] `optional,extension`
+}
+
+func (ie *PRSMuting) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *PRSMuting) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.PRSMutingOption1 != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if ie.PRSMutingOption2 != nil {
+		aper.SetBit(optionals, 2)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 3)
+	}
+	if err = w.WriteBits(optionals, 3); err != nil {
+		return
+	}
+	if ie.PRSMutingOption1 != nil {
+		if err = ie.PRSMutingOption1.encode(w); err != nil {
+			err = utils.WrapError("Encode PRSMutingOption1", err)
+			return
+		}
+	}
+	if ie.PRSMutingOption2 != nil {
+		if err = ie.PRSMutingOption2.encode(w); err != nil {
+			err = utils.WrapError("Encode PRSMutingOption2", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PRSMuting) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PRSMuting) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(3); err != nil {
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(PRSMutingOption1)
+		if err = tmp.decode(r); err != nil {
+			err = readError("PRSMutingOption1", err)
+			return
+		}
+		ie.PRSMutingOption1 = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		tmp := new(PRSMutingOption2)
+		if err = tmp.decode(r); err != nil {
+			err = readError("PRSMutingOption2", err)
+			return
+		}
+		ie.PRSMutingOption2 = tmp
+	}
+	if aper.IsBitSet(optionals, 3) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *PRSMuting) Validate() error {
+	var v validator
+	if ie.PRSMutingOption1 != nil {
+		v.ie("PRSMutingOption1", ie.PRSMutingOption1)
+	}
+	if ie.PRSMutingOption2 != nil {
+		v.ie("PRSMutingOption2", ie.PRSMutingOption2)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,120 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type PRSMutingOption1 struct {
+	MutingPattern             DLPRSMutingPattern                        `mandatory`
+	MutingBitRepetitionFactor PRSMutingOption1MutingBitRepetitionFactor `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[PRSMutingOption1] `optional,extension`
+}
+
+func (ie *PRSMutingOption1) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *PRSMutingOption1) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.MutingPattern.encode(w); err != nil {
+		err = utils.WrapError("Encode MutingPattern", err)
+		return
+	}
+	if err = ie.MutingBitRepetitionFactor.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode MutingBitRepetitionFactor", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PRSMutingOption1) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PRSMutingOption1) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.MutingPattern.decode(r); err != nil {
+		err = readError("MutingPattern", err)
+		return
+	}
+	if err = ie.MutingBitRepetitionFactor.Decode(r.AperReader); err != nil {
+		err = readError("MutingBitRepetitionFactor", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *PRSMutingOption1) Validate() error {
+	var v validator
+	v.ie("MutingPattern", &ie.MutingPattern)
+	v.ie("MutingBitRepetitionFactor", &ie.MutingBitRepetitionFactor)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
+
+const (
+	PRSMutingOption1MutingBitRepetitionFactorN1 aper.Enumerated = 0
+	PRSMutingOption1MutingBitRepetitionFactorN2 aper.Enumerated = 1
+	PRSMutingOption1MutingBitRepetitionFactorN4 aper.Enumerated = 2
+	PRSMutingOption1MutingBitRepetitionFactorN8 aper.Enumerated = 3
+)
+
+type PRSMutingOption1MutingBitRepetitionFactor struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:3,valueExt"`
+}
+
+func (ie *PRSMutingOption1MutingBitRepetitionFactor) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *PRSMutingOption1MutingBitRepetitionFactor) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *PRSMutingOption1MutingBitRepetitionFactor) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 4)
+	return v.err()
+}
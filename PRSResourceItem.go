@@ -0,0 +1,178 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type PRSResourceItem struct {
+	PRSResourceID        PRSResourceID       `mandatory`
+	SequenceID           int64               `lb:0,ub:4095,mandatory`
+	REOffset             int64               `lb:0,ub:11,valueExt,mandatory`
+	ResourceSlotOffset   int64               `lb:0,ub:511,mandatory`
+	ResourceSymbolOffset int64               `lb:0,ub:12,mandatory`
+	QCLInfo              *PRSResourceQCLInfo `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[PRSResourceItem] `optional,extension`
+}
+
+func (ie *PRSResourceItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *PRSResourceItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.QCLInfo != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	if err = ie.PRSResourceID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PRSResourceID", err)
+		return
+	}
+	tmp_SequenceID := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 4095},
+		ext:   false,
+		Value: aper.Integer(ie.SequenceID),
+	}
+	if err = tmp_SequenceID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SequenceID", err)
+		return
+	}
+	tmp_REOffset := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 11},
+		ext:   true,
+		Value: aper.Integer(ie.REOffset),
+	}
+	if err = tmp_REOffset.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode REOffset", err)
+		return
+	}
+	tmp_ResourceSlotOffset := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 511},
+		ext:   false,
+		Value: aper.Integer(ie.ResourceSlotOffset),
+	}
+	if err = tmp_ResourceSlotOffset.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode ResourceSlotOffset", err)
+		return
+	}
+	tmp_ResourceSymbolOffset := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 12},
+		ext:   false,
+		Value: aper.Integer(ie.ResourceSymbolOffset),
+	}
+	if err = tmp_ResourceSymbolOffset.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode ResourceSymbolOffset", err)
+		return
+	}
+	if ie.QCLInfo != nil {
+		if err = ie.QCLInfo.encode(w); err != nil {
+			err = utils.WrapError("Encode QCLInfo", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PRSResourceItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PRSResourceItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	if err = ie.PRSResourceID.Decode(r.AperReader); err != nil {
+		err = readError("PRSResourceID", err)
+		return
+	}
+	tmp_SequenceID := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 4095},
+		ext: false,
+	}
+	if err = tmp_SequenceID.Decode(r.AperReader); err != nil {
+		err = readError("SequenceID", err)
+		return
+	}
+	ie.SequenceID = int64(tmp_SequenceID.Value)
+	tmp_REOffset := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 11},
+		ext: true,
+	}
+	if err = tmp_REOffset.Decode(r.AperReader); err != nil {
+		err = readError("REOffset", err)
+		return
+	}
+	ie.REOffset = int64(tmp_REOffset.Value)
+	tmp_ResourceSlotOffset := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 511},
+		ext: false,
+	}
+	if err = tmp_ResourceSlotOffset.Decode(r.AperReader); err != nil {
+		err = readError("ResourceSlotOffset", err)
+		return
+	}
+	ie.ResourceSlotOffset = int64(tmp_ResourceSlotOffset.Value)
+	tmp_ResourceSymbolOffset := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 12},
+		ext: false,
+	}
+	if err = tmp_ResourceSymbolOffset.Decode(r.AperReader); err != nil {
+		err = readError("ResourceSymbolOffset", err)
+		return
+	}
+	ie.ResourceSymbolOffset = int64(tmp_ResourceSymbolOffset.Value)
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(PRSResourceQCLInfo)
+		if err = tmp.decode(r); err != nil {
+			err = readError("QCLInfo", err)
+			return
+		}
+		ie.QCLInfo = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *PRSResourceItem) Validate() error {
+	var v validator
+	v.ie("PRSResourceID", &ie.PRSResourceID)
+	v.integer("SequenceID", ie.SequenceID, 0, 4095)
+	v.integer("REOffset", ie.REOffset, 0, 11)
+	v.integer("ResourceSlotOffset", ie.ResourceSlotOffset, 0, 511)
+	v.integer("ResourceSymbolOffset", ie.ResourceSymbolOffset, 0, 12)
+	if ie.QCLInfo != nil {
+		v.ie("QCLInfo", ie.QCLInfo)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
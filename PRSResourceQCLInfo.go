@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	PRSResourceQCLInfoPresentNothing uint64 = iota
+	PRSResourceQCLInfoPresentQCLSourceSSB
+	PRSResourceQCLInfoPresentQCLSourcePRS
+	PRSResourceQCLInfoPresentChoiceExtension
+)
+
+type PRSResourceQCLInfo struct {
+	Choice          uint64
+	QCLSourceSSB    *PRSResourceQCLSourceSSB
+	QCLSourcePRS    *PRSResourceQCLSourcePRS
+	ChoiceExtension *RawIE
+}
+
+func (ie *PRSResourceQCLInfo) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *PRSResourceQCLInfo) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case PRSResourceQCLInfoPresentQCLSourceSSB:
+		if err = ie.QCLSourceSSB.encode(w); err != nil {
+			err = utils.WrapError("Encode QCLSourceSSB", err)
+			return
+		}
+	case PRSResourceQCLInfoPresentQCLSourcePRS:
+		if err = ie.QCLSourcePRS.encode(w); err != nil {
+			err = utils.WrapError("Encode QCLSourcePRS", err)
+			return
+		}
+	case PRSResourceQCLInfoPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PRSResourceQCLInfo) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PRSResourceQCLInfo) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case PRSResourceQCLInfoPresentQCLSourceSSB:
+		tmp := new(PRSResourceQCLSourceSSB)
+		if err = tmp.decode(r); err != nil {
+			err = readError("QCLSourceSSB", err)
+			return
+		}
+		ie.QCLSourceSSB = tmp
+	case PRSResourceQCLInfoPresentQCLSourcePRS:
+		tmp := new(PRSResourceQCLSourcePRS)
+		if err = tmp.decode(r); err != nil {
+			err = readError("QCLSourcePRS", err)
+			return
+		}
+		ie.QCLSourcePRS = tmp
+	case PRSResourceQCLInfoPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *PRSResourceQCLInfo) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case PRSResourceQCLInfoPresentQCLSourceSSB:
+		if ie.QCLSourceSSB == nil {
+			v.absent("QCLSourceSSB")
+		} else {
+			v.ie("QCLSourceSSB", ie.QCLSourceSSB)
+		}
+	case PRSResourceQCLInfoPresentQCLSourcePRS:
+		if ie.QCLSourcePRS == nil {
+			v.absent("QCLSourcePRS")
+		} else {
+			v.ie("QCLSourcePRS", ie.QCLSourcePRS)
+		}
+	case PRSResourceQCLInfoPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
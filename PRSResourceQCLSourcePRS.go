@@ -0,0 +1,98 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type PRSResourceQCLSourcePRS struct {
+	QCLSourcePRSResourceSetID PRSResourceSetID `mandatory`
+	QCLSourcePRSResourceID    *PRSResourceID   `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[PRSResourceQCLSourcePRS] `optional,extension`
+}
+
+func (ie *PRSResourceQCLSourcePRS) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *PRSResourceQCLSourcePRS) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.QCLSourcePRSResourceID != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	if err = ie.QCLSourcePRSResourceSetID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode QCLSourcePRSResourceSetID", err)
+		return
+	}
+	if ie.QCLSourcePRSResourceID != nil {
+		if err = ie.QCLSourcePRSResourceID.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode QCLSourcePRSResourceID", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PRSResourceQCLSourcePRS) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PRSResourceQCLSourcePRS) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	if err = ie.QCLSourcePRSResourceSetID.Decode(r.AperReader); err != nil {
+		err = readError("QCLSourcePRSResourceSetID", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(PRSResourceID)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("QCLSourcePRSResourceID", err)
+			return
+		}
+		ie.QCLSourcePRSResourceID = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *PRSResourceQCLSourcePRS) Validate() error {
+	var v validator
+	v.ie("QCLSourcePRSResourceSetID", &ie.QCLSourcePRSResourceSetID)
+	if ie.QCLSourcePRSResourceID != nil {
+		v.ie("QCLSourcePRSResourceID", ie.QCLSourcePRSResourceID)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
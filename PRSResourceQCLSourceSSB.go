@@ -0,0 +1,98 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type PRSResourceQCLSourceSSB struct {
+	PCINR    NRPCI     `mandatory`
+	SSBIndex *SSBIndex `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[PRSResourceQCLSourceSSB] `optional,extension`
+}
+
+func (ie *PRSResourceQCLSourceSSB) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *PRSResourceQCLSourceSSB) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.SSBIndex != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	if err = ie.PCINR.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PCINR", err)
+		return
+	}
+	if ie.SSBIndex != nil {
+		if err = ie.SSBIndex.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode SSBIndex", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PRSResourceQCLSourceSSB) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PRSResourceQCLSourceSSB) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	if err = ie.PCINR.Decode(r.AperReader); err != nil {
+		err = readError("PCINR", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(SSBIndex)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("SSBIndex", err)
+			return
+		}
+		ie.SSBIndex = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *PRSResourceQCLSourceSSB) Validate() error {
+	var v validator
+	v.integer("PCINR", int64(ie.PCINR.Value), 0, 1007)
+	if ie.SSBIndex != nil {
+		v.ie("SSBIndex", ie.SSBIndex)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
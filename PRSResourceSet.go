@@ -0,0 +1,543 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type PRSResourceSet struct {
+	PRSResourceSetID         PRSResourceSetID                       `mandatory`
+	SubcarrierSpacing        PRSResourceSetSubcarrierSpacing        `mandatory`
+	PRSbandwidth             int64                                  `lb:1,ub:63,mandatory`
+	StartPRB                 int64                                  `lb:0,ub:2176,mandatory`
+	PointA                   int64                                  `lb:0,ub:3279165,mandatory`
+	CombSize                 PRSResourceSetCombSize                 `mandatory`
+	CPType                   PRSResourceSetCPType                   `mandatory`
+	ResourceSetPeriodicity   PRSResourceSetResourceSetPeriodicity   `mandatory`
+	ResourceSetSlotOffset    int64                                  `lb:0,ub:81919,valueExt,mandatory`
+	ResourceRepetitionFactor PRSResourceSetResourceRepetitionFactor `mandatory`
+	ResourceTimeGap          PRSResourceSetResourceTimeGap          `mandatory`
+	ResourceNumberofSymbols  PRSResourceSetResourceNumberofSymbols  `mandatory`
+	PRSMuting                *PRSMuting                             `optional`
+	PRSResourceTransmitPower int64                                  `lb:-60,ub:50,mandatory`
+	PRSResourceList          []PRSResourceItem                      `lb:1,ub:maxnoofPRSresources,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[PRSResourceSet] `optional,extension`
+}
+
+func (ie *PRSResourceSet) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *PRSResourceSet) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.PRSMuting != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	if err = ie.PRSResourceSetID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PRSResourceSetID", err)
+		return
+	}
+	if err = ie.SubcarrierSpacing.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SubcarrierSpacing", err)
+		return
+	}
+	tmp_PRSbandwidth := INTEGER{
+		c:     aper.Constraint{Lb: 1, Ub: 63},
+		ext:   false,
+		Value: aper.Integer(ie.PRSbandwidth),
+	}
+	if err = tmp_PRSbandwidth.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PRSbandwidth", err)
+		return
+	}
+	tmp_StartPRB := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 2176},
+		ext:   false,
+		Value: aper.Integer(ie.StartPRB),
+	}
+	if err = tmp_StartPRB.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode StartPRB", err)
+		return
+	}
+	tmp_PointA := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 3279165},
+		ext:   false,
+		Value: aper.Integer(ie.PointA),
+	}
+	if err = tmp_PointA.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PointA", err)
+		return
+	}
+	if err = ie.CombSize.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode CombSize", err)
+		return
+	}
+	if err = ie.CPType.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode CPType", err)
+		return
+	}
+	if err = ie.ResourceSetPeriodicity.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode ResourceSetPeriodicity", err)
+		return
+	}
+	tmp_ResourceSetSlotOffset := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 81919},
+		ext:   true,
+		Value: aper.Integer(ie.ResourceSetSlotOffset),
+	}
+	if err = tmp_ResourceSetSlotOffset.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode ResourceSetSlotOffset", err)
+		return
+	}
+	if err = ie.ResourceRepetitionFactor.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode ResourceRepetitionFactor", err)
+		return
+	}
+	if err = ie.ResourceTimeGap.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode ResourceTimeGap", err)
+		return
+	}
+	if err = ie.ResourceNumberofSymbols.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode ResourceNumberofSymbols", err)
+		return
+	}
+	if ie.PRSMuting != nil {
+		if err = ie.PRSMuting.encode(w); err != nil {
+			err = utils.WrapError("Encode PRSMuting", err)
+			return
+		}
+	}
+	tmp_PRSResourceTransmitPower := INTEGER{
+		c:     aper.Constraint{Lb: -60, Ub: 50},
+		ext:   false,
+		Value: aper.Integer(ie.PRSResourceTransmitPower),
+	}
+	if err = tmp_PRSResourceTransmitPower.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PRSResourceTransmitPower", err)
+		return
+	}
+	tmp_PRSResourceList := sequenceOf[*PRSResourceItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofPRSresources},
+		ext: false,
+	}
+	for k := range ie.PRSResourceList {
+		tmp_PRSResourceList.Value = append(tmp_PRSResourceList.Value, &ie.PRSResourceList[k])
+	}
+	if err = tmp_PRSResourceList.encode(w); err != nil {
+		err = utils.WrapError("Encode PRSResourceList", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PRSResourceSet) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PRSResourceSet) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	if err = ie.PRSResourceSetID.Decode(r.AperReader); err != nil {
+		err = readError("PRSResourceSetID", err)
+		return
+	}
+	if err = ie.SubcarrierSpacing.Decode(r.AperReader); err != nil {
+		err = readError("SubcarrierSpacing", err)
+		return
+	}
+	tmp_PRSbandwidth := INTEGER{
+		c:   aper.Constraint{Lb: 1, Ub: 63},
+		ext: false,
+	}
+	if err = tmp_PRSbandwidth.Decode(r.AperReader); err != nil {
+		err = readError("PRSbandwidth", err)
+		return
+	}
+	ie.PRSbandwidth = int64(tmp_PRSbandwidth.Value)
+	tmp_StartPRB := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 2176},
+		ext: false,
+	}
+	if err = tmp_StartPRB.Decode(r.AperReader); err != nil {
+		err = readError("StartPRB", err)
+		return
+	}
+	ie.StartPRB = int64(tmp_StartPRB.Value)
+	tmp_PointA := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 3279165},
+		ext: false,
+	}
+	if err = tmp_PointA.Decode(r.AperReader); err != nil {
+		err = readError("PointA", err)
+		return
+	}
+	ie.PointA = int64(tmp_PointA.Value)
+	if err = ie.CombSize.Decode(r.AperReader); err != nil {
+		err = readError("CombSize", err)
+		return
+	}
+	if err = ie.CPType.Decode(r.AperReader); err != nil {
+		err = readError("CPType", err)
+		return
+	}
+	if err = ie.ResourceSetPeriodicity.Decode(r.AperReader); err != nil {
+		err = readError("ResourceSetPeriodicity", err)
+		return
+	}
+	tmp_ResourceSetSlotOffset := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 81919},
+		ext: true,
+	}
+	if err = tmp_ResourceSetSlotOffset.Decode(r.AperReader); err != nil {
+		err = readError("ResourceSetSlotOffset", err)
+		return
+	}
+	ie.ResourceSetSlotOffset = int64(tmp_ResourceSetSlotOffset.Value)
+	if err = ie.ResourceRepetitionFactor.Decode(r.AperReader); err != nil {
+		err = readError("ResourceRepetitionFactor", err)
+		return
+	}
+	if err = ie.ResourceTimeGap.Decode(r.AperReader); err != nil {
+		err = readError("ResourceTimeGap", err)
+		return
+	}
+	if err = ie.ResourceNumberofSymbols.Decode(r.AperReader); err != nil {
+		err = readError("ResourceNumberofSymbols", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(PRSMuting)
+		if err = tmp.decode(r); err != nil {
+			err = readError("PRSMuting", err)
+			return
+		}
+		ie.PRSMuting = tmp
+	}
+	tmp_PRSResourceTransmitPower := INTEGER{
+		c:   aper.Constraint{Lb: -60, Ub: 50},
+		ext: false,
+	}
+	if err = tmp_PRSResourceTransmitPower.Decode(r.AperReader); err != nil {
+		err = readError("PRSResourceTransmitPower", err)
+		return
+	}
+	ie.PRSResourceTransmitPower = int64(tmp_PRSResourceTransmitPower.Value)
+	var tmp_PRSResourceList []*PRSResourceItem
+	if tmp_PRSResourceList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofPRSresources}, false, func() *PRSResourceItem { return new(PRSResourceItem) }); err != nil {
+		err = readError("PRSResourceList", err)
+		return
+	}
+	ie.PRSResourceList = make([]PRSResourceItem, len(tmp_PRSResourceList))
+	for k, i := range tmp_PRSResourceList {
+		ie.PRSResourceList[k] = *i
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *PRSResourceSet) Validate() error {
+	var v validator
+	v.ie("PRSResourceSetID", &ie.PRSResourceSetID)
+	v.ie("SubcarrierSpacing", &ie.SubcarrierSpacing)
+	v.integer("PRSbandwidth", ie.PRSbandwidth, 1, 63)
+	v.integer("StartPRB", ie.StartPRB, 0, 2176)
+	v.integer("PointA", ie.PointA, 0, 3279165)
+	v.ie("CombSize", &ie.CombSize)
+	v.ie("CPType", &ie.CPType)
+	v.ie("ResourceSetPeriodicity", &ie.ResourceSetPeriodicity)
+	v.integer("ResourceSetSlotOffset", ie.ResourceSetSlotOffset, 0, 81919)
+	v.ie("ResourceRepetitionFactor", &ie.ResourceRepetitionFactor)
+	v.ie("ResourceTimeGap", &ie.ResourceTimeGap)
+	v.ie("ResourceNumberofSymbols", &ie.ResourceNumberofSymbols)
+	if ie.PRSMuting != nil {
+		v.ie("PRSMuting", ie.PRSMuting)
+	}
+	v.integer("PRSResourceTransmitPower", ie.PRSResourceTransmitPower, -60, 50)
+	v.size("PRSResourceList", len(ie.PRSResourceList), 1, maxnoofPRSresources)
+	for i := range ie.PRSResourceList {
+		v.item("PRSResourceList", i, &ie.PRSResourceList[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
+
+const (
+	PRSResourceSetSubcarrierSpacingKHz15  aper.Enumerated = 0
+	PRSResourceSetSubcarrierSpacingKHz30  aper.Enumerated = 1
+	PRSResourceSetSubcarrierSpacingKHz60  aper.Enumerated = 2
+	PRSResourceSetSubcarrierSpacingKHz120 aper.Enumerated = 3
+)
+
+type PRSResourceSetSubcarrierSpacing struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:3,valueExt"`
+}
+
+func (ie *PRSResourceSetSubcarrierSpacing) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *PRSResourceSetSubcarrierSpacing) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *PRSResourceSetSubcarrierSpacing) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 4)
+	return v.err()
+}
+
+const (
+	PRSResourceSetCombSizeN2  aper.Enumerated = 0
+	PRSResourceSetCombSizeN4  aper.Enumerated = 1
+	PRSResourceSetCombSizeN6  aper.Enumerated = 2
+	PRSResourceSetCombSizeN12 aper.Enumerated = 3
+)
+
+type PRSResourceSetCombSize struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:3,valueExt"`
+}
+
+func (ie *PRSResourceSetCombSize) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *PRSResourceSetCombSize) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *PRSResourceSetCombSize) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 4)
+	return v.err()
+}
+
+const (
+	PRSResourceSetCPTypeNormal   aper.Enumerated = 0
+	PRSResourceSetCPTypeExtended aper.Enumerated = 1
+)
+
+type PRSResourceSetCPType struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:1,valueExt"`
+}
+
+func (ie *PRSResourceSetCPType) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 1}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *PRSResourceSetCPType) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 1}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *PRSResourceSetCPType) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 2)
+	return v.err()
+}
+
+const (
+	PRSResourceSetResourceSetPeriodicityN4     aper.Enumerated = 0
+	PRSResourceSetResourceSetPeriodicityN5     aper.Enumerated = 1
+	PRSResourceSetResourceSetPeriodicityN8     aper.Enumerated = 2
+	PRSResourceSetResourceSetPeriodicityN10    aper.Enumerated = 3
+	PRSResourceSetResourceSetPeriodicityN16    aper.Enumerated = 4
+	PRSResourceSetResourceSetPeriodicityN20    aper.Enumerated = 5
+	PRSResourceSetResourceSetPeriodicityN32    aper.Enumerated = 6
+	PRSResourceSetResourceSetPeriodicityN40    aper.Enumerated = 7
+	PRSResourceSetResourceSetPeriodicityN64    aper.Enumerated = 8
+	PRSResourceSetResourceSetPeriodicityN80    aper.Enumerated = 9
+	PRSResourceSetResourceSetPeriodicityN160   aper.Enumerated = 10
+	PRSResourceSetResourceSetPeriodicityN320   aper.Enumerated = 11
+	PRSResourceSetResourceSetPeriodicityN640   aper.Enumerated = 12
+	PRSResourceSetResourceSetPeriodicityN1280  aper.Enumerated = 13
+	PRSResourceSetResourceSetPeriodicityN2560  aper.Enumerated = 14
+	PRSResourceSetResourceSetPeriodicityN5120  aper.Enumerated = 15
+	PRSResourceSetResourceSetPeriodicityN10240 aper.Enumerated = 16
+	PRSResourceSetResourceSetPeriodicityN20480 aper.Enumerated = 17
+	PRSResourceSetResourceSetPeriodicityN40960 aper.Enumerated = 18
+	PRSResourceSetResourceSetPeriodicityN81920 aper.Enumerated = 19
+)
+
+type PRSResourceSetResourceSetPeriodicity struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:19,valueExt"`
+}
+
+func (ie *PRSResourceSetResourceSetPeriodicity) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 19}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *PRSResourceSetResourceSetPeriodicity) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 19}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *PRSResourceSetResourceSetPeriodicity) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 20)
+	return v.err()
+}
+
+const (
+	PRSResourceSetResourceRepetitionFactorRf1  aper.Enumerated = 0
+	PRSResourceSetResourceRepetitionFactorRf2  aper.Enumerated = 1
+	PRSResourceSetResourceRepetitionFactorRf4  aper.Enumerated = 2
+	PRSResourceSetResourceRepetitionFactorRf6  aper.Enumerated = 3
+	PRSResourceSetResourceRepetitionFactorRf8  aper.Enumerated = 4
+	PRSResourceSetResourceRepetitionFactorRf16 aper.Enumerated = 5
+	PRSResourceSetResourceRepetitionFactorRf32 aper.Enumerated = 6
+)
+
+type PRSResourceSetResourceRepetitionFactor struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:6,valueExt"`
+}
+
+func (ie *PRSResourceSetResourceRepetitionFactor) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 6}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *PRSResourceSetResourceRepetitionFactor) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 6}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *PRSResourceSetResourceRepetitionFactor) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 7)
+	return v.err()
+}
+
+const (
+	PRSResourceSetResourceTimeGapTg1  aper.Enumerated = 0
+	PRSResourceSetResourceTimeGapTg2  aper.Enumerated = 1
+	PRSResourceSetResourceTimeGapTg4  aper.Enumerated = 2
+	PRSResourceSetResourceTimeGapTg8  aper.Enumerated = 3
+	PRSResourceSetResourceTimeGapTg16 aper.Enumerated = 4
+	PRSResourceSetResourceTimeGapTg32 aper.Enumerated = 5
+)
+
+type PRSResourceSetResourceTimeGap struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:5,valueExt"`
+}
+
+func (ie *PRSResourceSetResourceTimeGap) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 5}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *PRSResourceSetResourceTimeGap) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 5}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *PRSResourceSetResourceTimeGap) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 6)
+	return v.err()
+}
+
+const (
+	PRSResourceSetResourceNumberofSymbolsN2  aper.Enumerated = 0
+	PRSResourceSetResourceNumberofSymbolsN4  aper.Enumerated = 1
+	PRSResourceSetResourceNumberofSymbolsN6  aper.Enumerated = 2
+	PRSResourceSetResourceNumberofSymbolsN12 aper.Enumerated = 3
+)
+
+type PRSResourceSetResourceNumberofSymbols struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:3,valueExt"`
+}
+
+func (ie *PRSResourceSetResourceNumberofSymbols) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *PRSResourceSetResourceNumberofSymbols) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *PRSResourceSetResourceNumberofSymbols) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 4)
+	return v.err()
+}
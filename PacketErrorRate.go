@@ -0,0 +1,87 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type PacketErrorRate struct {
+	PERScalar   PERScalar   `mandatory`
+	PERExponent PERExponent `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[PacketErrorRate] `optional,extension`
+}
+
+func (ie *PacketErrorRate) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *PacketErrorRate) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.PERScalar.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PERScalar", err)
+		return
+	}
+	if err = ie.PERExponent.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PERExponent", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PacketErrorRate) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PacketErrorRate) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.PERScalar.Decode(r.AperReader); err != nil {
+		err = readError("PERScalar", err)
+		return
+	}
+	if err = ie.PERExponent.Decode(r.AperReader); err != nil {
+		err = readError("PERExponent", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *PacketErrorRate) Validate() error {
+	var v validator
+	v.ie("PERScalar", &ie.PERScalar)
+	v.ie("PERExponent", &ie.PERExponent)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	PathlossReferenceSignalPresentNothing uint64 = iota
+	PathlossReferenceSignalPresentSSB
+	PathlossReferenceSignalPresentDLPRS
+	PathlossReferenceSignalPresentChoiceExtension
+)
+
+type PathlossReferenceSignal struct {
+	Choice          uint64
+	SSB             *SSB
+	DLPRS           *DLPRS
+	ChoiceExtension *RawIE
+}
+
+func (ie *PathlossReferenceSignal) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *PathlossReferenceSignal) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case PathlossReferenceSignalPresentSSB:
+		if err = ie.SSB.encode(w); err != nil {
+			err = utils.WrapError("Encode SSB", err)
+			return
+		}
+	case PathlossReferenceSignalPresentDLPRS:
+		if err = ie.DLPRS.encode(w); err != nil {
+			err = utils.WrapError("Encode DLPRS", err)
+			return
+		}
+	case PathlossReferenceSignalPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PathlossReferenceSignal) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PathlossReferenceSignal) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case PathlossReferenceSignalPresentSSB:
+		tmp := new(SSB)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SSB", err)
+			return
+		}
+		ie.SSB = tmp
+	case PathlossReferenceSignalPresentDLPRS:
+		tmp := new(DLPRS)
+		if err = tmp.decode(r); err != nil {
+			err = readError("DLPRS", err)
+			return
+		}
+		ie.DLPRS = tmp
+	case PathlossReferenceSignalPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *PathlossReferenceSignal) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case PathlossReferenceSignalPresentSSB:
+		if ie.SSB == nil {
+			v.absent("SSB")
+		} else {
+			v.ie("SSB", ie.SSB)
+		}
+	case PathlossReferenceSignalPresentDLPRS:
+		if ie.DLPRS == nil {
+			v.absent("DLPRS")
+		} else {
+			v.ie("DLPRS", ie.DLPRS)
+		}
+	case PathlossReferenceSignalPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
@@ -0,0 +1,77 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type PeriodicityListItem struct {
+	PeriodicitySRS PeriodicitySRS `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[PeriodicityListItem] `optional,extension`
+}
+
+func (ie *PeriodicityListItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *PeriodicityListItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.PeriodicitySRS.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PeriodicitySRS", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PeriodicityListItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PeriodicityListItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.PeriodicitySRS.Decode(r.AperReader); err != nil {
+		err = readError("PeriodicitySRS", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *PeriodicityListItem) Validate() error {
+	var v validator
+	v.ie("PeriodicitySRS", &ie.PeriodicitySRS)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
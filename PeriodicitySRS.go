@@ -0,0 +1,59 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+const (
+	PeriodicitySRSMs0dot125 aper.Enumerated = 0
+	PeriodicitySRSMs0dot25  aper.Enumerated = 1
+	PeriodicitySRSMs0dot5   aper.Enumerated = 2
+	PeriodicitySRSMs0dot625 aper.Enumerated = 3
+	PeriodicitySRSMs1       aper.Enumerated = 4
+	PeriodicitySRSMs1dot25  aper.Enumerated = 5
+	PeriodicitySRSMs2       aper.Enumerated = 6
+	PeriodicitySRSMs2dot5   aper.Enumerated = 7
+	PeriodicitySRSMs4       aper.Enumerated = 8
+	PeriodicitySRSMs5       aper.Enumerated = 9
+	PeriodicitySRSMs8       aper.Enumerated = 10
+	PeriodicitySRSMs10      aper.Enumerated = 11
+	PeriodicitySRSMs16      aper.Enumerated = 12
+	PeriodicitySRSMs20      aper.Enumerated = 13
+	PeriodicitySRSMs32      aper.Enumerated = 14
+	PeriodicitySRSMs40      aper.Enumerated = 15
+	PeriodicitySRSMs64      aper.Enumerated = 16
+	PeriodicitySRSMs80      aper.Enumerated = 17
+	PeriodicitySRSMs160     aper.Enumerated = 18
+	PeriodicitySRSMs320     aper.Enumerated = 19
+	PeriodicitySRSMs640     aper.Enumerated = 20
+	PeriodicitySRSMs1280    aper.Enumerated = 21
+	PeriodicitySRSMs2560    aper.Enumerated = 22
+	PeriodicitySRSMs5120    aper.Enumerated = 23
+	PeriodicitySRSMs10240   aper.Enumerated = 24
+)
+
+type PeriodicitySRS struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:24,valueExt"`
+}
+
+func (ie *PeriodicitySRS) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 24}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *PeriodicitySRS) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 24}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *PeriodicitySRS) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 25)
+	return v.err()
+}
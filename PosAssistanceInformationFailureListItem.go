@@ -0,0 +1,87 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type PosAssistanceInformationFailureListItem struct {
+	PosSIBType PosSIBType `mandatory`
+	Outcome    Outcome    `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[PosAssistanceInformationFailureListItem] `optional,extension`
+}
+
+func (ie *PosAssistanceInformationFailureListItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *PosAssistanceInformationFailureListItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.PosSIBType.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PosSIBType", err)
+		return
+	}
+	if err = ie.Outcome.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Outcome", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PosAssistanceInformationFailureListItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PosAssistanceInformationFailureListItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.PosSIBType.Decode(r.AperReader); err != nil {
+		err = readError("PosSIBType", err)
+		return
+	}
+	if err = ie.Outcome.Decode(r.AperReader); err != nil {
+		err = readError("Outcome", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *PosAssistanceInformationFailureListItem) Validate() error {
+	var v validator
+	v.ie("PosSIBType", &ie.PosSIBType)
+	v.ie("Outcome", &ie.Outcome)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,39 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+const (
+	PosBroadcastStart aper.Enumerated = 0
+	PosBroadcastStop  aper.Enumerated = 1
+)
+
+// start or stop broadcasting the posSIBs of PositioningAssistanceInformationControl;
+// a control message carrying PosAssistanceInformation without PosBroadcast
+// updates the posSIBs already being broadcast
+type PosBroadcast struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:1,valueExt"`
+}
+
+func (ie *PosBroadcast) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 1}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *PosBroadcast) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 1}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *PosBroadcast) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 2)
+	return v.err()
+}
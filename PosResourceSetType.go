@@ -0,0 +1,127 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	PosResourceSetTypePresentNothing uint64 = iota
+	PosResourceSetTypePresentPeriodic
+	PosResourceSetTypePresentSemiPersistent
+	PosResourceSetTypePresentAperiodic
+	PosResourceSetTypePresentChoiceExtension
+)
+
+type PosResourceSetType struct {
+	Choice          uint64
+	Periodic        *PosResourceSetTypePR
+	SemiPersistent  *PosResourceSetTypeSP
+	Aperiodic       *PosResourceSetTypeAP
+	ChoiceExtension *RawIE
+}
+
+func (ie *PosResourceSetType) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *PosResourceSetType) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 3, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case PosResourceSetTypePresentPeriodic:
+		if err = ie.Periodic.encode(w); err != nil {
+			err = utils.WrapError("Encode Periodic", err)
+			return
+		}
+	case PosResourceSetTypePresentSemiPersistent:
+		if err = ie.SemiPersistent.encode(w); err != nil {
+			err = utils.WrapError("Encode SemiPersistent", err)
+			return
+		}
+	case PosResourceSetTypePresentAperiodic:
+		if err = ie.Aperiodic.encode(w); err != nil {
+			err = utils.WrapError("Encode Aperiodic", err)
+			return
+		}
+	case PosResourceSetTypePresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PosResourceSetType) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PosResourceSetType) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(3, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case PosResourceSetTypePresentPeriodic:
+		tmp := new(PosResourceSetTypePR)
+		if err = tmp.decode(r); err != nil {
+			err = readError("Periodic", err)
+			return
+		}
+		ie.Periodic = tmp
+	case PosResourceSetTypePresentSemiPersistent:
+		tmp := new(PosResourceSetTypeSP)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SemiPersistent", err)
+			return
+		}
+		ie.SemiPersistent = tmp
+	case PosResourceSetTypePresentAperiodic:
+		tmp := new(PosResourceSetTypeAP)
+		if err = tmp.decode(r); err != nil {
+			err = readError("Aperiodic", err)
+			return
+		}
+		ie.Aperiodic = tmp
+	case PosResourceSetTypePresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *PosResourceSetType) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case PosResourceSetTypePresentPeriodic:
+		if ie.Periodic == nil {
+			v.absent("Periodic")
+		} else {
+			v.ie("Periodic", ie.Periodic)
+		}
+	case PosResourceSetTypePresentSemiPersistent:
+		if ie.SemiPersistent == nil {
+			v.absent("SemiPersistent")
+		} else {
+			v.ie("SemiPersistent", ie.SemiPersistent)
+		}
+	case PosResourceSetTypePresentAperiodic:
+		if ie.Aperiodic == nil {
+			v.absent("Aperiodic")
+		} else {
+			v.ie("Aperiodic", ie.Aperiodic)
+		}
+	case PosResourceSetTypePresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
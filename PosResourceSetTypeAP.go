@@ -0,0 +1,87 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type PosResourceSetTypeAP struct {
+	SRSResourceTriggerList int64 `lb:1,ub:3,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[PosResourceSetTypeAP] `optional,extension`
+}
+
+func (ie *PosResourceSetTypeAP) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *PosResourceSetTypeAP) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_SRSResourceTriggerList := INTEGER{
+		c:     aper.Constraint{Lb: 1, Ub: 3},
+		ext:   false,
+		Value: aper.Integer(ie.SRSResourceTriggerList),
+	}
+	if err = tmp_SRSResourceTriggerList.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SRSResourceTriggerList", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PosResourceSetTypeAP) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PosResourceSetTypeAP) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	tmp_SRSResourceTriggerList := INTEGER{
+		c:   aper.Constraint{Lb: 1, Ub: 3},
+		ext: false,
+	}
+	if err = tmp_SRSResourceTriggerList.Decode(r.AperReader); err != nil {
+		err = readError("SRSResourceTriggerList", err)
+		return
+	}
+	ie.SRSResourceTriggerList = int64(tmp_SRSResourceTriggerList.Value)
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *PosResourceSetTypeAP) Validate() error {
+	var v validator
+	v.integer("SRSResourceTriggerList", ie.SRSResourceTriggerList, 1, 3)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
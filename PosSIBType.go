@@ -0,0 +1,71 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+const (
+	PosSIBTypePosSibType11  aper.Enumerated = 0
+	PosSIBTypePosSibType12  aper.Enumerated = 1
+	PosSIBTypePosSibType13  aper.Enumerated = 2
+	PosSIBTypePosSibType14  aper.Enumerated = 3
+	PosSIBTypePosSibType15  aper.Enumerated = 4
+	PosSIBTypePosSibType16  aper.Enumerated = 5
+	PosSIBTypePosSibType17  aper.Enumerated = 6
+	PosSIBTypePosSibType18  aper.Enumerated = 7
+	PosSIBTypePosSibType21  aper.Enumerated = 8
+	PosSIBTypePosSibType22  aper.Enumerated = 9
+	PosSIBTypePosSibType23  aper.Enumerated = 10
+	PosSIBTypePosSibType24  aper.Enumerated = 11
+	PosSIBTypePosSibType25  aper.Enumerated = 12
+	PosSIBTypePosSibType26  aper.Enumerated = 13
+	PosSIBTypePosSibType27  aper.Enumerated = 14
+	PosSIBTypePosSibType28  aper.Enumerated = 15
+	PosSIBTypePosSibType29  aper.Enumerated = 16
+	PosSIBTypePosSibType210 aper.Enumerated = 17
+	PosSIBTypePosSibType211 aper.Enumerated = 18
+	PosSIBTypePosSibType212 aper.Enumerated = 19
+	PosSIBTypePosSibType213 aper.Enumerated = 20
+	PosSIBTypePosSibType214 aper.Enumerated = 21
+	PosSIBTypePosSibType215 aper.Enumerated = 22
+	PosSIBTypePosSibType216 aper.Enumerated = 23
+	PosSIBTypePosSibType217 aper.Enumerated = 24
+	PosSIBTypePosSibType218 aper.Enumerated = 25
+	PosSIBTypePosSibType219 aper.Enumerated = 26
+	PosSIBTypePosSibType220 aper.Enumerated = 27
+	PosSIBTypePosSibType221 aper.Enumerated = 28
+	PosSIBTypePosSibType222 aper.Enumerated = 29
+	PosSIBTypePosSibType223 aper.Enumerated = 30
+	PosSIBTypePosSibType31  aper.Enumerated = 31
+	PosSIBTypePosSibType41  aper.Enumerated = 32
+	PosSIBTypePosSibType51  aper.Enumerated = 33
+	PosSIBTypePosSibType61  aper.Enumerated = 34
+	PosSIBTypePosSibType62  aper.Enumerated = 35
+	PosSIBTypePosSibType63  aper.Enumerated = 36
+)
+
+type PosSIBType struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:36,valueExt"`
+}
+
+func (ie *PosSIBType) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 36}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *PosSIBType) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 36}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *PosSIBType) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 37)
+	return v.err()
+}
@@ -0,0 +1,284 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type PosSRSResourceItem struct {
+	SrsPosResourceId       SRSPosResourceID                         `mandatory`
+	TransmissionCombPos    TransmissionCombPos                      `mandatory`
+	StartPosition          int64                                    `lb:0,ub:13,mandatory`
+	NrofSymbols            PosSRSResourceItemNrofSymbols            `mandatory`
+	FreqDomainShift        int64                                    `lb:0,ub:268,mandatory`
+	CSRS                   int64                                    `lb:0,ub:63,mandatory`
+	GroupOrSequenceHopping PosSRSResourceItemGroupOrSequenceHopping `mandatory`
+	ResourceTypePos        ResourceTypePos                          `mandatory`
+	SequenceId             int64                                    `lb:0,ub:65535,mandatory`
+	SpatialRelationPos     *SpatialRelationPos                      `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[PosSRSResourceItem] `optional,extension`
+}
+
+func (ie *PosSRSResourceItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *PosSRSResourceItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.SpatialRelationPos != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	if err = ie.SrsPosResourceId.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SrsPosResourceId", err)
+		return
+	}
+	if err = ie.TransmissionCombPos.encode(w); err != nil {
+		err = utils.WrapError("Encode TransmissionCombPos", err)
+		return
+	}
+	tmp_StartPosition := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 13},
+		ext:   false,
+		Value: aper.Integer(ie.StartPosition),
+	}
+	if err = tmp_StartPosition.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode StartPosition", err)
+		return
+	}
+	if err = ie.NrofSymbols.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode NrofSymbols", err)
+		return
+	}
+	tmp_FreqDomainShift := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 268},
+		ext:   false,
+		Value: aper.Integer(ie.FreqDomainShift),
+	}
+	if err = tmp_FreqDomainShift.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode FreqDomainShift", err)
+		return
+	}
+	tmp_CSRS := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 63},
+		ext:   false,
+		Value: aper.Integer(ie.CSRS),
+	}
+	if err = tmp_CSRS.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode CSRS", err)
+		return
+	}
+	if err = ie.GroupOrSequenceHopping.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode GroupOrSequenceHopping", err)
+		return
+	}
+	if err = ie.ResourceTypePos.encode(w); err != nil {
+		err = utils.WrapError("Encode ResourceTypePos", err)
+		return
+	}
+	tmp_SequenceId := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 65535},
+		ext:   false,
+		Value: aper.Integer(ie.SequenceId),
+	}
+	if err = tmp_SequenceId.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SequenceId", err)
+		return
+	}
+	if ie.SpatialRelationPos != nil {
+		if err = ie.SpatialRelationPos.encode(w); err != nil {
+			err = utils.WrapError("Encode SpatialRelationPos", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PosSRSResourceItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PosSRSResourceItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	if err = ie.SrsPosResourceId.Decode(r.AperReader); err != nil {
+		err = readError("SrsPosResourceId", err)
+		return
+	}
+	if err = ie.TransmissionCombPos.decode(r); err != nil {
+		err = readError("TransmissionCombPos", err)
+		return
+	}
+	tmp_StartPosition := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 13},
+		ext: false,
+	}
+	if err = tmp_StartPosition.Decode(r.AperReader); err != nil {
+		err = readError("StartPosition", err)
+		return
+	}
+	ie.StartPosition = int64(tmp_StartPosition.Value)
+	if err = ie.NrofSymbols.Decode(r.AperReader); err != nil {
+		err = readError("NrofSymbols", err)
+		return
+	}
+	tmp_FreqDomainShift := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 268},
+		ext: false,
+	}
+	if err = tmp_FreqDomainShift.Decode(r.AperReader); err != nil {
+		err = readError("FreqDomainShift", err)
+		return
+	}
+	ie.FreqDomainShift = int64(tmp_FreqDomainShift.Value)
+	tmp_CSRS := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 63},
+		ext: false,
+	}
+	if err = tmp_CSRS.Decode(r.AperReader); err != nil {
+		err = readError("CSRS", err)
+		return
+	}
+	ie.CSRS = int64(tmp_CSRS.Value)
+	if err = ie.GroupOrSequenceHopping.Decode(r.AperReader); err != nil {
+		err = readError("GroupOrSequenceHopping", err)
+		return
+	}
+	if err = ie.ResourceTypePos.decode(r); err != nil {
+		err = readError("ResourceTypePos", err)
+		return
+	}
+	tmp_SequenceId := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 65535},
+		ext: false,
+	}
+	if err = tmp_SequenceId.Decode(r.AperReader); err != nil {
+		err = readError("SequenceId", err)
+		return
+	}
+	ie.SequenceId = int64(tmp_SequenceId.Value)
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(SpatialRelationPos)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SpatialRelationPos", err)
+			return
+		}
+		ie.SpatialRelationPos = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *PosSRSResourceItem) Validate() error {
+	var v validator
+	v.ie("SrsPosResourceId", &ie.SrsPosResourceId)
+	v.ie("TransmissionCombPos", &ie.TransmissionCombPos)
+	v.integer("StartPosition", ie.StartPosition, 0, 13)
+	v.ie("NrofSymbols", &ie.NrofSymbols)
+	v.integer("FreqDomainShift", ie.FreqDomainShift, 0, 268)
+	v.integer("CSRS", ie.CSRS, 0, 63)
+	v.ie("GroupOrSequenceHopping", &ie.GroupOrSequenceHopping)
+	v.ie("ResourceTypePos", &ie.ResourceTypePos)
+	v.integer("SequenceId", ie.SequenceId, 0, 65535)
+	if ie.SpatialRelationPos != nil {
+		v.ie("SpatialRelationPos", ie.SpatialRelationPos)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
+
+const (
+	PosSRSResourceItemNrofSymbolsN1  aper.Enumerated = 0
+	PosSRSResourceItemNrofSymbolsN2  aper.Enumerated = 1
+	PosSRSResourceItemNrofSymbolsN4  aper.Enumerated = 2
+	PosSRSResourceItemNrofSymbolsN8  aper.Enumerated = 3
+	PosSRSResourceItemNrofSymbolsN12 aper.Enumerated = 4
+)
+
+type PosSRSResourceItemNrofSymbols struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:4"`
+}
+
+func (ie *PosSRSResourceItemNrofSymbols) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 4}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *PosSRSResourceItemNrofSymbols) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 4}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *PosSRSResourceItemNrofSymbols) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 5)
+	return v.err()
+}
+
+const (
+	PosSRSResourceItemGroupOrSequenceHoppingNeither         aper.Enumerated = 0
+	PosSRSResourceItemGroupOrSequenceHoppingGroupHopping    aper.Enumerated = 1
+	PosSRSResourceItemGroupOrSequenceHoppingSequenceHopping aper.Enumerated = 2
+)
+
+type PosSRSResourceItemGroupOrSequenceHopping struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:2"`
+}
+
+func (ie *PosSRSResourceItemGroupOrSequenceHopping) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 2}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *PosSRSResourceItemGroupOrSequenceHopping) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 2}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *PosSRSResourceItemGroupOrSequenceHopping) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 3)
+	return v.err()
+}
@@ -0,0 +1,122 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type PosSRSResourceSetItem struct {
+	PossrsResourceSetID  int64              `lb:0,ub:15,mandatory`
+	PossRSResourceIDList []SRSPosResourceID `lb:1,ub:maxnoSRSPosResourcePerSet,mandatory`
+	PosresourceSetType   PosResourceSetType `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[PosSRSResourceSetItem] `optional,extension`
+}
+
+func (ie *PosSRSResourceSetItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *PosSRSResourceSetItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_PossrsResourceSetID := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 15},
+		ext:   false,
+		Value: aper.Integer(ie.PossrsResourceSetID),
+	}
+	if err = tmp_PossrsResourceSetID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PossrsResourceSetID", err)
+		return
+	}
+	tmp_PossRSResourceIDList := sequenceOf[*SRSPosResourceID]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoSRSPosResourcePerSet},
+		ext: false,
+	}
+	for k := range ie.PossRSResourceIDList {
+		tmp_PossRSResourceIDList.Value = append(tmp_PossRSResourceIDList.Value, &ie.PossRSResourceIDList[k])
+	}
+	if err = tmp_PossRSResourceIDList.encode(w); err != nil {
+		err = utils.WrapError("Encode PossRSResourceIDList", err)
+		return
+	}
+	if err = ie.PosresourceSetType.encode(w); err != nil {
+		err = utils.WrapError("Encode PosresourceSetType", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PosSRSResourceSetItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PosSRSResourceSetItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	tmp_PossrsResourceSetID := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 15},
+		ext: false,
+	}
+	if err = tmp_PossrsResourceSetID.Decode(r.AperReader); err != nil {
+		err = readError("PossrsResourceSetID", err)
+		return
+	}
+	ie.PossrsResourceSetID = int64(tmp_PossrsResourceSetID.Value)
+	var tmp_PossRSResourceIDList []*SRSPosResourceID
+	if tmp_PossRSResourceIDList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoSRSPosResourcePerSet}, false, func() *SRSPosResourceID { return new(SRSPosResourceID) }); err != nil {
+		err = readError("PossRSResourceIDList", err)
+		return
+	}
+	ie.PossRSResourceIDList = make([]SRSPosResourceID, len(tmp_PossRSResourceIDList))
+	for k, i := range tmp_PossRSResourceIDList {
+		ie.PossRSResourceIDList[k] = *i
+	}
+	if err = ie.PosresourceSetType.decode(r); err != nil {
+		err = readError("PosresourceSetType", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *PosSRSResourceSetItem) Validate() error {
+	var v validator
+	v.integer("PossrsResourceSetID", ie.PossrsResourceSetID, 0, 15)
+	v.size("PossRSResourceIDList", len(ie.PossRSResourceIDList), 1, maxnoSRSPosResourcePerSet)
+	for i := range ie.PossRSResourceIDList {
+		v.item("PossRSResourceIDList", i, &ie.PossRSResourceIDList[i])
+	}
+	v.ie("PosresourceSetType", &ie.PosresourceSetType)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,139 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type PositioningActivationFailure struct {
+	GNBCUUEF1APID          GNBCUUEF1APID           `mandatory,reject`
+	GNBDUUEF1APID          GNBDUUEF1APID           `mandatory,reject`
+	Cause                  Cause                   `mandatory,ignore`
+	CriticalityDiagnostics *CriticalityDiagnostics `optional,ignore`
+	UnknownIEs             []RawIE
+}
+
+func (msg *PositioningActivationFailure) ProcedureCode() int64 {
+	return ProcedureCode_PositioningActivation
+}
+
+func (msg *PositioningActivationFailure) Present() uint8 {
+	return F1apPduUnsuccessfulOutcome
+}
+
+func (msg *PositioningActivationFailure) Criticality() aper.Enumerated {
+	return Criticality_PresentReject
+}
+
+func (msg *PositioningActivationFailure) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("PositioningActivationFailure"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *PositioningActivationFailure) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("PositioningActivationFailure"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *PositioningActivationFailure) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_Cause},
+		Criticality: Criticality{Value: Criticality_PresentIgnore},
+		Value:       &msg.Cause,
+	})
+	if msg.CriticalityDiagnostics != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CriticalityDiagnostics},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.CriticalityDiagnostics,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *PositioningActivationFailure) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *PositioningActivationFailure) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *PositioningActivationFailure) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("PositioningActivationFailure", wire, limits, positioningActivationFailureIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var positioningActivationFailureIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_Cause, Criticality_PresentIgnore, true},
+	{ProtocolIEID_CriticalityDiagnostics, Criticality_PresentIgnore, false},
+}
+
+func (msg *PositioningActivationFailure) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_Cause:
+		var tmp Cause
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("Cause", err)
+			return
+		}
+		msg.Cause = tmp
+
+	case ProtocolIEID_CriticalityDiagnostics:
+		var tmp CriticalityDiagnostics
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("CriticalityDiagnostics", err)
+			return
+		}
+		msg.CriticalityDiagnostics = &tmp
+
+	}
+	return
+}
+
+func (msg *PositioningActivationFailure) Validate() error {
+	var v validator
+	return v.messageErr("PositioningActivationFailure")
+}
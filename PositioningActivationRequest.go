@@ -0,0 +1,143 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type PositioningActivationRequest struct {
+	GNBCUUEF1APID  GNBCUUEF1APID     `mandatory,reject`
+	GNBDUUEF1APID  GNBDUUEF1APID     `mandatory,reject`
+	SRSType        SRSType           `mandatory,reject`
+	ActivationTime *RelativeTime1900 `optional,ignore`
+	UnknownIEs     []RawIE
+}
+
+func (msg *PositioningActivationRequest) ProcedureCode() int64 {
+	return ProcedureCode_PositioningActivation
+}
+
+func (msg *PositioningActivationRequest) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *PositioningActivationRequest) Criticality() aper.Enumerated {
+	return Criticality_PresentReject
+}
+
+func (msg *PositioningActivationRequest) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("PositioningActivationRequest"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *PositioningActivationRequest) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("PositioningActivationRequest"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *PositioningActivationRequest) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_SRSType},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.SRSType,
+	})
+	if msg.ActivationTime != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ActivationTime},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.ActivationTime,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *PositioningActivationRequest) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *PositioningActivationRequest) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *PositioningActivationRequest) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("PositioningActivationRequest", wire, limits, positioningActivationRequestIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var positioningActivationRequestIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_SRSType, Criticality_PresentReject, true},
+	{ProtocolIEID_ActivationTime, Criticality_PresentIgnore, false},
+}
+
+func (msg *PositioningActivationRequest) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_SRSType:
+		var tmp SRSType
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("SRSType", err)
+			return
+		}
+		msg.SRSType = tmp
+
+	case ProtocolIEID_ActivationTime:
+		var tmp RelativeTime1900
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("ActivationTime", err)
+			return
+		}
+		msg.ActivationTime = &tmp
+
+	}
+	return
+}
+
+func (msg *PositioningActivationRequest) Validate() error {
+	var v validator
+	v.ie("SRSType", &msg.SRSType)
+	if msg.ActivationTime != nil {
+		v.ie("ActivationTime", msg.ActivationTime)
+	}
+	return v.messageErr("PositioningActivationRequest")
+}
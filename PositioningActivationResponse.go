@@ -0,0 +1,164 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type PositioningActivationResponse struct {
+	GNBCUUEF1APID          GNBCUUEF1APID           `mandatory,reject`
+	GNBDUUEF1APID          GNBDUUEF1APID           `mandatory,reject`
+	SystemFrameNumber      *SystemFrameNumber      `optional,ignore`
+	SlotNumber             *SlotNumber             `optional,ignore`
+	CriticalityDiagnostics *CriticalityDiagnostics `optional,ignore`
+	UnknownIEs             []RawIE
+}
+
+func (msg *PositioningActivationResponse) ProcedureCode() int64 {
+	return ProcedureCode_PositioningActivation
+}
+
+func (msg *PositioningActivationResponse) Present() uint8 {
+	return F1apPduSuccessfulOutcome
+}
+
+func (msg *PositioningActivationResponse) Criticality() aper.Enumerated {
+	return Criticality_PresentReject
+}
+
+func (msg *PositioningActivationResponse) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("PositioningActivationResponse"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *PositioningActivationResponse) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("PositioningActivationResponse"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *PositioningActivationResponse) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	if msg.SystemFrameNumber != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SystemFrameNumber},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.SystemFrameNumber,
+		})
+	}
+	if msg.SlotNumber != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SlotNumber},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.SlotNumber,
+		})
+	}
+	if msg.CriticalityDiagnostics != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CriticalityDiagnostics},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.CriticalityDiagnostics,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *PositioningActivationResponse) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *PositioningActivationResponse) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *PositioningActivationResponse) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("PositioningActivationResponse", wire, limits, positioningActivationResponseIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var positioningActivationResponseIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_SystemFrameNumber, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SlotNumber, Criticality_PresentIgnore, false},
+	{ProtocolIEID_CriticalityDiagnostics, Criticality_PresentIgnore, false},
+}
+
+func (msg *PositioningActivationResponse) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_SystemFrameNumber:
+		var tmp SystemFrameNumber
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("SystemFrameNumber", err)
+			return
+		}
+		msg.SystemFrameNumber = &tmp
+
+	case ProtocolIEID_SlotNumber:
+		var tmp SlotNumber
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("SlotNumber", err)
+			return
+		}
+		msg.SlotNumber = &tmp
+
+	case ProtocolIEID_CriticalityDiagnostics:
+		var tmp CriticalityDiagnostics
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("CriticalityDiagnostics", err)
+			return
+		}
+		msg.CriticalityDiagnostics = &tmp
+
+	}
+	return
+}
+
+func (msg *PositioningActivationResponse) Validate() error {
+	var v validator
+	if msg.SystemFrameNumber != nil {
+		v.ie("SystemFrameNumber", msg.SystemFrameNumber)
+	}
+	if msg.SlotNumber != nil {
+		v.ie("SlotNumber", msg.SlotNumber)
+	}
+	return v.messageErr("PositioningActivationResponse")
+}
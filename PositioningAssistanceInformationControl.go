@@ -0,0 +1,183 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type PositioningAssistanceInformationControl struct {
+	TransactionID             TransactionID             `mandatory,reject`
+	PosAssistanceInformation  *PosAssistanceInformation `optional,reject`
+	PosBroadcast              *PosBroadcast             `optional,reject`
+	PositioningBroadcastCells []NRCGI                   `optional,reject`
+	RoutingID                 *RoutingID                `optional,reject`
+	UnknownIEs                []RawIE
+}
+
+func (msg *PositioningAssistanceInformationControl) ProcedureCode() int64 {
+	return ProcedureCode_PositioningAssistanceInformationControl
+}
+
+func (msg *PositioningAssistanceInformationControl) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *PositioningAssistanceInformationControl) Criticality() aper.Enumerated {
+	return Criticality_PresentIgnore
+}
+
+func (msg *PositioningAssistanceInformationControl) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("PositioningAssistanceInformationControl"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *PositioningAssistanceInformationControl) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("PositioningAssistanceInformationControl"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *PositioningAssistanceInformationControl) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_TransactionID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.TransactionID,
+	})
+	if msg.PosAssistanceInformation != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_PosAssistanceInformation},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.PosAssistanceInformation,
+		})
+	}
+	if msg.PosBroadcast != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_PosBroadcast},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.PosBroadcast,
+		})
+	}
+	if len(msg.PositioningBroadcastCells) > 0 {
+		tmp_PositioningBroadcastCells := sequenceOf[*NRCGI]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoBcastCell},
+			ext: false,
+		}
+		for k := range msg.PositioningBroadcastCells {
+			tmp_PositioningBroadcastCells.Value = append(tmp_PositioningBroadcastCells.Value, &msg.PositioningBroadcastCells[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_PositioningBroadcastCells},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_PositioningBroadcastCells,
+		})
+	}
+	if msg.RoutingID != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RoutingID},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.RoutingID,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *PositioningAssistanceInformationControl) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *PositioningAssistanceInformationControl) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *PositioningAssistanceInformationControl) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("PositioningAssistanceInformationControl", wire, limits, positioningAssistanceInformationControlIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var positioningAssistanceInformationControlIEs = []messageIE{
+	{ProtocolIEID_TransactionID, Criticality_PresentReject, true},
+	{ProtocolIEID_PosAssistanceInformation, Criticality_PresentReject, false},
+	{ProtocolIEID_PosBroadcast, Criticality_PresentReject, false},
+	{ProtocolIEID_PositioningBroadcastCells, Criticality_PresentReject, false},
+	{ProtocolIEID_RoutingID, Criticality_PresentReject, false},
+}
+
+func (msg *PositioningAssistanceInformationControl) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_TransactionID:
+		var tmp TransactionID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("TransactionID", err)
+			return
+		}
+		msg.TransactionID = tmp
+
+	case ProtocolIEID_PosAssistanceInformation:
+		var tmp PosAssistanceInformation
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("PosAssistanceInformation", err)
+			return
+		}
+		msg.PosAssistanceInformation = &tmp
+
+	case ProtocolIEID_PosBroadcast:
+		var tmp PosBroadcast
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("PosBroadcast", err)
+			return
+		}
+		msg.PosBroadcast = &tmp
+
+	case ProtocolIEID_PositioningBroadcastCells:
+		var tmp []*NRCGI
+		if tmp, err = readList(ieR, aper.Constraint{Lb: 1, Ub: maxnoBcastCell}, false, func() *NRCGI { return new(NRCGI) }); err != nil {
+			err = readError("PositioningBroadcastCells", err)
+			return
+		}
+		msg.PositioningBroadcastCells = make([]NRCGI, len(tmp))
+		for k, i := range tmp {
+			msg.PositioningBroadcastCells[k] = *i
+		}
+
+	case ProtocolIEID_RoutingID:
+		var tmp RoutingID
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("RoutingID", err)
+			return
+		}
+		msg.RoutingID = &tmp
+
+	}
+	return
+}
+
+func (msg *PositioningAssistanceInformationControl) Validate() error {
+	var v validator
+	v.integer("TransactionID", int64(msg.TransactionID.Value), 0, 255)
+	if msg.PosAssistanceInformation != nil {
+		v.ie("PosAssistanceInformation", msg.PosAssistanceInformation)
+	}
+	if msg.PosBroadcast != nil {
+		v.ie("PosBroadcast", msg.PosBroadcast)
+	}
+	if len(msg.PositioningBroadcastCells) > 0 {
+		v.size("PositioningBroadcastCells", len(msg.PositioningBroadcastCells), 1, maxnoBcastCell)
+	}
+	if msg.RoutingID != nil {
+		v.ie("RoutingID", msg.RoutingID)
+	}
+	return v.messageErr("PositioningAssistanceInformationControl")
+}
@@ -0,0 +1,43 @@
+package ies
+
+import (
+	"testing"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+func TestPositioningAssistanceInformationControlWire(t *testing.T) {
+	m := &PositioningAssistanceInformationControl{
+		TransactionID:            TransactionID{Value: 1},
+		PosAssistanceInformation: &PosAssistanceInformation{Value: aper.OctetString{0xde, 0xad, 0xbe}},
+		PosBroadcast:             &PosBroadcast{Value: PosBroadcastStart},
+		RoutingID:                &RoutingID{Value: aper.OctetString{0x01}},
+	}
+	want := []byte{
+		0x00, 0x2a, 0x40, 0x1c, // initiatingMessage, positioningAssistanceInformationControl, ignore, length
+		0x00, 0x00, 0x04, // extension bit, 4 IEs
+		0x00, 0x4e, 0x00, 0x02, 0x00, 0x01, // TransactionID 1
+		0x01, 0x88, 0x00, 0x04, 0x03, 0xde, 0xad, 0xbe, // PosAssistanceInformation
+		0x01, 0x89, 0x00, 0x01, 0x00, // PosBroadcast start
+		0x01, 0x8a, 0x00, 0x02, 0x01, 0x01, // RoutingID
+	}
+	checkMessage(t, m, new(PositioningAssistanceInformationControl), want)
+}
+
+func TestPositioningAssistanceInformationFeedbackWire(t *testing.T) {
+	m := &PositioningAssistanceInformationFeedback{
+		TransactionID: TransactionID{Value: 1},
+		PosAssistanceInformationFailureList: []PosAssistanceInformationFailureListItem{{
+			PosSIBType: PosSIBType{Value: PosSIBTypePosSibType13},
+			Outcome:    Outcome{Value: OutcomeFailed},
+		}},
+	}
+	want := []byte{
+		0x00, 0x2b, 0x40, 0x0f, // initiatingMessage, positioningAssistanceInformationFeedback, ignore, length
+		0x00, 0x00, 0x02, // extension bit, 2 IEs
+		0x00, 0x4e, 0x00, 0x02, 0x00, 0x01, // TransactionID 1
+		0x01, 0x8b, 0x00, 0x02, // PosAssistanceInformationFailureList
+		0x00, 0x08, // 1 item, posSibType1-3 failed
+	}
+	checkMessage(t, m, new(PositioningAssistanceInformationFeedback), want)
+}
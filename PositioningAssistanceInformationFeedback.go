@@ -0,0 +1,193 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type PositioningAssistanceInformationFeedback struct {
+	TransactionID                       TransactionID                             `mandatory,reject`
+	PosAssistanceInformationFailureList []PosAssistanceInformationFailureListItem `optional,reject`
+	PositioningBroadcastCells           []NRCGI                                   `optional,reject`
+	RoutingID                           *RoutingID                                `optional,reject`
+	CriticalityDiagnostics              *CriticalityDiagnostics                   `optional,ignore`
+	UnknownIEs                          []RawIE
+}
+
+func (msg *PositioningAssistanceInformationFeedback) ProcedureCode() int64 {
+	return ProcedureCode_PositioningAssistanceInformationFeedback
+}
+
+func (msg *PositioningAssistanceInformationFeedback) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *PositioningAssistanceInformationFeedback) Criticality() aper.Enumerated {
+	return Criticality_PresentIgnore
+}
+
+func (msg *PositioningAssistanceInformationFeedback) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("PositioningAssistanceInformationFeedback"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *PositioningAssistanceInformationFeedback) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("PositioningAssistanceInformationFeedback"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *PositioningAssistanceInformationFeedback) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_TransactionID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.TransactionID,
+	})
+	if len(msg.PosAssistanceInformationFailureList) > 0 {
+		tmp_PosAssistanceInformationFailureList := sequenceOf[*PosAssistanceInformationFailureListItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofAssistInfoFailureListItems},
+			ext: false,
+		}
+		for k := range msg.PosAssistanceInformationFailureList {
+			tmp_PosAssistanceInformationFailureList.Value = append(tmp_PosAssistanceInformationFailureList.Value, &msg.PosAssistanceInformationFailureList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_PosAssistanceInformationFailureList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_PosAssistanceInformationFailureList,
+		})
+	}
+	if len(msg.PositioningBroadcastCells) > 0 {
+		tmp_PositioningBroadcastCells := sequenceOf[*NRCGI]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoBcastCell},
+			ext: false,
+		}
+		for k := range msg.PositioningBroadcastCells {
+			tmp_PositioningBroadcastCells.Value = append(tmp_PositioningBroadcastCells.Value, &msg.PositioningBroadcastCells[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_PositioningBroadcastCells},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_PositioningBroadcastCells,
+		})
+	}
+	if msg.RoutingID != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RoutingID},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.RoutingID,
+		})
+	}
+	if msg.CriticalityDiagnostics != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CriticalityDiagnostics},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.CriticalityDiagnostics,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *PositioningAssistanceInformationFeedback) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *PositioningAssistanceInformationFeedback) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *PositioningAssistanceInformationFeedback) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("PositioningAssistanceInformationFeedback", wire, limits, positioningAssistanceInformationFeedbackIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var positioningAssistanceInformationFeedbackIEs = []messageIE{
+	{ProtocolIEID_TransactionID, Criticality_PresentReject, true},
+	{ProtocolIEID_PosAssistanceInformationFailureList, Criticality_PresentReject, false},
+	{ProtocolIEID_PositioningBroadcastCells, Criticality_PresentReject, false},
+	{ProtocolIEID_RoutingID, Criticality_PresentReject, false},
+	{ProtocolIEID_CriticalityDiagnostics, Criticality_PresentIgnore, false},
+}
+
+func (msg *PositioningAssistanceInformationFeedback) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_TransactionID:
+		var tmp TransactionID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("TransactionID", err)
+			return
+		}
+		msg.TransactionID = tmp
+
+	case ProtocolIEID_PosAssistanceInformationFailureList:
+		var tmp []*PosAssistanceInformationFailureListItem
+		if tmp, err = readList(ieR, aper.Constraint{Lb: 1, Ub: maxnoofAssistInfoFailureListItems}, false, func() *PosAssistanceInformationFailureListItem { return new(PosAssistanceInformationFailureListItem) }); err != nil {
+			err = readError("PosAssistanceInformationFailureList", err)
+			return
+		}
+		msg.PosAssistanceInformationFailureList = make([]PosAssistanceInformationFailureListItem, len(tmp))
+		for k, i := range tmp {
+			msg.PosAssistanceInformationFailureList[k] = *i
+		}
+
+	case ProtocolIEID_PositioningBroadcastCells:
+		var tmp []*NRCGI
+		if tmp, err = readList(ieR, aper.Constraint{Lb: 1, Ub: maxnoBcastCell}, false, func() *NRCGI { return new(NRCGI) }); err != nil {
+			err = readError("PositioningBroadcastCells", err)
+			return
+		}
+		msg.PositioningBroadcastCells = make([]NRCGI, len(tmp))
+		for k, i := range tmp {
+			msg.PositioningBroadcastCells[k] = *i
+		}
+
+	case ProtocolIEID_RoutingID:
+		var tmp RoutingID
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("RoutingID", err)
+			return
+		}
+		msg.RoutingID = &tmp
+
+	case ProtocolIEID_CriticalityDiagnostics:
+		var tmp CriticalityDiagnostics
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("CriticalityDiagnostics", err)
+			return
+		}
+		msg.CriticalityDiagnostics = &tmp
+
+	}
+	return
+}
+
+func (msg *PositioningAssistanceInformationFeedback) Validate() error {
+	var v validator
+	v.integer("TransactionID", int64(msg.TransactionID.Value), 0, 255)
+	if len(msg.PosAssistanceInformationFailureList) > 0 {
+		v.size("PosAssistanceInformationFailureList", len(msg.PosAssistanceInformationFailureList), 1, maxnoofAssistInfoFailureListItems)
+		for i := range msg.PosAssistanceInformationFailureList {
+			v.item("PosAssistanceInformationFailureList", i, &msg.PosAssistanceInformationFailureList[i])
+		}
+	}
+	if len(msg.PositioningBroadcastCells) > 0 {
+		v.size("PositioningBroadcastCells", len(msg.PositioningBroadcastCells), 1, maxnoBcastCell)
+	}
+	if msg.RoutingID != nil {
+		v.ie("RoutingID", msg.RoutingID)
+	}
+	return v.messageErr("PositioningAssistanceInformationFeedback")
+}
@@ -0,0 +1,123 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type PositioningDeactivation struct {
+	GNBCUUEF1APID     GNBCUUEF1APID     `mandatory,reject`
+	GNBDUUEF1APID     GNBDUUEF1APID     `mandatory,reject`
+	AbortTransmission AbortTransmission `mandatory,ignore`
+	UnknownIEs        []RawIE
+}
+
+func (msg *PositioningDeactivation) ProcedureCode() int64 {
+	return ProcedureCode_PositioningDeactivation
+}
+
+func (msg *PositioningDeactivation) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *PositioningDeactivation) Criticality() aper.Enumerated {
+	return Criticality_PresentIgnore
+}
+
+func (msg *PositioningDeactivation) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("PositioningDeactivation"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *PositioningDeactivation) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("PositioningDeactivation"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *PositioningDeactivation) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_AbortTransmission},
+		Criticality: Criticality{Value: Criticality_PresentIgnore},
+		Value:       &msg.AbortTransmission,
+	})
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *PositioningDeactivation) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *PositioningDeactivation) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *PositioningDeactivation) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("PositioningDeactivation", wire, limits, positioningDeactivationIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var positioningDeactivationIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_AbortTransmission, Criticality_PresentIgnore, true},
+}
+
+func (msg *PositioningDeactivation) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_AbortTransmission:
+		var tmp AbortTransmission
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("AbortTransmission", err)
+			return
+		}
+		msg.AbortTransmission = tmp
+
+	}
+	return
+}
+
+func (msg *PositioningDeactivation) Validate() error {
+	var v validator
+	v.ie("AbortTransmission", &msg.AbortTransmission)
+	return v.messageErr("PositioningDeactivation")
+}
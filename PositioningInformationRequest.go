@@ -0,0 +1,127 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type PositioningInformationRequest struct {
+	GNBCUUEF1APID                           GNBCUUEF1APID                            `mandatory,reject`
+	GNBDUUEF1APID                           GNBDUUEF1APID                            `mandatory,reject`
+	RequestedSRSTransmissionCharacteristics *RequestedSRSTransmissionCharacteristics `optional,ignore`
+	UnknownIEs                              []RawIE
+}
+
+func (msg *PositioningInformationRequest) ProcedureCode() int64 {
+	return ProcedureCode_PositioningInformationExchange
+}
+
+func (msg *PositioningInformationRequest) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *PositioningInformationRequest) Criticality() aper.Enumerated {
+	return Criticality_PresentReject
+}
+
+func (msg *PositioningInformationRequest) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("PositioningInformationRequest"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *PositioningInformationRequest) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("PositioningInformationRequest"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *PositioningInformationRequest) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	if msg.RequestedSRSTransmissionCharacteristics != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RequestedSRSTransmissionCharacteristics},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.RequestedSRSTransmissionCharacteristics,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *PositioningInformationRequest) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *PositioningInformationRequest) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *PositioningInformationRequest) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("PositioningInformationRequest", wire, limits, positioningInformationRequestIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var positioningInformationRequestIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_RequestedSRSTransmissionCharacteristics, Criticality_PresentIgnore, false},
+}
+
+func (msg *PositioningInformationRequest) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_RequestedSRSTransmissionCharacteristics:
+		var tmp RequestedSRSTransmissionCharacteristics
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("RequestedSRSTransmissionCharacteristics", err)
+			return
+		}
+		msg.RequestedSRSTransmissionCharacteristics = &tmp
+
+	}
+	return
+}
+
+func (msg *PositioningInformationRequest) Validate() error {
+	var v validator
+	if msg.RequestedSRSTransmissionCharacteristics != nil {
+		v.ie("RequestedSRSTransmissionCharacteristics", msg.RequestedSRSTransmissionCharacteristics)
+	}
+	return v.messageErr("PositioningInformationRequest")
+}
@@ -0,0 +1,164 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type PositioningInformationResponse struct {
+	GNBCUUEF1APID          GNBCUUEF1APID           `mandatory,reject`
+	GNBDUUEF1APID          GNBDUUEF1APID           `mandatory,reject`
+	SRSConfiguration       *SRSConfiguration       `optional,ignore`
+	SFNInitialisationTime  *RelativeTime1900       `optional,ignore`
+	CriticalityDiagnostics *CriticalityDiagnostics `optional,ignore`
+	UnknownIEs             []RawIE
+}
+
+func (msg *PositioningInformationResponse) ProcedureCode() int64 {
+	return ProcedureCode_PositioningInformationExchange
+}
+
+func (msg *PositioningInformationResponse) Present() uint8 {
+	return F1apPduSuccessfulOutcome
+}
+
+func (msg *PositioningInformationResponse) Criticality() aper.Enumerated {
+	return Criticality_PresentReject
+}
+
+func (msg *PositioningInformationResponse) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("PositioningInformationResponse"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *PositioningInformationResponse) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("PositioningInformationResponse"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *PositioningInformationResponse) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	if msg.SRSConfiguration != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SRSConfiguration},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.SRSConfiguration,
+		})
+	}
+	if msg.SFNInitialisationTime != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SFNInitialisationTime},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.SFNInitialisationTime,
+		})
+	}
+	if msg.CriticalityDiagnostics != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CriticalityDiagnostics},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.CriticalityDiagnostics,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *PositioningInformationResponse) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *PositioningInformationResponse) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *PositioningInformationResponse) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("PositioningInformationResponse", wire, limits, positioningInformationResponseIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var positioningInformationResponseIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_SRSConfiguration, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SFNInitialisationTime, Criticality_PresentIgnore, false},
+	{ProtocolIEID_CriticalityDiagnostics, Criticality_PresentIgnore, false},
+}
+
+func (msg *PositioningInformationResponse) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_SRSConfiguration:
+		var tmp SRSConfiguration
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("SRSConfiguration", err)
+			return
+		}
+		msg.SRSConfiguration = &tmp
+
+	case ProtocolIEID_SFNInitialisationTime:
+		var tmp RelativeTime1900
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("SFNInitialisationTime", err)
+			return
+		}
+		msg.SFNInitialisationTime = &tmp
+
+	case ProtocolIEID_CriticalityDiagnostics:
+		var tmp CriticalityDiagnostics
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("CriticalityDiagnostics", err)
+			return
+		}
+		msg.CriticalityDiagnostics = &tmp
+
+	}
+	return
+}
+
+func (msg *PositioningInformationResponse) Validate() error {
+	var v validator
+	if msg.SRSConfiguration != nil {
+		v.ie("SRSConfiguration", msg.SRSConfiguration)
+	}
+	if msg.SFNInitialisationTime != nil {
+		v.ie("SFNInitialisationTime", msg.SFNInitialisationTime)
+	}
+	return v.messageErr("PositioningInformationResponse")
+}
@@ -0,0 +1,147 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type PositioningInformationUpdate struct {
+	GNBCUUEF1APID         GNBCUUEF1APID     `mandatory,reject`
+	GNBDUUEF1APID         GNBDUUEF1APID     `mandatory,reject`
+	SRSConfiguration      *SRSConfiguration `optional,ignore`
+	SFNInitialisationTime *RelativeTime1900 `optional,ignore`
+	UnknownIEs            []RawIE
+}
+
+func (msg *PositioningInformationUpdate) ProcedureCode() int64 {
+	return ProcedureCode_PositioningInformationUpdate
+}
+
+func (msg *PositioningInformationUpdate) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *PositioningInformationUpdate) Criticality() aper.Enumerated {
+	return Criticality_PresentIgnore
+}
+
+func (msg *PositioningInformationUpdate) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("PositioningInformationUpdate"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *PositioningInformationUpdate) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("PositioningInformationUpdate"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *PositioningInformationUpdate) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	if msg.SRSConfiguration != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SRSConfiguration},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.SRSConfiguration,
+		})
+	}
+	if msg.SFNInitialisationTime != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SFNInitialisationTime},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.SFNInitialisationTime,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *PositioningInformationUpdate) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *PositioningInformationUpdate) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *PositioningInformationUpdate) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("PositioningInformationUpdate", wire, limits, positioningInformationUpdateIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var positioningInformationUpdateIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_SRSConfiguration, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SFNInitialisationTime, Criticality_PresentIgnore, false},
+}
+
+func (msg *PositioningInformationUpdate) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_SRSConfiguration:
+		var tmp SRSConfiguration
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("SRSConfiguration", err)
+			return
+		}
+		msg.SRSConfiguration = &tmp
+
+	case ProtocolIEID_SFNInitialisationTime:
+		var tmp RelativeTime1900
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("SFNInitialisationTime", err)
+			return
+		}
+		msg.SFNInitialisationTime = &tmp
+
+	}
+	return
+}
+
+func (msg *PositioningInformationUpdate) Validate() error {
+	var v validator
+	if msg.SRSConfiguration != nil {
+		v.ie("SRSConfiguration", msg.SRSConfiguration)
+	}
+	if msg.SFNInitialisationTime != nil {
+		v.ie("SFNInitialisationTime", msg.SFNInitialisationTime)
+	}
+	return v.messageErr("PositioningInformationUpdate")
+}
@@ -0,0 +1,36 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+const (
+	PreEmptionCapabilityShallnottriggerpreemption aper.Enumerated = 0
+	PreEmptionCapabilityMaytriggerpreemption      aper.Enumerated = 1
+)
+
+type PreEmptionCapability struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:1"`
+}
+
+func (ie *PreEmptionCapability) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 1}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *PreEmptionCapability) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 1}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *PreEmptionCapability) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 2)
+	return v.err()
+}
@@ -0,0 +1,36 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+const (
+	PreEmptionVulnerabilityNotpreemptable aper.Enumerated = 0
+	PreEmptionVulnerabilityPreemptable    aper.Enumerated = 1
+)
+
+type PreEmptionVulnerability struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:1"`
+}
+
+func (ie *PreEmptionVulnerability) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 1}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *PreEmptionVulnerability) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 1}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *PreEmptionVulnerability) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 2)
+	return v.err()
+}
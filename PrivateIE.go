@@ -0,0 +1,164 @@
+package ies
+
+import (
+	"encoding/asn1"
+	"fmt"
+	"sync"
+
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+// represent a PrivateIE-Field in PrivateMessage
+type PrivateIE struct {
+	Id          PrivateIEID // private IE identity
+	Criticality Criticality
+	Value       aper.AperMarshaller // open type, *PrivateIERaw if no codec is registered
+}
+
+func (ie PrivateIE) Encode(w *aper.AperWriter) (err error) {
+	return ie.encode(newWriter(w))
+}
+
+func (ie PrivateIE) encode(w *writer) (err error) {
+	if err = ie.encodeHeader(w.AperWriter); err != nil {
+		return
+	}
+	if raw, ok := ie.Value.(*PrivateIERaw); ok {
+		err = w.WriteOpenType(raw.Value)
+		return
+	}
+	if err = w.writeOpenType(ie.Value); err != nil {
+		err = utils.WrapError("Encode Value", err)
+	}
+	return
+}
+
+// encode the id and criticality of the IE, which must have a value
+func (ie *PrivateIE) encodeHeader(w *aper.AperWriter) (err error) {
+	if ie.Value == nil {
+		err = utils.WrapError("Encode Value", fmt.Errorf("Value is nil"))
+		return
+	}
+	if err = ie.Id.Encode(w); err != nil {
+		err = utils.WrapError("Encode Id", err)
+		return
+	}
+	if err = ie.Criticality.Encode(w); err != nil {
+		err = utils.WrapError("Encode Criticality", err)
+	}
+	return
+}
+
+func (ie *PrivateIE) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PrivateIE) decode(r *decoder) (err error) {
+	var c uint64
+	var buf []byte
+	if err = ie.Id.decode(r); err != nil {
+		err = readError("Id", err)
+		return
+	}
+	if c, err = r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 2}, false); err != nil {
+		err = readError("Criticality", err)
+		return
+	}
+	ie.Criticality.Value = aper.Enumerated(c)
+	if buf, err = readOpenType(r); err != nil {
+		err = readError("Value", err)
+		return
+	}
+	fn := lookupPrivateIE(&ie.Id)
+	if fn == nil {
+		ie.Value = &PrivateIERaw{Value: buf}
+		return
+	}
+	var valueR *decoder
+	if valueR, err = newOpenTypeReader(r, buf); err != nil {
+		err = readError("Value", err)
+		return
+	}
+	tmp := fn()
+	if err = decodeValue(valueR, tmp); err != nil {
+		err = readError("Value", err)
+		return
+	}
+	ie.Value = tmp
+	return
+}
+
+func (ie *PrivateIE) Validate() error {
+	var v validator
+	v.ie("Id", &ie.Id)
+	v.ie("Criticality", &ie.Criticality)
+	v.value("Value", ie.Value)
+	return v.err()
+}
+
+// the undecoded value of a private IE
+type PrivateIERaw struct {
+	Value []byte
+}
+
+func (ie *PrivateIERaw) Encode(w *aper.AperWriter) (err error) {
+	return w.WriteBits(ie.Value, uint(len(ie.Value)*8))
+}
+
+// an undecoded value has no constraints to check
+func (ie *PrivateIERaw) Validate() error {
+	return nil
+}
+
+// a vendor private IE value; PrivateIE.Validate reports a value without
+// Validate method
+type PrivateIEValue interface {
+	Encode(*aper.AperWriter) error
+	Decode(*aper.AperReader) error
+}
+
+var privateIEs = struct {
+	sync.RWMutex
+	local  map[int64]func() PrivateIEValue
+	global map[string]func() PrivateIEValue
+}{
+	local:  make(map[int64]func() PrivateIEValue),
+	global: make(map[string]func() PrivateIEValue),
+}
+
+// RegisterLocalPrivateIE registers the codec of the private IE with a local
+// identity; fn returns a new value to decode into
+func RegisterLocalPrivateIE(id int64, fn func() PrivateIEValue) {
+	privateIEs.Lock()
+	defer privateIEs.Unlock()
+	if fn == nil {
+		delete(privateIEs.local, id)
+		return
+	}
+	privateIEs.local[id] = fn
+}
+
+// RegisterGlobalPrivateIE registers the codec of the private IE with a global
+// (object identifier) identity; fn returns a new value to decode into
+func RegisterGlobalPrivateIE(oid asn1.ObjectIdentifier, fn func() PrivateIEValue) {
+	privateIEs.Lock()
+	defer privateIEs.Unlock()
+	if fn == nil {
+		delete(privateIEs.global, oid.String())
+		return
+	}
+	privateIEs.global[oid.String()] = fn
+}
+
+func lookupPrivateIE(id *PrivateIEID) func() PrivateIEValue {
+	privateIEs.RLock()
+	defer privateIEs.RUnlock()
+	switch id.Choice {
+	case PrivateIEIDPresentLocal:
+		return privateIEs.local[*id.Local]
+	case PrivateIEIDPresentGlobal:
+		return privateIEs.global[id.Global.String()]
+	}
+	return nil
+}
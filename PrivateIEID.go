@@ -0,0 +1,186 @@
+package ies
+
+import (
+	"encoding/asn1"
+	"fmt"
+
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	PrivateIEIDPresentNothing uint64 = iota
+	PrivateIEIDPresentLocal
+	PrivateIEIDPresentGlobal
+)
+
+type PrivateIEID struct {
+	Choice uint64
+	Local  *int64 `lb:0,ub:65535`
+	Global asn1.ObjectIdentifier
+}
+
+func (ie *PrivateIEID) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteChoice(ie.Choice, 1, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case PrivateIEIDPresentLocal:
+		if ie.Local == nil {
+			err = utils.WrapError("Encode Local", fmt.Errorf("Local is nil"))
+			return
+		}
+		tmp_Local := INTEGER{
+			c:     aper.Constraint{Lb: 0, Ub: 65535},
+			ext:   false,
+			Value: aper.Integer(*ie.Local),
+		}
+		if err = tmp_Local.Encode(w); err != nil {
+			err = utils.WrapError("Encode Local", err)
+			return
+		}
+	case PrivateIEIDPresentGlobal:
+		var content []byte
+		if content, err = encodeOID(ie.Global); err != nil {
+			err = utils.WrapError("Encode Global", err)
+			return
+		}
+		// an OBJECT IDENTIFIER is its BER contents octets preceded by an
+		// unconstrained length, same as an unconstrained OCTET STRING
+		if err = w.WriteOctetString(content, nil, false); err != nil {
+			err = utils.WrapError("Encode Global", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *PrivateIEID) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *PrivateIEID) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(1, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case PrivateIEIDPresentLocal:
+		tmp := INTEGER{
+			c:   aper.Constraint{Lb: 0, Ub: 65535},
+			ext: false,
+		}
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("Local", err)
+			return
+		}
+		v := int64(tmp.Value)
+		ie.Local = &v
+	case PrivateIEIDPresentGlobal:
+		var content []byte
+		if content, err = readOctetString(r, nil, false); err != nil {
+			err = readError("Global", err)
+			return
+		}
+		if ie.Global, err = decodeOID(content); err != nil {
+			err = readError("Global", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *PrivateIEID) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case PrivateIEIDPresentLocal:
+		if ie.Local == nil {
+			v.absent("Local")
+		} else {
+			v.integer("Local", *ie.Local, 0, 65535)
+		}
+	case PrivateIEIDPresentGlobal:
+		if _, err := encodeOID(ie.Global); err != nil {
+			v.violation("Global", ie.Global)
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
+
+// encode the BER contents octets of an OBJECT IDENTIFIER
+func encodeOID(oid asn1.ObjectIdentifier) (content []byte, err error) {
+	if len(oid) < 2 || oid[0] > 2 || (oid[0] < 2 && oid[1] >= 40) || oid[1] < 0 {
+		err = fmt.Errorf("invalid object identifier %v", oid)
+		return
+	}
+	content = appendOIDArc(content, oid[0]*40+oid[1])
+	for _, arc := range oid[2:] {
+		if arc < 0 {
+			err = fmt.Errorf("invalid object identifier %v", oid)
+			return
+		}
+		content = appendOIDArc(content, arc)
+	}
+	return
+}
+
+// append an arc in base 128, high bit set on all but the last octet
+func appendOIDArc(dst []byte, arc int) []byte {
+	n := 1
+	for v := arc >> 7; v > 0; v >>= 7 {
+		n++
+	}
+	for i := n - 1; i >= 0; i-- {
+		b := byte(arc>>(7*i)) & 0x7f
+		if i > 0 {
+			b |= 0x80
+		}
+		dst = append(dst, b)
+	}
+	return dst
+}
+
+// decode the BER contents octets of an OBJECT IDENTIFIER
+func decodeOID(content []byte) (oid asn1.ObjectIdentifier, err error) {
+	var arcs []int
+	arc := 0
+	for i, b := range content {
+		if arc == 0 && b == 0x80 {
+			err = fmt.Errorf("non-minimal object identifier arc")
+			return
+		}
+		if arc > (1<<31-1)>>7 {
+			err = fmt.Errorf("object identifier arc too large")
+			return
+		}
+		arc = arc<<7 | int(b&0x7f)
+		if b&0x80 != 0 {
+			if i == len(content)-1 {
+				err = fmt.Errorf("truncated object identifier")
+				return
+			}
+			continue
+		}
+		arcs = append(arcs, arc)
+		arc = 0
+	}
+	if len(arcs) == 0 {
+		err = fmt.Errorf("empty object identifier")
+		return
+	}
+	// the first subidentifier packs the first two arcs
+	first := arcs[0]
+	switch {
+	case first < 40:
+		oid = asn1.ObjectIdentifier{0, first}
+	case first < 80:
+		oid = asn1.ObjectIdentifier{1, first - 40}
+	default:
+		oid = asn1.ObjectIdentifier{2, first - 80}
+	}
+	oid = append(oid, arcs[1:]...)
+	return
+}
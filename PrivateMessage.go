@@ -0,0 +1,114 @@
+package ies
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type PrivateMessage struct {
+	PrivateIEs []PrivateIE `mandatory,ignore`
+}
+
+func (msg *PrivateMessage) ProcedureCode() int64 {
+	return ProcedureCode_PrivateMessage
+}
+
+func (msg *PrivateMessage) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *PrivateMessage) Criticality() aper.Enumerated {
+	return Criticality_PresentIgnore
+}
+
+func (msg *PrivateMessage) Encode(w io.Writer) (err error) {
+	if len(msg.PrivateIEs) == 0 {
+		err = msgErrors(fmt.Errorf("PrivateMessage"), fmt.Errorf("PrivateIEs is nil"))
+		return
+	}
+	return writePdu(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), msg.writeIes)
+}
+
+func (msg *PrivateMessage) AppendEncode(dst []byte) ([]byte, error) {
+	if len(msg.PrivateIEs) == 0 {
+		return dst, msgErrors(fmt.Errorf("PrivateMessage"), fmt.Errorf("PrivateIEs is nil"))
+	}
+	return appendPdu(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), msg.writeIes)
+}
+
+func (msg *PrivateMessage) writeIes(e *encoder) error {
+	return writeContainer(e, msg.PrivateIEs, aper.Constraint{
+		Lb: 1,
+		Ub: maxPrivateIEs,
+	})
+}
+
+func (msg *PrivateMessage) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	_, err = msg.DecodeWithReport(wire)
+	return
+}
+
+func (msg *PrivateMessage) decode(wire []byte, limits DecodeLimits) (err error) {
+	var r *decoder
+	if r, err = newPduDecoder(wire, limits); err != nil {
+		err = messageError("PrivateMessage", err)
+		return
+	}
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		err = messageError("PrivateMessage", readError("PrivateIEs", err))
+		return
+	}
+	n := 0
+	decodeIE := func(*aper.AperReader) (ie *PrivateIE, err error) {
+		n++
+		if err = checkIEs(r, n); err != nil {
+			return
+		}
+		ie = new(PrivateIE)
+		if err = ie.decode(r); err != nil {
+			err = readError("PrivateIE", err)
+		}
+		return
+	}
+	if msg.PrivateIEs, err = aper.ReadSequenceOf[PrivateIE](decodeIE, r.AperReader, &aper.Constraint{Lb: 1, Ub: maxPrivateIEs}, false); err != nil {
+		err = messageError("PrivateMessage", err)
+		return
+	}
+	if extended {
+		if err = skipExtensions(r); err != nil {
+			err = messageError("PrivateMessage", err)
+		}
+	}
+	return
+}
+
+func (msg *PrivateMessage) Validate() error {
+	var v validator
+	v.size("PrivateIEs", len(msg.PrivateIEs), 1, maxPrivateIEs)
+	for i := range msg.PrivateIEs {
+		v.item("PrivateIEs", i, &msg.PrivateIEs[i])
+	}
+	return v.messageErr("PrivateMessage")
+}
+
+// DecodeWithReport decodes the message; private IEs are kept as received, so
+// the report of a decoded message is empty. The report of a message that
+// fails to decode is returned with the error, to reject the message when it
+// exceeds a decode limit.
+func (msg *PrivateMessage) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *PrivateMessage) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	rep := &DecodeReport{Message: "PrivateMessage"}
+	err := msg.decode(wire, limits)
+	var le *LimitError
+	if errors.As(err, &le) {
+		rep.Action = ActionReject
+	}
+	return rep, err
+}
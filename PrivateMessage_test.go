@@ -0,0 +1,117 @@
+package ies
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+// a vendor private IE: INTEGER (0..100)
+type vendorLoad struct {
+	Load int64
+}
+
+func (v *vendorLoad) Encode(w *aper.AperWriter) error {
+	return w.WriteInteger(v.Load, &aper.Constraint{Lb: 0, Ub: 100}, false)
+}
+
+func (v *vendorLoad) Decode(r *aper.AperReader) (err error) {
+	v.Load, err = r.ReadInteger(&aper.Constraint{Lb: 0, Ub: 100}, false)
+	return
+}
+
+func (v *vendorLoad) Validate() error {
+	var vd validator
+	vd.integer("Load", v.Load, 0, 100)
+	return vd.err()
+}
+
+func TestPrivateMessageWire(t *testing.T) {
+	RegisterLocalPrivateIE(7, func() PrivateIEValue { return new(vendorLoad) })
+	defer RegisterLocalPrivateIE(7, nil)
+	local := int64(7)
+	msg := PrivateMessage{PrivateIEs: []PrivateIE{{
+		Id:          PrivateIEID{Choice: PrivateIEIDPresentLocal, Local: &local},
+		Criticality: Criticality{Value: Criticality_PresentIgnore},
+		Value:       &vendorLoad{Load: 42},
+	}, {
+		Id:          PrivateIEID{Choice: PrivateIEIDPresentGlobal, Global: asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 99999, 300}},
+		Criticality: Criticality{Value: Criticality_PresentIgnore},
+		Value:       &PrivateIERaw{Value: []byte{1, 2, 3}},
+	}}}
+	want := []byte{
+		0x00, 0x0e, 0x40, 0x1a, // initiatingMessage, privateMessage, ignore, length
+		0x00, 0x00, 0x01, // extension bit, 2 IEs
+		0x00, 0x00, 0x07, 0x40, 0x01, 0x54, // local 7, ignore, Load 42
+		0x80, 0x0a, 0x2b, 0x06, 0x01, 0x04, 0x01, 0x86, 0x8d, 0x1f, 0x82, 0x2c, // global 1.3.6.1.4.1.99999.300
+		0x40, 0x03, 0x01, 0x02, 0x03, // ignore, raw value
+	}
+	var buf bytes.Buffer
+	if err := msg.Encode(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Fatalf("encoded %x, want %x", buf.Bytes(), want)
+	}
+	var out PrivateMessage
+	rep, err := out.DecodeWithReport(want[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rep == nil || len(rep.Errors) != 0 {
+		t.Fatalf("report %+v", rep)
+	}
+	if !reflect.DeepEqual(out, msg) {
+		t.Fatalf("decoded %+v, want %+v", out, msg)
+	}
+	if err = out.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPrivateMessageErrors(t *testing.T) {
+	local := int64(7)
+	msg := PrivateMessage{PrivateIEs: []PrivateIE{{
+		Id:          PrivateIEID{Choice: PrivateIEIDPresentLocal, Local: &local},
+		Criticality: Criticality{Value: Criticality_PresentIgnore},
+	}}}
+	var buf bytes.Buffer
+	if err := msg.Encode(&buf); err == nil {
+		t.Fatal("encoded a private IE without value")
+	}
+	if _, err := msg.AppendEncode(nil); err == nil {
+		t.Fatal("appended a private IE without value")
+	}
+	if err := (&PrivateMessage{}).Encode(&buf); err == nil {
+		t.Fatal("encoded a message without private IEs")
+	}
+
+	for _, wire := range [][]byte{nil, {0x00, 0x00}, {0x00, 0x00, 0x00, 0x00, 0x00, 0x07, 0x40, 0x05, 0x54}} {
+		var out PrivateMessage
+		rep, err := out.DecodeWithReport(wire)
+		if !errors.Is(err, ErrTransferSyntax) {
+			t.Fatalf("%x: error %v", wire, err)
+		}
+		if rep == nil || rep.Message != "PrivateMessage" || rep.Action != ActionProceed {
+			t.Fatalf("%x: report %+v along with %v", wire, rep, err)
+		}
+	}
+
+	var out PrivateMessage
+	rep, err := out.DecodeWithLimits([]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x07, 0x40, 0x01, 0x54}, DecodeLimits{MaxPDUSize: 8})
+	if le := limitError(t, err); le.Limit != "MaxPDUSize" || rep == nil || rep.Action != ActionReject {
+		t.Fatalf("report %+v along with %v", rep, err)
+	}
+}
+
+func TestPrivateIEIDWithoutLocal(t *testing.T) {
+	id := PrivateIEID{Choice: PrivateIEIDPresentLocal}
+	var buf bytes.Buffer
+	if err := id.Encode(aper.NewWriter(&buf)); err == nil {
+		t.Fatal("encoded a local private IE id without value")
+	}
+}
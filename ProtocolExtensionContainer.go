@@ -0,0 +1,174 @@
+package ies
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+// ProtocolExtensionContainer holds the extension IEs of an IE of type T that
+// have no typed field in T: values of the extensions registered for T with
+// RegisterExtension, raw values otherwise. The typed extension fields of T are
+// encoded in the same container, among Items.
+type ProtocolExtensionContainer[T any] struct {
+	Items []ProtocolExtensionField
+}
+
+// an extension IE of a ProtocolExtensionContainer
+type ProtocolExtensionField struct {
+	Id          aper.Integer
+	Criticality aper.Enumerated
+	Value       aper.AperMarshaller // open type, *ExtensionRaw if no codec is registered
+	Position    int                 // index in the container, among the typed extension IEs
+}
+
+// the undecoded value of an extension IE
+type ExtensionRaw struct {
+	Value []byte
+}
+
+func (ie *ExtensionRaw) Encode(w *aper.AperWriter) (err error) {
+	return w.WriteBits(ie.Value, uint(len(ie.Value)*8))
+}
+
+// an undecoded value has no constraints to check
+func (ie *ExtensionRaw) Validate() error {
+	return nil
+}
+
+// an extension IE value; ProtocolExtensionContainer.Validate reports a value
+// without Validate method
+type ExtensionValue interface {
+	Encode(*aper.AperWriter) error
+	Decode(*aper.AperReader) error
+}
+
+var extensionIEs = struct {
+	sync.RWMutex
+	types map[reflect.Type]map[aper.Integer]func() ExtensionValue
+}{
+	types: make(map[reflect.Type]map[aper.Integer]func() ExtensionValue),
+}
+
+// RegisterExtension registers the codec of the extension IE id of the IE type
+// T; fn returns a new value to decode into. An extension IE with a typed field
+// in T is always decoded into the field.
+func RegisterExtension[T any](id aper.Integer, fn func() ExtensionValue) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	extensionIEs.Lock()
+	defer extensionIEs.Unlock()
+	if fn == nil {
+		delete(extensionIEs.types[t], id)
+		return
+	}
+	if extensionIEs.types[t] == nil {
+		extensionIEs.types[t] = make(map[aper.Integer]func() ExtensionValue)
+	}
+	extensionIEs.types[t][id] = fn
+}
+
+func lookupExtension[T any](id aper.Integer) func() ExtensionValue {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	extensionIEs.RLock()
+	defer extensionIEs.RUnlock()
+	return extensionIEs.types[t][id]
+}
+
+// Get returns the value of the extension IE id, nil if it is absent
+func (c *ProtocolExtensionContainer[T]) Get(id aper.Integer) aper.AperMarshaller {
+	for _, item := range c.Items {
+		if item.Id == id {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+func (c *ProtocolExtensionContainer[T]) Encode(w *aper.AperWriter) (err error) {
+	return c.encode(newWriter(w))
+}
+
+func (c *ProtocolExtensionContainer[T]) encode(w *writer) (err error) {
+	return writeExtensions(w, c.insert(nil))
+}
+
+// Decode reads a container with the codecs registered for T
+func (c *ProtocolExtensionContainer[T]) Decode(r *aper.AperReader) (err error) {
+	return c.decode(newDecoder(r), nil)
+}
+
+func (c *ProtocolExtensionContainer[T]) Validate() error {
+	var v validator
+	for i := range c.Items {
+		v.value(itemName("Items", i), c.Items[i].Value)
+	}
+	return v.err()
+}
+
+// insert the items at their positions among the typed extension IEs exts; a
+// position beyond the end appends the item
+func (c *ProtocolExtensionContainer[T]) insert(exts []F1apMessageIE) []F1apMessageIE {
+	for _, item := range c.Items {
+		ie := F1apMessageIE{
+			Id:          ProtocolIEID{Value: item.Id},
+			Criticality: Criticality{Value: item.Criticality},
+			Value:       item.Value,
+		}
+		if item.Position >= len(exts) {
+			exts = append(exts, ie)
+			continue
+		}
+		exts = append(exts, F1apMessageIE{})
+		copy(exts[item.Position+1:], exts[item.Position:])
+		exts[item.Position] = ie
+	}
+	return exts
+}
+
+// read a container; decode is called with the id and a reader over the value
+// of each extension IE and tells whether it is a typed extension of T. Other
+// extension IEs are decoded with the registered codecs or kept raw in Items.
+// decode may be nil when T has no typed extension.
+func (c *ProtocolExtensionContainer[T]) decode(r *decoder, decode func(aper.Integer, *decoder) (bool, error)) (err error) {
+	c.Items = nil
+	pos := 0
+	decodeItem := func(*aper.AperReader) (ie *F1apMessageIE, err error) {
+		var buf []byte
+		if ie, buf, err = readContainerIE(r, pos+1); err != nil {
+			return
+		}
+		var ieR *decoder
+		if ieR, err = newOpenTypeReader(r, buf); err != nil {
+			return
+		}
+		ok := false
+		if decode != nil {
+			if ok, err = decode(ie.Id.Value, ieR); err != nil {
+				return
+			}
+		}
+		if !ok {
+			item := ProtocolExtensionField{
+				Id:          ie.Id.Value,
+				Criticality: ie.Criticality.Value,
+				Position:    pos,
+			}
+			if fn := lookupExtension[T](ie.Id.Value); fn != nil {
+				tmp := fn()
+				if err = decodeValue(ieR, tmp); err != nil {
+					err = readError("Value", err)
+					return
+				}
+				item.Value = tmp
+			} else {
+				item.Value = &ExtensionRaw{Value: buf}
+			}
+			c.Items = append(c.Items, item)
+		}
+		pos++
+		return
+	}
+	_, err = aper.ReadSequenceOf[F1apMessageIE](decodeItem, r.AperReader, &aper.Constraint{Lb: 1, Ub: maxProtocolExtensions}, false)
+	return
+}
@@ -0,0 +1,103 @@
+package ies
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+// a vendor extension IE: INTEGER (0..255)
+type vendorExtension struct {
+	V int64
+}
+
+func (e *vendorExtension) Encode(w *aper.AperWriter) error {
+	return w.WriteInteger(e.V, &aper.Constraint{Lb: 0, Ub: 255}, false)
+}
+
+func (e *vendorExtension) Decode(r *aper.AperReader) (err error) {
+	e.V, err = r.ReadInteger(&aper.Constraint{Lb: 0, Ub: 255}, false)
+	return
+}
+
+func encodeIE(t *testing.T, v aper.AperMarshaller) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := aper.NewWriter(&buf)
+	if err := v.Encode(w); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// NRFreqInfo with the extension IE 7777 before its typed extension
+// FrequencyShift7p5khz
+func freqInfoWire(value ...byte) []byte {
+	wire := []byte{
+		0x20, 0x05, // no SUL information, extensions, NR ARFCN 5
+		0x00, 0x00, 0x4d, 0x00, // band n78 without SUL bands
+		0x00, 0x01, // 2 extension IEs
+		0x1e, 0x61, 0x40, byte(len(value)), // id 7777, ignore
+	}
+	wire = append(wire, value...)
+	return append(wire, 0x01, 0x64, 0x40, 0x01, 0x00) // FrequencyShift7p5khz false
+}
+
+func TestExtensionContainerRaw(t *testing.T) {
+	in := NRFreqInfo{
+		NRARFCN:              5,
+		FreqBandListNr:       []FreqBandNrItem{{FreqBandIndicatorNr: 78}},
+		FrequencyShift7p5khz: &FrequencyShift7p5khz{Value: FrequencyShift7p5khzFalse},
+		Extensions: ProtocolExtensionContainer[NRFreqInfo]{Items: []ProtocolExtensionField{{
+			Id:          7777,
+			Criticality: Criticality_PresentIgnore,
+			Value:       &ExtensionRaw{Value: []byte{9, 8}},
+			Position:    0,
+		}}},
+	}
+	want := freqInfoWire(9, 8)
+	if got := encodeIE(t, &in); !bytes.Equal(got, want) {
+		t.Fatalf("encoded %x, want %x", got, want)
+	}
+	var out NRFreqInfo
+	if err := DecodeValue(want, &out); err != nil {
+		t.Fatal(err)
+	}
+	out.FreqBandListNr[0].SupportedSULBandList = nil
+	if !reflect.DeepEqual(out, in) {
+		t.Fatalf("decoded %+v, want %+v", out, in)
+	}
+}
+
+func TestExtensionContainerRegistered(t *testing.T) {
+	RegisterExtension[NRFreqInfo](7777, func() ExtensionValue { return new(vendorExtension) })
+	defer RegisterExtension[NRFreqInfo](7777, nil)
+	wire := freqInfoWire(0x2a)
+	var out NRFreqInfo
+	if err := DecodeValue(wire, &out); err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Extensions.Items) != 1 || out.Extensions.Items[0].Position != 0 || out.FrequencyShift7p5khz == nil {
+		t.Fatalf("decoded %+v", out)
+	}
+	if v, ok := out.Extensions.Get(7777).(*vendorExtension); !ok || v.V != 42 {
+		t.Fatalf("extension 7777 decoded as %#v", out.Extensions.Get(7777))
+	}
+	if got := encodeIE(t, &out); !bytes.Equal(got, wire) {
+		t.Fatalf("encoded %x, want %x", got, wire)
+	}
+
+	// the codec is registered for NRFreqInfo only
+	var items ProtocolExtensionContainer[FreqBandNrItem]
+	if err := DecodeValue(wire[6:], &items); err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := items.Get(7777).(*ExtensionRaw); !ok || !bytes.Equal(v.Value, []byte{0x2a}) {
+		t.Fatalf("extension 7777 decoded as %#v", items.Get(7777))
+	}
+}
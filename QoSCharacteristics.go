@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	QoSCharacteristicsPresentNothing uint64 = iota
+	QoSCharacteristicsPresentNonDynamic5QI
+	QoSCharacteristicsPresentDynamic5QI
+	QoSCharacteristicsPresentChoiceExtension
+)
+
+type QoSCharacteristics struct {
+	Choice          uint64
+	NonDynamic5QI   *NonDynamic5QIDescriptor
+	Dynamic5QI      *Dynamic5QIDescriptor
+	ChoiceExtension *RawIE
+}
+
+func (ie *QoSCharacteristics) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *QoSCharacteristics) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case QoSCharacteristicsPresentNonDynamic5QI:
+		if err = ie.NonDynamic5QI.encode(w); err != nil {
+			err = utils.WrapError("Encode NonDynamic5QI", err)
+			return
+		}
+	case QoSCharacteristicsPresentDynamic5QI:
+		if err = ie.Dynamic5QI.encode(w); err != nil {
+			err = utils.WrapError("Encode Dynamic5QI", err)
+			return
+		}
+	case QoSCharacteristicsPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *QoSCharacteristics) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *QoSCharacteristics) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case QoSCharacteristicsPresentNonDynamic5QI:
+		tmp := new(NonDynamic5QIDescriptor)
+		if err = tmp.decode(r); err != nil {
+			err = readError("NonDynamic5QI", err)
+			return
+		}
+		ie.NonDynamic5QI = tmp
+	case QoSCharacteristicsPresentDynamic5QI:
+		tmp := new(Dynamic5QIDescriptor)
+		if err = tmp.decode(r); err != nil {
+			err = readError("Dynamic5QI", err)
+			return
+		}
+		ie.Dynamic5QI = tmp
+	case QoSCharacteristicsPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *QoSCharacteristics) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case QoSCharacteristicsPresentNonDynamic5QI:
+		if ie.NonDynamic5QI == nil {
+			v.absent("NonDynamic5QI")
+		} else {
+			v.ie("NonDynamic5QI", ie.NonDynamic5QI)
+		}
+	case QoSCharacteristicsPresentDynamic5QI:
+		if ie.Dynamic5QI == nil {
+			v.absent("Dynamic5QI")
+		} else {
+			v.ie("Dynamic5QI", ie.Dynamic5QI)
+		}
+	case QoSCharacteristicsPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
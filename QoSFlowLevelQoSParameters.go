@@ -0,0 +1,205 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type QoSFlowLevelQoSParameters struct {
+	QoSCharacteristics               QoSCharacteristics                  `mandatory`
+	NGRANallocationRetentionPriority NGRANAllocationAndRetentionPriority `mandatory`
+	GBRQoSFlowInformation            *GBRQoSFlowInformation              `optional`
+	ReflectiveQoSAttribute           *ReflectiveQoSAttribute             `optional`
+	// IEExtensions
+	PDUSessionID                        *PDUSessionID                                         `optional,ignore,extension`
+	ULPDUSessionAggregateMaximumBitRate *BitRate                                              `optional,ignore,extension`
+	QosMonitoringRequest                *QosMonitoringRequest                                 `optional,ignore,extension`
+	Extensions                          ProtocolExtensionContainer[QoSFlowLevelQoSParameters] `optional,extension`
+}
+
+func (ie *QoSFlowLevelQoSParameters) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *QoSFlowLevelQoSParameters) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(ie.extensions())
+	optionals := []byte{0x0}
+	if ie.GBRQoSFlowInformation != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if ie.ReflectiveQoSAttribute != nil {
+		aper.SetBit(optionals, 2)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 3)
+	}
+	if err = w.WriteBits(optionals, 3); err != nil {
+		return
+	}
+	if err = ie.QoSCharacteristics.encode(w); err != nil {
+		err = utils.WrapError("Encode QoSCharacteristics", err)
+		return
+	}
+	if err = ie.NGRANallocationRetentionPriority.encode(w); err != nil {
+		err = utils.WrapError("Encode NGRANallocationRetentionPriority", err)
+		return
+	}
+	if ie.GBRQoSFlowInformation != nil {
+		if err = ie.GBRQoSFlowInformation.encode(w); err != nil {
+			err = utils.WrapError("Encode GBRQoSFlowInformation", err)
+			return
+		}
+	}
+	if ie.ReflectiveQoSAttribute != nil {
+		if err = ie.ReflectiveQoSAttribute.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode ReflectiveQoSAttribute", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *QoSFlowLevelQoSParameters) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *QoSFlowLevelQoSParameters) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(3); err != nil {
+		return
+	}
+	if err = ie.QoSCharacteristics.decode(r); err != nil {
+		err = readError("QoSCharacteristics", err)
+		return
+	}
+	if err = ie.NGRANallocationRetentionPriority.decode(r); err != nil {
+		err = readError("NGRANallocationRetentionPriority", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(GBRQoSFlowInformation)
+		if err = tmp.decode(r); err != nil {
+			err = readError("GBRQoSFlowInformation", err)
+			return
+		}
+		ie.GBRQoSFlowInformation = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		tmp := new(ReflectiveQoSAttribute)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("ReflectiveQoSAttribute", err)
+			return
+		}
+		ie.ReflectiveQoSAttribute = tmp
+	}
+	if aper.IsBitSet(optionals, 3) {
+		if err = ie.Extensions.decode(r, ie.decodeExtension); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *QoSFlowLevelQoSParameters) extensions() (exts []F1apMessageIE) {
+	if ie.PDUSessionID != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_PDUSessionID},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.PDUSessionID,
+		})
+	}
+	if ie.ULPDUSessionAggregateMaximumBitRate != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ULPDUSessionAggregateMaximumBitRate},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.ULPDUSessionAggregateMaximumBitRate,
+		})
+	}
+	if ie.QosMonitoringRequest != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_QosMonitoringRequest},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.QosMonitoringRequest,
+		})
+	}
+	return
+}
+
+func (ie *QoSFlowLevelQoSParameters) decodeExtension(id aper.Integer, r *decoder) (ok bool, err error) {
+	ok = true
+	switch id {
+	case ProtocolIEID_PDUSessionID:
+		tmp := new(PDUSessionID)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("PDUSessionID", err)
+			return
+		}
+		ie.PDUSessionID = tmp
+	case ProtocolIEID_ULPDUSessionAggregateMaximumBitRate:
+		tmp := new(BitRate)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("ULPDUSessionAggregateMaximumBitRate", err)
+			return
+		}
+		ie.ULPDUSessionAggregateMaximumBitRate = tmp
+	case ProtocolIEID_QosMonitoringRequest:
+		tmp := new(QosMonitoringRequest)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("QosMonitoringRequest", err)
+			return
+		}
+		ie.QosMonitoringRequest = tmp
+	default:
+		ok = false
+	}
+	return
+}
+
+func (ie *QoSFlowLevelQoSParameters) Validate() error {
+	var v validator
+	v.ie("QoSCharacteristics", &ie.QoSCharacteristics)
+	v.ie("NGRANallocationRetentionPriority", &ie.NGRANallocationRetentionPriority)
+	if ie.GBRQoSFlowInformation != nil {
+		v.ie("GBRQoSFlowInformation", ie.GBRQoSFlowInformation)
+	}
+	if ie.ReflectiveQoSAttribute != nil {
+		v.ie("ReflectiveQoSAttribute", ie.ReflectiveQoSAttribute)
+	}
+	if ie.PDUSessionID != nil {
+		v.ie("PDUSessionID", ie.PDUSessionID)
+	}
+	if ie.ULPDUSessionAggregateMaximumBitRate != nil {
+		v.ie("ULPDUSessionAggregateMaximumBitRate", ie.ULPDUSessionAggregateMaximumBitRate)
+	}
+	if ie.QosMonitoringRequest != nil {
+		v.ie("QosMonitoringRequest", ie.QosMonitoringRequest)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	// Delay Critical and Averaging Window are present for a GBR QoS flow (C-ifGBRflow)
+	if c := ie.QoSCharacteristics.Dynamic5QI; ie.GBRQoSFlowInformation != nil && c != nil {
+		if c.DelayCritical == nil {
+			v.absent("DelayCritical", "QoSCharacteristics", "Dynamic5QI")
+		}
+		if c.AveragingWindow == nil {
+			v.absent("AveragingWindow", "QoSCharacteristics", "Dynamic5QI")
+		}
+	}
+	return v.err()
+}
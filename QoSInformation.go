@@ -0,0 +1,112 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	QoSInformationPresentNothing uint64 = iota
+	QoSInformationPresentEUTRANQoS
+	QoSInformationPresentDRBInformation
+)
+
+type QoSInformation struct {
+	Choice          uint64
+	EUTRANQoS       *EUTRANQoS
+	DRBInformation  *DRBInformation
+	ChoiceExtension *RawIE
+}
+
+func (ie *QoSInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *QoSInformation) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 1, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case QoSInformationPresentEUTRANQoS:
+		if err = ie.EUTRANQoS.encode(w); err != nil {
+			err = utils.WrapError("Encode EUTRANQoS", err)
+			return
+		}
+	case QoSInformationPresentDRBInformation:
+		if ie.DRBInformation == nil {
+			if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+				err = utils.WrapError("Encode DRBInformation", err)
+				return
+			}
+			break
+		}
+		tmp_DRBInformation := F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DRBInformation},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.DRBInformation,
+		}
+		if err = tmp_DRBInformation.encode(w); err != nil {
+			err = utils.WrapError("Encode DRBInformation", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *QoSInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *QoSInformation) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(1, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case QoSInformationPresentEUTRANQoS:
+		tmp := new(EUTRANQoS)
+		if err = tmp.decode(r); err != nil {
+			err = readError("EUTRANQoS", err)
+			return
+		}
+		ie.EUTRANQoS = tmp
+	case QoSInformationPresentDRBInformation:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, func(id aper.Integer, r *decoder) (ok bool, err error) {
+			if id != ProtocolIEID_DRBInformation {
+				return
+			}
+			tmp := new(DRBInformation)
+			if err = tmp.decode(r); err != nil {
+				return
+			}
+			ie.DRBInformation = tmp
+			return true, nil
+		}); err != nil {
+			err = readError("DRBInformation", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *QoSInformation) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case QoSInformationPresentEUTRANQoS:
+		if ie.EUTRANQoS == nil {
+			v.absent("EUTRANQoS")
+		} else {
+			v.ie("EUTRANQoS", ie.EUTRANQoS)
+		}
+	case QoSInformationPresentDRBInformation:
+		if ie.DRBInformation != nil {
+			v.ie("DRBInformation", ie.DRBInformation)
+		} else if ie.ChoiceExtension == nil {
+			v.absent("DRBInformation")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
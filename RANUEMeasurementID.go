@@ -0,0 +1,31 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type RANUEMeasurementID struct {
+	Value aper.Integer `aper:"valueLB:1,valueUB:256,valueExt"`
+}
+
+func (ie *RANUEMeasurementID) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteInteger(int64(ie.Value), &aper.Constraint{Lb: 1, Ub: 256}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *RANUEMeasurementID) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadInteger(&aper.Constraint{Lb: 1, Ub: 256}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Integer(v)
+	}
+	return nil
+}
+
+func (ie *RANUEMeasurementID) Validate() error {
+	var v validator
+	v.integer("Value", int64(ie.Value), 1, 256)
+	return v.err()
+}
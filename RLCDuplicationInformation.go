@@ -0,0 +1,113 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type RLCDuplicationInformation struct {
+	RLCDuplicationStateList []RLCDuplicationStateItem `lb:1,ub:maxnoofRLCDuplicationState,mandatory`
+	PrimaryPathIndication   *PrimaryPathIndication    `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[RLCDuplicationInformation] `optional,extension`
+}
+
+func (ie *RLCDuplicationInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *RLCDuplicationInformation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.PrimaryPathIndication != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	tmp_RLCDuplicationStateList := sequenceOf[*RLCDuplicationStateItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofRLCDuplicationState},
+		ext: false,
+	}
+	for k := range ie.RLCDuplicationStateList {
+		tmp_RLCDuplicationStateList.Value = append(tmp_RLCDuplicationStateList.Value, &ie.RLCDuplicationStateList[k])
+	}
+	if err = tmp_RLCDuplicationStateList.encode(w); err != nil {
+		err = utils.WrapError("Encode RLCDuplicationStateList", err)
+		return
+	}
+	if ie.PrimaryPathIndication != nil {
+		if err = ie.PrimaryPathIndication.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode PrimaryPathIndication", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *RLCDuplicationInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *RLCDuplicationInformation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	var tmp_RLCDuplicationStateList []*RLCDuplicationStateItem
+	if tmp_RLCDuplicationStateList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofRLCDuplicationState}, false, func() *RLCDuplicationStateItem { return new(RLCDuplicationStateItem) }); err != nil {
+		err = readError("RLCDuplicationStateList", err)
+		return
+	}
+	ie.RLCDuplicationStateList = make([]RLCDuplicationStateItem, len(tmp_RLCDuplicationStateList))
+	for k, i := range tmp_RLCDuplicationStateList {
+		ie.RLCDuplicationStateList[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(PrimaryPathIndication)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("PrimaryPathIndication", err)
+			return
+		}
+		ie.PrimaryPathIndication = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *RLCDuplicationInformation) Validate() error {
+	var v validator
+	v.size("RLCDuplicationStateList", len(ie.RLCDuplicationStateList), 1, maxnoofRLCDuplicationState)
+	for i := range ie.RLCDuplicationStateList {
+		v.item("RLCDuplicationStateList", i, &ie.RLCDuplicationStateList[i])
+	}
+	if ie.PrimaryPathIndication != nil {
+		v.ie("PrimaryPathIndication", ie.PrimaryPathIndication)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
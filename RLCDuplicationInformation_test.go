@@ -0,0 +1,41 @@
+package ies
+
+import (
+	"testing"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+func TestDRBsRequiredToBeModifiedItemDuplicationWire(t *testing.T) {
+	in := DRBsRequiredToBeModifiedItem{
+		DRBID: DRBID{Value: 4},
+		DLUPTNLInformationToBeSetupList: []DLUPTNLInformationToBeSetupItem{{
+			DLUPTNLInformation: UPTransportLayerInformation{
+				Choice: UPTransportLayerInformationPresentGTPTunnel,
+				GTPTunnel: &GTPTunnel{
+					TransportLayerAddress: TransportLayerAddress{Value: aper.BitString{Bytes: []byte{10, 0, 0, 1}, NumBits: 32}},
+					GTPTEID:               GTPTEID{Value: aper.OctetString{0, 0, 0, 1}},
+				},
+			},
+		}},
+		RLCDuplicationInformation: &RLCDuplicationInformation{
+			RLCDuplicationStateList: []RLCDuplicationStateItem{
+				{DuplicationState: DuplicationState{Value: DuplicationStateActive}},
+				{DuplicationState: DuplicationState{Value: DuplicationStateInactive}},
+			},
+			PrimaryPathIndication: &PrimaryPathIndication{Value: PrimaryPathIndicationTrue},
+		},
+		AdditionalDuplicationIndication: &AdditionalDuplicationIndication{Value: AdditionalDuplicationIndicationThree},
+	}
+	want := []byte{
+		0x43,       // extensions present, DRB 4
+		0x00, 0x3e, // 1 tunnel, GTP tunnel, address of 32 bits
+		0x0a, 0x00, 0x00, 0x01, // 10.0.0.1
+		0x00, 0x00, 0x00, 0x01, // GTP TEID
+		0x00, 0x01, // 2 extensions
+		0x01, 0x73, 0x40, 0x02, // RLCDuplicationInformation
+		0x48, 0x08, // 2 RLC entities, active and inactive, primary path
+		0x01, 0x74, 0x40, 0x01, 0x00, // AdditionalDuplicationIndication three
+	}
+	checkIE(t, &in, new(DRBsRequiredToBeModifiedItem), want)
+}
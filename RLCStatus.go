@@ -0,0 +1,77 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type RLCStatus struct {
+	ReestablishmentIndication ReestablishmentIndication `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[RLCStatus] `optional,extension`
+}
+
+func (ie *RLCStatus) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *RLCStatus) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.ReestablishmentIndication.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode ReestablishmentIndication", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *RLCStatus) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *RLCStatus) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.ReestablishmentIndication.Decode(r.AperReader); err != nil {
+		err = readError("ReestablishmentIndication", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *RLCStatus) Validate() error {
+	var v validator
+	v.ie("ReestablishmentIndication", &ie.ReestablishmentIndication)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
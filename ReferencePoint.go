@@ -0,0 +1,127 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	ReferencePointPresentNothing uint64 = iota
+	ReferencePointPresentRelativeCoordinateID
+	ReferencePointPresentReferencePointCoordinate
+	ReferencePointPresentReferencePointCoordinateHA
+	ReferencePointPresentChoiceExtension
+)
+
+type ReferencePoint struct {
+	Choice                     uint64
+	RelativeCoordinateID       *CoordinateID
+	ReferencePointCoordinate   *AccessPointPosition
+	ReferencePointCoordinateHA *NGRANHighAccuracyAccessPointPosition
+	ChoiceExtension            *RawIE
+}
+
+func (ie *ReferencePoint) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ReferencePoint) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 3, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case ReferencePointPresentRelativeCoordinateID:
+		if err = ie.RelativeCoordinateID.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode RelativeCoordinateID", err)
+			return
+		}
+	case ReferencePointPresentReferencePointCoordinate:
+		if err = ie.ReferencePointCoordinate.encode(w); err != nil {
+			err = utils.WrapError("Encode ReferencePointCoordinate", err)
+			return
+		}
+	case ReferencePointPresentReferencePointCoordinateHA:
+		if err = ie.ReferencePointCoordinateHA.encode(w); err != nil {
+			err = utils.WrapError("Encode ReferencePointCoordinateHA", err)
+			return
+		}
+	case ReferencePointPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ReferencePoint) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ReferencePoint) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(3, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case ReferencePointPresentRelativeCoordinateID:
+		tmp := new(CoordinateID)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("RelativeCoordinateID", err)
+			return
+		}
+		ie.RelativeCoordinateID = tmp
+	case ReferencePointPresentReferencePointCoordinate:
+		tmp := new(AccessPointPosition)
+		if err = tmp.decode(r); err != nil {
+			err = readError("ReferencePointCoordinate", err)
+			return
+		}
+		ie.ReferencePointCoordinate = tmp
+	case ReferencePointPresentReferencePointCoordinateHA:
+		tmp := new(NGRANHighAccuracyAccessPointPosition)
+		if err = tmp.decode(r); err != nil {
+			err = readError("ReferencePointCoordinateHA", err)
+			return
+		}
+		ie.ReferencePointCoordinateHA = tmp
+	case ReferencePointPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *ReferencePoint) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case ReferencePointPresentRelativeCoordinateID:
+		if ie.RelativeCoordinateID == nil {
+			v.absent("RelativeCoordinateID")
+		} else {
+			v.ie("RelativeCoordinateID", ie.RelativeCoordinateID)
+		}
+	case ReferencePointPresentReferencePointCoordinate:
+		if ie.ReferencePointCoordinate == nil {
+			v.absent("ReferencePointCoordinate")
+		} else {
+			v.ie("ReferencePointCoordinate", ie.ReferencePointCoordinate)
+		}
+	case ReferencePointPresentReferencePointCoordinateHA:
+		if ie.ReferencePointCoordinateHA == nil {
+			v.absent("ReferencePointCoordinateHA")
+		} else {
+			v.ie("ReferencePointCoordinateHA", ie.ReferencePointCoordinateHA)
+		}
+	case ReferencePointPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
@@ -0,0 +1,176 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	ReferenceSignalPresentNothing uint64 = iota
+	ReferenceSignalPresentNZPCSIRS
+	ReferenceSignalPresentSSB
+	ReferenceSignalPresentSRS
+	ReferenceSignalPresentPositioningSRS
+	ReferenceSignalPresentDLPRS
+	ReferenceSignalPresentChoiceExtension
+)
+
+type ReferenceSignal struct {
+	Choice          uint64
+	NZPCSIRS        *int64 `lb:0,ub:191`
+	SSB             *SSB
+	SRS             *SRSResourceID
+	PositioningSRS  *SRSPosResourceID
+	DLPRS           *DLPRS
+	ChoiceExtension *RawIE
+}
+
+func (ie *ReferenceSignal) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ReferenceSignal) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 5, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case ReferenceSignalPresentNZPCSIRS:
+		tmp_NZPCSIRS := INTEGER{
+			c:     aper.Constraint{Lb: 0, Ub: 191},
+			ext:   false,
+			Value: aper.Integer(*ie.NZPCSIRS),
+		}
+		if err = tmp_NZPCSIRS.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode NZPCSIRS", err)
+			return
+		}
+	case ReferenceSignalPresentSSB:
+		if err = ie.SSB.encode(w); err != nil {
+			err = utils.WrapError("Encode SSB", err)
+			return
+		}
+	case ReferenceSignalPresentSRS:
+		if err = ie.SRS.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode SRS", err)
+			return
+		}
+	case ReferenceSignalPresentPositioningSRS:
+		if err = ie.PositioningSRS.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode PositioningSRS", err)
+			return
+		}
+	case ReferenceSignalPresentDLPRS:
+		if err = ie.DLPRS.encode(w); err != nil {
+			err = utils.WrapError("Encode DLPRS", err)
+			return
+		}
+	case ReferenceSignalPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ReferenceSignal) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ReferenceSignal) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(5, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case ReferenceSignalPresentNZPCSIRS:
+		tmp_NZPCSIRS := INTEGER{
+			c:   aper.Constraint{Lb: 0, Ub: 191},
+			ext: false,
+		}
+		if err = tmp_NZPCSIRS.Decode(r.AperReader); err != nil {
+			err = readError("NZPCSIRS", err)
+			return
+		}
+		tmp := int64(tmp_NZPCSIRS.Value)
+		ie.NZPCSIRS = &tmp
+	case ReferenceSignalPresentSSB:
+		tmp := new(SSB)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SSB", err)
+			return
+		}
+		ie.SSB = tmp
+	case ReferenceSignalPresentSRS:
+		tmp := new(SRSResourceID)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("SRS", err)
+			return
+		}
+		ie.SRS = tmp
+	case ReferenceSignalPresentPositioningSRS:
+		tmp := new(SRSPosResourceID)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("PositioningSRS", err)
+			return
+		}
+		ie.PositioningSRS = tmp
+	case ReferenceSignalPresentDLPRS:
+		tmp := new(DLPRS)
+		if err = tmp.decode(r); err != nil {
+			err = readError("DLPRS", err)
+			return
+		}
+		ie.DLPRS = tmp
+	case ReferenceSignalPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *ReferenceSignal) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case ReferenceSignalPresentNZPCSIRS:
+		if ie.NZPCSIRS == nil {
+			v.absent("NZPCSIRS")
+		} else {
+			v.integer("NZPCSIRS", *ie.NZPCSIRS, 0, 191)
+		}
+	case ReferenceSignalPresentSSB:
+		if ie.SSB == nil {
+			v.absent("SSB")
+		} else {
+			v.ie("SSB", ie.SSB)
+		}
+	case ReferenceSignalPresentSRS:
+		if ie.SRS == nil {
+			v.absent("SRS")
+		} else {
+			v.ie("SRS", ie.SRS)
+		}
+	case ReferenceSignalPresentPositioningSRS:
+		if ie.PositioningSRS == nil {
+			v.absent("PositioningSRS")
+		} else {
+			v.ie("PositioningSRS", ie.PositioningSRS)
+		}
+	case ReferenceSignalPresentDLPRS:
+		if ie.DLPRS == nil {
+			v.absent("DLPRS")
+		} else {
+			v.ie("DLPRS", ie.DLPRS)
+		}
+	case ReferenceSignalPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
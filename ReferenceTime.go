@@ -0,0 +1,33 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type ReferenceTime struct {
+	Value aper.OctetString
+}
+
+func (ie *ReferenceTime) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteOctetString([]byte(ie.Value), nil, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *ReferenceTime) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ReferenceTime) decode(r *decoder) error {
+	if v, err := readOctetString(r, nil, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.OctetString(v)
+	}
+	return nil
+}
+
+func (ie *ReferenceTime) Validate() error {
+	return nil
+}
@@ -0,0 +1,109 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type ReferenceTimeInformationReport struct {
+	TransactionID            TransactionID            `mandatory,ignore`
+	TimeReferenceInformation TimeReferenceInformation `mandatory,ignore`
+	UnknownIEs               []RawIE
+}
+
+func (msg *ReferenceTimeInformationReport) ProcedureCode() int64 {
+	return ProcedureCode_ReferenceTimeInformationReport
+}
+
+func (msg *ReferenceTimeInformationReport) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *ReferenceTimeInformationReport) Criticality() aper.Enumerated {
+	return Criticality_PresentIgnore
+}
+
+func (msg *ReferenceTimeInformationReport) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("ReferenceTimeInformationReport"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *ReferenceTimeInformationReport) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("ReferenceTimeInformationReport"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *ReferenceTimeInformationReport) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_TransactionID},
+		Criticality: Criticality{Value: Criticality_PresentIgnore},
+		Value:       &msg.TransactionID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_TimeReferenceInformation},
+		Criticality: Criticality{Value: Criticality_PresentIgnore},
+		Value:       &msg.TimeReferenceInformation,
+	})
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *ReferenceTimeInformationReport) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *ReferenceTimeInformationReport) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *ReferenceTimeInformationReport) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("ReferenceTimeInformationReport", wire, limits, referenceTimeInformationReportIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var referenceTimeInformationReportIEs = []messageIE{
+	{ProtocolIEID_TransactionID, Criticality_PresentIgnore, true},
+	{ProtocolIEID_TimeReferenceInformation, Criticality_PresentIgnore, true},
+}
+
+func (msg *ReferenceTimeInformationReport) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_TransactionID:
+		var tmp TransactionID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("TransactionID", err)
+			return
+		}
+		msg.TransactionID = tmp
+
+	case ProtocolIEID_TimeReferenceInformation:
+		var tmp TimeReferenceInformation
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("TimeReferenceInformation", err)
+			return
+		}
+		msg.TimeReferenceInformation = tmp
+
+	}
+	return
+}
+
+func (msg *ReferenceTimeInformationReport) Validate() error {
+	var v validator
+	v.integer("TransactionID", int64(msg.TransactionID.Value), 0, 255)
+	v.ie("TimeReferenceInformation", &msg.TimeReferenceInformation)
+	return v.messageErr("ReferenceTimeInformationReport")
+}
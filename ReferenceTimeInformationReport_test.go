@@ -0,0 +1,48 @@
+package ies
+
+import (
+	"testing"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+func TestReferenceTimeInformationReportingControlWire(t *testing.T) {
+	periodicity := ReportingPeriodicityValue{Value: 100}
+	m := &ReferenceTimeInformationReportingControl{
+		TransactionID: TransactionID{Value: 1},
+		ReportingRequestType: ReportingRequestType{
+			EventType:                 EventType{Value: EventTypePeriodic},
+			ReportingPeriodicityValue: &periodicity,
+		},
+	}
+	want := []byte{
+		0x00, 0x3a, 0x40, 0x10, // initiatingMessage, referenceTimeInformationReportingControl, ignore, length
+		0x00, 0x00, 0x02, // extension bit, 2 IEs
+		0x00, 0x4e, 0x00, 0x02, 0x00, 0x01, // TransactionID 1
+		0x01, 0x6d, 0x00, 0x03, // ReportingRequestType
+		0x44, 0x00, 0x64, // periodic, every 100
+	}
+	checkMessage(t, m, new(ReferenceTimeInformationReportingControl), want)
+}
+
+func TestReferenceTimeInformationReportWire(t *testing.T) {
+	m := &ReferenceTimeInformationReport{
+		TransactionID: TransactionID{Value: 2},
+		TimeReferenceInformation: TimeReferenceInformation{
+			ReferenceTime:       ReferenceTime{Value: aper.OctetString{0x01, 0x02}},
+			ReferenceSFN:        ReferenceSFN{Value: 512},
+			Uncertainty:         Uncertainty{Value: 10},
+			TimeInformationType: TimeInformationType{Value: TimeInformationTypeLocalClock},
+		},
+	}
+	want := []byte{
+		0x00, 0x39, 0x40, 0x16, // initiatingMessage, referenceTimeInformationReport, ignore, length
+		0x00, 0x00, 0x02, // extension bit, 2 IEs
+		0x00, 0x4e, 0x40, 0x02, 0x00, 0x02, // TransactionID 2
+		0x01, 0x6e, 0x40, 0x09, // TimeReferenceInformation
+		0x00, 0x02, 0x01, 0x02, // reference time
+		0x02, 0x00, // SFN 512
+		0x00, 0x00, 0x0a, // uncertainty 10, local clock
+	}
+	checkMessage(t, m, new(ReferenceTimeInformationReport), want)
+}
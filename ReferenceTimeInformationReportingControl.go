@@ -0,0 +1,109 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type ReferenceTimeInformationReportingControl struct {
+	TransactionID        TransactionID        `mandatory,reject`
+	ReportingRequestType ReportingRequestType `mandatory,reject`
+	UnknownIEs           []RawIE
+}
+
+func (msg *ReferenceTimeInformationReportingControl) ProcedureCode() int64 {
+	return ProcedureCode_ReferenceTimeInformationReportingControl
+}
+
+func (msg *ReferenceTimeInformationReportingControl) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *ReferenceTimeInformationReportingControl) Criticality() aper.Enumerated {
+	return Criticality_PresentIgnore
+}
+
+func (msg *ReferenceTimeInformationReportingControl) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("ReferenceTimeInformationReportingControl"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *ReferenceTimeInformationReportingControl) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("ReferenceTimeInformationReportingControl"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *ReferenceTimeInformationReportingControl) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_TransactionID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.TransactionID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_ReportingRequestType},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.ReportingRequestType,
+	})
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *ReferenceTimeInformationReportingControl) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *ReferenceTimeInformationReportingControl) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *ReferenceTimeInformationReportingControl) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("ReferenceTimeInformationReportingControl", wire, limits, referenceTimeInformationReportingControlIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var referenceTimeInformationReportingControlIEs = []messageIE{
+	{ProtocolIEID_TransactionID, Criticality_PresentReject, true},
+	{ProtocolIEID_ReportingRequestType, Criticality_PresentReject, true},
+}
+
+func (msg *ReferenceTimeInformationReportingControl) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_TransactionID:
+		var tmp TransactionID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("TransactionID", err)
+			return
+		}
+		msg.TransactionID = tmp
+
+	case ProtocolIEID_ReportingRequestType:
+		var tmp ReportingRequestType
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("ReportingRequestType", err)
+			return
+		}
+		msg.ReportingRequestType = tmp
+
+	}
+	return
+}
+
+func (msg *ReferenceTimeInformationReportingControl) Validate() error {
+	var v validator
+	v.integer("TransactionID", int64(msg.TransactionID.Value), 0, 255)
+	v.ie("ReportingRequestType", &msg.ReportingRequestType)
+	return v.messageErr("ReferenceTimeInformationReportingControl")
+}
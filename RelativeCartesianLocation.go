@@ -0,0 +1,179 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type RelativeCartesianLocation struct {
+	XYZunit             RelativeCartesianLocationXYZunit `mandatory`
+	Xvalue              int64                            `lb:-65536,ub:65535,mandatory`
+	Yvalue              int64                            `lb:-65536,ub:65535,mandatory`
+	Zvalue              int64                            `lb:-32768,ub:32767,mandatory`
+	LocationUncertainty LocationUncertainty              `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[RelativeCartesianLocation] `optional,extension`
+}
+
+func (ie *RelativeCartesianLocation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *RelativeCartesianLocation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.XYZunit.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode XYZunit", err)
+		return
+	}
+	tmp_Xvalue := INTEGER{
+		c:     aper.Constraint{Lb: -65536, Ub: 65535},
+		ext:   false,
+		Value: aper.Integer(ie.Xvalue),
+	}
+	if err = tmp_Xvalue.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Xvalue", err)
+		return
+	}
+	tmp_Yvalue := INTEGER{
+		c:     aper.Constraint{Lb: -65536, Ub: 65535},
+		ext:   false,
+		Value: aper.Integer(ie.Yvalue),
+	}
+	if err = tmp_Yvalue.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Yvalue", err)
+		return
+	}
+	tmp_Zvalue := INTEGER{
+		c:     aper.Constraint{Lb: -32768, Ub: 32767},
+		ext:   false,
+		Value: aper.Integer(ie.Zvalue),
+	}
+	if err = tmp_Zvalue.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Zvalue", err)
+		return
+	}
+	if err = ie.LocationUncertainty.encode(w); err != nil {
+		err = utils.WrapError("Encode LocationUncertainty", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *RelativeCartesianLocation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *RelativeCartesianLocation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.XYZunit.Decode(r.AperReader); err != nil {
+		err = readError("XYZunit", err)
+		return
+	}
+	tmp_Xvalue := INTEGER{
+		c:   aper.Constraint{Lb: -65536, Ub: 65535},
+		ext: false,
+	}
+	if err = tmp_Xvalue.Decode(r.AperReader); err != nil {
+		err = readError("Xvalue", err)
+		return
+	}
+	ie.Xvalue = int64(tmp_Xvalue.Value)
+	tmp_Yvalue := INTEGER{
+		c:   aper.Constraint{Lb: -65536, Ub: 65535},
+		ext: false,
+	}
+	if err = tmp_Yvalue.Decode(r.AperReader); err != nil {
+		err = readError("Yvalue", err)
+		return
+	}
+	ie.Yvalue = int64(tmp_Yvalue.Value)
+	tmp_Zvalue := INTEGER{
+		c:   aper.Constraint{Lb: -32768, Ub: 32767},
+		ext: false,
+	}
+	if err = tmp_Zvalue.Decode(r.AperReader); err != nil {
+		err = readError("Zvalue", err)
+		return
+	}
+	ie.Zvalue = int64(tmp_Zvalue.Value)
+	if err = ie.LocationUncertainty.decode(r); err != nil {
+		err = readError("LocationUncertainty", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *RelativeCartesianLocation) Validate() error {
+	var v validator
+	v.ie("XYZunit", &ie.XYZunit)
+	v.integer("Xvalue", ie.Xvalue, -65536, 65535)
+	v.integer("Yvalue", ie.Yvalue, -65536, 65535)
+	v.integer("Zvalue", ie.Zvalue, -32768, 32767)
+	v.ie("LocationUncertainty", &ie.LocationUncertainty)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
+
+const (
+	RelativeCartesianLocationXYZunitMm aper.Enumerated = 0
+	RelativeCartesianLocationXYZunitCm aper.Enumerated = 1
+	RelativeCartesianLocationXYZunitDm aper.Enumerated = 2
+)
+
+type RelativeCartesianLocationXYZunit struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:2,valueExt"`
+}
+
+func (ie *RelativeCartesianLocationXYZunit) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 2}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *RelativeCartesianLocationXYZunit) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 2}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *RelativeCartesianLocationXYZunit) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 3)
+	return v.err()
+}
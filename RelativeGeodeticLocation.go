@@ -0,0 +1,221 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type RelativeGeodeticLocation struct {
+	MilliArcSecondUnits RelativeGeodeticLocationMilliArcSecondUnits `mandatory`
+	HeightUnits         RelativeGeodeticLocationHeightUnits         `mandatory`
+	DeltaLatitude       int64                                       `lb:-1024,ub:1023,mandatory`
+	DeltaLongitude      int64                                       `lb:-1024,ub:1023,mandatory`
+	DeltaHeight         int64                                       `lb:-1024,ub:1023,mandatory`
+	LocationUncertainty LocationUncertainty                         `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[RelativeGeodeticLocation] `optional,extension`
+}
+
+func (ie *RelativeGeodeticLocation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *RelativeGeodeticLocation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.MilliArcSecondUnits.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode MilliArcSecondUnits", err)
+		return
+	}
+	if err = ie.HeightUnits.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode HeightUnits", err)
+		return
+	}
+	tmp_DeltaLatitude := INTEGER{
+		c:     aper.Constraint{Lb: -1024, Ub: 1023},
+		ext:   false,
+		Value: aper.Integer(ie.DeltaLatitude),
+	}
+	if err = tmp_DeltaLatitude.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode DeltaLatitude", err)
+		return
+	}
+	tmp_DeltaLongitude := INTEGER{
+		c:     aper.Constraint{Lb: -1024, Ub: 1023},
+		ext:   false,
+		Value: aper.Integer(ie.DeltaLongitude),
+	}
+	if err = tmp_DeltaLongitude.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode DeltaLongitude", err)
+		return
+	}
+	tmp_DeltaHeight := INTEGER{
+		c:     aper.Constraint{Lb: -1024, Ub: 1023},
+		ext:   false,
+		Value: aper.Integer(ie.DeltaHeight),
+	}
+	if err = tmp_DeltaHeight.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode DeltaHeight", err)
+		return
+	}
+	if err = ie.LocationUncertainty.encode(w); err != nil {
+		err = utils.WrapError("Encode LocationUncertainty", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *RelativeGeodeticLocation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *RelativeGeodeticLocation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.MilliArcSecondUnits.Decode(r.AperReader); err != nil {
+		err = readError("MilliArcSecondUnits", err)
+		return
+	}
+	if err = ie.HeightUnits.Decode(r.AperReader); err != nil {
+		err = readError("HeightUnits", err)
+		return
+	}
+	tmp_DeltaLatitude := INTEGER{
+		c:   aper.Constraint{Lb: -1024, Ub: 1023},
+		ext: false,
+	}
+	if err = tmp_DeltaLatitude.Decode(r.AperReader); err != nil {
+		err = readError("DeltaLatitude", err)
+		return
+	}
+	ie.DeltaLatitude = int64(tmp_DeltaLatitude.Value)
+	tmp_DeltaLongitude := INTEGER{
+		c:   aper.Constraint{Lb: -1024, Ub: 1023},
+		ext: false,
+	}
+	if err = tmp_DeltaLongitude.Decode(r.AperReader); err != nil {
+		err = readError("DeltaLongitude", err)
+		return
+	}
+	ie.DeltaLongitude = int64(tmp_DeltaLongitude.Value)
+	tmp_DeltaHeight := INTEGER{
+		c:   aper.Constraint{Lb: -1024, Ub: 1023},
+		ext: false,
+	}
+	if err = tmp_DeltaHeight.Decode(r.AperReader); err != nil {
+		err = readError("DeltaHeight", err)
+		return
+	}
+	ie.DeltaHeight = int64(tmp_DeltaHeight.Value)
+	if err = ie.LocationUncertainty.decode(r); err != nil {
+		err = readError("LocationUncertainty", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *RelativeGeodeticLocation) Validate() error {
+	var v validator
+	v.ie("MilliArcSecondUnits", &ie.MilliArcSecondUnits)
+	v.ie("HeightUnits", &ie.HeightUnits)
+	v.integer("DeltaLatitude", ie.DeltaLatitude, -1024, 1023)
+	v.integer("DeltaLongitude", ie.DeltaLongitude, -1024, 1023)
+	v.integer("DeltaHeight", ie.DeltaHeight, -1024, 1023)
+	v.ie("LocationUncertainty", &ie.LocationUncertainty)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
+
+const (
+	RelativeGeodeticLocationMilliArcSecondUnitsZerodot03 aper.Enumerated = 0
+	RelativeGeodeticLocationMilliArcSecondUnitsZerodot3  aper.Enumerated = 1
+	RelativeGeodeticLocationMilliArcSecondUnitsThree     aper.Enumerated = 2
+)
+
+type RelativeGeodeticLocationMilliArcSecondUnits struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:2,valueExt"`
+}
+
+func (ie *RelativeGeodeticLocationMilliArcSecondUnits) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 2}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *RelativeGeodeticLocationMilliArcSecondUnits) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 2}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *RelativeGeodeticLocationMilliArcSecondUnits) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 3)
+	return v.err()
+}
+
+const (
+	RelativeGeodeticLocationHeightUnitsMm aper.Enumerated = 0
+	RelativeGeodeticLocationHeightUnitsCm aper.Enumerated = 1
+	RelativeGeodeticLocationHeightUnitsM  aper.Enumerated = 2
+)
+
+type RelativeGeodeticLocationHeightUnits struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:2,valueExt"`
+}
+
+func (ie *RelativeGeodeticLocationHeightUnits) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 2}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *RelativeGeodeticLocationHeightUnits) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 2}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *RelativeGeodeticLocationHeightUnits) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 3)
+	return v.err()
+}
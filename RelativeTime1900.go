@@ -0,0 +1,35 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type RelativeTime1900 struct {
+	Value aper.BitString `aper:"sizeLB:64,sizeUB:64"`
+}
+
+func (ie *RelativeTime1900) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteBitString(ie.Value.Bytes, uint(ie.Value.NumBits), &aper.Constraint{Lb: 64, Ub: 64}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *RelativeTime1900) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *RelativeTime1900) decode(r *decoder) error {
+	if v, n, err := readBitString(r, &aper.Constraint{Lb: 64, Ub: 64}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.BitString{Bytes: v, NumBits: uint64(n)}
+	}
+	return nil
+}
+
+func (ie *RelativeTime1900) Validate() error {
+	var v validator
+	v.size("Value", int(ie.Value.NumBits), 64, 64)
+	return v.err()
+}
@@ -0,0 +1,31 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type ReportingPeriodicityValue struct {
+	Value aper.Integer `aper:"valueLB:0,valueUB:512,valueExt"`
+}
+
+func (ie *ReportingPeriodicityValue) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteInteger(int64(ie.Value), &aper.Constraint{Lb: 0, Ub: 512}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *ReportingPeriodicityValue) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadInteger(&aper.Constraint{Lb: 0, Ub: 512}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Integer(v)
+	}
+	return nil
+}
+
+func (ie *ReportingPeriodicityValue) Validate() error {
+	var v validator
+	v.integer("Value", int64(ie.Value), 0, 512)
+	return v.err()
+}
@@ -0,0 +1,98 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type ReportingRequestType struct {
+	EventType                 EventType                  `mandatory`
+	ReportingPeriodicityValue *ReportingPeriodicityValue `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[ReportingRequestType] `optional,extension`
+}
+
+func (ie *ReportingRequestType) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ReportingRequestType) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.ReportingPeriodicityValue != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	if err = ie.EventType.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode EventType", err)
+		return
+	}
+	if ie.ReportingPeriodicityValue != nil {
+		if err = ie.ReportingPeriodicityValue.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode ReportingPeriodicityValue", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ReportingRequestType) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ReportingRequestType) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	if err = ie.EventType.Decode(r.AperReader); err != nil {
+		err = readError("EventType", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(ReportingPeriodicityValue)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("ReportingPeriodicityValue", err)
+			return
+		}
+		ie.ReportingPeriodicityValue = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *ReportingRequestType) Validate() error {
+	var v validator
+	v.ie("EventType", &ie.EventType)
+	if ie.ReportingPeriodicityValue != nil {
+		v.ie("ReportingPeriodicityValue", ie.ReportingPeriodicityValue)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
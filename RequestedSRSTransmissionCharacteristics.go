@@ -0,0 +1,204 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type RequestedSRSTransmissionCharacteristics struct {
+	NumberOfTransmissions *int64                                              `lb:0,ub:500,valueExt,optional`
+	ResourceType          RequestedSRSTransmissionCharacteristicsResourceType `mandatory`
+	Bandwidth             BandwidthSRS                                        `mandatory`
+	ListOfSRSResourceSet  []SRSResourceSetItem                                `lb:1,ub:maxnoSRSResourceSets,optional`
+	SSBInformation        *SSBInformation                                     `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[RequestedSRSTransmissionCharacteristics] `optional,extension`
+}
+
+func (ie *RequestedSRSTransmissionCharacteristics) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *RequestedSRSTransmissionCharacteristics) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.NumberOfTransmissions != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(ie.ListOfSRSResourceSet) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if ie.SSBInformation != nil {
+		aper.SetBit(optionals, 3)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 4)
+	}
+	if err = w.WriteBits(optionals, 4); err != nil {
+		return
+	}
+	if ie.NumberOfTransmissions != nil {
+		tmp_NumberOfTransmissions := INTEGER{
+			c:     aper.Constraint{Lb: 0, Ub: 500},
+			ext:   true,
+			Value: aper.Integer(*ie.NumberOfTransmissions),
+		}
+		if err = tmp_NumberOfTransmissions.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode NumberOfTransmissions", err)
+			return
+		}
+	}
+	if err = ie.ResourceType.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode ResourceType", err)
+		return
+	}
+	if err = ie.Bandwidth.encode(w); err != nil {
+		err = utils.WrapError("Encode Bandwidth", err)
+		return
+	}
+	if len(ie.ListOfSRSResourceSet) > 0 {
+		tmp_ListOfSRSResourceSet := sequenceOf[*SRSResourceSetItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoSRSResourceSets},
+			ext: false,
+		}
+		for k := range ie.ListOfSRSResourceSet {
+			tmp_ListOfSRSResourceSet.Value = append(tmp_ListOfSRSResourceSet.Value, &ie.ListOfSRSResourceSet[k])
+		}
+		if err = tmp_ListOfSRSResourceSet.encode(w); err != nil {
+			err = utils.WrapError("Encode ListOfSRSResourceSet", err)
+			return
+		}
+	}
+	if ie.SSBInformation != nil {
+		if err = ie.SSBInformation.encode(w); err != nil {
+			err = utils.WrapError("Encode SSBInformation", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *RequestedSRSTransmissionCharacteristics) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *RequestedSRSTransmissionCharacteristics) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(4); err != nil {
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp_NumberOfTransmissions := INTEGER{
+			c:   aper.Constraint{Lb: 0, Ub: 500},
+			ext: true,
+		}
+		if err = tmp_NumberOfTransmissions.Decode(r.AperReader); err != nil {
+			err = readError("NumberOfTransmissions", err)
+			return
+		}
+		tmp := int64(tmp_NumberOfTransmissions.Value)
+		ie.NumberOfTransmissions = &tmp
+	}
+	if err = ie.ResourceType.Decode(r.AperReader); err != nil {
+		err = readError("ResourceType", err)
+		return
+	}
+	if err = ie.Bandwidth.decode(r); err != nil {
+		err = readError("Bandwidth", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 2) {
+		var tmp_ListOfSRSResourceSet []*SRSResourceSetItem
+		if tmp_ListOfSRSResourceSet, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoSRSResourceSets}, false, func() *SRSResourceSetItem { return new(SRSResourceSetItem) }); err != nil {
+			err = readError("ListOfSRSResourceSet", err)
+			return
+		}
+		ie.ListOfSRSResourceSet = make([]SRSResourceSetItem, len(tmp_ListOfSRSResourceSet))
+		for k, i := range tmp_ListOfSRSResourceSet {
+			ie.ListOfSRSResourceSet[k] = *i
+		}
+	}
+	if aper.IsBitSet(optionals, 3) {
+		tmp := new(SSBInformation)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SSBInformation", err)
+			return
+		}
+		ie.SSBInformation = tmp
+	}
+	if aper.IsBitSet(optionals, 4) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *RequestedSRSTransmissionCharacteristics) Validate() error {
+	var v validator
+	if ie.NumberOfTransmissions != nil {
+		v.integer("NumberOfTransmissions", *ie.NumberOfTransmissions, 0, 500)
+	}
+	v.ie("ResourceType", &ie.ResourceType)
+	v.ie("Bandwidth", &ie.Bandwidth)
+	if len(ie.ListOfSRSResourceSet) > 0 {
+		v.size("ListOfSRSResourceSet", len(ie.ListOfSRSResourceSet), 1, maxnoSRSResourceSets)
+		for i := range ie.ListOfSRSResourceSet {
+			v.item("ListOfSRSResourceSet", i, &ie.ListOfSRSResourceSet[i])
+		}
+	}
+	if ie.SSBInformation != nil {
+		v.ie("SSBInformation", ie.SSBInformation)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
+
+const (
+	RequestedSRSTransmissionCharacteristicsResourceTypePeriodic       aper.Enumerated = 0
+	RequestedSRSTransmissionCharacteristicsResourceTypeSemipersistent aper.Enumerated = 1
+	RequestedSRSTransmissionCharacteristicsResourceTypeAperiodic      aper.Enumerated = 2
+)
+
+type RequestedSRSTransmissionCharacteristicsResourceType struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:2,valueExt"`
+}
+
+func (ie *RequestedSRSTransmissionCharacteristicsResourceType) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 2}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *RequestedSRSTransmissionCharacteristicsResourceType) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 2}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *RequestedSRSTransmissionCharacteristicsResourceType) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 3)
+	return v.err()
+}
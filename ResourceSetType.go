@@ -0,0 +1,127 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	ResourceSetTypePresentNothing uint64 = iota
+	ResourceSetTypePresentPeriodic
+	ResourceSetTypePresentSemiPersistent
+	ResourceSetTypePresentAperiodic
+	ResourceSetTypePresentChoiceExtension
+)
+
+type ResourceSetType struct {
+	Choice          uint64
+	Periodic        *ResourceSetTypePeriodic
+	SemiPersistent  *ResourceSetTypeSemiPersistent
+	Aperiodic       *ResourceSetTypeAperiodic
+	ChoiceExtension *RawIE
+}
+
+func (ie *ResourceSetType) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ResourceSetType) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 3, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case ResourceSetTypePresentPeriodic:
+		if err = ie.Periodic.encode(w); err != nil {
+			err = utils.WrapError("Encode Periodic", err)
+			return
+		}
+	case ResourceSetTypePresentSemiPersistent:
+		if err = ie.SemiPersistent.encode(w); err != nil {
+			err = utils.WrapError("Encode SemiPersistent", err)
+			return
+		}
+	case ResourceSetTypePresentAperiodic:
+		if err = ie.Aperiodic.encode(w); err != nil {
+			err = utils.WrapError("Encode Aperiodic", err)
+			return
+		}
+	case ResourceSetTypePresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ResourceSetType) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ResourceSetType) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(3, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case ResourceSetTypePresentPeriodic:
+		tmp := new(ResourceSetTypePeriodic)
+		if err = tmp.decode(r); err != nil {
+			err = readError("Periodic", err)
+			return
+		}
+		ie.Periodic = tmp
+	case ResourceSetTypePresentSemiPersistent:
+		tmp := new(ResourceSetTypeSemiPersistent)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SemiPersistent", err)
+			return
+		}
+		ie.SemiPersistent = tmp
+	case ResourceSetTypePresentAperiodic:
+		tmp := new(ResourceSetTypeAperiodic)
+		if err = tmp.decode(r); err != nil {
+			err = readError("Aperiodic", err)
+			return
+		}
+		ie.Aperiodic = tmp
+	case ResourceSetTypePresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *ResourceSetType) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case ResourceSetTypePresentPeriodic:
+		if ie.Periodic == nil {
+			v.absent("Periodic")
+		} else {
+			v.ie("Periodic", ie.Periodic)
+		}
+	case ResourceSetTypePresentSemiPersistent:
+		if ie.SemiPersistent == nil {
+			v.absent("SemiPersistent")
+		} else {
+			v.ie("SemiPersistent", ie.SemiPersistent)
+		}
+	case ResourceSetTypePresentAperiodic:
+		if ie.Aperiodic == nil {
+			v.absent("Aperiodic")
+		} else {
+			v.ie("Aperiodic", ie.Aperiodic)
+		}
+	case ResourceSetTypePresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
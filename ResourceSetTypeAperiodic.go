@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type ResourceSetTypeAperiodic struct {
+	SRSResourceTrigger int64 `lb:1,ub:3,mandatory`
+	Slotoffset         int64 `lb:0,ub:32,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[ResourceSetTypeAperiodic] `optional,extension`
+}
+
+func (ie *ResourceSetTypeAperiodic) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ResourceSetTypeAperiodic) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_SRSResourceTrigger := INTEGER{
+		c:     aper.Constraint{Lb: 1, Ub: 3},
+		ext:   false,
+		Value: aper.Integer(ie.SRSResourceTrigger),
+	}
+	if err = tmp_SRSResourceTrigger.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SRSResourceTrigger", err)
+		return
+	}
+	tmp_Slotoffset := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 32},
+		ext:   false,
+		Value: aper.Integer(ie.Slotoffset),
+	}
+	if err = tmp_Slotoffset.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Slotoffset", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ResourceSetTypeAperiodic) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ResourceSetTypeAperiodic) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	tmp_SRSResourceTrigger := INTEGER{
+		c:   aper.Constraint{Lb: 1, Ub: 3},
+		ext: false,
+	}
+	if err = tmp_SRSResourceTrigger.Decode(r.AperReader); err != nil {
+		err = readError("SRSResourceTrigger", err)
+		return
+	}
+	ie.SRSResourceTrigger = int64(tmp_SRSResourceTrigger.Value)
+	tmp_Slotoffset := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 32},
+		ext: false,
+	}
+	if err = tmp_Slotoffset.Decode(r.AperReader); err != nil {
+		err = readError("Slotoffset", err)
+		return
+	}
+	ie.Slotoffset = int64(tmp_Slotoffset.Value)
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *ResourceSetTypeAperiodic) Validate() error {
+	var v validator
+	v.integer("SRSResourceTrigger", ie.SRSResourceTrigger, 1, 3)
+	v.integer("Slotoffset", ie.Slotoffset, 0, 32)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type ResourceSetTypePeriodic struct {
+	PeriodicSet ResourceSetTypePeriodicPeriodicSet `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[ResourceSetTypePeriodic] `optional,extension`
+}
+
+func (ie *ResourceSetTypePeriodic) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ResourceSetTypePeriodic) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.PeriodicSet.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PeriodicSet", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ResourceSetTypePeriodic) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ResourceSetTypePeriodic) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.PeriodicSet.Decode(r.AperReader); err != nil {
+		err = readError("PeriodicSet", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *ResourceSetTypePeriodic) Validate() error {
+	var v validator
+	v.ie("PeriodicSet", &ie.PeriodicSet)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
+
+const (
+	ResourceSetTypePeriodicPeriodicSetTrue aper.Enumerated = 0
+)
+
+type ResourceSetTypePeriodicPeriodicSet struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:0,valueExt"`
+}
+
+func (ie *ResourceSetTypePeriodicPeriodicSet) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 0}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *ResourceSetTypePeriodicPeriodicSet) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 0}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *ResourceSetTypePeriodicPeriodicSet) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 1)
+	return v.err()
+}
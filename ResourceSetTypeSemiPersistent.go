@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type ResourceSetTypeSemiPersistent struct {
+	SemiPersistentSet ResourceSetTypeSemiPersistentSemiPersistentSet `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[ResourceSetTypeSemiPersistent] `optional,extension`
+}
+
+func (ie *ResourceSetTypeSemiPersistent) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ResourceSetTypeSemiPersistent) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.SemiPersistentSet.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SemiPersistentSet", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ResourceSetTypeSemiPersistent) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ResourceSetTypeSemiPersistent) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.SemiPersistentSet.Decode(r.AperReader); err != nil {
+		err = readError("SemiPersistentSet", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *ResourceSetTypeSemiPersistent) Validate() error {
+	var v validator
+	v.ie("SemiPersistentSet", &ie.SemiPersistentSet)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
+
+const (
+	ResourceSetTypeSemiPersistentSemiPersistentSetTrue aper.Enumerated = 0
+)
+
+type ResourceSetTypeSemiPersistentSemiPersistentSet struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:0,valueExt"`
+}
+
+func (ie *ResourceSetTypeSemiPersistentSemiPersistentSet) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 0}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *ResourceSetTypeSemiPersistentSemiPersistentSet) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 0}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *ResourceSetTypeSemiPersistentSemiPersistentSet) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 1)
+	return v.err()
+}
@@ -0,0 +1,127 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	ResourceTypePresentNothing uint64 = iota
+	ResourceTypePresentPeriodic
+	ResourceTypePresentSemiPersistent
+	ResourceTypePresentAperiodic
+	ResourceTypePresentChoiceExtension
+)
+
+type ResourceType struct {
+	Choice          uint64
+	Periodic        *ResourceTypePeriodic
+	SemiPersistent  *ResourceTypeSemiPersistent
+	Aperiodic       *ResourceTypeAperiodic
+	ChoiceExtension *RawIE
+}
+
+func (ie *ResourceType) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ResourceType) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 3, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case ResourceTypePresentPeriodic:
+		if err = ie.Periodic.encode(w); err != nil {
+			err = utils.WrapError("Encode Periodic", err)
+			return
+		}
+	case ResourceTypePresentSemiPersistent:
+		if err = ie.SemiPersistent.encode(w); err != nil {
+			err = utils.WrapError("Encode SemiPersistent", err)
+			return
+		}
+	case ResourceTypePresentAperiodic:
+		if err = ie.Aperiodic.encode(w); err != nil {
+			err = utils.WrapError("Encode Aperiodic", err)
+			return
+		}
+	case ResourceTypePresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ResourceType) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ResourceType) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(3, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case ResourceTypePresentPeriodic:
+		tmp := new(ResourceTypePeriodic)
+		if err = tmp.decode(r); err != nil {
+			err = readError("Periodic", err)
+			return
+		}
+		ie.Periodic = tmp
+	case ResourceTypePresentSemiPersistent:
+		tmp := new(ResourceTypeSemiPersistent)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SemiPersistent", err)
+			return
+		}
+		ie.SemiPersistent = tmp
+	case ResourceTypePresentAperiodic:
+		tmp := new(ResourceTypeAperiodic)
+		if err = tmp.decode(r); err != nil {
+			err = readError("Aperiodic", err)
+			return
+		}
+		ie.Aperiodic = tmp
+	case ResourceTypePresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *ResourceType) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case ResourceTypePresentPeriodic:
+		if ie.Periodic == nil {
+			v.absent("Periodic")
+		} else {
+			v.ie("Periodic", ie.Periodic)
+		}
+	case ResourceTypePresentSemiPersistent:
+		if ie.SemiPersistent == nil {
+			v.absent("SemiPersistent")
+		} else {
+			v.ie("SemiPersistent", ie.SemiPersistent)
+		}
+	case ResourceTypePresentAperiodic:
+		if ie.Aperiodic == nil {
+			v.absent("Aperiodic")
+		} else {
+			v.ie("Aperiodic", ie.Aperiodic)
+		}
+	case ResourceTypePresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
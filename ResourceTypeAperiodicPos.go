@@ -0,0 +1,87 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type ResourceTypeAperiodicPos struct {
+	SlotOffset int64 `lb:0,ub:32,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[ResourceTypeAperiodicPos] `optional,extension`
+}
+
+func (ie *ResourceTypeAperiodicPos) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ResourceTypeAperiodicPos) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_SlotOffset := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 32},
+		ext:   false,
+		Value: aper.Integer(ie.SlotOffset),
+	}
+	if err = tmp_SlotOffset.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SlotOffset", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ResourceTypeAperiodicPos) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ResourceTypeAperiodicPos) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	tmp_SlotOffset := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 32},
+		ext: false,
+	}
+	if err = tmp_SlotOffset.Decode(r.AperReader); err != nil {
+		err = readError("SlotOffset", err)
+		return
+	}
+	ie.SlotOffset = int64(tmp_SlotOffset.Value)
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *ResourceTypeAperiodicPos) Validate() error {
+	var v validator
+	v.integer("SlotOffset", ie.SlotOffset, 0, 32)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,143 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type ResourceTypePeriodic struct {
+	Periodicity ResourceTypePeriodicPeriodicity `mandatory`
+	Offset      int64                           `lb:0,ub:2559,valueExt,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[ResourceTypePeriodic] `optional,extension`
+}
+
+func (ie *ResourceTypePeriodic) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ResourceTypePeriodic) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.Periodicity.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Periodicity", err)
+		return
+	}
+	tmp_Offset := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 2559},
+		ext:   true,
+		Value: aper.Integer(ie.Offset),
+	}
+	if err = tmp_Offset.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Offset", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ResourceTypePeriodic) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ResourceTypePeriodic) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.Periodicity.Decode(r.AperReader); err != nil {
+		err = readError("Periodicity", err)
+		return
+	}
+	tmp_Offset := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 2559},
+		ext: true,
+	}
+	if err = tmp_Offset.Decode(r.AperReader); err != nil {
+		err = readError("Offset", err)
+		return
+	}
+	ie.Offset = int64(tmp_Offset.Value)
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *ResourceTypePeriodic) Validate() error {
+	var v validator
+	v.ie("Periodicity", &ie.Periodicity)
+	v.integer("Offset", ie.Offset, 0, 2559)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
+
+const (
+	ResourceTypePeriodicPeriodicitySlot1    aper.Enumerated = 0
+	ResourceTypePeriodicPeriodicitySlot2    aper.Enumerated = 1
+	ResourceTypePeriodicPeriodicitySlot4    aper.Enumerated = 2
+	ResourceTypePeriodicPeriodicitySlot5    aper.Enumerated = 3
+	ResourceTypePeriodicPeriodicitySlot8    aper.Enumerated = 4
+	ResourceTypePeriodicPeriodicitySlot10   aper.Enumerated = 5
+	ResourceTypePeriodicPeriodicitySlot16   aper.Enumerated = 6
+	ResourceTypePeriodicPeriodicitySlot20   aper.Enumerated = 7
+	ResourceTypePeriodicPeriodicitySlot32   aper.Enumerated = 8
+	ResourceTypePeriodicPeriodicitySlot40   aper.Enumerated = 9
+	ResourceTypePeriodicPeriodicitySlot64   aper.Enumerated = 10
+	ResourceTypePeriodicPeriodicitySlot80   aper.Enumerated = 11
+	ResourceTypePeriodicPeriodicitySlot160  aper.Enumerated = 12
+	ResourceTypePeriodicPeriodicitySlot320  aper.Enumerated = 13
+	ResourceTypePeriodicPeriodicitySlot640  aper.Enumerated = 14
+	ResourceTypePeriodicPeriodicitySlot1280 aper.Enumerated = 15
+	ResourceTypePeriodicPeriodicitySlot2560 aper.Enumerated = 16
+)
+
+type ResourceTypePeriodicPeriodicity struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:16,valueExt"`
+}
+
+func (ie *ResourceTypePeriodicPeriodicity) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 16}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *ResourceTypePeriodicPeriodicity) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 16}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *ResourceTypePeriodicPeriodicity) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 17)
+	return v.err()
+}
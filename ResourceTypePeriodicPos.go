@@ -0,0 +1,148 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type ResourceTypePeriodicPos struct {
+	Periodicity ResourceTypePeriodicPosPeriodicity `mandatory`
+	Offset      int64                              `lb:0,ub:81919,valueExt,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[ResourceTypePeriodicPos] `optional,extension`
+}
+
+func (ie *ResourceTypePeriodicPos) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ResourceTypePeriodicPos) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.Periodicity.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Periodicity", err)
+		return
+	}
+	tmp_Offset := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 81919},
+		ext:   true,
+		Value: aper.Integer(ie.Offset),
+	}
+	if err = tmp_Offset.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Offset", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ResourceTypePeriodicPos) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ResourceTypePeriodicPos) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.Periodicity.Decode(r.AperReader); err != nil {
+		err = readError("Periodicity", err)
+		return
+	}
+	tmp_Offset := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 81919},
+		ext: true,
+	}
+	if err = tmp_Offset.Decode(r.AperReader); err != nil {
+		err = readError("Offset", err)
+		return
+	}
+	ie.Offset = int64(tmp_Offset.Value)
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *ResourceTypePeriodicPos) Validate() error {
+	var v validator
+	v.ie("Periodicity", &ie.Periodicity)
+	v.integer("Offset", ie.Offset, 0, 81919)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
+
+const (
+	ResourceTypePeriodicPosPeriodicitySlot1     aper.Enumerated = 0
+	ResourceTypePeriodicPosPeriodicitySlot2     aper.Enumerated = 1
+	ResourceTypePeriodicPosPeriodicitySlot4     aper.Enumerated = 2
+	ResourceTypePeriodicPosPeriodicitySlot5     aper.Enumerated = 3
+	ResourceTypePeriodicPosPeriodicitySlot8     aper.Enumerated = 4
+	ResourceTypePeriodicPosPeriodicitySlot10    aper.Enumerated = 5
+	ResourceTypePeriodicPosPeriodicitySlot16    aper.Enumerated = 6
+	ResourceTypePeriodicPosPeriodicitySlot20    aper.Enumerated = 7
+	ResourceTypePeriodicPosPeriodicitySlot32    aper.Enumerated = 8
+	ResourceTypePeriodicPosPeriodicitySlot40    aper.Enumerated = 9
+	ResourceTypePeriodicPosPeriodicitySlot64    aper.Enumerated = 10
+	ResourceTypePeriodicPosPeriodicitySlot80    aper.Enumerated = 11
+	ResourceTypePeriodicPosPeriodicitySlot160   aper.Enumerated = 12
+	ResourceTypePeriodicPosPeriodicitySlot320   aper.Enumerated = 13
+	ResourceTypePeriodicPosPeriodicitySlot640   aper.Enumerated = 14
+	ResourceTypePeriodicPosPeriodicitySlot1280  aper.Enumerated = 15
+	ResourceTypePeriodicPosPeriodicitySlot2560  aper.Enumerated = 16
+	ResourceTypePeriodicPosPeriodicitySlot5120  aper.Enumerated = 17
+	ResourceTypePeriodicPosPeriodicitySlot10240 aper.Enumerated = 18
+	ResourceTypePeriodicPosPeriodicitySlot20480 aper.Enumerated = 19
+	ResourceTypePeriodicPosPeriodicitySlot40960 aper.Enumerated = 20
+	ResourceTypePeriodicPosPeriodicitySlot81920 aper.Enumerated = 21
+)
+
+type ResourceTypePeriodicPosPeriodicity struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:21,valueExt"`
+}
+
+func (ie *ResourceTypePeriodicPosPeriodicity) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 21}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *ResourceTypePeriodicPosPeriodicity) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 21}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *ResourceTypePeriodicPosPeriodicity) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 22)
+	return v.err()
+}
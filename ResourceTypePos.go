@@ -0,0 +1,127 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	ResourceTypePosPresentNothing uint64 = iota
+	ResourceTypePosPresentPeriodic
+	ResourceTypePosPresentSemiPersistent
+	ResourceTypePosPresentAperiodic
+	ResourceTypePosPresentChoiceExtension
+)
+
+type ResourceTypePos struct {
+	Choice          uint64
+	Periodic        *ResourceTypePeriodicPos
+	SemiPersistent  *ResourceTypeSemiPersistentPos
+	Aperiodic       *ResourceTypeAperiodicPos
+	ChoiceExtension *RawIE
+}
+
+func (ie *ResourceTypePos) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ResourceTypePos) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 3, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case ResourceTypePosPresentPeriodic:
+		if err = ie.Periodic.encode(w); err != nil {
+			err = utils.WrapError("Encode Periodic", err)
+			return
+		}
+	case ResourceTypePosPresentSemiPersistent:
+		if err = ie.SemiPersistent.encode(w); err != nil {
+			err = utils.WrapError("Encode SemiPersistent", err)
+			return
+		}
+	case ResourceTypePosPresentAperiodic:
+		if err = ie.Aperiodic.encode(w); err != nil {
+			err = utils.WrapError("Encode Aperiodic", err)
+			return
+		}
+	case ResourceTypePosPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ResourceTypePos) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ResourceTypePos) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(3, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case ResourceTypePosPresentPeriodic:
+		tmp := new(ResourceTypePeriodicPos)
+		if err = tmp.decode(r); err != nil {
+			err = readError("Periodic", err)
+			return
+		}
+		ie.Periodic = tmp
+	case ResourceTypePosPresentSemiPersistent:
+		tmp := new(ResourceTypeSemiPersistentPos)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SemiPersistent", err)
+			return
+		}
+		ie.SemiPersistent = tmp
+	case ResourceTypePosPresentAperiodic:
+		tmp := new(ResourceTypeAperiodicPos)
+		if err = tmp.decode(r); err != nil {
+			err = readError("Aperiodic", err)
+			return
+		}
+		ie.Aperiodic = tmp
+	case ResourceTypePosPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *ResourceTypePos) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case ResourceTypePosPresentPeriodic:
+		if ie.Periodic == nil {
+			v.absent("Periodic")
+		} else {
+			v.ie("Periodic", ie.Periodic)
+		}
+	case ResourceTypePosPresentSemiPersistent:
+		if ie.SemiPersistent == nil {
+			v.absent("SemiPersistent")
+		} else {
+			v.ie("SemiPersistent", ie.SemiPersistent)
+		}
+	case ResourceTypePosPresentAperiodic:
+		if ie.Aperiodic == nil {
+			v.absent("Aperiodic")
+		} else {
+			v.ie("Aperiodic", ie.Aperiodic)
+		}
+	case ResourceTypePosPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
@@ -0,0 +1,148 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type ResourceTypeSemiPersistentPos struct {
+	Periodicity ResourceTypeSemiPersistentPosPeriodicity `mandatory`
+	Offset      int64                                    `lb:0,ub:81919,valueExt,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[ResourceTypeSemiPersistentPos] `optional,extension`
+}
+
+func (ie *ResourceTypeSemiPersistentPos) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ResourceTypeSemiPersistentPos) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.Periodicity.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Periodicity", err)
+		return
+	}
+	tmp_Offset := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 81919},
+		ext:   true,
+		Value: aper.Integer(ie.Offset),
+	}
+	if err = tmp_Offset.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Offset", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ResourceTypeSemiPersistentPos) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ResourceTypeSemiPersistentPos) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.Periodicity.Decode(r.AperReader); err != nil {
+		err = readError("Periodicity", err)
+		return
+	}
+	tmp_Offset := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 81919},
+		ext: true,
+	}
+	if err = tmp_Offset.Decode(r.AperReader); err != nil {
+		err = readError("Offset", err)
+		return
+	}
+	ie.Offset = int64(tmp_Offset.Value)
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *ResourceTypeSemiPersistentPos) Validate() error {
+	var v validator
+	v.ie("Periodicity", &ie.Periodicity)
+	v.integer("Offset", ie.Offset, 0, 81919)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
+
+const (
+	ResourceTypeSemiPersistentPosPeriodicitySlot1     aper.Enumerated = 0
+	ResourceTypeSemiPersistentPosPeriodicitySlot2     aper.Enumerated = 1
+	ResourceTypeSemiPersistentPosPeriodicitySlot4     aper.Enumerated = 2
+	ResourceTypeSemiPersistentPosPeriodicitySlot5     aper.Enumerated = 3
+	ResourceTypeSemiPersistentPosPeriodicitySlot8     aper.Enumerated = 4
+	ResourceTypeSemiPersistentPosPeriodicitySlot10    aper.Enumerated = 5
+	ResourceTypeSemiPersistentPosPeriodicitySlot16    aper.Enumerated = 6
+	ResourceTypeSemiPersistentPosPeriodicitySlot20    aper.Enumerated = 7
+	ResourceTypeSemiPersistentPosPeriodicitySlot32    aper.Enumerated = 8
+	ResourceTypeSemiPersistentPosPeriodicitySlot40    aper.Enumerated = 9
+	ResourceTypeSemiPersistentPosPeriodicitySlot64    aper.Enumerated = 10
+	ResourceTypeSemiPersistentPosPeriodicitySlot80    aper.Enumerated = 11
+	ResourceTypeSemiPersistentPosPeriodicitySlot160   aper.Enumerated = 12
+	ResourceTypeSemiPersistentPosPeriodicitySlot320   aper.Enumerated = 13
+	ResourceTypeSemiPersistentPosPeriodicitySlot640   aper.Enumerated = 14
+	ResourceTypeSemiPersistentPosPeriodicitySlot1280  aper.Enumerated = 15
+	ResourceTypeSemiPersistentPosPeriodicitySlot2560  aper.Enumerated = 16
+	ResourceTypeSemiPersistentPosPeriodicitySlot5120  aper.Enumerated = 17
+	ResourceTypeSemiPersistentPosPeriodicitySlot10240 aper.Enumerated = 18
+	ResourceTypeSemiPersistentPosPeriodicitySlot20480 aper.Enumerated = 19
+	ResourceTypeSemiPersistentPosPeriodicitySlot40960 aper.Enumerated = 20
+	ResourceTypeSemiPersistentPosPeriodicitySlot81920 aper.Enumerated = 21
+)
+
+type ResourceTypeSemiPersistentPosPeriodicity struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:21,valueExt"`
+}
+
+func (ie *ResourceTypeSemiPersistentPosPeriodicity) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 21}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *ResourceTypeSemiPersistentPosPeriodicity) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 21}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *ResourceTypeSemiPersistentPosPeriodicity) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 22)
+	return v.err()
+}
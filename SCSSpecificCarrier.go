@@ -0,0 +1,150 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SCSSpecificCarrier struct {
+	OffsetToCarrier   int64                               `lb:0,ub:2199,valueExt,mandatory`
+	SubcarrierSpacing SCSSpecificCarrierSubcarrierSpacing `mandatory`
+	CarrierBandwidth  int64                               `lb:1,ub:275,valueExt,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[SCSSpecificCarrier] `optional,extension`
+}
+
+func (ie *SCSSpecificCarrier) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SCSSpecificCarrier) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_OffsetToCarrier := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 2199},
+		ext:   true,
+		Value: aper.Integer(ie.OffsetToCarrier),
+	}
+	if err = tmp_OffsetToCarrier.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode OffsetToCarrier", err)
+		return
+	}
+	if err = ie.SubcarrierSpacing.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SubcarrierSpacing", err)
+		return
+	}
+	tmp_CarrierBandwidth := INTEGER{
+		c:     aper.Constraint{Lb: 1, Ub: 275},
+		ext:   true,
+		Value: aper.Integer(ie.CarrierBandwidth),
+	}
+	if err = tmp_CarrierBandwidth.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode CarrierBandwidth", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SCSSpecificCarrier) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SCSSpecificCarrier) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	tmp_OffsetToCarrier := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 2199},
+		ext: true,
+	}
+	if err = tmp_OffsetToCarrier.Decode(r.AperReader); err != nil {
+		err = readError("OffsetToCarrier", err)
+		return
+	}
+	ie.OffsetToCarrier = int64(tmp_OffsetToCarrier.Value)
+	if err = ie.SubcarrierSpacing.Decode(r.AperReader); err != nil {
+		err = readError("SubcarrierSpacing", err)
+		return
+	}
+	tmp_CarrierBandwidth := INTEGER{
+		c:   aper.Constraint{Lb: 1, Ub: 275},
+		ext: true,
+	}
+	if err = tmp_CarrierBandwidth.Decode(r.AperReader); err != nil {
+		err = readError("CarrierBandwidth", err)
+		return
+	}
+	ie.CarrierBandwidth = int64(tmp_CarrierBandwidth.Value)
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SCSSpecificCarrier) Validate() error {
+	var v validator
+	v.integer("OffsetToCarrier", ie.OffsetToCarrier, 0, 2199)
+	v.ie("SubcarrierSpacing", &ie.SubcarrierSpacing)
+	v.integer("CarrierBandwidth", ie.CarrierBandwidth, 1, 275)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
+
+const (
+	SCSSpecificCarrierSubcarrierSpacingKHz15  aper.Enumerated = 0
+	SCSSpecificCarrierSubcarrierSpacingKHz30  aper.Enumerated = 1
+	SCSSpecificCarrierSubcarrierSpacingKHz60  aper.Enumerated = 2
+	SCSSpecificCarrierSubcarrierSpacingKHz120 aper.Enumerated = 3
+)
+
+type SCSSpecificCarrierSubcarrierSpacing struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:3,valueExt"`
+}
+
+func (ie *SCSSpecificCarrierSubcarrierSpacing) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *SCSSpecificCarrierSubcarrierSpacing) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *SCSSpecificCarrierSubcarrierSpacing) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 4)
+	return v.err()
+}
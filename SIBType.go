@@ -0,0 +1,31 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type SIBType struct {
+	Value aper.Integer `aper:"valueLB:2,valueUB:32,valueExt"`
+}
+
+func (ie *SIBType) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteInteger(int64(ie.Value), &aper.Constraint{Lb: 2, Ub: 32}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *SIBType) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadInteger(&aper.Constraint{Lb: 2, Ub: 32}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Integer(v)
+	}
+	return nil
+}
+
+func (ie *SIBType) Validate() error {
+	var v validator
+	v.integer("Value", int64(ie.Value), 2, 32)
+	return v.err()
+}
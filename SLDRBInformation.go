@@ -0,0 +1,102 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SLDRBInformation struct {
+	SLDRBQoS               PC5QoSParameters         `mandatory`
+	FlowsMappedToSLDRBList []FlowsMappedToSLDRBItem `lb:1,ub:maxnoofPC5QoSFlows,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[SLDRBInformation] `optional,extension`
+}
+
+func (ie *SLDRBInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SLDRBInformation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.SLDRBQoS.encode(w); err != nil {
+		err = utils.WrapError("Encode SLDRBQoS", err)
+		return
+	}
+	tmp_FlowsMappedToSLDRBList := sequenceOf[*FlowsMappedToSLDRBItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofPC5QoSFlows},
+		ext: false,
+	}
+	for k := range ie.FlowsMappedToSLDRBList {
+		tmp_FlowsMappedToSLDRBList.Value = append(tmp_FlowsMappedToSLDRBList.Value, &ie.FlowsMappedToSLDRBList[k])
+	}
+	if err = tmp_FlowsMappedToSLDRBList.encode(w); err != nil {
+		err = utils.WrapError("Encode FlowsMappedToSLDRBList", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SLDRBInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SLDRBInformation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.SLDRBQoS.decode(r); err != nil {
+		err = readError("SLDRBQoS", err)
+		return
+	}
+	var tmp_FlowsMappedToSLDRBList []*FlowsMappedToSLDRBItem
+	if tmp_FlowsMappedToSLDRBList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofPC5QoSFlows}, false, func() *FlowsMappedToSLDRBItem { return new(FlowsMappedToSLDRBItem) }); err != nil {
+		err = readError("FlowsMappedToSLDRBList", err)
+		return
+	}
+	ie.FlowsMappedToSLDRBList = make([]FlowsMappedToSLDRBItem, len(tmp_FlowsMappedToSLDRBList))
+	for k, i := range tmp_FlowsMappedToSLDRBList {
+		ie.FlowsMappedToSLDRBList[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SLDRBInformation) Validate() error {
+	var v validator
+	v.ie("SLDRBQoS", &ie.SLDRBQoS)
+	v.size("FlowsMappedToSLDRBList", len(ie.FlowsMappedToSLDRBList), 1, maxnoofPC5QoSFlows)
+	for i := range ie.FlowsMappedToSLDRBList {
+		v.item("FlowsMappedToSLDRBList", i, &ie.FlowsMappedToSLDRBList[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
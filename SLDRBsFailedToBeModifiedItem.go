@@ -0,0 +1,95 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SLDRBsFailedToBeModifiedItem struct {
+	SLDRBID SLDRBID `mandatory`
+	Cause   *Cause  `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[SLDRBsFailedToBeModifiedItem] `optional,extension`
+}
+
+func (ie *SLDRBsFailedToBeModifiedItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SLDRBsFailedToBeModifiedItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.Cause != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	if err = ie.SLDRBID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SLDRBID", err)
+		return
+	}
+	if ie.Cause != nil {
+		if err = ie.Cause.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode Cause", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SLDRBsFailedToBeModifiedItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SLDRBsFailedToBeModifiedItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	if err = ie.SLDRBID.Decode(r.AperReader); err != nil {
+		err = readError("SLDRBID", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(Cause)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("Cause", err)
+			return
+		}
+		ie.Cause = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SLDRBsFailedToBeModifiedItem) Validate() error {
+	var v validator
+	v.ie("SLDRBID", &ie.SLDRBID)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,119 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SLDRBsToBeModifiedItem struct {
+	SLDRBID          SLDRBID           `mandatory`
+	SLDRBInformation *SLDRBInformation `optional`
+	RLCMode          *RLCMode          `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[SLDRBsToBeModifiedItem] `optional,extension`
+}
+
+func (ie *SLDRBsToBeModifiedItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SLDRBsToBeModifiedItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.SLDRBInformation != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if ie.RLCMode != nil {
+		aper.SetBit(optionals, 2)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 3)
+	}
+	if err = w.WriteBits(optionals, 3); err != nil {
+		return
+	}
+	if err = ie.SLDRBID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SLDRBID", err)
+		return
+	}
+	if ie.SLDRBInformation != nil {
+		if err = ie.SLDRBInformation.encode(w); err != nil {
+			err = utils.WrapError("Encode SLDRBInformation", err)
+			return
+		}
+	}
+	if ie.RLCMode != nil {
+		if err = ie.RLCMode.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode RLCMode", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SLDRBsToBeModifiedItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SLDRBsToBeModifiedItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(3); err != nil {
+		return
+	}
+	if err = ie.SLDRBID.Decode(r.AperReader); err != nil {
+		err = readError("SLDRBID", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(SLDRBInformation)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SLDRBInformation", err)
+			return
+		}
+		ie.SLDRBInformation = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		tmp := new(RLCMode)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("RLCMode", err)
+			return
+		}
+		ie.RLCMode = tmp
+	}
+	if aper.IsBitSet(optionals, 3) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SLDRBsToBeModifiedItem) Validate() error {
+	var v validator
+	v.ie("SLDRBID", &ie.SLDRBID)
+	if ie.SLDRBInformation != nil {
+		v.ie("SLDRBInformation", ie.SLDRBInformation)
+	}
+	if ie.RLCMode != nil {
+		v.ie("RLCMode", ie.RLCMode)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,65 @@
+package ies
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+// an IE as the tests encode and decode it
+type testIE interface {
+	Encode(*aper.AperWriter) error
+	Decode(*aper.AperReader) error
+	Validate() error
+}
+
+// encode in, compare it with want, decode want into out and validate it
+func checkIE(t *testing.T, in, out testIE, want []byte) {
+	t.Helper()
+	var buf bytes.Buffer
+	w := aper.NewWriter(&buf)
+	if err := in.Encode(w); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Fatalf("encoded %x\n want %x", buf.Bytes(), want)
+	}
+	if err := DecodeValue(want, out); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out, in) {
+		t.Fatalf("decoded %+v\n want %+v", out, in)
+	}
+	if err := out.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSLDRBsToBeSetupItemWire(t *testing.T) {
+	in := SLDRBsToBeSetupItem{
+		SLDRBID: SLDRBID{Value: 2},
+		SLDRBInformation: SLDRBInformation{
+			SLDRBQoS: PC5QoSParameters{
+				PC5QoSCharacteristics: PC5QoSCharacteristics{
+					Choice:        PC5QoSCharacteristicsPresentNonDynamicPQI,
+					NonDynamicPQI: &NonDynamicPQIDescriptor{FiveQI: 23},
+				},
+			},
+			FlowsMappedToSLDRBList: []FlowsMappedToSLDRBItem{{Pc5QoSFlowIdentifier: PC5QoSFlowIdentifier{Value: 5}}},
+		},
+		RLCMode: &RLCMode{Value: RLCModeRlcumbidirectional},
+	}
+	want := []byte{
+		0x40, 0x00, 0x01, // RLC mode present, SL DRB 2
+		0x00, 0x00, 0x17, // non-dynamic PQI, 5QI 23
+		0x00, 0x00, // 1 flow
+		0x00, 0x00, 0x04, // PC5 QoS flow 5
+		0x20, // RLC UM bidirectional
+	}
+	checkIE(t, &in, new(SLDRBsToBeSetupItem), want)
+}
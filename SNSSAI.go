@@ -0,0 +1,116 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SNSSAI struct {
+	SST []byte `lb:1,ub:1,mandatory`
+	SD  []byte `lb:3,ub:3,optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[SNSSAI] `optional,extension`
+}
+
+func (ie *SNSSAI) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SNSSAI) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.SD != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	tmp_SST := OCTETSTRING{
+		c:     aper.Constraint{Lb: 1, Ub: 1},
+		ext:   false,
+		Value: ie.SST,
+	}
+	if err = tmp_SST.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SST", err)
+		return
+	}
+	if ie.SD != nil {
+		tmp_SD := OCTETSTRING{
+			c:     aper.Constraint{Lb: 3, Ub: 3},
+			ext:   false,
+			Value: ie.SD,
+		}
+		if err = tmp_SD.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode SD", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SNSSAI) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SNSSAI) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	tmp_SST := OCTETSTRING{
+		c:   aper.Constraint{Lb: 1, Ub: 1},
+		ext: false,
+	}
+	if err = tmp_SST.Decode(r.AperReader); err != nil {
+		err = readError("SST", err)
+		return
+	}
+	ie.SST = tmp_SST.Value
+	if aper.IsBitSet(optionals, 1) {
+		tmp_SD := OCTETSTRING{
+			c:   aper.Constraint{Lb: 3, Ub: 3},
+			ext: false,
+		}
+		if err = tmp_SD.Decode(r.AperReader); err != nil {
+			err = readError("SD", err)
+			return
+		}
+		ie.SD = tmp_SD.Value
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SNSSAI) Validate() error {
+	var v validator
+	v.size("SST", len(ie.SST), 1, 1)
+	if ie.SD != nil {
+		v.size("SD", len(ie.SD), 3, 3)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,143 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SRSCarrierListItem struct {
+	PointA                    int64                `lb:0,ub:3279165,mandatory`
+	UplinkChannelBWPerSCSList []SCSSpecificCarrier `lb:1,ub:maxnoSCSs,mandatory`
+	ActiveULBWP               ActiveULBWP          `mandatory`
+	PCI                       *NRPCI               `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[SRSCarrierListItem] `optional,extension`
+}
+
+func (ie *SRSCarrierListItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SRSCarrierListItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.PCI != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	tmp_PointA := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 3279165},
+		ext:   false,
+		Value: aper.Integer(ie.PointA),
+	}
+	if err = tmp_PointA.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PointA", err)
+		return
+	}
+	tmp_UplinkChannelBWPerSCSList := sequenceOf[*SCSSpecificCarrier]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoSCSs},
+		ext: false,
+	}
+	for k := range ie.UplinkChannelBWPerSCSList {
+		tmp_UplinkChannelBWPerSCSList.Value = append(tmp_UplinkChannelBWPerSCSList.Value, &ie.UplinkChannelBWPerSCSList[k])
+	}
+	if err = tmp_UplinkChannelBWPerSCSList.encode(w); err != nil {
+		err = utils.WrapError("Encode UplinkChannelBWPerSCSList", err)
+		return
+	}
+	if err = ie.ActiveULBWP.encode(w); err != nil {
+		err = utils.WrapError("Encode ActiveULBWP", err)
+		return
+	}
+	if ie.PCI != nil {
+		if err = ie.PCI.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode PCI", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SRSCarrierListItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SRSCarrierListItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	tmp_PointA := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 3279165},
+		ext: false,
+	}
+	if err = tmp_PointA.Decode(r.AperReader); err != nil {
+		err = readError("PointA", err)
+		return
+	}
+	ie.PointA = int64(tmp_PointA.Value)
+	var tmp_UplinkChannelBWPerSCSList []*SCSSpecificCarrier
+	if tmp_UplinkChannelBWPerSCSList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoSCSs}, false, func() *SCSSpecificCarrier { return new(SCSSpecificCarrier) }); err != nil {
+		err = readError("UplinkChannelBWPerSCSList", err)
+		return
+	}
+	ie.UplinkChannelBWPerSCSList = make([]SCSSpecificCarrier, len(tmp_UplinkChannelBWPerSCSList))
+	for k, i := range tmp_UplinkChannelBWPerSCSList {
+		ie.UplinkChannelBWPerSCSList[k] = *i
+	}
+	if err = ie.ActiveULBWP.decode(r); err != nil {
+		err = readError("ActiveULBWP", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(NRPCI)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("PCI", err)
+			return
+		}
+		ie.PCI = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SRSCarrierListItem) Validate() error {
+	var v validator
+	v.integer("PointA", ie.PointA, 0, 3279165)
+	v.size("UplinkChannelBWPerSCSList", len(ie.UplinkChannelBWPerSCSList), 1, maxnoSCSs)
+	for i := range ie.UplinkChannelBWPerSCSList {
+		v.item("UplinkChannelBWPerSCSList", i, &ie.UplinkChannelBWPerSCSList[i])
+	}
+	v.ie("ActiveULBWP", &ie.ActiveULBWP)
+	if ie.PCI != nil {
+		v.integer("PCI", int64(ie.PCI.Value), 0, 1007)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
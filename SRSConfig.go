@@ -0,0 +1,203 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SRSConfig struct {
+	SRSResourceList       []SRSResource           `lb:1,ub:maxnoSRSResources,optional`
+	PosSRSResourceList    []PosSRSResourceItem    `lb:1,ub:maxnoSRSPosResources,optional`
+	SRSResourceSetList    []SRSResourceSet        `lb:1,ub:maxnoSRSResourceSets,optional`
+	PosSRSResourceSetList []PosSRSResourceSetItem `lb:1,ub:maxnoSRSPosResourceSets,optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[SRSConfig] `optional,extension`
+}
+
+func (ie *SRSConfig) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SRSConfig) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(ie.SRSResourceList) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if len(ie.PosSRSResourceList) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if len(ie.SRSResourceSetList) > 0 {
+		aper.SetBit(optionals, 3)
+	}
+	if len(ie.PosSRSResourceSetList) > 0 {
+		aper.SetBit(optionals, 4)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 5)
+	}
+	if err = w.WriteBits(optionals, 5); err != nil {
+		return
+	}
+	if len(ie.SRSResourceList) > 0 {
+		tmp_SRSResourceList := sequenceOf[*SRSResource]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoSRSResources},
+			ext: false,
+		}
+		for k := range ie.SRSResourceList {
+			tmp_SRSResourceList.Value = append(tmp_SRSResourceList.Value, &ie.SRSResourceList[k])
+		}
+		if err = tmp_SRSResourceList.encode(w); err != nil {
+			err = utils.WrapError("Encode SRSResourceList", err)
+			return
+		}
+	}
+	if len(ie.PosSRSResourceList) > 0 {
+		tmp_PosSRSResourceList := sequenceOf[*PosSRSResourceItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoSRSPosResources},
+			ext: false,
+		}
+		for k := range ie.PosSRSResourceList {
+			tmp_PosSRSResourceList.Value = append(tmp_PosSRSResourceList.Value, &ie.PosSRSResourceList[k])
+		}
+		if err = tmp_PosSRSResourceList.encode(w); err != nil {
+			err = utils.WrapError("Encode PosSRSResourceList", err)
+			return
+		}
+	}
+	if len(ie.SRSResourceSetList) > 0 {
+		tmp_SRSResourceSetList := sequenceOf[*SRSResourceSet]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoSRSResourceSets},
+			ext: false,
+		}
+		for k := range ie.SRSResourceSetList {
+			tmp_SRSResourceSetList.Value = append(tmp_SRSResourceSetList.Value, &ie.SRSResourceSetList[k])
+		}
+		if err = tmp_SRSResourceSetList.encode(w); err != nil {
+			err = utils.WrapError("Encode SRSResourceSetList", err)
+			return
+		}
+	}
+	if len(ie.PosSRSResourceSetList) > 0 {
+		tmp_PosSRSResourceSetList := sequenceOf[*PosSRSResourceSetItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoSRSPosResourceSets},
+			ext: false,
+		}
+		for k := range ie.PosSRSResourceSetList {
+			tmp_PosSRSResourceSetList.Value = append(tmp_PosSRSResourceSetList.Value, &ie.PosSRSResourceSetList[k])
+		}
+		if err = tmp_PosSRSResourceSetList.encode(w); err != nil {
+			err = utils.WrapError("Encode PosSRSResourceSetList", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SRSConfig) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SRSConfig) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(5); err != nil {
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		var tmp_SRSResourceList []*SRSResource
+		if tmp_SRSResourceList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoSRSResources}, false, func() *SRSResource { return new(SRSResource) }); err != nil {
+			err = readError("SRSResourceList", err)
+			return
+		}
+		ie.SRSResourceList = make([]SRSResource, len(tmp_SRSResourceList))
+		for k, i := range tmp_SRSResourceList {
+			ie.SRSResourceList[k] = *i
+		}
+	}
+	if aper.IsBitSet(optionals, 2) {
+		var tmp_PosSRSResourceList []*PosSRSResourceItem
+		if tmp_PosSRSResourceList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoSRSPosResources}, false, func() *PosSRSResourceItem { return new(PosSRSResourceItem) }); err != nil {
+			err = readError("PosSRSResourceList", err)
+			return
+		}
+		ie.PosSRSResourceList = make([]PosSRSResourceItem, len(tmp_PosSRSResourceList))
+		for k, i := range tmp_PosSRSResourceList {
+			ie.PosSRSResourceList[k] = *i
+		}
+	}
+	if aper.IsBitSet(optionals, 3) {
+		var tmp_SRSResourceSetList []*SRSResourceSet
+		if tmp_SRSResourceSetList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoSRSResourceSets}, false, func() *SRSResourceSet { return new(SRSResourceSet) }); err != nil {
+			err = readError("SRSResourceSetList", err)
+			return
+		}
+		ie.SRSResourceSetList = make([]SRSResourceSet, len(tmp_SRSResourceSetList))
+		for k, i := range tmp_SRSResourceSetList {
+			ie.SRSResourceSetList[k] = *i
+		}
+	}
+	if aper.IsBitSet(optionals, 4) {
+		var tmp_PosSRSResourceSetList []*PosSRSResourceSetItem
+		if tmp_PosSRSResourceSetList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoSRSPosResourceSets}, false, func() *PosSRSResourceSetItem { return new(PosSRSResourceSetItem) }); err != nil {
+			err = readError("PosSRSResourceSetList", err)
+			return
+		}
+		ie.PosSRSResourceSetList = make([]PosSRSResourceSetItem, len(tmp_PosSRSResourceSetList))
+		for k, i := range tmp_PosSRSResourceSetList {
+			ie.PosSRSResourceSetList[k] = *i
+		}
+	}
+	if aper.IsBitSet(optionals, 5) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SRSConfig) Validate() error {
+	var v validator
+	if len(ie.SRSResourceList) > 0 {
+		v.size("SRSResourceList", len(ie.SRSResourceList), 1, maxnoSRSResources)
+		for i := range ie.SRSResourceList {
+			v.item("SRSResourceList", i, &ie.SRSResourceList[i])
+		}
+	}
+	if len(ie.PosSRSResourceList) > 0 {
+		v.size("PosSRSResourceList", len(ie.PosSRSResourceList), 1, maxnoSRSPosResources)
+		for i := range ie.PosSRSResourceList {
+			v.item("PosSRSResourceList", i, &ie.PosSRSResourceList[i])
+		}
+	}
+	if len(ie.SRSResourceSetList) > 0 {
+		v.size("SRSResourceSetList", len(ie.SRSResourceSetList), 1, maxnoSRSResourceSets)
+		for i := range ie.SRSResourceSetList {
+			v.item("SRSResourceSetList", i, &ie.SRSResourceSetList[i])
+		}
+	}
+	if len(ie.PosSRSResourceSetList) > 0 {
+		v.size("PosSRSResourceSetList", len(ie.PosSRSResourceSetList), 1, maxnoSRSPosResourceSets)
+		for i := range ie.PosSRSResourceSetList {
+			v.item("PosSRSResourceSetList", i, &ie.PosSRSResourceSetList[i])
+		}
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
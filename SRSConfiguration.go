@@ -0,0 +1,92 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SRSConfiguration struct {
+	SRSCarrierList []SRSCarrierListItem `lb:1,ub:maxnoSRSCarriers,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[SRSConfiguration] `optional,extension`
+}
+
+func (ie *SRSConfiguration) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SRSConfiguration) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_SRSCarrierList := sequenceOf[*SRSCarrierListItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoSRSCarriers},
+		ext: false,
+	}
+	for k := range ie.SRSCarrierList {
+		tmp_SRSCarrierList.Value = append(tmp_SRSCarrierList.Value, &ie.SRSCarrierList[k])
+	}
+	if err = tmp_SRSCarrierList.encode(w); err != nil {
+		err = utils.WrapError("Encode SRSCarrierList", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SRSConfiguration) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SRSConfiguration) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	var tmp_SRSCarrierList []*SRSCarrierListItem
+	if tmp_SRSCarrierList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoSRSCarriers}, false, func() *SRSCarrierListItem { return new(SRSCarrierListItem) }); err != nil {
+		err = readError("SRSCarrierList", err)
+		return
+	}
+	ie.SRSCarrierList = make([]SRSCarrierListItem, len(tmp_SRSCarrierList))
+	for k, i := range tmp_SRSCarrierList {
+		ie.SRSCarrierList[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SRSConfiguration) Validate() error {
+	var v validator
+	v.size("SRSCarrierList", len(ie.SRSCarrierList), 1, maxnoSRSCarriers)
+	for i := range ie.SRSCarrierList {
+		v.item("SRSCarrierList", i, &ie.SRSCarrierList[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,405 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SRSResource struct {
+	SRSResourceID          SRSResourceID                     `mandatory`
+	NrofSRSPorts           SRSResourceNrofSRSPorts           `mandatory`
+	TransmissionComb       TransmissionComb                  `mandatory`
+	StartPosition          int64                             `lb:0,ub:5,mandatory`
+	NrofSymbols            SRSResourceNrofSymbols            `mandatory`
+	RepetitionFactor       SRSResourceRepetitionFactor       `mandatory`
+	FreqDomainPosition     int64                             `lb:0,ub:67,mandatory`
+	FreqDomainShift        int64                             `lb:0,ub:268,mandatory`
+	CSRS                   int64                             `lb:0,ub:63,mandatory`
+	BSRS                   int64                             `lb:0,ub:3,mandatory`
+	BHop                   int64                             `lb:0,ub:3,mandatory`
+	GroupOrSequenceHopping SRSResourceGroupOrSequenceHopping `mandatory`
+	ResourceType           ResourceType                      `mandatory`
+	SequenceId             int64                             `lb:0,ub:1023,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[SRSResource] `optional,extension`
+}
+
+func (ie *SRSResource) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SRSResource) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.SRSResourceID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SRSResourceID", err)
+		return
+	}
+	if err = ie.NrofSRSPorts.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode NrofSRSPorts", err)
+		return
+	}
+	if err = ie.TransmissionComb.encode(w); err != nil {
+		err = utils.WrapError("Encode TransmissionComb", err)
+		return
+	}
+	tmp_StartPosition := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 5},
+		ext:   false,
+		Value: aper.Integer(ie.StartPosition),
+	}
+	if err = tmp_StartPosition.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode StartPosition", err)
+		return
+	}
+	if err = ie.NrofSymbols.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode NrofSymbols", err)
+		return
+	}
+	if err = ie.RepetitionFactor.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode RepetitionFactor", err)
+		return
+	}
+	tmp_FreqDomainPosition := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 67},
+		ext:   false,
+		Value: aper.Integer(ie.FreqDomainPosition),
+	}
+	if err = tmp_FreqDomainPosition.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode FreqDomainPosition", err)
+		return
+	}
+	tmp_FreqDomainShift := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 268},
+		ext:   false,
+		Value: aper.Integer(ie.FreqDomainShift),
+	}
+	if err = tmp_FreqDomainShift.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode FreqDomainShift", err)
+		return
+	}
+	tmp_CSRS := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 63},
+		ext:   false,
+		Value: aper.Integer(ie.CSRS),
+	}
+	if err = tmp_CSRS.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode CSRS", err)
+		return
+	}
+	tmp_BSRS := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 3},
+		ext:   false,
+		Value: aper.Integer(ie.BSRS),
+	}
+	if err = tmp_BSRS.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode BSRS", err)
+		return
+	}
+	tmp_BHop := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 3},
+		ext:   false,
+		Value: aper.Integer(ie.BHop),
+	}
+	if err = tmp_BHop.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode BHop", err)
+		return
+	}
+	if err = ie.GroupOrSequenceHopping.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode GroupOrSequenceHopping", err)
+		return
+	}
+	if err = ie.ResourceType.encode(w); err != nil {
+		err = utils.WrapError("Encode ResourceType", err)
+		return
+	}
+	tmp_SequenceId := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 1023},
+		ext:   false,
+		Value: aper.Integer(ie.SequenceId),
+	}
+	if err = tmp_SequenceId.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SequenceId", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SRSResource) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SRSResource) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.SRSResourceID.Decode(r.AperReader); err != nil {
+		err = readError("SRSResourceID", err)
+		return
+	}
+	if err = ie.NrofSRSPorts.Decode(r.AperReader); err != nil {
+		err = readError("NrofSRSPorts", err)
+		return
+	}
+	if err = ie.TransmissionComb.decode(r); err != nil {
+		err = readError("TransmissionComb", err)
+		return
+	}
+	tmp_StartPosition := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 5},
+		ext: false,
+	}
+	if err = tmp_StartPosition.Decode(r.AperReader); err != nil {
+		err = readError("StartPosition", err)
+		return
+	}
+	ie.StartPosition = int64(tmp_StartPosition.Value)
+	if err = ie.NrofSymbols.Decode(r.AperReader); err != nil {
+		err = readError("NrofSymbols", err)
+		return
+	}
+	if err = ie.RepetitionFactor.Decode(r.AperReader); err != nil {
+		err = readError("RepetitionFactor", err)
+		return
+	}
+	tmp_FreqDomainPosition := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 67},
+		ext: false,
+	}
+	if err = tmp_FreqDomainPosition.Decode(r.AperReader); err != nil {
+		err = readError("FreqDomainPosition", err)
+		return
+	}
+	ie.FreqDomainPosition = int64(tmp_FreqDomainPosition.Value)
+	tmp_FreqDomainShift := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 268},
+		ext: false,
+	}
+	if err = tmp_FreqDomainShift.Decode(r.AperReader); err != nil {
+		err = readError("FreqDomainShift", err)
+		return
+	}
+	ie.FreqDomainShift = int64(tmp_FreqDomainShift.Value)
+	tmp_CSRS := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 63},
+		ext: false,
+	}
+	if err = tmp_CSRS.Decode(r.AperReader); err != nil {
+		err = readError("CSRS", err)
+		return
+	}
+	ie.CSRS = int64(tmp_CSRS.Value)
+	tmp_BSRS := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 3},
+		ext: false,
+	}
+	if err = tmp_BSRS.Decode(r.AperReader); err != nil {
+		err = readError("BSRS", err)
+		return
+	}
+	ie.BSRS = int64(tmp_BSRS.Value)
+	tmp_BHop := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 3},
+		ext: false,
+	}
+	if err = tmp_BHop.Decode(r.AperReader); err != nil {
+		err = readError("BHop", err)
+		return
+	}
+	ie.BHop = int64(tmp_BHop.Value)
+	if err = ie.GroupOrSequenceHopping.Decode(r.AperReader); err != nil {
+		err = readError("GroupOrSequenceHopping", err)
+		return
+	}
+	if err = ie.ResourceType.decode(r); err != nil {
+		err = readError("ResourceType", err)
+		return
+	}
+	tmp_SequenceId := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 1023},
+		ext: false,
+	}
+	if err = tmp_SequenceId.Decode(r.AperReader); err != nil {
+		err = readError("SequenceId", err)
+		return
+	}
+	ie.SequenceId = int64(tmp_SequenceId.Value)
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SRSResource) Validate() error {
+	var v validator
+	v.ie("SRSResourceID", &ie.SRSResourceID)
+	v.ie("NrofSRSPorts", &ie.NrofSRSPorts)
+	v.ie("TransmissionComb", &ie.TransmissionComb)
+	v.integer("StartPosition", ie.StartPosition, 0, 5)
+	v.ie("NrofSymbols", &ie.NrofSymbols)
+	v.ie("RepetitionFactor", &ie.RepetitionFactor)
+	v.integer("FreqDomainPosition", ie.FreqDomainPosition, 0, 67)
+	v.integer("FreqDomainShift", ie.FreqDomainShift, 0, 268)
+	v.integer("CSRS", ie.CSRS, 0, 63)
+	v.integer("BSRS", ie.BSRS, 0, 3)
+	v.integer("BHop", ie.BHop, 0, 3)
+	v.ie("GroupOrSequenceHopping", &ie.GroupOrSequenceHopping)
+	v.ie("ResourceType", &ie.ResourceType)
+	v.integer("SequenceId", ie.SequenceId, 0, 1023)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
+
+const (
+	SRSResourceNrofSRSPortsPort1  aper.Enumerated = 0
+	SRSResourceNrofSRSPortsPorts2 aper.Enumerated = 1
+	SRSResourceNrofSRSPortsPorts4 aper.Enumerated = 2
+)
+
+type SRSResourceNrofSRSPorts struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:2"`
+}
+
+func (ie *SRSResourceNrofSRSPorts) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 2}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *SRSResourceNrofSRSPorts) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 2}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *SRSResourceNrofSRSPorts) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 3)
+	return v.err()
+}
+
+const (
+	SRSResourceNrofSymbolsN1 aper.Enumerated = 0
+	SRSResourceNrofSymbolsN2 aper.Enumerated = 1
+	SRSResourceNrofSymbolsN4 aper.Enumerated = 2
+)
+
+type SRSResourceNrofSymbols struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:2"`
+}
+
+func (ie *SRSResourceNrofSymbols) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 2}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *SRSResourceNrofSymbols) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 2}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *SRSResourceNrofSymbols) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 3)
+	return v.err()
+}
+
+const (
+	SRSResourceRepetitionFactorN1 aper.Enumerated = 0
+	SRSResourceRepetitionFactorN2 aper.Enumerated = 1
+	SRSResourceRepetitionFactorN4 aper.Enumerated = 2
+)
+
+type SRSResourceRepetitionFactor struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:2"`
+}
+
+func (ie *SRSResourceRepetitionFactor) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 2}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *SRSResourceRepetitionFactor) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 2}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *SRSResourceRepetitionFactor) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 3)
+	return v.err()
+}
+
+const (
+	SRSResourceGroupOrSequenceHoppingNeither         aper.Enumerated = 0
+	SRSResourceGroupOrSequenceHoppingGroupHopping    aper.Enumerated = 1
+	SRSResourceGroupOrSequenceHoppingSequenceHopping aper.Enumerated = 2
+)
+
+type SRSResourceGroupOrSequenceHopping struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:2"`
+}
+
+func (ie *SRSResourceGroupOrSequenceHopping) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 2}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *SRSResourceGroupOrSequenceHopping) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 2}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *SRSResourceGroupOrSequenceHopping) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 3)
+	return v.err()
+}
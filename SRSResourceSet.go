@@ -0,0 +1,122 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SRSResourceSet struct {
+	SRSResourceSetID  int64           `lb:0,ub:15,mandatory`
+	SRSResourceIDList []SRSResourceID `lb:1,ub:maxnoSRSResourcePerSet,mandatory`
+	ResourceSetType   ResourceSetType `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[SRSResourceSet] `optional,extension`
+}
+
+func (ie *SRSResourceSet) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SRSResourceSet) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_SRSResourceSetID := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 15},
+		ext:   false,
+		Value: aper.Integer(ie.SRSResourceSetID),
+	}
+	if err = tmp_SRSResourceSetID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SRSResourceSetID", err)
+		return
+	}
+	tmp_SRSResourceIDList := sequenceOf[*SRSResourceID]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoSRSResourcePerSet},
+		ext: false,
+	}
+	for k := range ie.SRSResourceIDList {
+		tmp_SRSResourceIDList.Value = append(tmp_SRSResourceIDList.Value, &ie.SRSResourceIDList[k])
+	}
+	if err = tmp_SRSResourceIDList.encode(w); err != nil {
+		err = utils.WrapError("Encode SRSResourceIDList", err)
+		return
+	}
+	if err = ie.ResourceSetType.encode(w); err != nil {
+		err = utils.WrapError("Encode ResourceSetType", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SRSResourceSet) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SRSResourceSet) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	tmp_SRSResourceSetID := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 15},
+		ext: false,
+	}
+	if err = tmp_SRSResourceSetID.Decode(r.AperReader); err != nil {
+		err = readError("SRSResourceSetID", err)
+		return
+	}
+	ie.SRSResourceSetID = int64(tmp_SRSResourceSetID.Value)
+	var tmp_SRSResourceIDList []*SRSResourceID
+	if tmp_SRSResourceIDList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoSRSResourcePerSet}, false, func() *SRSResourceID { return new(SRSResourceID) }); err != nil {
+		err = readError("SRSResourceIDList", err)
+		return
+	}
+	ie.SRSResourceIDList = make([]SRSResourceID, len(tmp_SRSResourceIDList))
+	for k, i := range tmp_SRSResourceIDList {
+		ie.SRSResourceIDList[k] = *i
+	}
+	if err = ie.ResourceSetType.decode(r); err != nil {
+		err = readError("ResourceSetType", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SRSResourceSet) Validate() error {
+	var v validator
+	v.integer("SRSResourceSetID", ie.SRSResourceSetID, 0, 15)
+	v.size("SRSResourceIDList", len(ie.SRSResourceIDList), 1, maxnoSRSResourcePerSet)
+	for i := range ie.SRSResourceIDList {
+		v.item("SRSResourceIDList", i, &ie.SRSResourceIDList[i])
+	}
+	v.ie("ResourceSetType", &ie.ResourceSetType)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,31 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type SRSResourceSetID struct {
+	Value aper.Integer `aper:"valueLB:0,valueUB:15,valueExt"`
+}
+
+func (ie *SRSResourceSetID) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteInteger(int64(ie.Value), &aper.Constraint{Lb: 0, Ub: 15}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *SRSResourceSetID) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadInteger(&aper.Constraint{Lb: 0, Ub: 15}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Integer(v)
+	}
+	return nil
+}
+
+func (ie *SRSResourceSetID) Validate() error {
+	var v validator
+	v.integer("Value", int64(ie.Value), 0, 15)
+	return v.err()
+}
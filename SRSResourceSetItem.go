@@ -0,0 +1,173 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SRSResourceSetItem struct {
+	NumSRSresourcesperset *int64                 `lb:1,ub:16,valueExt,optional`
+	PeriodicityList       []PeriodicityListItem  `lb:1,ub:maxnoSRSResourcePerSet,optional`
+	SpatialRelationInfo   *SpatialRelationInfo   `optional`
+	PathlossReferenceInfo *PathlossReferenceInfo `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[SRSResourceSetItem] `optional,extension`
+}
+
+func (ie *SRSResourceSetItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SRSResourceSetItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.NumSRSresourcesperset != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(ie.PeriodicityList) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if ie.SpatialRelationInfo != nil {
+		aper.SetBit(optionals, 3)
+	}
+	if ie.PathlossReferenceInfo != nil {
+		aper.SetBit(optionals, 4)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 5)
+	}
+	if err = w.WriteBits(optionals, 5); err != nil {
+		return
+	}
+	if ie.NumSRSresourcesperset != nil {
+		tmp_NumSRSresourcesperset := INTEGER{
+			c:     aper.Constraint{Lb: 1, Ub: 16},
+			ext:   true,
+			Value: aper.Integer(*ie.NumSRSresourcesperset),
+		}
+		if err = tmp_NumSRSresourcesperset.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode NumSRSresourcesperset", err)
+			return
+		}
+	}
+	if len(ie.PeriodicityList) > 0 {
+		tmp_PeriodicityList := sequenceOf[*PeriodicityListItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoSRSResourcePerSet},
+			ext: false,
+		}
+		for k := range ie.PeriodicityList {
+			tmp_PeriodicityList.Value = append(tmp_PeriodicityList.Value, &ie.PeriodicityList[k])
+		}
+		if err = tmp_PeriodicityList.encode(w); err != nil {
+			err = utils.WrapError("Encode PeriodicityList", err)
+			return
+		}
+	}
+	if ie.SpatialRelationInfo != nil {
+		if err = ie.SpatialRelationInfo.encode(w); err != nil {
+			err = utils.WrapError("Encode SpatialRelationInfo", err)
+			return
+		}
+	}
+	if ie.PathlossReferenceInfo != nil {
+		if err = ie.PathlossReferenceInfo.encode(w); err != nil {
+			err = utils.WrapError("Encode PathlossReferenceInfo", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SRSResourceSetItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SRSResourceSetItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(5); err != nil {
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp_NumSRSresourcesperset := INTEGER{
+			c:   aper.Constraint{Lb: 1, Ub: 16},
+			ext: true,
+		}
+		if err = tmp_NumSRSresourcesperset.Decode(r.AperReader); err != nil {
+			err = readError("NumSRSresourcesperset", err)
+			return
+		}
+		tmp := int64(tmp_NumSRSresourcesperset.Value)
+		ie.NumSRSresourcesperset = &tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		var tmp_PeriodicityList []*PeriodicityListItem
+		if tmp_PeriodicityList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoSRSResourcePerSet}, false, func() *PeriodicityListItem { return new(PeriodicityListItem) }); err != nil {
+			err = readError("PeriodicityList", err)
+			return
+		}
+		ie.PeriodicityList = make([]PeriodicityListItem, len(tmp_PeriodicityList))
+		for k, i := range tmp_PeriodicityList {
+			ie.PeriodicityList[k] = *i
+		}
+	}
+	if aper.IsBitSet(optionals, 3) {
+		tmp := new(SpatialRelationInfo)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SpatialRelationInfo", err)
+			return
+		}
+		ie.SpatialRelationInfo = tmp
+	}
+	if aper.IsBitSet(optionals, 4) {
+		tmp := new(PathlossReferenceInfo)
+		if err = tmp.decode(r); err != nil {
+			err = readError("PathlossReferenceInfo", err)
+			return
+		}
+		ie.PathlossReferenceInfo = tmp
+	}
+	if aper.IsBitSet(optionals, 5) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SRSResourceSetItem) Validate() error {
+	var v validator
+	if ie.NumSRSresourcesperset != nil {
+		v.integer("NumSRSresourcesperset", *ie.NumSRSresourcesperset, 1, 16)
+	}
+	if len(ie.PeriodicityList) > 0 {
+		v.size("PeriodicityList", len(ie.PeriodicityList), 1, maxnoSRSResourcePerSet)
+		for i := range ie.PeriodicityList {
+			v.item("PeriodicityList", i, &ie.PeriodicityList[i])
+		}
+	}
+	if ie.SpatialRelationInfo != nil {
+		v.ie("SpatialRelationInfo", ie.SpatialRelationInfo)
+	}
+	if ie.PathlossReferenceInfo != nil {
+		v.ie("PathlossReferenceInfo", ie.PathlossReferenceInfo)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,92 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SRSResourceTrigger struct {
+	AperiodicSRSResourceTriggerList []AperiodicSRSResourceTrigger `lb:1,ub:maxnoofSRSTriggerStates,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[SRSResourceTrigger] `optional,extension`
+}
+
+func (ie *SRSResourceTrigger) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SRSResourceTrigger) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_AperiodicSRSResourceTriggerList := sequenceOf[*AperiodicSRSResourceTrigger]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofSRSTriggerStates},
+		ext: false,
+	}
+	for k := range ie.AperiodicSRSResourceTriggerList {
+		tmp_AperiodicSRSResourceTriggerList.Value = append(tmp_AperiodicSRSResourceTriggerList.Value, &ie.AperiodicSRSResourceTriggerList[k])
+	}
+	if err = tmp_AperiodicSRSResourceTriggerList.encode(w); err != nil {
+		err = utils.WrapError("Encode AperiodicSRSResourceTriggerList", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SRSResourceTrigger) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SRSResourceTrigger) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	var tmp_AperiodicSRSResourceTriggerList []*AperiodicSRSResourceTrigger
+	if tmp_AperiodicSRSResourceTriggerList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofSRSTriggerStates}, false, func() *AperiodicSRSResourceTrigger { return new(AperiodicSRSResourceTrigger) }); err != nil {
+		err = readError("AperiodicSRSResourceTriggerList", err)
+		return
+	}
+	ie.AperiodicSRSResourceTriggerList = make([]AperiodicSRSResourceTrigger, len(tmp_AperiodicSRSResourceTriggerList))
+	for k, i := range tmp_AperiodicSRSResourceTriggerList {
+		ie.AperiodicSRSResourceTriggerList[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SRSResourceTrigger) Validate() error {
+	var v validator
+	v.size("AperiodicSRSResourceTriggerList", len(ie.AperiodicSRSResourceTriggerList), 1, maxnoofSRSTriggerStates)
+	for i := range ie.AperiodicSRSResourceTriggerList {
+		v.item("AperiodicSRSResourceTriggerList", i, &ie.AperiodicSRSResourceTriggerList[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	SRSTypePresentNothing uint64 = iota
+	SRSTypePresentSemipersistentSRS
+	SRSTypePresentAperiodicSRS
+	SRSTypePresentChoiceExtension
+)
+
+type SRSType struct {
+	Choice            uint64
+	SemipersistentSRS *SemipersistentSRS
+	AperiodicSRS      *AperiodicSRS
+	ChoiceExtension   *RawIE
+}
+
+func (ie *SRSType) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SRSType) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case SRSTypePresentSemipersistentSRS:
+		if err = ie.SemipersistentSRS.encode(w); err != nil {
+			err = utils.WrapError("Encode SemipersistentSRS", err)
+			return
+		}
+	case SRSTypePresentAperiodicSRS:
+		if err = ie.AperiodicSRS.encode(w); err != nil {
+			err = utils.WrapError("Encode AperiodicSRS", err)
+			return
+		}
+	case SRSTypePresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SRSType) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SRSType) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case SRSTypePresentSemipersistentSRS:
+		tmp := new(SemipersistentSRS)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SemipersistentSRS", err)
+			return
+		}
+		ie.SemipersistentSRS = tmp
+	case SRSTypePresentAperiodicSRS:
+		tmp := new(AperiodicSRS)
+		if err = tmp.decode(r); err != nil {
+			err = readError("AperiodicSRS", err)
+			return
+		}
+		ie.AperiodicSRS = tmp
+	case SRSTypePresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *SRSType) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case SRSTypePresentSemipersistentSRS:
+		if ie.SemipersistentSRS == nil {
+			v.absent("SemipersistentSRS")
+		} else {
+			v.ie("SemipersistentSRS", ie.SemipersistentSRS)
+		}
+	case SRSTypePresentAperiodicSRS:
+		if ie.AperiodicSRS == nil {
+			v.absent("AperiodicSRS")
+		} else {
+			v.ie("AperiodicSRS", ie.AperiodicSRS)
+		}
+	case SRSTypePresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
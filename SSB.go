@@ -0,0 +1,98 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SSB struct {
+	PCINR    NRPCI     `mandatory`
+	SsbIndex *SSBIndex `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[SSB] `optional,extension`
+}
+
+func (ie *SSB) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SSB) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.SsbIndex != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	if err = ie.PCINR.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PCINR", err)
+		return
+	}
+	if ie.SsbIndex != nil {
+		if err = ie.SsbIndex.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode SsbIndex", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SSB) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SSB) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	if err = ie.PCINR.Decode(r.AperReader); err != nil {
+		err = readError("PCINR", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(SSBIndex)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("SsbIndex", err)
+			return
+		}
+		ie.SsbIndex = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SSB) Validate() error {
+	var v validator
+	v.integer("PCINR", int64(ie.PCINR.Value), 0, 1007)
+	if ie.SsbIndex != nil {
+		v.ie("SsbIndex", ie.SsbIndex)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,92 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SSBInformation struct {
+	SSBInformationList []SSBInformationItem `lb:1,ub:maxnoofSSBs,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[SSBInformation] `optional,extension`
+}
+
+func (ie *SSBInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SSBInformation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_SSBInformationList := sequenceOf[*SSBInformationItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofSSBs},
+		ext: false,
+	}
+	for k := range ie.SSBInformationList {
+		tmp_SSBInformationList.Value = append(tmp_SSBInformationList.Value, &ie.SSBInformationList[k])
+	}
+	if err = tmp_SSBInformationList.encode(w); err != nil {
+		err = utils.WrapError("Encode SSBInformationList", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SSBInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SSBInformation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	var tmp_SSBInformationList []*SSBInformationItem
+	if tmp_SSBInformationList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofSSBs}, false, func() *SSBInformationItem { return new(SSBInformationItem) }); err != nil {
+		err = readError("SSBInformationList", err)
+		return
+	}
+	ie.SSBInformationList = make([]SSBInformationItem, len(tmp_SSBInformationList))
+	for k, i := range tmp_SSBInformationList {
+		ie.SSBInformationList[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SSBInformation) Validate() error {
+	var v validator
+	v.size("SSBInformationList", len(ie.SSBInformationList), 1, maxnoofSSBs)
+	for i := range ie.SSBInformationList {
+		v.item("SSBInformationList", i, &ie.SSBInformationList[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,87 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SSBInformationItem struct {
+	SSBConfiguration SSBTFConfiguration `mandatory`
+	PCINR            NRPCI              `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[SSBInformationItem] `optional,extension`
+}
+
+func (ie *SSBInformationItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SSBInformationItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.SSBConfiguration.encode(w); err != nil {
+		err = utils.WrapError("Encode SSBConfiguration", err)
+		return
+	}
+	if err = ie.PCINR.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PCINR", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SSBInformationItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SSBInformationItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.SSBConfiguration.decode(r); err != nil {
+		err = readError("SSBConfiguration", err)
+		return
+	}
+	if err = ie.PCINR.Decode(r.AperReader); err != nil {
+		err = readError("PCINR", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SSBInformationItem) Validate() error {
+	var v validator
+	v.ie("SSBConfiguration", &ie.SSBConfiguration)
+	v.integer("PCINR", int64(ie.PCINR.Value), 0, 1007)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,42 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+const (
+	SSBPerRACHOccasionOneEighth aper.Enumerated = 0
+	SSBPerRACHOccasionOneFourth aper.Enumerated = 1
+	SSBPerRACHOccasionOneHalf   aper.Enumerated = 2
+	SSBPerRACHOccasionOne       aper.Enumerated = 3
+	SSBPerRACHOccasionTwo       aper.Enumerated = 4
+	SSBPerRACHOccasionFour      aper.Enumerated = 5
+	SSBPerRACHOccasionEight     aper.Enumerated = 6
+	SSBPerRACHOccasionSixteen   aper.Enumerated = 7
+)
+
+type SSBPerRACHOccasion struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:7,valueExt"`
+}
+
+func (ie *SSBPerRACHOccasion) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 7}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *SSBPerRACHOccasion) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 7}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *SSBPerRACHOccasion) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 8)
+	return v.err()
+}
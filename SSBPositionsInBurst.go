@@ -0,0 +1,154 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	SSBPositionsInBurstPresentNothing uint64 = iota
+	SSBPositionsInBurstPresentShortBitmap
+	SSBPositionsInBurstPresentMediumBitmap
+	SSBPositionsInBurstPresentLongBitmap
+	SSBPositionsInBurstPresentChoiceExtension
+)
+
+type SSBPositionsInBurst struct {
+	Choice          uint64
+	ShortBitmap     *aper.BitString `lb:4,ub:4`
+	MediumBitmap    *aper.BitString `lb:8,ub:8`
+	LongBitmap      *aper.BitString `lb:64,ub:64`
+	ChoiceExtension *RawIE
+}
+
+func (ie *SSBPositionsInBurst) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SSBPositionsInBurst) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 3, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case SSBPositionsInBurstPresentShortBitmap:
+		tmp_ShortBitmap := BITSTRING{
+			c:     aper.Constraint{Lb: 4, Ub: 4},
+			ext:   false,
+			Value: *ie.ShortBitmap,
+		}
+		if err = tmp_ShortBitmap.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode ShortBitmap", err)
+			return
+		}
+	case SSBPositionsInBurstPresentMediumBitmap:
+		tmp_MediumBitmap := BITSTRING{
+			c:     aper.Constraint{Lb: 8, Ub: 8},
+			ext:   false,
+			Value: *ie.MediumBitmap,
+		}
+		if err = tmp_MediumBitmap.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode MediumBitmap", err)
+			return
+		}
+	case SSBPositionsInBurstPresentLongBitmap:
+		tmp_LongBitmap := BITSTRING{
+			c:     aper.Constraint{Lb: 64, Ub: 64},
+			ext:   false,
+			Value: *ie.LongBitmap,
+		}
+		if err = tmp_LongBitmap.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode LongBitmap", err)
+			return
+		}
+	case SSBPositionsInBurstPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SSBPositionsInBurst) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SSBPositionsInBurst) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(3, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case SSBPositionsInBurstPresentShortBitmap:
+		tmp_ShortBitmap := BITSTRING{
+			c:   aper.Constraint{Lb: 4, Ub: 4},
+			ext: false,
+		}
+		if err = tmp_ShortBitmap.Decode(r.AperReader); err != nil {
+			err = readError("ShortBitmap", err)
+			return
+		}
+		tmp := tmp_ShortBitmap.Value
+		ie.ShortBitmap = &tmp
+	case SSBPositionsInBurstPresentMediumBitmap:
+		tmp_MediumBitmap := BITSTRING{
+			c:   aper.Constraint{Lb: 8, Ub: 8},
+			ext: false,
+		}
+		if err = tmp_MediumBitmap.Decode(r.AperReader); err != nil {
+			err = readError("MediumBitmap", err)
+			return
+		}
+		tmp := tmp_MediumBitmap.Value
+		ie.MediumBitmap = &tmp
+	case SSBPositionsInBurstPresentLongBitmap:
+		tmp_LongBitmap := BITSTRING{
+			c:   aper.Constraint{Lb: 64, Ub: 64},
+			ext: false,
+		}
+		if err = tmp_LongBitmap.Decode(r.AperReader); err != nil {
+			err = readError("LongBitmap", err)
+			return
+		}
+		tmp := tmp_LongBitmap.Value
+		ie.LongBitmap = &tmp
+	case SSBPositionsInBurstPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *SSBPositionsInBurst) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case SSBPositionsInBurstPresentShortBitmap:
+		if ie.ShortBitmap == nil {
+			v.absent("ShortBitmap")
+		} else {
+			v.size("ShortBitmap", int(ie.ShortBitmap.NumBits), 4, 4)
+		}
+	case SSBPositionsInBurstPresentMediumBitmap:
+		if ie.MediumBitmap == nil {
+			v.absent("MediumBitmap")
+		} else {
+			v.size("MediumBitmap", int(ie.MediumBitmap.NumBits), 8, 8)
+		}
+	case SSBPositionsInBurstPresentLongBitmap:
+		if ie.LongBitmap == nil {
+			v.absent("LongBitmap")
+		} else {
+			v.size("LongBitmap", int(ie.LongBitmap.NumBits), 64, 64)
+		}
+	case SSBPositionsInBurstPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
@@ -0,0 +1,278 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SSBTFConfiguration struct {
+	SSBFrequency          int64                                  `lb:0,ub:3279165,mandatory`
+	SSBSubcarrierSpacing  SSBTFConfigurationSSBSubcarrierSpacing `mandatory`
+	SSBTransmitPower      int64                                  `lb:-60,ub:50,mandatory`
+	SSBPeriodicity        SSBTFConfigurationSSBPeriodicity       `mandatory`
+	SSBHalfFrameOffset    int64                                  `lb:0,ub:1,mandatory`
+	SSBSFNOffset          int64                                  `lb:0,ub:15,mandatory`
+	SSBPositionInBurst    *SSBPositionsInBurst                   `optional`
+	SFNInitialisationTime *RelativeTime1900                      `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[SSBTFConfiguration] `optional,extension`
+}
+
+func (ie *SSBTFConfiguration) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SSBTFConfiguration) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.SSBPositionInBurst != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if ie.SFNInitialisationTime != nil {
+		aper.SetBit(optionals, 2)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 3)
+	}
+	if err = w.WriteBits(optionals, 3); err != nil {
+		return
+	}
+	tmp_SSBFrequency := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 3279165},
+		ext:   false,
+		Value: aper.Integer(ie.SSBFrequency),
+	}
+	if err = tmp_SSBFrequency.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SSBFrequency", err)
+		return
+	}
+	if err = ie.SSBSubcarrierSpacing.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SSBSubcarrierSpacing", err)
+		return
+	}
+	tmp_SSBTransmitPower := INTEGER{
+		c:     aper.Constraint{Lb: -60, Ub: 50},
+		ext:   false,
+		Value: aper.Integer(ie.SSBTransmitPower),
+	}
+	if err = tmp_SSBTransmitPower.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SSBTransmitPower", err)
+		return
+	}
+	if err = ie.SSBPeriodicity.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SSBPeriodicity", err)
+		return
+	}
+	tmp_SSBHalfFrameOffset := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 1},
+		ext:   false,
+		Value: aper.Integer(ie.SSBHalfFrameOffset),
+	}
+	if err = tmp_SSBHalfFrameOffset.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SSBHalfFrameOffset", err)
+		return
+	}
+	tmp_SSBSFNOffset := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 15},
+		ext:   false,
+		Value: aper.Integer(ie.SSBSFNOffset),
+	}
+	if err = tmp_SSBSFNOffset.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SSBSFNOffset", err)
+		return
+	}
+	if ie.SSBPositionInBurst != nil {
+		if err = ie.SSBPositionInBurst.encode(w); err != nil {
+			err = utils.WrapError("Encode SSBPositionInBurst", err)
+			return
+		}
+	}
+	if ie.SFNInitialisationTime != nil {
+		if err = ie.SFNInitialisationTime.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode SFNInitialisationTime", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SSBTFConfiguration) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SSBTFConfiguration) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(3); err != nil {
+		return
+	}
+	tmp_SSBFrequency := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 3279165},
+		ext: false,
+	}
+	if err = tmp_SSBFrequency.Decode(r.AperReader); err != nil {
+		err = readError("SSBFrequency", err)
+		return
+	}
+	ie.SSBFrequency = int64(tmp_SSBFrequency.Value)
+	if err = ie.SSBSubcarrierSpacing.Decode(r.AperReader); err != nil {
+		err = readError("SSBSubcarrierSpacing", err)
+		return
+	}
+	tmp_SSBTransmitPower := INTEGER{
+		c:   aper.Constraint{Lb: -60, Ub: 50},
+		ext: false,
+	}
+	if err = tmp_SSBTransmitPower.Decode(r.AperReader); err != nil {
+		err = readError("SSBTransmitPower", err)
+		return
+	}
+	ie.SSBTransmitPower = int64(tmp_SSBTransmitPower.Value)
+	if err = ie.SSBPeriodicity.Decode(r.AperReader); err != nil {
+		err = readError("SSBPeriodicity", err)
+		return
+	}
+	tmp_SSBHalfFrameOffset := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 1},
+		ext: false,
+	}
+	if err = tmp_SSBHalfFrameOffset.Decode(r.AperReader); err != nil {
+		err = readError("SSBHalfFrameOffset", err)
+		return
+	}
+	ie.SSBHalfFrameOffset = int64(tmp_SSBHalfFrameOffset.Value)
+	tmp_SSBSFNOffset := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 15},
+		ext: false,
+	}
+	if err = tmp_SSBSFNOffset.Decode(r.AperReader); err != nil {
+		err = readError("SSBSFNOffset", err)
+		return
+	}
+	ie.SSBSFNOffset = int64(tmp_SSBSFNOffset.Value)
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(SSBPositionsInBurst)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SSBPositionInBurst", err)
+			return
+		}
+		ie.SSBPositionInBurst = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		tmp := new(RelativeTime1900)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SFNInitialisationTime", err)
+			return
+		}
+		ie.SFNInitialisationTime = tmp
+	}
+	if aper.IsBitSet(optionals, 3) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SSBTFConfiguration) Validate() error {
+	var v validator
+	v.integer("SSBFrequency", ie.SSBFrequency, 0, 3279165)
+	v.ie("SSBSubcarrierSpacing", &ie.SSBSubcarrierSpacing)
+	v.integer("SSBTransmitPower", ie.SSBTransmitPower, -60, 50)
+	v.ie("SSBPeriodicity", &ie.SSBPeriodicity)
+	v.integer("SSBHalfFrameOffset", ie.SSBHalfFrameOffset, 0, 1)
+	v.integer("SSBSFNOffset", ie.SSBSFNOffset, 0, 15)
+	if ie.SSBPositionInBurst != nil {
+		v.ie("SSBPositionInBurst", ie.SSBPositionInBurst)
+	}
+	if ie.SFNInitialisationTime != nil {
+		v.ie("SFNInitialisationTime", ie.SFNInitialisationTime)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
+
+const (
+	SSBTFConfigurationSSBSubcarrierSpacingKHz15  aper.Enumerated = 0
+	SSBTFConfigurationSSBSubcarrierSpacingKHz30  aper.Enumerated = 1
+	SSBTFConfigurationSSBSubcarrierSpacingKHz120 aper.Enumerated = 2
+	SSBTFConfigurationSSBSubcarrierSpacingKHz240 aper.Enumerated = 3
+	SSBTFConfigurationSSBSubcarrierSpacingKHz60  aper.Enumerated = 4
+)
+
+type SSBTFConfigurationSSBSubcarrierSpacing struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:3,valueExt"`
+}
+
+func (ie *SSBTFConfigurationSSBSubcarrierSpacing) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *SSBTFConfigurationSSBSubcarrierSpacing) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *SSBTFConfigurationSSBSubcarrierSpacing) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 5)
+	return v.err()
+}
+
+const (
+	SSBTFConfigurationSSBPeriodicityMs5   aper.Enumerated = 0
+	SSBTFConfigurationSSBPeriodicityMs10  aper.Enumerated = 1
+	SSBTFConfigurationSSBPeriodicityMs20  aper.Enumerated = 2
+	SSBTFConfigurationSSBPeriodicityMs40  aper.Enumerated = 3
+	SSBTFConfigurationSSBPeriodicityMs80  aper.Enumerated = 4
+	SSBTFConfigurationSSBPeriodicityMs160 aper.Enumerated = 5
+)
+
+type SSBTFConfigurationSSBPeriodicity struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:5,valueExt"`
+}
+
+func (ie *SSBTFConfigurationSSBPeriodicity) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 5}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *SSBTFConfigurationSSBPeriodicity) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 5}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *SSBTFConfigurationSSBPeriodicity) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 6)
+	return v.err()
+}
@@ -0,0 +1,159 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SULInformation struct {
+	SULNRARFCN               int64                 `lb:0,ub:maxNRARFCN,mandatory`
+	SULTransmissionBandwidth TransmissionBandwidth `mandatory`
+	// IEExtensions
+	CarrierList          []NRCarrierItem                            `lb:1,ub:maxnoofNrCellBands,optional,ignore,extension`
+	FrequencyShift7p5khz *FrequencyShift7p5khz                      `optional,ignore,extension`
+	Extensions           ProtocolExtensionContainer[SULInformation] `optional,extension`
+}
+
+func (ie *SULInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SULInformation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(ie.extensions())
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_SULNRARFCN := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: maxNRARFCN},
+		ext:   false,
+		Value: aper.Integer(ie.SULNRARFCN),
+	}
+	if err = tmp_SULNRARFCN.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SULNRARFCN", err)
+		return
+	}
+	if err = ie.SULTransmissionBandwidth.encode(w); err != nil {
+		err = utils.WrapError("Encode SULTransmissionBandwidth", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SULInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SULInformation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	tmp_SULNRARFCN := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: maxNRARFCN},
+		ext: false,
+	}
+	if err = tmp_SULNRARFCN.Decode(r.AperReader); err != nil {
+		err = readError("SULNRARFCN", err)
+		return
+	}
+	ie.SULNRARFCN = int64(tmp_SULNRARFCN.Value)
+	if err = ie.SULTransmissionBandwidth.decode(r); err != nil {
+		err = readError("SULTransmissionBandwidth", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, ie.decodeExtension); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SULInformation) extensions() (exts []F1apMessageIE) {
+	if len(ie.CarrierList) > 0 {
+		tmp_CarrierList := sequenceOf[*NRCarrierItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofNrCellBands},
+			ext: false,
+		}
+		for k := range ie.CarrierList {
+			tmp_CarrierList.Value = append(tmp_CarrierList.Value, &ie.CarrierList[k])
+		}
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CarrierList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_CarrierList,
+		})
+	}
+	if ie.FrequencyShift7p5khz != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_FrequencyShift7p5khz},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.FrequencyShift7p5khz,
+		})
+	}
+	return
+}
+
+func (ie *SULInformation) decodeExtension(id aper.Integer, r *decoder) (ok bool, err error) {
+	ok = true
+	switch id {
+	case ProtocolIEID_CarrierList:
+		var tmp []*NRCarrierItem
+		if tmp, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofNrCellBands}, false, func() *NRCarrierItem { return new(NRCarrierItem) }); err != nil {
+			err = readError("CarrierList", err)
+			return
+		}
+		ie.CarrierList = make([]NRCarrierItem, len(tmp))
+		for k, i := range tmp {
+			ie.CarrierList[k] = *i
+		}
+	case ProtocolIEID_FrequencyShift7p5khz:
+		tmp := new(FrequencyShift7p5khz)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("FrequencyShift7p5khz", err)
+			return
+		}
+		ie.FrequencyShift7p5khz = tmp
+	default:
+		ok = false
+	}
+	return
+}
+
+func (ie *SULInformation) Validate() error {
+	var v validator
+	v.integer("SULNRARFCN", ie.SULNRARFCN, 0, maxNRARFCN)
+	v.ie("SULTransmissionBandwidth", &ie.SULTransmissionBandwidth)
+	if len(ie.CarrierList) > 0 {
+		v.size("CarrierList", len(ie.CarrierList), 1, maxnoofNrCellBands)
+		for i := range ie.CarrierList {
+			v.item("CarrierList", i, &ie.CarrierList[i])
+		}
+	}
+	if ie.FrequencyShift7p5khz != nil {
+		v.ie("FrequencyShift7p5khz", ie.FrequencyShift7p5khz)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
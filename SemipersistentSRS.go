@@ -0,0 +1,98 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SemipersistentSRS struct {
+	SRSResourceSetID   SRSResourceSetID     `mandatory`
+	SRSSpatialRelation *SpatialRelationInfo `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[SemipersistentSRS] `optional,extension`
+}
+
+func (ie *SemipersistentSRS) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SemipersistentSRS) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.SRSSpatialRelation != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	if err = ie.SRSResourceSetID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SRSResourceSetID", err)
+		return
+	}
+	if ie.SRSSpatialRelation != nil {
+		if err = ie.SRSSpatialRelation.encode(w); err != nil {
+			err = utils.WrapError("Encode SRSSpatialRelation", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SemipersistentSRS) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SemipersistentSRS) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	if err = ie.SRSResourceSetID.Decode(r.AperReader); err != nil {
+		err = readError("SRSResourceSetID", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(SpatialRelationInfo)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SRSSpatialRelation", err)
+			return
+		}
+		ie.SRSSpatialRelation = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SemipersistentSRS) Validate() error {
+	var v validator
+	v.ie("SRSResourceSetID", &ie.SRSResourceSetID)
+	if ie.SRSSpatialRelation != nil {
+		v.ie("SRSSpatialRelation", ie.SRSSpatialRelation)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
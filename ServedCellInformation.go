@@ -0,0 +1,414 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type ServedCellInformation struct {
+	NRCGI                          NRCGI             `mandatory`
+	NRPCI                          NRPCI             `mandatory`
+	FiveGSTAC                      *FiveGSTAC        `optional`
+	ConfiguredEPSTAC               *ConfiguredEPSTAC `optional`
+	ServedPLMNs                    []ServedPLMNsItem `lb:1,ub:maxnoofBPLMNs,mandatory`
+	NRModeInfo                     NRModeInfo        `mandatory`
+	MeasurementTimingConfiguration []byte            `mandatory`
+	// IEExtensions
+	RANAC                   *RANAC                                            `optional,ignore,extension`
+	ExtendedServedPLMNsList []ExtendedServedPLMNsItem                         `lb:1,ub:maxnoofExtendedBPLMNs,optional,ignore,extension`
+	CellDirection           *CellDirection                                    `optional,ignore,extension`
+	BPLMNIDInfoList         []BPLMNIDInfoItem                                 `lb:1,ub:maxnoofBPLMNsNR,optional,ignore,extension`
+	CellType                *CellType                                         `optional,ignore,extension`
+	ConfiguredTACIndication *ConfiguredTACIndication                          `optional,ignore,extension`
+	AggressorGNBDUSetID     *AggressorGNBSetID                                `optional,ignore,extension`
+	VictimGNBDUSetID        *VictimGNBSetID                                   `optional,ignore,extension`
+	IABInfoIABDU            *IABInfoIABDU                                     `optional,ignore,extension`
+	SSBPositionsInBurst     *SSBPositionsInBurst                              `optional,ignore,extension`
+	NRPRACHConfig           *NRPRACHConfig                                    `optional,ignore,extension`
+	NPNBroadcastInformation *NPNBroadcastInformation                          `optional,reject,extension`
+	Extensions              ProtocolExtensionContainer[ServedCellInformation] `optional,extension`
+}
+
+func (ie *ServedCellInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ServedCellInformation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(ie.extensions())
+	optionals := []byte{0x0}
+	if ie.FiveGSTAC != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if ie.ConfiguredEPSTAC != nil {
+		aper.SetBit(optionals, 2)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 3)
+	}
+	if err = w.WriteBits(optionals, 3); err != nil {
+		return
+	}
+	if err = ie.NRCGI.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode NRCGI", err)
+		return
+	}
+	if err = ie.NRPCI.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode NRPCI", err)
+		return
+	}
+	if ie.FiveGSTAC != nil {
+		if err = ie.FiveGSTAC.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode FiveGSTAC", err)
+			return
+		}
+	}
+	if ie.ConfiguredEPSTAC != nil {
+		if err = ie.ConfiguredEPSTAC.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode ConfiguredEPSTAC", err)
+			return
+		}
+	}
+	tmp_ServedPLMNs := sequenceOf[*ServedPLMNsItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofBPLMNs},
+		ext: false,
+	}
+	for k := range ie.ServedPLMNs {
+		tmp_ServedPLMNs.Value = append(tmp_ServedPLMNs.Value, &ie.ServedPLMNs[k])
+	}
+	if err = tmp_ServedPLMNs.encode(w); err != nil {
+		err = utils.WrapError("Encode ServedPLMNs", err)
+		return
+	}
+	if err = ie.NRModeInfo.encode(w); err != nil {
+		err = utils.WrapError("Encode NRModeInfo", err)
+		return
+	}
+	tmp_MeasurementTimingConfiguration := OCTETSTRING{
+		c:     aper.Constraint{Lb: 0, Ub: 0},
+		ext:   false,
+		Value: ie.MeasurementTimingConfiguration,
+	}
+	if err = tmp_MeasurementTimingConfiguration.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode MeasurementTimingConfiguration", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ServedCellInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ServedCellInformation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(3); err != nil {
+		return
+	}
+	if err = ie.NRCGI.Decode(r.AperReader); err != nil {
+		err = readError("NRCGI", err)
+		return
+	}
+	if err = ie.NRPCI.Decode(r.AperReader); err != nil {
+		err = readError("NRPCI", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(FiveGSTAC)
+		if err = tmp.decode(r); err != nil {
+			err = readError("FiveGSTAC", err)
+			return
+		}
+		ie.FiveGSTAC = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		tmp := new(ConfiguredEPSTAC)
+		if err = tmp.decode(r); err != nil {
+			err = readError("ConfiguredEPSTAC", err)
+			return
+		}
+		ie.ConfiguredEPSTAC = tmp
+	}
+	var tmp_ServedPLMNs []*ServedPLMNsItem
+	if tmp_ServedPLMNs, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofBPLMNs}, false, func() *ServedPLMNsItem { return new(ServedPLMNsItem) }); err != nil {
+		err = readError("ServedPLMNs", err)
+		return
+	}
+	ie.ServedPLMNs = make([]ServedPLMNsItem, len(tmp_ServedPLMNs))
+	for k, i := range tmp_ServedPLMNs {
+		ie.ServedPLMNs[k] = *i
+	}
+	if err = ie.NRModeInfo.decode(r); err != nil {
+		err = readError("NRModeInfo", err)
+		return
+	}
+	if ie.MeasurementTimingConfiguration, err = readOctetString(r, nil, false); err != nil {
+		err = readError("MeasurementTimingConfiguration", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 3) {
+		if err = ie.Extensions.decode(r, ie.decodeExtension); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *ServedCellInformation) extensions() (exts []F1apMessageIE) {
+	if ie.RANAC != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RANAC},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.RANAC,
+		})
+	}
+	if len(ie.ExtendedServedPLMNsList) > 0 {
+		tmp_ExtendedServedPLMNsList := sequenceOf[*ExtendedServedPLMNsItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofExtendedBPLMNs},
+			ext: false,
+		}
+		for k := range ie.ExtendedServedPLMNsList {
+			tmp_ExtendedServedPLMNsList.Value = append(tmp_ExtendedServedPLMNsList.Value, &ie.ExtendedServedPLMNsList[k])
+		}
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ExtendedServedPLMNsList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_ExtendedServedPLMNsList,
+		})
+	}
+	if ie.CellDirection != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CellDirection},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.CellDirection,
+		})
+	}
+	if len(ie.BPLMNIDInfoList) > 0 {
+		tmp_BPLMNIDInfoList := sequenceOf[*BPLMNIDInfoItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofBPLMNsNR},
+			ext: false,
+		}
+		for k := range ie.BPLMNIDInfoList {
+			tmp_BPLMNIDInfoList.Value = append(tmp_BPLMNIDInfoList.Value, &ie.BPLMNIDInfoList[k])
+		}
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_BPLMNIDInfoList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_BPLMNIDInfoList,
+		})
+	}
+	if ie.CellType != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CellType},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.CellType,
+		})
+	}
+	if ie.ConfiguredTACIndication != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ConfiguredTACIndication},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.ConfiguredTACIndication,
+		})
+	}
+	if ie.AggressorGNBDUSetID != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_AggressorGNBDUSetID},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.AggressorGNBDUSetID,
+		})
+	}
+	if ie.VictimGNBDUSetID != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_VictimGNBDUSetID},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.VictimGNBDUSetID,
+		})
+	}
+	if ie.IABInfoIABDU != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_IABInfoIABDU},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.IABInfoIABDU,
+		})
+	}
+	if ie.SSBPositionsInBurst != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SSBPositionsInBurst},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.SSBPositionsInBurst,
+		})
+	}
+	if ie.NRPRACHConfig != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_NRPRACHConfig},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.NRPRACHConfig,
+		})
+	}
+	if ie.NPNBroadcastInformation != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_NPNBroadcastInformation},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       ie.NPNBroadcastInformation,
+		})
+	}
+	return
+}
+
+func (ie *ServedCellInformation) decodeExtension(id aper.Integer, r *decoder) (ok bool, err error) {
+	ok = true
+	switch id {
+	case ProtocolIEID_RANAC:
+		tmp := new(RANAC)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("RANAC", err)
+			return
+		}
+		ie.RANAC = tmp
+	case ProtocolIEID_ExtendedServedPLMNsList:
+		var tmp []*ExtendedServedPLMNsItem
+		if tmp, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofExtendedBPLMNs}, false, func() *ExtendedServedPLMNsItem { return new(ExtendedServedPLMNsItem) }); err != nil {
+			err = readError("ExtendedServedPLMNsList", err)
+			return
+		}
+		ie.ExtendedServedPLMNsList = make([]ExtendedServedPLMNsItem, len(tmp))
+		for k, i := range tmp {
+			ie.ExtendedServedPLMNsList[k] = *i
+		}
+	case ProtocolIEID_CellDirection:
+		tmp := new(CellDirection)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("CellDirection", err)
+			return
+		}
+		ie.CellDirection = tmp
+	case ProtocolIEID_BPLMNIDInfoList:
+		var tmp []*BPLMNIDInfoItem
+		if tmp, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofBPLMNsNR}, false, func() *BPLMNIDInfoItem { return new(BPLMNIDInfoItem) }); err != nil {
+			err = readError("BPLMNIDInfoList", err)
+			return
+		}
+		ie.BPLMNIDInfoList = make([]BPLMNIDInfoItem, len(tmp))
+		for k, i := range tmp {
+			ie.BPLMNIDInfoList[k] = *i
+		}
+	case ProtocolIEID_CellType:
+		tmp := new(CellType)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("CellType", err)
+			return
+		}
+		ie.CellType = tmp
+	case ProtocolIEID_ConfiguredTACIndication:
+		tmp := new(ConfiguredTACIndication)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("ConfiguredTACIndication", err)
+			return
+		}
+		ie.ConfiguredTACIndication = tmp
+	case ProtocolIEID_AggressorGNBDUSetID:
+		tmp := new(AggressorGNBSetID)
+		if err = tmp.decode(r); err != nil {
+			err = readError("AggressorGNBDUSetID", err)
+			return
+		}
+		ie.AggressorGNBDUSetID = tmp
+	case ProtocolIEID_VictimGNBDUSetID:
+		tmp := new(VictimGNBSetID)
+		if err = tmp.decode(r); err != nil {
+			err = readError("VictimGNBDUSetID", err)
+			return
+		}
+		ie.VictimGNBDUSetID = tmp
+	case ProtocolIEID_IABInfoIABDU:
+		tmp := new(IABInfoIABDU)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("IABInfoIABDU", err)
+			return
+		}
+		ie.IABInfoIABDU = tmp
+	case ProtocolIEID_SSBPositionsInBurst:
+		tmp := new(SSBPositionsInBurst)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SSBPositionsInBurst", err)
+			return
+		}
+		ie.SSBPositionsInBurst = tmp
+	case ProtocolIEID_NRPRACHConfig:
+		tmp := new(NRPRACHConfig)
+		if err = tmp.decode(r); err != nil {
+			err = readError("NRPRACHConfig", err)
+			return
+		}
+		ie.NRPRACHConfig = tmp
+	case ProtocolIEID_NPNBroadcastInformation:
+		tmp := new(NPNBroadcastInformation)
+		if err = tmp.decode(r); err != nil {
+			err = readError("NPNBroadcastInformation", err)
+			return
+		}
+		ie.NPNBroadcastInformation = tmp
+	default:
+		ok = false
+	}
+	return
+}
+
+func (ie *ServedCellInformation) Validate() error {
+	var v validator
+	v.integer("NRPCI", int64(ie.NRPCI.Value), 0, 1007)
+	if ie.FiveGSTAC != nil {
+		v.ie("FiveGSTAC", ie.FiveGSTAC)
+	}
+	if ie.ConfiguredEPSTAC != nil {
+		v.ie("ConfiguredEPSTAC", ie.ConfiguredEPSTAC)
+	}
+	v.size("ServedPLMNs", len(ie.ServedPLMNs), 1, maxnoofBPLMNs)
+	for i := range ie.ServedPLMNs {
+		v.item("ServedPLMNs", i, &ie.ServedPLMNs[i])
+	}
+	v.ie("NRModeInfo", &ie.NRModeInfo)
+	if len(ie.ExtendedServedPLMNsList) > 0 {
+		v.size("ExtendedServedPLMNsList", len(ie.ExtendedServedPLMNsList), 1, maxnoofExtendedBPLMNs)
+	}
+	if len(ie.BPLMNIDInfoList) > 0 {
+		v.size("BPLMNIDInfoList", len(ie.BPLMNIDInfoList), 1, maxnoofBPLMNsNR)
+	}
+	if ie.ConfiguredTACIndication != nil {
+		v.ie("ConfiguredTACIndication", ie.ConfiguredTACIndication)
+	}
+	if ie.AggressorGNBDUSetID != nil {
+		v.ie("AggressorGNBDUSetID", ie.AggressorGNBDUSetID)
+	}
+	if ie.VictimGNBDUSetID != nil {
+		v.ie("VictimGNBDUSetID", ie.VictimGNBDUSetID)
+	}
+	if ie.SSBPositionsInBurst != nil {
+		v.ie("SSBPositionsInBurst", ie.SSBPositionsInBurst)
+	}
+	if ie.NRPRACHConfig != nil {
+		v.ie("NRPRACHConfig", ie.NRPRACHConfig)
+	}
+	if ie.NPNBroadcastInformation != nil {
+		v.ie("NPNBroadcastInformation", ie.NPNBroadcastInformation)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,98 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type ServedCellsToAddItem struct {
+	ServedCellInformation  ServedCellInformation   `mandatory`
+	GNBDUSystemInformation *GNBDUSystemInformation `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[ServedCellsToAddItem] `optional,extension`
+}
+
+func (ie *ServedCellsToAddItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ServedCellsToAddItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.GNBDUSystemInformation != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	if err = ie.ServedCellInformation.encode(w); err != nil {
+		err = utils.WrapError("Encode ServedCellInformation", err)
+		return
+	}
+	if ie.GNBDUSystemInformation != nil {
+		if err = ie.GNBDUSystemInformation.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode GNBDUSystemInformation", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ServedCellsToAddItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ServedCellsToAddItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	if err = ie.ServedCellInformation.decode(r); err != nil {
+		err = readError("ServedCellInformation", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(GNBDUSystemInformation)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("GNBDUSystemInformation", err)
+			return
+		}
+		ie.GNBDUSystemInformation = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *ServedCellsToAddItem) Validate() error {
+	var v validator
+	v.ie("ServedCellInformation", &ie.ServedCellInformation)
+	if ie.GNBDUSystemInformation != nil {
+		v.ie("GNBDUSystemInformation", ie.GNBDUSystemInformation)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,170 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type ServedPLMNsItem struct {
+	PLMNIdentity PLMNIdentity `mandatory`
+	// IEExtensions
+	TAISliceSupportList         []SliceSupportItem                          `lb:1,ub:maxnoofSliceItems,optional,ignore,extension`
+	NPNSupportInfo              *NPNSupportInfo                             `optional,reject,extension`
+	ExtendedTAISliceSupportList []SliceSupportItem                          `lb:1,ub:maxnoofExtSliceItems,optional,reject,extension`
+	Extensions                  ProtocolExtensionContainer[ServedPLMNsItem] `optional,extension`
+}
+
+func (ie *ServedPLMNsItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ServedPLMNsItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(ie.extensions())
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.PLMNIdentity.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PLMNIdentity", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ServedPLMNsItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ServedPLMNsItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.PLMNIdentity.Decode(r.AperReader); err != nil {
+		err = readError("PLMNIdentity", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, ie.decodeExtension); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *ServedPLMNsItem) extensions() (exts []F1apMessageIE) {
+	if len(ie.TAISliceSupportList) > 0 {
+		tmp_TAISliceSupportList := sequenceOf[*SliceSupportItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofSliceItems},
+			ext: false,
+		}
+		for k := range ie.TAISliceSupportList {
+			tmp_TAISliceSupportList.Value = append(tmp_TAISliceSupportList.Value, &ie.TAISliceSupportList[k])
+		}
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_TAISliceSupportList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_TAISliceSupportList,
+		})
+	}
+	if ie.NPNSupportInfo != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_NPNSupportInfo},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       ie.NPNSupportInfo,
+		})
+	}
+	if len(ie.ExtendedTAISliceSupportList) > 0 {
+		tmp_ExtendedTAISliceSupportList := sequenceOf[*SliceSupportItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofExtSliceItems},
+			ext: false,
+		}
+		for k := range ie.ExtendedTAISliceSupportList {
+			tmp_ExtendedTAISliceSupportList.Value = append(tmp_ExtendedTAISliceSupportList.Value, &ie.ExtendedTAISliceSupportList[k])
+		}
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ExtendedTAISliceSupportList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_ExtendedTAISliceSupportList,
+		})
+	}
+	return
+}
+
+func (ie *ServedPLMNsItem) decodeExtension(id aper.Integer, r *decoder) (ok bool, err error) {
+	ok = true
+	switch id {
+	case ProtocolIEID_TAISliceSupportList:
+		var tmp []*SliceSupportItem
+		if tmp, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofSliceItems}, false, func() *SliceSupportItem { return new(SliceSupportItem) }); err != nil {
+			err = readError("TAISliceSupportList", err)
+			return
+		}
+		ie.TAISliceSupportList = make([]SliceSupportItem, len(tmp))
+		for k, i := range tmp {
+			ie.TAISliceSupportList[k] = *i
+		}
+	case ProtocolIEID_NPNSupportInfo:
+		tmp := new(NPNSupportInfo)
+		if err = tmp.decode(r); err != nil {
+			err = readError("NPNSupportInfo", err)
+			return
+		}
+		ie.NPNSupportInfo = tmp
+	case ProtocolIEID_ExtendedTAISliceSupportList:
+		var tmp []*SliceSupportItem
+		if tmp, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofExtSliceItems}, false, func() *SliceSupportItem { return new(SliceSupportItem) }); err != nil {
+			err = readError("ExtendedTAISliceSupportList", err)
+			return
+		}
+		ie.ExtendedTAISliceSupportList = make([]SliceSupportItem, len(tmp))
+		for k, i := range tmp {
+			ie.ExtendedTAISliceSupportList[k] = *i
+		}
+	default:
+		ok = false
+	}
+	return
+}
+
+func (ie *ServedPLMNsItem) Validate() error {
+	var v validator
+	v.size("PLMNIdentity", len(ie.PLMNIdentity.Value), 3, 3)
+	if len(ie.TAISliceSupportList) > 0 {
+		v.size("TAISliceSupportList", len(ie.TAISliceSupportList), 1, maxnoofSliceItems)
+		for i := range ie.TAISliceSupportList {
+			v.item("TAISliceSupportList", i, &ie.TAISliceSupportList[i])
+		}
+	}
+	if ie.NPNSupportInfo != nil {
+		v.ie("NPNSupportInfo", ie.NPNSupportInfo)
+	}
+	if len(ie.ExtendedTAISliceSupportList) > 0 {
+		v.size("ExtendedTAISliceSupportList", len(ie.ExtendedTAISliceSupportList), 1, maxnoofExtSliceItems)
+		for i := range ie.ExtendedTAISliceSupportList {
+			v.item("ExtendedTAISliceSupportList", i, &ie.ExtendedTAISliceSupportList[i])
+		}
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
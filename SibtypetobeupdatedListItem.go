@@ -0,0 +1,142 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SibtypetobeupdatedListItem struct {
+	SIBtype    SIBType `mandatory`
+	SIBmessage []byte  `mandatory`
+	ValueTag   int64   `lb:0,ub:31,valueExt,mandatory`
+	// IEExtensions
+	AreaScope  *AreaScope                                             `optional,ignore,extension`
+	Extensions ProtocolExtensionContainer[SibtypetobeupdatedListItem] `optional,extension`
+}
+
+func (ie *SibtypetobeupdatedListItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SibtypetobeupdatedListItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(ie.extensions())
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.SIBtype.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SIBtype", err)
+		return
+	}
+	tmp_SIBmessage := OCTETSTRING{
+		c:     aper.Constraint{Lb: 0, Ub: 0},
+		ext:   false,
+		Value: ie.SIBmessage,
+	}
+	if err = tmp_SIBmessage.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SIBmessage", err)
+		return
+	}
+	tmp_ValueTag := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 31},
+		ext:   true,
+		Value: aper.Integer(ie.ValueTag),
+	}
+	if err = tmp_ValueTag.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode ValueTag", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SibtypetobeupdatedListItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SibtypetobeupdatedListItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.SIBtype.Decode(r.AperReader); err != nil {
+		err = readError("SIBtype", err)
+		return
+	}
+	if ie.SIBmessage, err = readOctetString(r, nil, false); err != nil {
+		err = readError("SIBmessage", err)
+		return
+	}
+	tmp_ValueTag := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 31},
+		ext: true,
+	}
+	if err = tmp_ValueTag.Decode(r.AperReader); err != nil {
+		err = readError("ValueTag", err)
+		return
+	}
+	ie.ValueTag = int64(tmp_ValueTag.Value)
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, ie.decodeExtension); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SibtypetobeupdatedListItem) extensions() (exts []F1apMessageIE) {
+	if ie.AreaScope != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_AreaScope},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.AreaScope,
+		})
+	}
+	return
+}
+
+func (ie *SibtypetobeupdatedListItem) decodeExtension(id aper.Integer, r *decoder) (ok bool, err error) {
+	ok = true
+	switch id {
+	case ProtocolIEID_AreaScope:
+		tmp := new(AreaScope)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("AreaScope", err)
+			return
+		}
+		ie.AreaScope = tmp
+	default:
+		ok = false
+	}
+	return
+}
+
+func (ie *SibtypetobeupdatedListItem) Validate() error {
+	var v validator
+	v.ie("SIBtype", &ie.SIBtype)
+	v.integer("ValueTag", ie.ValueTag, 0, 31)
+	if ie.AreaScope != nil {
+		v.ie("AreaScope", ie.AreaScope)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,97 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SlotConfigurationItem struct {
+	SlotIndex         int64             `lb:0,ub:5119,mandatory`
+	SymbolAllocInSlot SymbolAllocInSlot `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[SlotConfigurationItem] `optional,extension`
+}
+
+func (ie *SlotConfigurationItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SlotConfigurationItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_SlotIndex := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 5119},
+		ext:   false,
+		Value: aper.Integer(ie.SlotIndex),
+	}
+	if err = tmp_SlotIndex.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode SlotIndex", err)
+		return
+	}
+	if err = ie.SymbolAllocInSlot.encode(w); err != nil {
+		err = utils.WrapError("Encode SymbolAllocInSlot", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SlotConfigurationItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SlotConfigurationItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	tmp_SlotIndex := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 5119},
+		ext: false,
+	}
+	if err = tmp_SlotIndex.Decode(r.AperReader); err != nil {
+		err = readError("SlotIndex", err)
+		return
+	}
+	ie.SlotIndex = int64(tmp_SlotIndex.Value)
+	if err = ie.SymbolAllocInSlot.decode(r); err != nil {
+		err = readError("SymbolAllocInSlot", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SlotConfigurationItem) Validate() error {
+	var v validator
+	v.integer("SlotIndex", ie.SlotIndex, 0, 5119)
+	v.ie("SymbolAllocInSlot", &ie.SymbolAllocInSlot)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,77 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SpatialDirectionInformation struct {
+	NRPRSBeamInformation NRPRSBeamInformation `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[SpatialDirectionInformation] `optional,extension`
+}
+
+func (ie *SpatialDirectionInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SpatialDirectionInformation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.NRPRSBeamInformation.encode(w); err != nil {
+		err = utils.WrapError("Encode NRPRSBeamInformation", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SpatialDirectionInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SpatialDirectionInformation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.NRPRSBeamInformation.decode(r); err != nil {
+		err = readError("NRPRSBeamInformation", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SpatialDirectionInformation) Validate() error {
+	var v validator
+	v.ie("NRPRSBeamInformation", &ie.NRPRSBeamInformation)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,92 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SpatialRelationInfo struct {
+	SpatialRelationforResourceID []SpatialRelationforResourceIDItem `lb:1,ub:maxnoSRSResourcePerSet,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[SpatialRelationInfo] `optional,extension`
+}
+
+func (ie *SpatialRelationInfo) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SpatialRelationInfo) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_SpatialRelationforResourceID := sequenceOf[*SpatialRelationforResourceIDItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoSRSResourcePerSet},
+		ext: false,
+	}
+	for k := range ie.SpatialRelationforResourceID {
+		tmp_SpatialRelationforResourceID.Value = append(tmp_SpatialRelationforResourceID.Value, &ie.SpatialRelationforResourceID[k])
+	}
+	if err = tmp_SpatialRelationforResourceID.encode(w); err != nil {
+		err = utils.WrapError("Encode SpatialRelationforResourceID", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SpatialRelationInfo) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SpatialRelationInfo) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	var tmp_SpatialRelationforResourceID []*SpatialRelationforResourceIDItem
+	if tmp_SpatialRelationforResourceID, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoSRSResourcePerSet}, false, func() *SpatialRelationforResourceIDItem { return new(SpatialRelationforResourceIDItem) }); err != nil {
+		err = readError("SpatialRelationforResourceID", err)
+		return
+	}
+	ie.SpatialRelationforResourceID = make([]SpatialRelationforResourceIDItem, len(tmp_SpatialRelationforResourceID))
+	for k, i := range tmp_SpatialRelationforResourceID {
+		ie.SpatialRelationforResourceID[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SpatialRelationInfo) Validate() error {
+	var v validator
+	v.size("SpatialRelationforResourceID", len(ie.SpatialRelationforResourceID), 1, maxnoSRSResourcePerSet)
+	for i := range ie.SpatialRelationforResourceID {
+		v.item("SpatialRelationforResourceID", i, &ie.SpatialRelationforResourceID[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	SpatialRelationPosPresentNothing uint64 = iota
+	SpatialRelationPosPresentSSBPos
+	SpatialRelationPosPresentPRSInformationPos
+	SpatialRelationPosPresentChoiceExtension
+)
+
+type SpatialRelationPos struct {
+	Choice            uint64
+	SSBPos            *SSB
+	PRSInformationPos *PRSInformationPos
+	ChoiceExtension   *RawIE
+}
+
+func (ie *SpatialRelationPos) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SpatialRelationPos) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case SpatialRelationPosPresentSSBPos:
+		if err = ie.SSBPos.encode(w); err != nil {
+			err = utils.WrapError("Encode SSBPos", err)
+			return
+		}
+	case SpatialRelationPosPresentPRSInformationPos:
+		if err = ie.PRSInformationPos.encode(w); err != nil {
+			err = utils.WrapError("Encode PRSInformationPos", err)
+			return
+		}
+	case SpatialRelationPosPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SpatialRelationPos) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SpatialRelationPos) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case SpatialRelationPosPresentSSBPos:
+		tmp := new(SSB)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SSBPos", err)
+			return
+		}
+		ie.SSBPos = tmp
+	case SpatialRelationPosPresentPRSInformationPos:
+		tmp := new(PRSInformationPos)
+		if err = tmp.decode(r); err != nil {
+			err = readError("PRSInformationPos", err)
+			return
+		}
+		ie.PRSInformationPos = tmp
+	case SpatialRelationPosPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *SpatialRelationPos) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case SpatialRelationPosPresentSSBPos:
+		if ie.SSBPos == nil {
+			v.absent("SSBPos")
+		} else {
+			v.ie("SSBPos", ie.SSBPos)
+		}
+	case SpatialRelationPosPresentPRSInformationPos:
+		if ie.PRSInformationPos == nil {
+			v.absent("PRSInformationPos")
+		} else {
+			v.ie("PRSInformationPos", ie.PRSInformationPos)
+		}
+	case SpatialRelationPosPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
@@ -0,0 +1,87 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type SupportedSULFreqBandItem struct {
+	FreqBandIndicatorNr int64 `lb:1,ub:1024,valueExt,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[SupportedSULFreqBandItem] `optional,extension`
+}
+
+func (ie *SupportedSULFreqBandItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SupportedSULFreqBandItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_FreqBandIndicatorNr := INTEGER{
+		c:     aper.Constraint{Lb: 1, Ub: 1024},
+		ext:   true,
+		Value: aper.Integer(ie.FreqBandIndicatorNr),
+	}
+	if err = tmp_FreqBandIndicatorNr.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode FreqBandIndicatorNr", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SupportedSULFreqBandItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SupportedSULFreqBandItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	tmp_FreqBandIndicatorNr := INTEGER{
+		c:   aper.Constraint{Lb: 1, Ub: 1024},
+		ext: true,
+	}
+	if err = tmp_FreqBandIndicatorNr.Decode(r.AperReader); err != nil {
+		err = readError("FreqBandIndicatorNr", err)
+		return
+	}
+	ie.FreqBandIndicatorNr = int64(tmp_FreqBandIndicatorNr.Value)
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *SupportedSULFreqBandItem) Validate() error {
+	var v validator
+	v.integer("FreqBandIndicatorNr", ie.FreqBandIndicatorNr, 1, 1024)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,93 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	SymbolAllocInSlotPresentNothing uint64 = iota
+	SymbolAllocInSlotPresentAllDL
+	SymbolAllocInSlotPresentAllUL
+	SymbolAllocInSlotPresentBothDLAndUL
+	SymbolAllocInSlotPresentChoiceExtension
+)
+
+type SymbolAllocInSlot struct {
+	Choice          uint64
+	BothDLAndUL     *NumDLULSymbols
+	ChoiceExtension *RawIE
+}
+
+func (ie *SymbolAllocInSlot) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *SymbolAllocInSlot) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 3, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case SymbolAllocInSlotPresentBothDLAndUL:
+		if err = ie.BothDLAndUL.encode(w); err != nil {
+			err = utils.WrapError("Encode BothDLAndUL", err)
+			return
+		}
+	case SymbolAllocInSlotPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *SymbolAllocInSlot) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SymbolAllocInSlot) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(3, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case SymbolAllocInSlotPresentAllDL:
+	case SymbolAllocInSlotPresentAllUL:
+	case SymbolAllocInSlotPresentBothDLAndUL:
+		tmp := new(NumDLULSymbols)
+		if err = tmp.decode(r); err != nil {
+			err = readError("BothDLAndUL", err)
+			return
+		}
+		ie.BothDLAndUL = tmp
+	case SymbolAllocInSlotPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *SymbolAllocInSlot) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case SymbolAllocInSlotPresentAllDL:
+	case SymbolAllocInSlotPresentAllUL:
+	case SymbolAllocInSlotPresentBothDLAndUL:
+		if ie.BothDLAndUL == nil {
+			v.absent("BothDLAndUL")
+		} else {
+			v.ie("BothDLAndUL", ie.BothDLAndUL)
+		}
+	case SymbolAllocInSlotPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
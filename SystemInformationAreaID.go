@@ -0,0 +1,35 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type SystemInformationAreaID struct {
+	Value aper.BitString `aper:"sizeLB:24,sizeUB:24"`
+}
+
+func (ie *SystemInformationAreaID) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteBitString(ie.Value.Bytes, uint(ie.Value.NumBits), &aper.Constraint{Lb: 24, Ub: 24}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *SystemInformationAreaID) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *SystemInformationAreaID) decode(r *decoder) error {
+	if v, n, err := readBitString(r, &aper.Constraint{Lb: 24, Ub: 24}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.BitString{Bytes: v, NumBits: uint64(n)}
+	}
+	return nil
+}
+
+func (ie *SystemInformationAreaID) Validate() error {
+	var v validator
+	v.size("Value", int(ie.Value.NumBits), 24, 24)
+	return v.err()
+}
@@ -0,0 +1,162 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type SystemInformationDeliveryCommand struct {
+	TransactionID TransactionID `mandatory,reject`
+	NRCGI         NRCGI         `mandatory,reject`
+	SITypeList    []SItypeItem  `mandatory,reject`
+	ConfirmedUEID GNBDUUEF1APID `mandatory,reject`
+	UnknownIEs    []RawIE
+}
+
+func (msg *SystemInformationDeliveryCommand) ProcedureCode() int64 {
+	return ProcedureCode_SystemInformationDeliveryCommand
+}
+
+func (msg *SystemInformationDeliveryCommand) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *SystemInformationDeliveryCommand) Criticality() aper.Enumerated {
+	return Criticality_PresentIgnore
+}
+
+func (msg *SystemInformationDeliveryCommand) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("SystemInformationDeliveryCommand"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *SystemInformationDeliveryCommand) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("SystemInformationDeliveryCommand"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *SystemInformationDeliveryCommand) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_TransactionID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.TransactionID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_NRCGI},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.NRCGI,
+	})
+	if len(msg.SITypeList) > 0 {
+		tmp_SITypeList := sequenceOf[*SItypeItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofSITypes},
+			ext: false,
+		}
+		for k := range msg.SITypeList {
+			tmp_SITypeList.Value = append(tmp_SITypeList.Value, &msg.SITypeList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SITypeList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_SITypeList,
+		})
+	} else {
+		err = fmt.Errorf("SITypeList is nil")
+		return
+	}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_ConfirmedUEID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.ConfirmedUEID,
+	})
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *SystemInformationDeliveryCommand) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *SystemInformationDeliveryCommand) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *SystemInformationDeliveryCommand) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("SystemInformationDeliveryCommand", wire, limits, systemInformationDeliveryCommandIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var systemInformationDeliveryCommandIEs = []messageIE{
+	{ProtocolIEID_TransactionID, Criticality_PresentReject, true},
+	{ProtocolIEID_NRCGI, Criticality_PresentReject, true},
+	{ProtocolIEID_SITypeList, Criticality_PresentReject, true},
+	{ProtocolIEID_ConfirmedUEID, Criticality_PresentReject, true},
+}
+
+func (msg *SystemInformationDeliveryCommand) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_TransactionID:
+		var tmp TransactionID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("TransactionID", err)
+			return
+		}
+		msg.TransactionID = tmp
+
+	case ProtocolIEID_NRCGI:
+		var tmp NRCGI
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("NRCGI", err)
+			return
+		}
+		msg.NRCGI = tmp
+
+	case ProtocolIEID_SITypeList:
+		var tmp []*SItypeItem
+		if tmp, err = readList(ieR, aper.Constraint{Lb: 1, Ub: maxnoofSITypes}, false, func() *SItypeItem { return new(SItypeItem) }); err != nil {
+			err = readError("SITypeList", err)
+			return
+		}
+		msg.SITypeList = make([]SItypeItem, len(tmp))
+		for k, i := range tmp {
+			msg.SITypeList[k] = *i
+		}
+
+	case ProtocolIEID_ConfirmedUEID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("ConfirmedUEID", err)
+			return
+		}
+		msg.ConfirmedUEID = tmp
+
+	}
+	return
+}
+
+func (msg *SystemInformationDeliveryCommand) Validate() error {
+	var v validator
+	v.integer("TransactionID", int64(msg.TransactionID.Value), 0, 255)
+	if len(msg.SITypeList) == 0 {
+		v.missing(ProtocolIEID_SITypeList)
+	} else {
+		v.size("SITypeList", len(msg.SITypeList), 1, maxnoofSITypes)
+		for i := range msg.SITypeList {
+			v.item("SITypeList", i, &msg.SITypeList[i])
+		}
+	}
+	v.integer("ConfirmedUEID", int64(msg.ConfirmedUEID.Value), 0, 4294967295)
+	return v.messageErr("SystemInformationDeliveryCommand")
+}
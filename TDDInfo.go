@@ -0,0 +1,167 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type TDDInfo struct {
+	NRFreqInfo            NRFreqInfo            `mandatory`
+	TransmissionBandwidth TransmissionBandwidth `mandatory`
+	// IEExtensions
+	IntendedTDDDLULConfig *IntendedTDDDLULConfig              `optional,ignore,extension`
+	TDDULDLConfigCommonNR *TDDULDLConfigCommonNR              `optional,ignore,extension`
+	CarrierList           []NRCarrierItem                     `lb:1,ub:maxnoofNrCellBands,optional,ignore,extension`
+	Extensions            ProtocolExtensionContainer[TDDInfo] `optional,extension`
+}
+
+func (ie *TDDInfo) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *TDDInfo) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(ie.extensions())
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.NRFreqInfo.encode(w); err != nil {
+		err = utils.WrapError("Encode NRFreqInfo", err)
+		return
+	}
+	if err = ie.TransmissionBandwidth.encode(w); err != nil {
+		err = utils.WrapError("Encode TransmissionBandwidth", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *TDDInfo) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TDDInfo) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.NRFreqInfo.decode(r); err != nil {
+		err = readError("NRFreqInfo", err)
+		return
+	}
+	if err = ie.TransmissionBandwidth.decode(r); err != nil {
+		err = readError("TransmissionBandwidth", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, ie.decodeExtension); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *TDDInfo) extensions() (exts []F1apMessageIE) {
+	if ie.IntendedTDDDLULConfig != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_IntendedTDDDLULConfig},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.IntendedTDDDLULConfig,
+		})
+	}
+	if ie.TDDULDLConfigCommonNR != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_TDDULDLConfigCommonNR},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.TDDULDLConfigCommonNR,
+		})
+	}
+	if len(ie.CarrierList) > 0 {
+		tmp_CarrierList := sequenceOf[*NRCarrierItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofNrCellBands},
+			ext: false,
+		}
+		for k := range ie.CarrierList {
+			tmp_CarrierList.Value = append(tmp_CarrierList.Value, &ie.CarrierList[k])
+		}
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CarrierList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_CarrierList,
+		})
+	}
+	return
+}
+
+func (ie *TDDInfo) decodeExtension(id aper.Integer, r *decoder) (ok bool, err error) {
+	ok = true
+	switch id {
+	case ProtocolIEID_IntendedTDDDLULConfig:
+		tmp := new(IntendedTDDDLULConfig)
+		if err = tmp.decode(r); err != nil {
+			err = readError("IntendedTDDDLULConfig", err)
+			return
+		}
+		ie.IntendedTDDDLULConfig = tmp
+	case ProtocolIEID_TDDULDLConfigCommonNR:
+		tmp := new(TDDULDLConfigCommonNR)
+		if err = tmp.decode(r); err != nil {
+			err = readError("TDDULDLConfigCommonNR", err)
+			return
+		}
+		ie.TDDULDLConfigCommonNR = tmp
+	case ProtocolIEID_CarrierList:
+		var tmp []*NRCarrierItem
+		if tmp, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofNrCellBands}, false, func() *NRCarrierItem { return new(NRCarrierItem) }); err != nil {
+			err = readError("CarrierList", err)
+			return
+		}
+		ie.CarrierList = make([]NRCarrierItem, len(tmp))
+		for k, i := range tmp {
+			ie.CarrierList[k] = *i
+		}
+	default:
+		ok = false
+	}
+	return
+}
+
+func (ie *TDDInfo) Validate() error {
+	var v validator
+	v.ie("NRFreqInfo", &ie.NRFreqInfo)
+	v.ie("TransmissionBandwidth", &ie.TransmissionBandwidth)
+	if ie.IntendedTDDDLULConfig != nil {
+		v.ie("IntendedTDDDLULConfig", ie.IntendedTDDDLULConfig)
+	}
+	if ie.TDDULDLConfigCommonNR != nil {
+		v.ie("TDDULDLConfigCommonNR", ie.TDDULDLConfigCommonNR)
+	}
+	if len(ie.CarrierList) > 0 {
+		v.size("CarrierList", len(ie.CarrierList), 1, maxnoofNrCellBands)
+		for i := range ie.CarrierList {
+			v.item("CarrierList", i, &ie.CarrierList[i])
+		}
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
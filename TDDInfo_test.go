@@ -0,0 +1,62 @@
+package ies
+
+import (
+	"testing"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+func TestTDDInfoWire(t *testing.T) {
+	in := TDDInfo{
+		NRFreqInfo: NRFreqInfo{
+			NRARFCN:        630000,
+			FreqBandListNr: []FreqBandNrItem{{FreqBandIndicatorNr: 78, SupportedSULBandList: []SupportedSULFreqBandItem{}}},
+		},
+		TransmissionBandwidth: TransmissionBandwidth{
+			NRSCS: NRSCS{Value: NRSCSScs30},
+			NRNRB: NRNRB{Value: NRNRBNrb106},
+		},
+		TDDULDLConfigCommonNR: &TDDULDLConfigCommonNR{Value: aper.OctetString{0xaa}},
+		CarrierList: []NRCarrierItem{{
+			CarrierSCS:       NRSCS{Value: NRSCSScs30},
+			CarrierBandwidth: 273,
+		}},
+	}
+	want := []byte{
+		0x44,             // extensions present, NR-ARFCN of 3 octets
+		0x09, 0x9c, 0xf0, // NR-ARFCN 630000
+		0x00, 0x00, 0x4d, // 1 band, n78
+		0x00, 0x27, 0x00, // no SUL bands, 30 kHz, 106 RBs
+		0x00, 0x01, // 2 extensions
+		0x01, 0x69, 0x40, 0x02, 0x01, 0xaa, // TDD-UL-DLConfigCommonNR
+		0x01, 0x62, 0x40, 0x07, // CarrierList
+		0x00, 0x40, // 1 carrier, 30 kHz
+		0x00, 0x00, // offset to carrier 0
+		0x00, 0x01, 0x11, // bandwidth 273
+	}
+	checkIE(t, &in, new(TDDInfo), want)
+}
+
+func TestNRPRACHConfigWire(t *testing.T) {
+	root := int64(1)
+	in := NRPRACHConfig{
+		UlPRACHConfigList: []NRPRACHConfigItem{{
+			NRSCS:              NRSCS{Value: NRSCSScs30},
+			Msg1FDM:            Msg1FDM{Value: Msg1FDMOne},
+			ParchConfigIndex:   16,
+			SsbPerRACHOccasion: SSBPerRACHOccasion{Value: SSBPerRACHOccasionOneEighth},
+			FreqDomainLength: FreqDomainLength{
+				Choice: FreqDomainLengthPresentL139,
+				L139:   &L139Info{PrachSCS: PRACHSCS{Value: PRACHSCSScs30}, RootSequenceIndex: &root},
+			},
+			ZeroCorrelZoneConfig: 7,
+		}},
+	}
+	want := []byte{
+		0x40, 0x84, // UL PRACH configs of 1 item, 30 kHz
+		0x00, 0x00, // PRACH frequency start 0
+		0x00, 0x10, // one FDM, configuration index 16
+		0x05, 0x10, 0x17, // one eighth SSB, L139 at 30 kHz, root sequence 1, zero correlation zone 7
+	}
+	checkIE(t, &in, new(NRPRACHConfig), want)
+}
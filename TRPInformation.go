@@ -0,0 +1,102 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type TRPInformation struct {
+	TRPID                          TRPID                            `mandatory`
+	TRPInformationTypeResponseList []TRPInformationTypeResponseItem `lb:1,ub:maxnoofTRPInfoTypes,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[TRPInformation] `optional,extension`
+}
+
+func (ie *TRPInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *TRPInformation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.TRPID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode TRPID", err)
+		return
+	}
+	tmp_TRPInformationTypeResponseList := sequenceOf[*TRPInformationTypeResponseItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofTRPInfoTypes},
+		ext: false,
+	}
+	for k := range ie.TRPInformationTypeResponseList {
+		tmp_TRPInformationTypeResponseList.Value = append(tmp_TRPInformationTypeResponseList.Value, &ie.TRPInformationTypeResponseList[k])
+	}
+	if err = tmp_TRPInformationTypeResponseList.encode(w); err != nil {
+		err = utils.WrapError("Encode TRPInformationTypeResponseList", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *TRPInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TRPInformation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.TRPID.Decode(r.AperReader); err != nil {
+		err = readError("TRPID", err)
+		return
+	}
+	var tmp_TRPInformationTypeResponseList []*TRPInformationTypeResponseItem
+	if tmp_TRPInformationTypeResponseList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofTRPInfoTypes}, false, func() *TRPInformationTypeResponseItem { return new(TRPInformationTypeResponseItem) }); err != nil {
+		err = readError("TRPInformationTypeResponseList", err)
+		return
+	}
+	ie.TRPInformationTypeResponseList = make([]TRPInformationTypeResponseItem, len(tmp_TRPInformationTypeResponseList))
+	for k, i := range tmp_TRPInformationTypeResponseList {
+		ie.TRPInformationTypeResponseList[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *TRPInformation) Validate() error {
+	var v validator
+	v.ie("TRPID", &ie.TRPID)
+	v.size("TRPInformationTypeResponseList", len(ie.TRPInformationTypeResponseList), 1, maxnoofTRPInfoTypes)
+	for i := range ie.TRPInformationTypeResponseList {
+		v.item("TRPInformationTypeResponseList", i, &ie.TRPInformationTypeResponseList[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
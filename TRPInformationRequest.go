@@ -0,0 +1,173 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type TRPInformationRequest struct {
+	TransactionID                            TransactionID            `mandatory,reject`
+	TRPList                                  []TRPListItem            `optional,ignore`
+	TRPInformationTypeListTRPReq             []TRPInformationTypeItem `mandatory,reject`
+	TRPInformationTypeListTRPReqUnknownItems []RawIE
+	UnknownIEs                               []RawIE
+}
+
+func (msg *TRPInformationRequest) ProcedureCode() int64 {
+	return ProcedureCode_TRPInformationExchange
+}
+
+func (msg *TRPInformationRequest) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *TRPInformationRequest) Criticality() aper.Enumerated {
+	return Criticality_PresentReject
+}
+
+func (msg *TRPInformationRequest) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("TRPInformationRequest"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *TRPInformationRequest) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("TRPInformationRequest"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *TRPInformationRequest) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_TransactionID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.TransactionID,
+	})
+	if len(msg.TRPList) > 0 {
+		tmp_TRPList := sequenceOf[*TRPListItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofTRPs},
+			ext: false,
+		}
+		for k := range msg.TRPList {
+			tmp_TRPList.Value = append(tmp_TRPList.Value, &msg.TRPList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_TRPList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_TRPList,
+		})
+	}
+	if len(msg.TRPInformationTypeListTRPReq) > 0 || len(msg.TRPInformationTypeListTRPReqUnknownItems) > 0 {
+		tmp_TRPInformationTypeListTRPReq := SingleContainerList[*TRPInformationTypeItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofTRPInfoTypes},
+			id:          ProtocolIEID_TRPInformationTypeItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.TRPInformationTypeListTRPReqUnknownItems,
+		}
+		for k := range msg.TRPInformationTypeListTRPReq {
+			tmp_TRPInformationTypeListTRPReq.Value = append(tmp_TRPInformationTypeListTRPReq.Value, &msg.TRPInformationTypeListTRPReq[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_TRPInformationTypeListTRPReq},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_TRPInformationTypeListTRPReq,
+		})
+	} else {
+		err = fmt.Errorf("TRPInformationTypeListTRPReq is nil")
+		return
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *TRPInformationRequest) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *TRPInformationRequest) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *TRPInformationRequest) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("TRPInformationRequest", wire, limits, trpInformationRequestIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var trpInformationRequestIEs = []messageIE{
+	{ProtocolIEID_TransactionID, Criticality_PresentReject, true},
+	{ProtocolIEID_TRPList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_TRPInformationTypeListTRPReq, Criticality_PresentReject, true},
+}
+
+func (msg *TRPInformationRequest) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_TransactionID:
+		var tmp TransactionID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("TransactionID", err)
+			return
+		}
+		msg.TransactionID = tmp
+
+	case ProtocolIEID_TRPList:
+		var tmp []*TRPListItem
+		if tmp, err = readList(ieR, aper.Constraint{Lb: 1, Ub: maxnoofTRPs}, false, func() *TRPListItem { return new(TRPListItem) }); err != nil {
+			err = readError("TRPList", err)
+			return
+		}
+		msg.TRPList = make([]TRPListItem, len(tmp))
+		for k, i := range tmp {
+			msg.TRPList[k] = *i
+		}
+
+	case ProtocolIEID_TRPInformationTypeListTRPReq:
+		tmp := SingleContainerList[*TRPInformationTypeItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofTRPInfoTypes},
+			id:          ProtocolIEID_TRPInformationTypeItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *TRPInformationTypeItem { return new(TRPInformationTypeItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("TRPInformationTypeListTRPReq", err)
+			return
+		}
+		msg.TRPInformationTypeListTRPReq = []TRPInformationTypeItem{}
+		for _, i := range tmp.Value {
+			msg.TRPInformationTypeListTRPReq = append(msg.TRPInformationTypeListTRPReq, *i)
+		}
+		msg.TRPInformationTypeListTRPReqUnknownItems = tmp.Unknown
+
+	}
+	return
+}
+
+func (msg *TRPInformationRequest) Validate() error {
+	var v validator
+	v.integer("TransactionID", int64(msg.TransactionID.Value), 0, 255)
+	if len(msg.TRPList) > 0 {
+		v.size("TRPList", len(msg.TRPList), 1, maxnoofTRPs)
+		for i := range msg.TRPList {
+			v.item("TRPList", i, &msg.TRPList[i])
+		}
+	}
+	if len(msg.TRPInformationTypeListTRPReq) == 0 {
+		v.missing(ProtocolIEID_TRPInformationTypeListTRPReq)
+	} else {
+		v.size("TRPInformationTypeListTRPReq", len(msg.TRPInformationTypeListTRPReq), 1, maxnoofTRPInfoTypes)
+		for i := range msg.TRPInformationTypeListTRPReq {
+			v.item("TRPInformationTypeListTRPReq", i, &msg.TRPInformationTypeListTRPReq[i])
+		}
+	}
+	return v.messageErr("TRPInformationRequest")
+}
@@ -0,0 +1,71 @@
+package ies
+
+import (
+	"reflect"
+	"testing"
+)
+
+// the message value of an F1AP PDU: the PDU choice, procedure code and
+// criticality take an octet each and a length determinant comes before the
+// value
+func pduValue(t *testing.T, pdu []byte) []byte {
+	t.Helper()
+	if len(pdu) < 5 {
+		t.Fatalf("short PDU %x", pdu)
+	}
+	if pdu[3]&0x80 == 0 {
+		return pdu[4:]
+	}
+	return pdu[5:]
+}
+
+// encode m with Encode and AppendEncode, compare the PDU with want, decode
+// its message value into out and validate it
+func checkMessage(t *testing.T, m, out F1apMessage, want []byte) {
+	t.Helper()
+	checkEncoding(t, m, want)
+	if err, _ := out.Decode(pduValue(t, want)); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out, m) {
+		t.Fatalf("decoded %+v\n want %+v", out, m)
+	}
+	if err := out.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTRPInformationRequestWire(t *testing.T) {
+	m := &TRPInformationRequest{
+		TransactionID:                TransactionID{Value: 1},
+		TRPList:                      []TRPListItem{{TRPID: TRPID{Value: 3}}},
+		TRPInformationTypeListTRPReq: []TRPInformationTypeItem{{Value: TRPInformationTypeItemNrPCI}, {Value: TRPInformationTypeItemArfcn}},
+	}
+	want := []byte{
+		0x00, 0x30, 0x00, 0x21, // initiatingMessage, tRPInformationExchange, reject, length
+		0x00, 0x00, 0x03, // extension bit, 3 IEs
+		0x00, 0x4e, 0x00, 0x02, 0x00, 0x01, // TransactionID 1
+		0x01, 0x9a, 0x40, 0x05, 0x00, 0x00, // TRPList of 1 item
+		0x00, 0x00, 0x03, // TRP 3
+		0x01, 0x8e, 0x00, 0x0b, 0x04, // TRPInformationTypeListTRPReq of 2 items
+		0x01, 0x8f, 0x00, 0x01, 0x00, // nrPCI
+		0x01, 0x8f, 0x00, 0x01, 0x20, // arfcn
+	}
+	checkMessage(t, m, new(TRPInformationRequest), want)
+}
+
+func TestPositioningDeactivationWire(t *testing.T) {
+	m := &PositioningDeactivation{
+		GNBCUUEF1APID:     GNBCUUEF1APID{Value: 1},
+		GNBDUUEF1APID:     GNBDUUEF1APID{Value: 2},
+		AbortTransmission: AbortTransmission{Choice: AbortTransmissionPresentReleaseALL},
+	}
+	want := []byte{
+		0x00, 0x33, 0x40, 0x14, // initiatingMessage, positioningDeactivation, ignore, length
+		0x00, 0x00, 0x03, // extension bit, 3 IEs
+		0x00, 0x28, 0x00, 0x02, 0x00, 0x01, // gNB-CU-UE-F1AP-ID 1
+		0x00, 0x29, 0x00, 0x02, 0x00, 0x02, // gNB-DU-UE-F1AP-ID 2
+		0x01, 0x95, 0x40, 0x01, 0x40, // AbortTransmission releaseALL
+	}
+	checkMessage(t, m, new(PositioningDeactivation), want)
+}
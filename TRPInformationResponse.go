@@ -0,0 +1,157 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type TRPInformationResponse struct {
+	TransactionID                         TransactionID           `mandatory,reject`
+	TRPInformationListTRPResp             []TRPInformationItem    `mandatory,ignore`
+	CriticalityDiagnostics                *CriticalityDiagnostics `optional,ignore`
+	TRPInformationListTRPRespUnknownItems []RawIE
+	UnknownIEs                            []RawIE
+}
+
+func (msg *TRPInformationResponse) ProcedureCode() int64 {
+	return ProcedureCode_TRPInformationExchange
+}
+
+func (msg *TRPInformationResponse) Present() uint8 {
+	return F1apPduSuccessfulOutcome
+}
+
+func (msg *TRPInformationResponse) Criticality() aper.Enumerated {
+	return Criticality_PresentReject
+}
+
+func (msg *TRPInformationResponse) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("TRPInformationResponse"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *TRPInformationResponse) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("TRPInformationResponse"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *TRPInformationResponse) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_TransactionID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.TransactionID,
+	})
+	if len(msg.TRPInformationListTRPResp) > 0 || len(msg.TRPInformationListTRPRespUnknownItems) > 0 {
+		tmp_TRPInformationListTRPResp := SingleContainerList[*TRPInformationItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofTRPs},
+			id:          ProtocolIEID_TRPInformationItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.TRPInformationListTRPRespUnknownItems,
+		}
+		for k := range msg.TRPInformationListTRPResp {
+			tmp_TRPInformationListTRPResp.Value = append(tmp_TRPInformationListTRPResp.Value, &msg.TRPInformationListTRPResp[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_TRPInformationListTRPResp},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_TRPInformationListTRPResp,
+		})
+	} else {
+		err = fmt.Errorf("TRPInformationListTRPResp is nil")
+		return
+	}
+	if msg.CriticalityDiagnostics != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CriticalityDiagnostics},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.CriticalityDiagnostics,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *TRPInformationResponse) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *TRPInformationResponse) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *TRPInformationResponse) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("TRPInformationResponse", wire, limits, trpInformationResponseIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var trpInformationResponseIEs = []messageIE{
+	{ProtocolIEID_TransactionID, Criticality_PresentReject, true},
+	{ProtocolIEID_TRPInformationListTRPResp, Criticality_PresentIgnore, true},
+	{ProtocolIEID_CriticalityDiagnostics, Criticality_PresentIgnore, false},
+}
+
+func (msg *TRPInformationResponse) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_TransactionID:
+		var tmp TransactionID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("TransactionID", err)
+			return
+		}
+		msg.TransactionID = tmp
+
+	case ProtocolIEID_TRPInformationListTRPResp:
+		tmp := SingleContainerList[*TRPInformationItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofTRPs},
+			id:          ProtocolIEID_TRPInformationItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *TRPInformationItem { return new(TRPInformationItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("TRPInformationListTRPResp", err)
+			return
+		}
+		msg.TRPInformationListTRPResp = []TRPInformationItem{}
+		for _, i := range tmp.Value {
+			msg.TRPInformationListTRPResp = append(msg.TRPInformationListTRPResp, *i)
+		}
+		msg.TRPInformationListTRPRespUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_CriticalityDiagnostics:
+		var tmp CriticalityDiagnostics
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("CriticalityDiagnostics", err)
+			return
+		}
+		msg.CriticalityDiagnostics = &tmp
+
+	}
+	return
+}
+
+func (msg *TRPInformationResponse) Validate() error {
+	var v validator
+	v.integer("TransactionID", int64(msg.TransactionID.Value), 0, 255)
+	if len(msg.TRPInformationListTRPResp) == 0 {
+		v.missing(ProtocolIEID_TRPInformationListTRPResp)
+	} else {
+		v.size("TRPInformationListTRPResp", len(msg.TRPInformationListTRPResp), 1, maxnoofTRPs)
+		for i := range msg.TRPInformationListTRPResp {
+			v.item("TRPInformationListTRPResp", i, &msg.TRPInformationListTRPResp[i])
+		}
+	}
+	return v.messageErr("TRPInformationResponse")
+}
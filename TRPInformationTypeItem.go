@@ -0,0 +1,42 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+const (
+	TRPInformationTypeItemNrPCI             aper.Enumerated = 0
+	TRPInformationTypeItemNGRANCGI          aper.Enumerated = 1
+	TRPInformationTypeItemArfcn             aper.Enumerated = 2
+	TRPInformationTypeItemPRSConfig         aper.Enumerated = 3
+	TRPInformationTypeItemSSBInfo           aper.Enumerated = 4
+	TRPInformationTypeItemSFNFrameTiming    aper.Enumerated = 5
+	TRPInformationTypeItemSpatialDirectInfo aper.Enumerated = 6
+	TRPInformationTypeItemGeoCoord          aper.Enumerated = 7
+)
+
+type TRPInformationTypeItem struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:7,valueExt"`
+}
+
+func (ie *TRPInformationTypeItem) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 7}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *TRPInformationTypeItem) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 7}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *TRPInformationTypeItem) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 8)
+	return v.err()
+}
@@ -0,0 +1,243 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	TRPInformationTypeResponseItemPresentNothing uint64 = iota
+	TRPInformationTypeResponseItemPresentPCINR
+	TRPInformationTypeResponseItemPresentNGRANCGI
+	TRPInformationTypeResponseItemPresentNRARFCN
+	TRPInformationTypeResponseItemPresentPRSConfiguration
+	TRPInformationTypeResponseItemPresentSSBinformation
+	TRPInformationTypeResponseItemPresentSFNInitialisationTime
+	TRPInformationTypeResponseItemPresentSpatialDirectionInformation
+	TRPInformationTypeResponseItemPresentGeographicalCoordinates
+	TRPInformationTypeResponseItemPresentChoiceExtension
+)
+
+type TRPInformationTypeResponseItem struct {
+	Choice                      uint64
+	PCINR                       *int64 `lb:0,ub:1007`
+	NGRANCGI                    *NRCGI
+	NRARFCN                     *int64 `lb:0,ub:maxNRARFCN`
+	PRSConfiguration            *PRSConfiguration
+	SSBinformation              *SSBInformation
+	SFNInitialisationTime       *RelativeTime1900
+	SpatialDirectionInformation *SpatialDirectionInformation
+	GeographicalCoordinates     *GeographicalCoordinates
+	ChoiceExtension             *RawIE
+}
+
+func (ie *TRPInformationTypeResponseItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *TRPInformationTypeResponseItem) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 8, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case TRPInformationTypeResponseItemPresentPCINR:
+		tmp_PCINR := INTEGER{
+			c:     aper.Constraint{Lb: 0, Ub: 1007},
+			ext:   false,
+			Value: aper.Integer(*ie.PCINR),
+		}
+		if err = tmp_PCINR.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode PCINR", err)
+			return
+		}
+	case TRPInformationTypeResponseItemPresentNGRANCGI:
+		if err = ie.NGRANCGI.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode NGRANCGI", err)
+			return
+		}
+	case TRPInformationTypeResponseItemPresentNRARFCN:
+		tmp_NRARFCN := INTEGER{
+			c:     aper.Constraint{Lb: 0, Ub: maxNRARFCN},
+			ext:   false,
+			Value: aper.Integer(*ie.NRARFCN),
+		}
+		if err = tmp_NRARFCN.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode NRARFCN", err)
+			return
+		}
+	case TRPInformationTypeResponseItemPresentPRSConfiguration:
+		if err = ie.PRSConfiguration.encode(w); err != nil {
+			err = utils.WrapError("Encode PRSConfiguration", err)
+			return
+		}
+	case TRPInformationTypeResponseItemPresentSSBinformation:
+		if err = ie.SSBinformation.encode(w); err != nil {
+			err = utils.WrapError("Encode SSBinformation", err)
+			return
+		}
+	case TRPInformationTypeResponseItemPresentSFNInitialisationTime:
+		if err = ie.SFNInitialisationTime.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode SFNInitialisationTime", err)
+			return
+		}
+	case TRPInformationTypeResponseItemPresentSpatialDirectionInformation:
+		if err = ie.SpatialDirectionInformation.encode(w); err != nil {
+			err = utils.WrapError("Encode SpatialDirectionInformation", err)
+			return
+		}
+	case TRPInformationTypeResponseItemPresentGeographicalCoordinates:
+		if err = ie.GeographicalCoordinates.encode(w); err != nil {
+			err = utils.WrapError("Encode GeographicalCoordinates", err)
+			return
+		}
+	case TRPInformationTypeResponseItemPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *TRPInformationTypeResponseItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TRPInformationTypeResponseItem) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(8, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case TRPInformationTypeResponseItemPresentPCINR:
+		tmp_PCINR := INTEGER{
+			c:   aper.Constraint{Lb: 0, Ub: 1007},
+			ext: false,
+		}
+		if err = tmp_PCINR.Decode(r.AperReader); err != nil {
+			err = readError("PCINR", err)
+			return
+		}
+		tmp := int64(tmp_PCINR.Value)
+		ie.PCINR = &tmp
+	case TRPInformationTypeResponseItemPresentNGRANCGI:
+		tmp := new(NRCGI)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("NGRANCGI", err)
+			return
+		}
+		ie.NGRANCGI = tmp
+	case TRPInformationTypeResponseItemPresentNRARFCN:
+		tmp_NRARFCN := INTEGER{
+			c:   aper.Constraint{Lb: 0, Ub: maxNRARFCN},
+			ext: false,
+		}
+		if err = tmp_NRARFCN.Decode(r.AperReader); err != nil {
+			err = readError("NRARFCN", err)
+			return
+		}
+		tmp := int64(tmp_NRARFCN.Value)
+		ie.NRARFCN = &tmp
+	case TRPInformationTypeResponseItemPresentPRSConfiguration:
+		tmp := new(PRSConfiguration)
+		if err = tmp.decode(r); err != nil {
+			err = readError("PRSConfiguration", err)
+			return
+		}
+		ie.PRSConfiguration = tmp
+	case TRPInformationTypeResponseItemPresentSSBinformation:
+		tmp := new(SSBInformation)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SSBinformation", err)
+			return
+		}
+		ie.SSBinformation = tmp
+	case TRPInformationTypeResponseItemPresentSFNInitialisationTime:
+		tmp := new(RelativeTime1900)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SFNInitialisationTime", err)
+			return
+		}
+		ie.SFNInitialisationTime = tmp
+	case TRPInformationTypeResponseItemPresentSpatialDirectionInformation:
+		tmp := new(SpatialDirectionInformation)
+		if err = tmp.decode(r); err != nil {
+			err = readError("SpatialDirectionInformation", err)
+			return
+		}
+		ie.SpatialDirectionInformation = tmp
+	case TRPInformationTypeResponseItemPresentGeographicalCoordinates:
+		tmp := new(GeographicalCoordinates)
+		if err = tmp.decode(r); err != nil {
+			err = readError("GeographicalCoordinates", err)
+			return
+		}
+		ie.GeographicalCoordinates = tmp
+	case TRPInformationTypeResponseItemPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *TRPInformationTypeResponseItem) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case TRPInformationTypeResponseItemPresentPCINR:
+		if ie.PCINR == nil {
+			v.absent("PCINR")
+		} else {
+			v.integer("PCINR", *ie.PCINR, 0, 1007)
+		}
+	case TRPInformationTypeResponseItemPresentNGRANCGI:
+		if ie.NGRANCGI == nil {
+			v.absent("NGRANCGI")
+		}
+	case TRPInformationTypeResponseItemPresentNRARFCN:
+		if ie.NRARFCN == nil {
+			v.absent("NRARFCN")
+		} else {
+			v.integer("NRARFCN", *ie.NRARFCN, 0, maxNRARFCN)
+		}
+	case TRPInformationTypeResponseItemPresentPRSConfiguration:
+		if ie.PRSConfiguration == nil {
+			v.absent("PRSConfiguration")
+		} else {
+			v.ie("PRSConfiguration", ie.PRSConfiguration)
+		}
+	case TRPInformationTypeResponseItemPresentSSBinformation:
+		if ie.SSBinformation == nil {
+			v.absent("SSBinformation")
+		} else {
+			v.ie("SSBinformation", ie.SSBinformation)
+		}
+	case TRPInformationTypeResponseItemPresentSFNInitialisationTime:
+		if ie.SFNInitialisationTime == nil {
+			v.absent("SFNInitialisationTime")
+		} else {
+			v.ie("SFNInitialisationTime", ie.SFNInitialisationTime)
+		}
+	case TRPInformationTypeResponseItemPresentSpatialDirectionInformation:
+		if ie.SpatialDirectionInformation == nil {
+			v.absent("SpatialDirectionInformation")
+		} else {
+			v.ie("SpatialDirectionInformation", ie.SpatialDirectionInformation)
+		}
+	case TRPInformationTypeResponseItemPresentGeographicalCoordinates:
+		if ie.GeographicalCoordinates == nil {
+			v.absent("GeographicalCoordinates")
+		} else {
+			v.ie("GeographicalCoordinates", ie.GeographicalCoordinates)
+		}
+	case TRPInformationTypeResponseItemPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	TRPPositionDefinitionTypePresentNothing uint64 = iota
+	TRPPositionDefinitionTypePresentDirect
+	TRPPositionDefinitionTypePresentReferenced
+	TRPPositionDefinitionTypePresentChoiceExtension
+)
+
+type TRPPositionDefinitionType struct {
+	Choice          uint64
+	Direct          *TRPPositionDirect
+	Referenced      *TRPPositionReferenced
+	ChoiceExtension *RawIE
+}
+
+func (ie *TRPPositionDefinitionType) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *TRPPositionDefinitionType) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case TRPPositionDefinitionTypePresentDirect:
+		if err = ie.Direct.encode(w); err != nil {
+			err = utils.WrapError("Encode Direct", err)
+			return
+		}
+	case TRPPositionDefinitionTypePresentReferenced:
+		if err = ie.Referenced.encode(w); err != nil {
+			err = utils.WrapError("Encode Referenced", err)
+			return
+		}
+	case TRPPositionDefinitionTypePresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *TRPPositionDefinitionType) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TRPPositionDefinitionType) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case TRPPositionDefinitionTypePresentDirect:
+		tmp := new(TRPPositionDirect)
+		if err = tmp.decode(r); err != nil {
+			err = readError("Direct", err)
+			return
+		}
+		ie.Direct = tmp
+	case TRPPositionDefinitionTypePresentReferenced:
+		tmp := new(TRPPositionReferenced)
+		if err = tmp.decode(r); err != nil {
+			err = readError("Referenced", err)
+			return
+		}
+		ie.Referenced = tmp
+	case TRPPositionDefinitionTypePresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *TRPPositionDefinitionType) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case TRPPositionDefinitionTypePresentDirect:
+		if ie.Direct == nil {
+			v.absent("Direct")
+		} else {
+			v.ie("Direct", ie.Direct)
+		}
+	case TRPPositionDefinitionTypePresentReferenced:
+		if ie.Referenced == nil {
+			v.absent("Referenced")
+		} else {
+			v.ie("Referenced", ie.Referenced)
+		}
+	case TRPPositionDefinitionTypePresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	TRPPositionDirectAccuracyPresentNothing uint64 = iota
+	TRPPositionDirectAccuracyPresentTRPPosition
+	TRPPositionDirectAccuracyPresentTRPHAposition
+	TRPPositionDirectAccuracyPresentChoiceExtension
+)
+
+type TRPPositionDirectAccuracy struct {
+	Choice          uint64
+	TRPPosition     *AccessPointPosition
+	TRPHAposition   *NGRANHighAccuracyAccessPointPosition
+	ChoiceExtension *RawIE
+}
+
+func (ie *TRPPositionDirectAccuracy) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *TRPPositionDirectAccuracy) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case TRPPositionDirectAccuracyPresentTRPPosition:
+		if err = ie.TRPPosition.encode(w); err != nil {
+			err = utils.WrapError("Encode TRPPosition", err)
+			return
+		}
+	case TRPPositionDirectAccuracyPresentTRPHAposition:
+		if err = ie.TRPHAposition.encode(w); err != nil {
+			err = utils.WrapError("Encode TRPHAposition", err)
+			return
+		}
+	case TRPPositionDirectAccuracyPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *TRPPositionDirectAccuracy) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TRPPositionDirectAccuracy) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case TRPPositionDirectAccuracyPresentTRPPosition:
+		tmp := new(AccessPointPosition)
+		if err = tmp.decode(r); err != nil {
+			err = readError("TRPPosition", err)
+			return
+		}
+		ie.TRPPosition = tmp
+	case TRPPositionDirectAccuracyPresentTRPHAposition:
+		tmp := new(NGRANHighAccuracyAccessPointPosition)
+		if err = tmp.decode(r); err != nil {
+			err = readError("TRPHAposition", err)
+			return
+		}
+		ie.TRPHAposition = tmp
+	case TRPPositionDirectAccuracyPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *TRPPositionDirectAccuracy) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case TRPPositionDirectAccuracyPresentTRPPosition:
+		if ie.TRPPosition == nil {
+			v.absent("TRPPosition")
+		} else {
+			v.ie("TRPPosition", ie.TRPPosition)
+		}
+	case TRPPositionDirectAccuracyPresentTRPHAposition:
+		if ie.TRPHAposition == nil {
+			v.absent("TRPHAposition")
+		} else {
+			v.ie("TRPHAposition", ie.TRPHAposition)
+		}
+	case TRPPositionDirectAccuracyPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
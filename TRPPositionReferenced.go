@@ -0,0 +1,87 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type TRPPositionReferenced struct {
+	ReferencePoint     ReferencePoint        `mandatory`
+	ReferencePointType TRPReferencePointType `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[TRPPositionReferenced] `optional,extension`
+}
+
+func (ie *TRPPositionReferenced) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *TRPPositionReferenced) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.ReferencePoint.encode(w); err != nil {
+		err = utils.WrapError("Encode ReferencePoint", err)
+		return
+	}
+	if err = ie.ReferencePointType.encode(w); err != nil {
+		err = utils.WrapError("Encode ReferencePointType", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *TRPPositionReferenced) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TRPPositionReferenced) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.ReferencePoint.decode(r); err != nil {
+		err = readError("ReferencePoint", err)
+		return
+	}
+	if err = ie.ReferencePointType.decode(r); err != nil {
+		err = readError("ReferencePointType", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *TRPPositionReferenced) Validate() error {
+	var v validator
+	v.ie("ReferencePoint", &ie.ReferencePoint)
+	v.ie("ReferencePointType", &ie.ReferencePointType)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
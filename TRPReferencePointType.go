@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	TRPReferencePointTypePresentNothing uint64 = iota
+	TRPReferencePointTypePresentTRPPositionRelativeGeodetic
+	TRPReferencePointTypePresentTRPPositionRelativeCartesian
+	TRPReferencePointTypePresentChoiceExtension
+)
+
+type TRPReferencePointType struct {
+	Choice                       uint64
+	TRPPositionRelativeGeodetic  *RelativeGeodeticLocation
+	TRPPositionRelativeCartesian *RelativeCartesianLocation
+	ChoiceExtension              *RawIE
+}
+
+func (ie *TRPReferencePointType) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *TRPReferencePointType) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case TRPReferencePointTypePresentTRPPositionRelativeGeodetic:
+		if err = ie.TRPPositionRelativeGeodetic.encode(w); err != nil {
+			err = utils.WrapError("Encode TRPPositionRelativeGeodetic", err)
+			return
+		}
+	case TRPReferencePointTypePresentTRPPositionRelativeCartesian:
+		if err = ie.TRPPositionRelativeCartesian.encode(w); err != nil {
+			err = utils.WrapError("Encode TRPPositionRelativeCartesian", err)
+			return
+		}
+	case TRPReferencePointTypePresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *TRPReferencePointType) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TRPReferencePointType) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case TRPReferencePointTypePresentTRPPositionRelativeGeodetic:
+		tmp := new(RelativeGeodeticLocation)
+		if err = tmp.decode(r); err != nil {
+			err = readError("TRPPositionRelativeGeodetic", err)
+			return
+		}
+		ie.TRPPositionRelativeGeodetic = tmp
+	case TRPReferencePointTypePresentTRPPositionRelativeCartesian:
+		tmp := new(RelativeCartesianLocation)
+		if err = tmp.decode(r); err != nil {
+			err = readError("TRPPositionRelativeCartesian", err)
+			return
+		}
+		ie.TRPPositionRelativeCartesian = tmp
+	case TRPReferencePointTypePresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *TRPReferencePointType) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case TRPReferencePointTypePresentTRPPositionRelativeGeodetic:
+		if ie.TRPPositionRelativeGeodetic == nil {
+			v.absent("TRPPositionRelativeGeodetic")
+		} else {
+			v.ie("TRPPositionRelativeGeodetic", ie.TRPPositionRelativeGeodetic)
+		}
+	case TRPReferencePointTypePresentTRPPositionRelativeCartesian:
+		if ie.TRPPositionRelativeCartesian == nil {
+			v.absent("TRPPositionRelativeCartesian")
+		} else {
+			v.ie("TRPPositionRelativeCartesian", ie.TRPPositionRelativeCartesian)
+		}
+	case TRPReferencePointTypePresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
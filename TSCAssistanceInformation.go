@@ -0,0 +1,98 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type TSCAssistanceInformation struct {
+	Periodicity      Periodicity       `mandatory`
+	BurstArrivalTime *BurstArrivalTime `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[TSCAssistanceInformation] `optional,extension`
+}
+
+func (ie *TSCAssistanceInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *TSCAssistanceInformation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.BurstArrivalTime != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 2)
+	}
+	if err = w.WriteBits(optionals, 2); err != nil {
+		return
+	}
+	if err = ie.Periodicity.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Periodicity", err)
+		return
+	}
+	if ie.BurstArrivalTime != nil {
+		if err = ie.BurstArrivalTime.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode BurstArrivalTime", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *TSCAssistanceInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TSCAssistanceInformation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(2); err != nil {
+		return
+	}
+	if err = ie.Periodicity.Decode(r.AperReader); err != nil {
+		err = readError("Periodicity", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(BurstArrivalTime)
+		if err = tmp.decode(r); err != nil {
+			err = readError("BurstArrivalTime", err)
+			return
+		}
+		ie.BurstArrivalTime = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *TSCAssistanceInformation) Validate() error {
+	var v validator
+	v.ie("Periodicity", &ie.Periodicity)
+	if ie.BurstArrivalTime != nil {
+		v.ie("BurstArrivalTime", ie.BurstArrivalTime)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,109 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type TSCTrafficCharacteristics struct {
+	TSCAssistanceInformationDL *TSCAssistanceInformation `optional`
+	TSCAssistanceInformationUL *TSCAssistanceInformation `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[TSCTrafficCharacteristics] `optional,extension`
+}
+
+func (ie *TSCTrafficCharacteristics) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *TSCTrafficCharacteristics) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.TSCAssistanceInformationDL != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if ie.TSCAssistanceInformationUL != nil {
+		aper.SetBit(optionals, 2)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 3)
+	}
+	if err = w.WriteBits(optionals, 3); err != nil {
+		return
+	}
+	if ie.TSCAssistanceInformationDL != nil {
+		if err = ie.TSCAssistanceInformationDL.encode(w); err != nil {
+			err = utils.WrapError("Encode TSCAssistanceInformationDL", err)
+			return
+		}
+	}
+	if ie.TSCAssistanceInformationUL != nil {
+		if err = ie.TSCAssistanceInformationUL.encode(w); err != nil {
+			err = utils.WrapError("Encode TSCAssistanceInformationUL", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *TSCTrafficCharacteristics) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TSCTrafficCharacteristics) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(3); err != nil {
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		tmp := new(TSCAssistanceInformation)
+		if err = tmp.decode(r); err != nil {
+			err = readError("TSCAssistanceInformationDL", err)
+			return
+		}
+		ie.TSCAssistanceInformationDL = tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		tmp := new(TSCAssistanceInformation)
+		if err = tmp.decode(r); err != nil {
+			err = readError("TSCAssistanceInformationUL", err)
+			return
+		}
+		ie.TSCAssistanceInformationUL = tmp
+	}
+	if aper.IsBitSet(optionals, 3) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *TSCTrafficCharacteristics) Validate() error {
+	var v validator
+	if ie.TSCAssistanceInformationDL != nil {
+		v.ie("TSCAssistanceInformationDL", ie.TSCAssistanceInformationDL)
+	}
+	if ie.TSCAssistanceInformationUL != nil {
+		v.ie("TSCAssistanceInformationUL", ie.TSCAssistanceInformationUL)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
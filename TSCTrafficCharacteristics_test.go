@@ -0,0 +1,41 @@
+package ies
+
+import (
+	"testing"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+func TestFlowsMappedToDRBItemTSCWire(t *testing.T) {
+	in := FlowsMappedToDRBItem{
+		QoSFlowIdentifier: QoSFlowIdentifier{Value: 3},
+		QoSFlowLevelQoSParameters: QoSFlowLevelQoSParameters{
+			QoSCharacteristics: QoSCharacteristics{
+				Choice:        QoSCharacteristicsPresentNonDynamic5QI,
+				NonDynamic5QI: &NonDynamic5QIDescriptor{FiveQI: FiveQI{Value: 9}},
+			},
+			NGRANallocationRetentionPriority: NGRANAllocationAndRetentionPriority{
+				PriorityLevel:           PriorityLevel{Value: 1},
+				PreEmptionCapability:    PreEmptionCapability{Value: PreEmptionCapabilityShallnottriggerpreemption},
+				PreEmptionVulnerability: PreEmptionVulnerability{Value: PreEmptionVulnerabilityPreemptable},
+			},
+		},
+		TSCTrafficCharacteristics: &TSCTrafficCharacteristics{
+			TSCAssistanceInformationDL: &TSCAssistanceInformation{
+				Periodicity:      Periodicity{Value: 20000},
+				BurstArrivalTime: &BurstArrivalTime{Value: aper.OctetString{0x01, 0x02}},
+			},
+		},
+	}
+	want := []byte{
+		0x43,             // extensions present, QFI 3
+		0x00, 0x00, 0x09, // non-dynamic 5QI 9
+		0x05,       // priority 1, shall not trigger, pre-emptable
+		0x00, 0x00, // 1 extension
+		0x01, 0x6c, 0x40, 0x07, // TSCTrafficCharacteristics
+		0x44, 0x40, // DL assistance with burst arrival time, periodicity of 2 octets
+		0x4e, 0x20, // periodicity 20000
+		0x02, 0x01, 0x02, // burst arrival time
+	}
+	checkIE(t, &in, new(FlowsMappedToDRBItem), want)
+}
@@ -0,0 +1,35 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+const (
+	TimeInformationTypeLocalClock aper.Enumerated = 0
+)
+
+type TimeInformationType struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:0"`
+}
+
+func (ie *TimeInformationType) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 0}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *TimeInformationType) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 0}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *TimeInformationType) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 1)
+	return v.err()
+}
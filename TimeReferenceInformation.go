@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type TimeReferenceInformation struct {
+	ReferenceTime       ReferenceTime       `mandatory`
+	ReferenceSFN        ReferenceSFN        `mandatory`
+	Uncertainty         Uncertainty         `mandatory`
+	TimeInformationType TimeInformationType `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[TimeReferenceInformation] `optional,extension`
+}
+
+func (ie *TimeReferenceInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *TimeReferenceInformation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.ReferenceTime.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode ReferenceTime", err)
+		return
+	}
+	if err = ie.ReferenceSFN.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode ReferenceSFN", err)
+		return
+	}
+	if err = ie.Uncertainty.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode Uncertainty", err)
+		return
+	}
+	if err = ie.TimeInformationType.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode TimeInformationType", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *TimeReferenceInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TimeReferenceInformation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.ReferenceTime.decode(r); err != nil {
+		err = readError("ReferenceTime", err)
+		return
+	}
+	if err = ie.ReferenceSFN.Decode(r.AperReader); err != nil {
+		err = readError("ReferenceSFN", err)
+		return
+	}
+	if err = ie.Uncertainty.Decode(r.AperReader); err != nil {
+		err = readError("Uncertainty", err)
+		return
+	}
+	if err = ie.TimeInformationType.Decode(r.AperReader); err != nil {
+		err = readError("TimeInformationType", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *TimeReferenceInformation) Validate() error {
+	var v validator
+	v.ie("ReferenceTime", &ie.ReferenceTime)
+	v.ie("ReferenceSFN", &ie.ReferenceSFN)
+	v.ie("Uncertainty", &ie.Uncertainty)
+	v.ie("TimeInformationType", &ie.TimeInformationType)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
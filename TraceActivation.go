@@ -0,0 +1,156 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type TraceActivation struct {
+	TraceID                        TraceID               `mandatory`
+	InterfacesToTrace              InterfacesToTrace     `mandatory`
+	TraceDepth                     TraceDepth            `mandatory`
+	TraceCollectionEntityIPAddress TransportLayerAddress `mandatory`
+	// IEExtensions
+	MDTConfiguration         *MDTConfiguration                           `optional,ignore,extension`
+	TraceCollectionEntityURI *URIAddress                                 `optional,ignore,extension`
+	Extensions               ProtocolExtensionContainer[TraceActivation] `optional,extension`
+}
+
+func (ie *TraceActivation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *TraceActivation) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(ie.extensions())
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.TraceID.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode TraceID", err)
+		return
+	}
+	if err = ie.InterfacesToTrace.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode InterfacesToTrace", err)
+		return
+	}
+	if err = ie.TraceDepth.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode TraceDepth", err)
+		return
+	}
+	if err = ie.TraceCollectionEntityIPAddress.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode TraceCollectionEntityIPAddress", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *TraceActivation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TraceActivation) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.TraceID.decode(r); err != nil {
+		err = readError("TraceID", err)
+		return
+	}
+	if err = ie.InterfacesToTrace.decode(r); err != nil {
+		err = readError("InterfacesToTrace", err)
+		return
+	}
+	if err = ie.TraceDepth.Decode(r.AperReader); err != nil {
+		err = readError("TraceDepth", err)
+		return
+	}
+	if err = ie.TraceCollectionEntityIPAddress.decode(r); err != nil {
+		err = readError("TraceCollectionEntityIPAddress", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, ie.decodeExtension); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *TraceActivation) extensions() (exts []F1apMessageIE) {
+	if ie.MDTConfiguration != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_MDTConfiguration},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.MDTConfiguration,
+		})
+	}
+	if ie.TraceCollectionEntityURI != nil {
+		exts = append(exts, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_TraceCollectionEntityURI},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       ie.TraceCollectionEntityURI,
+		})
+	}
+	return
+}
+
+func (ie *TraceActivation) decodeExtension(id aper.Integer, r *decoder) (ok bool, err error) {
+	ok = true
+	switch id {
+	case ProtocolIEID_MDTConfiguration:
+		tmp := new(MDTConfiguration)
+		if err = tmp.decode(r); err != nil {
+			err = readError("MDTConfiguration", err)
+			return
+		}
+		ie.MDTConfiguration = tmp
+	case ProtocolIEID_TraceCollectionEntityURI:
+		tmp := new(URIAddress)
+		if err = tmp.decode(r); err != nil {
+			err = readError("TraceCollectionEntityURI", err)
+			return
+		}
+		ie.TraceCollectionEntityURI = tmp
+	default:
+		ok = false
+	}
+	return
+}
+
+func (ie *TraceActivation) Validate() error {
+	var v validator
+	v.ie("TraceID", &ie.TraceID)
+	v.ie("InterfacesToTrace", &ie.InterfacesToTrace)
+	v.ie("TraceDepth", &ie.TraceDepth)
+	v.ie("TraceCollectionEntityIPAddress", &ie.TraceCollectionEntityIPAddress)
+	if ie.MDTConfiguration != nil {
+		v.ie("MDTConfiguration", ie.MDTConfiguration)
+	}
+	if ie.TraceCollectionEntityURI != nil {
+		v.ie("TraceCollectionEntityURI", ie.TraceCollectionEntityURI)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,40 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+const (
+	TraceDepthMinimum                               aper.Enumerated = 0
+	TraceDepthMedium                                aper.Enumerated = 1
+	TraceDepthMaximum                               aper.Enumerated = 2
+	TraceDepthMinimumWithoutVendorSpecificExtension aper.Enumerated = 3
+	TraceDepthMediumWithoutVendorSpecificExtension  aper.Enumerated = 4
+	TraceDepthMaximumWithoutVendorSpecificExtension aper.Enumerated = 5
+)
+
+type TraceDepth struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:5,valueExt"`
+}
+
+func (ie *TraceDepth) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 5}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *TraceDepth) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 5}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *TraceDepth) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 6)
+	return v.err()
+}
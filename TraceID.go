@@ -0,0 +1,35 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type TraceID struct {
+	Value aper.OctetString `aper:"sizeLB:8,sizeUB:8"`
+}
+
+func (ie *TraceID) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteOctetString([]byte(ie.Value), &aper.Constraint{Lb: 8, Ub: 8}, false); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *TraceID) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TraceID) decode(r *decoder) error {
+	if v, err := readOctetString(r, &aper.Constraint{Lb: 8, Ub: 8}, false); err != nil {
+		return err
+	} else {
+		ie.Value = aper.OctetString(v)
+	}
+	return nil
+}
+
+func (ie *TraceID) Validate() error {
+	var v validator
+	v.size("Value", len(ie.Value), 8, 8)
+	return v.err()
+}
@@ -0,0 +1,125 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type TraceStart struct {
+	GNBCUUEF1APID   GNBCUUEF1APID   `mandatory,reject`
+	GNBDUUEF1APID   GNBDUUEF1APID   `mandatory,reject`
+	TraceActivation TraceActivation `mandatory,ignore`
+	UnknownIEs      []RawIE
+}
+
+func (msg *TraceStart) ProcedureCode() int64 {
+	return ProcedureCode_TraceStart
+}
+
+func (msg *TraceStart) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *TraceStart) Criticality() aper.Enumerated {
+	return Criticality_PresentIgnore
+}
+
+func (msg *TraceStart) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("TraceStart"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *TraceStart) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("TraceStart"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *TraceStart) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_TraceActivation},
+		Criticality: Criticality{Value: Criticality_PresentIgnore},
+		Value:       &msg.TraceActivation,
+	})
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *TraceStart) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *TraceStart) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *TraceStart) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("TraceStart", wire, limits, traceStartIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var traceStartIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_TraceActivation, Criticality_PresentIgnore, true},
+}
+
+func (msg *TraceStart) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_TraceActivation:
+		var tmp TraceActivation
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("TraceActivation", err)
+			return
+		}
+		msg.TraceActivation = tmp
+
+	}
+	return
+}
+
+func (msg *TraceStart) Validate() error {
+	var v validator
+	v.integer("GNBCUUEF1APID", int64(msg.GNBCUUEF1APID.Value), 0, 4294967295)
+	v.integer("GNBDUUEF1APID", int64(msg.GNBDUUEF1APID.Value), 0, 4294967295)
+	v.ie("TraceActivation", &msg.TraceActivation)
+	return v.messageErr("TraceStart")
+}
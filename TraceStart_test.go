@@ -0,0 +1,49 @@
+package ies
+
+import (
+	"testing"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+func TestTraceStartMDTWire(t *testing.T) {
+	m := &TraceStart{
+		GNBCUUEF1APID: GNBCUUEF1APID{Value: 1},
+		GNBDUUEF1APID: GNBDUUEF1APID{Value: 2},
+		TraceActivation: TraceActivation{
+			TraceID:                        TraceID{Value: aper.OctetString{1, 2, 3, 4, 5, 6, 7, 8}},
+			InterfacesToTrace:              InterfacesToTrace{Value: aper.BitString{Bytes: []byte{0x80}, NumBits: 8}},
+			TraceDepth:                     TraceDepth{Value: TraceDepthMedium},
+			TraceCollectionEntityIPAddress: TransportLayerAddress{Value: aper.BitString{Bytes: []byte{10, 0, 0, 1}, NumBits: 32}},
+			MDTConfiguration: &MDTConfiguration{
+				MdtActivation:          MDTActivation{Value: MDTActivationImmediateMDTandTrace},
+				MeasurementsToActivate: MeasurementsToActivate{Value: aper.BitString{Bytes: []byte{0x48}, NumBits: 8}},
+				M2Configuration:        &M2Configuration{Value: M2ConfigurationTrue},
+				M5Configuration: &M5Configuration{
+					M5period:     M5period{Value: M5periodMs2048},
+					M5LinksToLog: M5LinksToLog{Value: M5LinksToLogBothuplinkanddownlink},
+				},
+			},
+			TraceCollectionEntityURI: &URIAddress{Value: aper.OctetString("ab")},
+		},
+	}
+	want := []byte{
+		0x00, 0x1c, 0x40, 0x34, // initiatingMessage, traceStart, ignore, length
+		0x00, 0x00, 0x03, // extension bit, 3 IEs
+		0x00, 0x28, 0x00, 0x02, 0x00, 0x01, // gNB-CU-UE-F1AP-ID 1
+		0x00, 0x29, 0x00, 0x02, 0x00, 0x02, // gNB-DU-UE-F1AP-ID 2
+		0x00, 0xf2, 0x40, 0x21, // TraceActivation
+		0x40,                                           // extensions present
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, // trace ID
+		0x80,       // interfaces to trace: F1-C
+		0x10, 0xf8, // medium depth, address of 32 bits
+		0x0a, 0x00, 0x00, 0x01, // 10.0.0.1
+		0x00, 0x01, // 2 extensions
+		0x01, 0x7d, 0x40, 0x04, // MDTConfiguration
+		0x61,       // M2 and M5 present, immediate MDT and trace
+		0x48,       // measurements to activate
+		0x02, 0x80, // M2, M5 every 2048 ms on both links
+		0x01, 0x7c, 0x40, 0x03, 0x02, 0x61, 0x62, // TraceCollectionEntityURI "ab"
+	}
+	checkMessage(t, m, new(TraceStart), want)
+}
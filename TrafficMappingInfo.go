@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	TrafficMappingInfoPresentNothing uint64 = iota
+	TrafficMappingInfoPresentIPtolayer2TrafficMappingInfo
+	TrafficMappingInfoPresentBAPlayerBHRLCchannelMappingInfo
+	TrafficMappingInfoPresentChoiceExtension
+)
+
+type TrafficMappingInfo struct {
+	Choice                          uint64
+	IPtolayer2TrafficMappingInfo    *IPtolayer2TrafficMappingInfo
+	BAPlayerBHRLCchannelMappingInfo *BAPlayerBHRLCchannelMappingInfo
+	ChoiceExtension                 *RawIE
+}
+
+func (ie *TrafficMappingInfo) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *TrafficMappingInfo) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case TrafficMappingInfoPresentIPtolayer2TrafficMappingInfo:
+		if err = ie.IPtolayer2TrafficMappingInfo.encode(w); err != nil {
+			err = utils.WrapError("Encode IPtolayer2TrafficMappingInfo", err)
+			return
+		}
+	case TrafficMappingInfoPresentBAPlayerBHRLCchannelMappingInfo:
+		if err = ie.BAPlayerBHRLCchannelMappingInfo.encode(w); err != nil {
+			err = utils.WrapError("Encode BAPlayerBHRLCchannelMappingInfo", err)
+			return
+		}
+	case TrafficMappingInfoPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *TrafficMappingInfo) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TrafficMappingInfo) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case TrafficMappingInfoPresentIPtolayer2TrafficMappingInfo:
+		tmp := new(IPtolayer2TrafficMappingInfo)
+		if err = tmp.decode(r); err != nil {
+			err = readError("IPtolayer2TrafficMappingInfo", err)
+			return
+		}
+		ie.IPtolayer2TrafficMappingInfo = tmp
+	case TrafficMappingInfoPresentBAPlayerBHRLCchannelMappingInfo:
+		tmp := new(BAPlayerBHRLCchannelMappingInfo)
+		if err = tmp.decode(r); err != nil {
+			err = readError("BAPlayerBHRLCchannelMappingInfo", err)
+			return
+		}
+		ie.BAPlayerBHRLCchannelMappingInfo = tmp
+	case TrafficMappingInfoPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *TrafficMappingInfo) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case TrafficMappingInfoPresentIPtolayer2TrafficMappingInfo:
+		if ie.IPtolayer2TrafficMappingInfo == nil {
+			v.absent("IPtolayer2TrafficMappingInfo")
+		} else {
+			v.ie("IPtolayer2TrafficMappingInfo", ie.IPtolayer2TrafficMappingInfo)
+		}
+	case TrafficMappingInfoPresentBAPlayerBHRLCchannelMappingInfo:
+		if ie.BAPlayerBHRLCchannelMappingInfo == nil {
+			v.absent("BAPlayerBHRLCchannelMappingInfo")
+		} else {
+			v.ie("BAPlayerBHRLCchannelMappingInfo", ie.BAPlayerBHRLCchannelMappingInfo)
+		}
+	case TrafficMappingInfoPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
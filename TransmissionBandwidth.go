@@ -0,0 +1,87 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type TransmissionBandwidth struct {
+	NRSCS NRSCS `mandatory`
+	NRNRB NRNRB `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[TransmissionBandwidth] `optional,extension`
+}
+
+func (ie *TransmissionBandwidth) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *TransmissionBandwidth) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.NRSCS.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode NRSCS", err)
+		return
+	}
+	if err = ie.NRNRB.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode NRNRB", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *TransmissionBandwidth) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TransmissionBandwidth) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.NRSCS.Decode(r.AperReader); err != nil {
+		err = readError("NRSCS", err)
+		return
+	}
+	if err = ie.NRNRB.Decode(r.AperReader); err != nil {
+		err = readError("NRNRB", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *TransmissionBandwidth) Validate() error {
+	var v validator
+	v.ie("NRSCS", &ie.NRSCS)
+	v.ie("NRNRB", &ie.NRNRB)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
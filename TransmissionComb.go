@@ -0,0 +1,235 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	TransmissionCombPresentNothing uint64 = iota
+	TransmissionCombPresentN2
+	TransmissionCombPresentN4
+	TransmissionCombPresentChoiceExtension
+)
+
+type TransmissionComb struct {
+	Choice          uint64
+	N2              *TransmissionCombN2
+	N4              *TransmissionCombN4
+	ChoiceExtension *RawIE
+}
+
+func (ie *TransmissionComb) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *TransmissionComb) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case TransmissionCombPresentN2:
+		if err = ie.N2.encode(w); err != nil {
+			err = utils.WrapError("Encode N2", err)
+			return
+		}
+	case TransmissionCombPresentN4:
+		if err = ie.N4.encode(w); err != nil {
+			err = utils.WrapError("Encode N4", err)
+			return
+		}
+	case TransmissionCombPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *TransmissionComb) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TransmissionComb) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case TransmissionCombPresentN2:
+		tmp := new(TransmissionCombN2)
+		if err = tmp.decode(r); err != nil {
+			err = readError("N2", err)
+			return
+		}
+		ie.N2 = tmp
+	case TransmissionCombPresentN4:
+		tmp := new(TransmissionCombN4)
+		if err = tmp.decode(r); err != nil {
+			err = readError("N4", err)
+			return
+		}
+		ie.N4 = tmp
+	case TransmissionCombPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *TransmissionComb) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case TransmissionCombPresentN2:
+		if ie.N2 == nil {
+			v.absent("N2")
+		} else {
+			v.ie("N2", ie.N2)
+		}
+	case TransmissionCombPresentN4:
+		if ie.N4 == nil {
+			v.absent("N4")
+		} else {
+			v.ie("N4", ie.N4)
+		}
+	case TransmissionCombPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
+
+type TransmissionCombN2 struct {
+	CombOffsetN2  int64 `lb:0,ub:1,mandatory`
+	CyclicShiftN2 int64 `lb:0,ub:7,mandatory`
+}
+
+func (ie *TransmissionCombN2) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *TransmissionCombN2) encode(w *writer) (err error) {
+	tmp_CombOffsetN2 := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 1},
+		ext:   false,
+		Value: aper.Integer(ie.CombOffsetN2),
+	}
+	if err = tmp_CombOffsetN2.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode CombOffsetN2", err)
+		return
+	}
+	tmp_CyclicShiftN2 := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 7},
+		ext:   false,
+		Value: aper.Integer(ie.CyclicShiftN2),
+	}
+	if err = tmp_CyclicShiftN2.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode CyclicShiftN2", err)
+		return
+	}
+	return
+}
+
+func (ie *TransmissionCombN2) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TransmissionCombN2) decode(r *decoder) (err error) {
+	tmp_CombOffsetN2 := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 1},
+		ext: false,
+	}
+	if err = tmp_CombOffsetN2.Decode(r.AperReader); err != nil {
+		err = readError("CombOffsetN2", err)
+		return
+	}
+	ie.CombOffsetN2 = int64(tmp_CombOffsetN2.Value)
+	tmp_CyclicShiftN2 := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 7},
+		ext: false,
+	}
+	if err = tmp_CyclicShiftN2.Decode(r.AperReader); err != nil {
+		err = readError("CyclicShiftN2", err)
+		return
+	}
+	ie.CyclicShiftN2 = int64(tmp_CyclicShiftN2.Value)
+	return
+}
+
+func (ie *TransmissionCombN2) Validate() error {
+	var v validator
+	v.integer("CombOffsetN2", ie.CombOffsetN2, 0, 1)
+	v.integer("CyclicShiftN2", ie.CyclicShiftN2, 0, 7)
+	return v.err()
+}
+
+type TransmissionCombN4 struct {
+	CombOffsetN4  int64 `lb:0,ub:3,mandatory`
+	CyclicShiftN4 int64 `lb:0,ub:11,mandatory`
+}
+
+func (ie *TransmissionCombN4) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *TransmissionCombN4) encode(w *writer) (err error) {
+	tmp_CombOffsetN4 := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 3},
+		ext:   false,
+		Value: aper.Integer(ie.CombOffsetN4),
+	}
+	if err = tmp_CombOffsetN4.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode CombOffsetN4", err)
+		return
+	}
+	tmp_CyclicShiftN4 := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 11},
+		ext:   false,
+		Value: aper.Integer(ie.CyclicShiftN4),
+	}
+	if err = tmp_CyclicShiftN4.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode CyclicShiftN4", err)
+		return
+	}
+	return
+}
+
+func (ie *TransmissionCombN4) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TransmissionCombN4) decode(r *decoder) (err error) {
+	tmp_CombOffsetN4 := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 3},
+		ext: false,
+	}
+	if err = tmp_CombOffsetN4.Decode(r.AperReader); err != nil {
+		err = readError("CombOffsetN4", err)
+		return
+	}
+	ie.CombOffsetN4 = int64(tmp_CombOffsetN4.Value)
+	tmp_CyclicShiftN4 := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 11},
+		ext: false,
+	}
+	if err = tmp_CyclicShiftN4.Decode(r.AperReader); err != nil {
+		err = readError("CyclicShiftN4", err)
+		return
+	}
+	ie.CyclicShiftN4 = int64(tmp_CyclicShiftN4.Value)
+	return
+}
+
+func (ie *TransmissionCombN4) Validate() error {
+	var v validator
+	v.integer("CombOffsetN4", ie.CombOffsetN4, 0, 3)
+	v.integer("CyclicShiftN4", ie.CyclicShiftN4, 0, 11)
+	return v.err()
+}
@@ -0,0 +1,319 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	TransmissionCombPosPresentNothing uint64 = iota
+	TransmissionCombPosPresentN2
+	TransmissionCombPosPresentN4
+	TransmissionCombPosPresentN8
+	TransmissionCombPosPresentChoiceExtension
+)
+
+type TransmissionCombPos struct {
+	Choice          uint64
+	N2              *TransmissionCombPosN2
+	N4              *TransmissionCombPosN4
+	N8              *TransmissionCombPosN8
+	ChoiceExtension *RawIE
+}
+
+func (ie *TransmissionCombPos) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *TransmissionCombPos) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 3, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case TransmissionCombPosPresentN2:
+		if err = ie.N2.encode(w); err != nil {
+			err = utils.WrapError("Encode N2", err)
+			return
+		}
+	case TransmissionCombPosPresentN4:
+		if err = ie.N4.encode(w); err != nil {
+			err = utils.WrapError("Encode N4", err)
+			return
+		}
+	case TransmissionCombPosPresentN8:
+		if err = ie.N8.encode(w); err != nil {
+			err = utils.WrapError("Encode N8", err)
+			return
+		}
+	case TransmissionCombPosPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *TransmissionCombPos) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TransmissionCombPos) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(3, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case TransmissionCombPosPresentN2:
+		tmp := new(TransmissionCombPosN2)
+		if err = tmp.decode(r); err != nil {
+			err = readError("N2", err)
+			return
+		}
+		ie.N2 = tmp
+	case TransmissionCombPosPresentN4:
+		tmp := new(TransmissionCombPosN4)
+		if err = tmp.decode(r); err != nil {
+			err = readError("N4", err)
+			return
+		}
+		ie.N4 = tmp
+	case TransmissionCombPosPresentN8:
+		tmp := new(TransmissionCombPosN8)
+		if err = tmp.decode(r); err != nil {
+			err = readError("N8", err)
+			return
+		}
+		ie.N8 = tmp
+	case TransmissionCombPosPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *TransmissionCombPos) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case TransmissionCombPosPresentN2:
+		if ie.N2 == nil {
+			v.absent("N2")
+		} else {
+			v.ie("N2", ie.N2)
+		}
+	case TransmissionCombPosPresentN4:
+		if ie.N4 == nil {
+			v.absent("N4")
+		} else {
+			v.ie("N4", ie.N4)
+		}
+	case TransmissionCombPosPresentN8:
+		if ie.N8 == nil {
+			v.absent("N8")
+		} else {
+			v.ie("N8", ie.N8)
+		}
+	case TransmissionCombPosPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
+
+type TransmissionCombPosN2 struct {
+	CombOffsetN2  int64 `lb:0,ub:1,mandatory`
+	CyclicShiftN2 int64 `lb:0,ub:7,mandatory`
+}
+
+func (ie *TransmissionCombPosN2) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *TransmissionCombPosN2) encode(w *writer) (err error) {
+	tmp_CombOffsetN2 := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 1},
+		ext:   false,
+		Value: aper.Integer(ie.CombOffsetN2),
+	}
+	if err = tmp_CombOffsetN2.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode CombOffsetN2", err)
+		return
+	}
+	tmp_CyclicShiftN2 := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 7},
+		ext:   false,
+		Value: aper.Integer(ie.CyclicShiftN2),
+	}
+	if err = tmp_CyclicShiftN2.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode CyclicShiftN2", err)
+		return
+	}
+	return
+}
+
+func (ie *TransmissionCombPosN2) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TransmissionCombPosN2) decode(r *decoder) (err error) {
+	tmp_CombOffsetN2 := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 1},
+		ext: false,
+	}
+	if err = tmp_CombOffsetN2.Decode(r.AperReader); err != nil {
+		err = readError("CombOffsetN2", err)
+		return
+	}
+	ie.CombOffsetN2 = int64(tmp_CombOffsetN2.Value)
+	tmp_CyclicShiftN2 := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 7},
+		ext: false,
+	}
+	if err = tmp_CyclicShiftN2.Decode(r.AperReader); err != nil {
+		err = readError("CyclicShiftN2", err)
+		return
+	}
+	ie.CyclicShiftN2 = int64(tmp_CyclicShiftN2.Value)
+	return
+}
+
+func (ie *TransmissionCombPosN2) Validate() error {
+	var v validator
+	v.integer("CombOffsetN2", ie.CombOffsetN2, 0, 1)
+	v.integer("CyclicShiftN2", ie.CyclicShiftN2, 0, 7)
+	return v.err()
+}
+
+type TransmissionCombPosN4 struct {
+	CombOffsetN4  int64 `lb:0,ub:3,mandatory`
+	CyclicShiftN4 int64 `lb:0,ub:11,mandatory`
+}
+
+func (ie *TransmissionCombPosN4) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *TransmissionCombPosN4) encode(w *writer) (err error) {
+	tmp_CombOffsetN4 := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 3},
+		ext:   false,
+		Value: aper.Integer(ie.CombOffsetN4),
+	}
+	if err = tmp_CombOffsetN4.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode CombOffsetN4", err)
+		return
+	}
+	tmp_CyclicShiftN4 := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 11},
+		ext:   false,
+		Value: aper.Integer(ie.CyclicShiftN4),
+	}
+	if err = tmp_CyclicShiftN4.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode CyclicShiftN4", err)
+		return
+	}
+	return
+}
+
+func (ie *TransmissionCombPosN4) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TransmissionCombPosN4) decode(r *decoder) (err error) {
+	tmp_CombOffsetN4 := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 3},
+		ext: false,
+	}
+	if err = tmp_CombOffsetN4.Decode(r.AperReader); err != nil {
+		err = readError("CombOffsetN4", err)
+		return
+	}
+	ie.CombOffsetN4 = int64(tmp_CombOffsetN4.Value)
+	tmp_CyclicShiftN4 := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 11},
+		ext: false,
+	}
+	if err = tmp_CyclicShiftN4.Decode(r.AperReader); err != nil {
+		err = readError("CyclicShiftN4", err)
+		return
+	}
+	ie.CyclicShiftN4 = int64(tmp_CyclicShiftN4.Value)
+	return
+}
+
+func (ie *TransmissionCombPosN4) Validate() error {
+	var v validator
+	v.integer("CombOffsetN4", ie.CombOffsetN4, 0, 3)
+	v.integer("CyclicShiftN4", ie.CyclicShiftN4, 0, 11)
+	return v.err()
+}
+
+type TransmissionCombPosN8 struct {
+	CombOffsetN8  int64 `lb:0,ub:7,mandatory`
+	CyclicShiftN8 int64 `lb:0,ub:5,mandatory`
+}
+
+func (ie *TransmissionCombPosN8) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *TransmissionCombPosN8) encode(w *writer) (err error) {
+	tmp_CombOffsetN8 := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 7},
+		ext:   false,
+		Value: aper.Integer(ie.CombOffsetN8),
+	}
+	if err = tmp_CombOffsetN8.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode CombOffsetN8", err)
+		return
+	}
+	tmp_CyclicShiftN8 := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 5},
+		ext:   false,
+		Value: aper.Integer(ie.CyclicShiftN8),
+	}
+	if err = tmp_CyclicShiftN8.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode CyclicShiftN8", err)
+		return
+	}
+	return
+}
+
+func (ie *TransmissionCombPosN8) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TransmissionCombPosN8) decode(r *decoder) (err error) {
+	tmp_CombOffsetN8 := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 7},
+		ext: false,
+	}
+	if err = tmp_CombOffsetN8.Decode(r.AperReader); err != nil {
+		err = readError("CombOffsetN8", err)
+		return
+	}
+	ie.CombOffsetN8 = int64(tmp_CombOffsetN8.Value)
+	tmp_CyclicShiftN8 := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 5},
+		ext: false,
+	}
+	if err = tmp_CyclicShiftN8.Decode(r.AperReader); err != nil {
+		err = readError("CyclicShiftN8", err)
+		return
+	}
+	ie.CyclicShiftN8 = int64(tmp_CyclicShiftN8.Value)
+	return
+}
+
+func (ie *TransmissionCombPosN8) Validate() error {
+	var v validator
+	v.integer("CombOffsetN8", ie.CombOffsetN8, 0, 7)
+	v.integer("CyclicShiftN8", ie.CyclicShiftN8, 0, 5)
+	return v.err()
+}
@@ -0,0 +1,35 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+type TransportLayerAddress struct {
+	Value aper.BitString `aper:"sizeLB:1,sizeUB:160,sizeExt"`
+}
+
+func (ie *TransportLayerAddress) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteBitString(ie.Value.Bytes, uint(ie.Value.NumBits), &aper.Constraint{Lb: 1, Ub: 160}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *TransportLayerAddress) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *TransportLayerAddress) decode(r *decoder) error {
+	if v, n, err := readBitString(r, &aper.Constraint{Lb: 1, Ub: 160}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.BitString{Bytes: v, NumBits: uint64(n)}
+	}
+	return nil
+}
+
+func (ie *TransportLayerAddress) Validate() error {
+	var v validator
+	v.size("Value", int(ie.Value.NumBits), 1, 160)
+	return v.err()
+}
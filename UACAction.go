@@ -0,0 +1,38 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+)
+
+const (
+	UACActionRejectnonemergencymodt                                    aper.Enumerated = 0
+	UACActionRejectrrccrsignalling                                     aper.Enumerated = 1
+	UACActionPermitemergencysessionsandmobileterminatedservicesonly    aper.Enumerated = 2
+	UACActionPermithighprioritysessionsandmobileterminatedservicesonly aper.Enumerated = 3
+)
+
+type UACAction struct {
+	Value aper.Enumerated `aper:"valueLB:0,valueUB:3,valueExt"`
+}
+
+func (ie *UACAction) Encode(w *aper.AperWriter) (err error) {
+	if err = w.WriteEnumerate(uint64(ie.Value), aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ie *UACAction) Decode(r *aper.AperReader) error {
+	if v, err := r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 3}, true); err != nil {
+		return err
+	} else {
+		ie.Value = aper.Enumerated(v)
+	}
+	return nil
+}
+
+func (ie *UACAction) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 4)
+	return v.err()
+}
@@ -0,0 +1,92 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type UACAssistanceInfo struct {
+	UACPLMNList []UACPLMNItem `lb:1,ub:maxnoofUACPLMNs,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[UACAssistanceInfo] `optional,extension`
+}
+
+func (ie *UACAssistanceInfo) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *UACAssistanceInfo) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_UACPLMNList := sequenceOf[*UACPLMNItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofUACPLMNs},
+		ext: false,
+	}
+	for k := range ie.UACPLMNList {
+		tmp_UACPLMNList.Value = append(tmp_UACPLMNList.Value, &ie.UACPLMNList[k])
+	}
+	if err = tmp_UACPLMNList.encode(w); err != nil {
+		err = utils.WrapError("Encode UACPLMNList", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *UACAssistanceInfo) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *UACAssistanceInfo) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	var tmp_UACPLMNList []*UACPLMNItem
+	if tmp_UACPLMNList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofUACPLMNs}, false, func() *UACPLMNItem { return new(UACPLMNItem) }); err != nil {
+		err = readError("UACPLMNList", err)
+		return
+	}
+	ie.UACPLMNList = make([]UACPLMNItem, len(tmp_UACPLMNList))
+	for k, i := range tmp_UACPLMNList {
+		ie.UACPLMNList[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *UACAssistanceInfo) Validate() error {
+	var v validator
+	v.size("UACPLMNList", len(ie.UACPLMNList), 1, maxnoofUACPLMNs)
+	for i := range ie.UACPLMNList {
+		v.item("UACPLMNList", i, &ie.UACPLMNList[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	UACCategoryTypePresentNothing uint64 = iota
+	UACCategoryTypePresentUACstandardized
+	UACCategoryTypePresentUACOperatorDefined
+	UACCategoryTypePresentChoiceExtension
+)
+
+type UACCategoryType struct {
+	Choice             uint64
+	UACstandardized    *UACAction
+	UACOperatorDefined *UACOperatorDefined
+	ChoiceExtension    *RawIE
+}
+
+func (ie *UACCategoryType) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *UACCategoryType) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case UACCategoryTypePresentUACstandardized:
+		if err = ie.UACstandardized.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode UACstandardized", err)
+			return
+		}
+	case UACCategoryTypePresentUACOperatorDefined:
+		if err = ie.UACOperatorDefined.encode(w); err != nil {
+			err = utils.WrapError("Encode UACOperatorDefined", err)
+			return
+		}
+	case UACCategoryTypePresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *UACCategoryType) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *UACCategoryType) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(2, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case UACCategoryTypePresentUACstandardized:
+		tmp := new(UACAction)
+		if err = tmp.Decode(r.AperReader); err != nil {
+			err = readError("UACstandardized", err)
+			return
+		}
+		ie.UACstandardized = tmp
+	case UACCategoryTypePresentUACOperatorDefined:
+		tmp := new(UACOperatorDefined)
+		if err = tmp.decode(r); err != nil {
+			err = readError("UACOperatorDefined", err)
+			return
+		}
+		ie.UACOperatorDefined = tmp
+	case UACCategoryTypePresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *UACCategoryType) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case UACCategoryTypePresentUACstandardized:
+		if ie.UACstandardized == nil {
+			v.absent("UACstandardized")
+		} else {
+			v.ie("UACstandardized", ie.UACstandardized)
+		}
+	case UACCategoryTypePresentUACOperatorDefined:
+		if ie.UACOperatorDefined == nil {
+			v.absent("UACOperatorDefined")
+		} else {
+			v.ie("UACOperatorDefined", ie.UACOperatorDefined)
+		}
+	case UACCategoryTypePresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
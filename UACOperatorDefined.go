@@ -0,0 +1,107 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type UACOperatorDefined struct {
+	AccessCategory int64          `lb:32,ub:63,valueExt,mandatory`
+	AccessIdentity aper.BitString `lb:7,ub:7,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[UACOperatorDefined] `optional,extension`
+}
+
+func (ie *UACOperatorDefined) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *UACOperatorDefined) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	tmp_AccessCategory := INTEGER{
+		c:     aper.Constraint{Lb: 32, Ub: 63},
+		ext:   true,
+		Value: aper.Integer(ie.AccessCategory),
+	}
+	if err = tmp_AccessCategory.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode AccessCategory", err)
+		return
+	}
+	tmp_AccessIdentity := BITSTRING{
+		c:     aper.Constraint{Lb: 7, Ub: 7},
+		ext:   false,
+		Value: ie.AccessIdentity,
+	}
+	if err = tmp_AccessIdentity.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode AccessIdentity", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *UACOperatorDefined) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *UACOperatorDefined) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	tmp_AccessCategory := INTEGER{
+		c:   aper.Constraint{Lb: 32, Ub: 63},
+		ext: true,
+	}
+	if err = tmp_AccessCategory.Decode(r.AperReader); err != nil {
+		err = readError("AccessCategory", err)
+		return
+	}
+	ie.AccessCategory = int64(tmp_AccessCategory.Value)
+	tmp_AccessIdentity := BITSTRING{
+		c:   aper.Constraint{Lb: 7, Ub: 7},
+		ext: false,
+	}
+	if err = tmp_AccessIdentity.Decode(r.AperReader); err != nil {
+		err = readError("AccessIdentity", err)
+		return
+	}
+	ie.AccessIdentity = tmp_AccessIdentity.Value
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *UACOperatorDefined) Validate() error {
+	var v validator
+	v.integer("AccessCategory", ie.AccessCategory, 32, 63)
+	v.size("AccessIdentity", int(ie.AccessIdentity.NumBits), 7, 7)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
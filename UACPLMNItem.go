@@ -0,0 +1,102 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type UACPLMNItem struct {
+	PLMNIdentity PLMNIdentity  `mandatory`
+	UACTypeList  []UACTypeItem `lb:1,ub:maxnoofUACperPLMN,mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[UACPLMNItem] `optional,extension`
+}
+
+func (ie *UACPLMNItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *UACPLMNItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.PLMNIdentity.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode PLMNIdentity", err)
+		return
+	}
+	tmp_UACTypeList := sequenceOf[*UACTypeItem]{
+		c:   aper.Constraint{Lb: 1, Ub: maxnoofUACperPLMN},
+		ext: false,
+	}
+	for k := range ie.UACTypeList {
+		tmp_UACTypeList.Value = append(tmp_UACTypeList.Value, &ie.UACTypeList[k])
+	}
+	if err = tmp_UACTypeList.encode(w); err != nil {
+		err = utils.WrapError("Encode UACTypeList", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *UACPLMNItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *UACPLMNItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.PLMNIdentity.Decode(r.AperReader); err != nil {
+		err = readError("PLMNIdentity", err)
+		return
+	}
+	var tmp_UACTypeList []*UACTypeItem
+	if tmp_UACTypeList, err = readList(r, aper.Constraint{Lb: 1, Ub: maxnoofUACperPLMN}, false, func() *UACTypeItem { return new(UACTypeItem) }); err != nil {
+		err = readError("UACTypeList", err)
+		return
+	}
+	ie.UACTypeList = make([]UACTypeItem, len(tmp_UACTypeList))
+	for k, i := range tmp_UACTypeList {
+		ie.UACTypeList[k] = *i
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *UACPLMNItem) Validate() error {
+	var v validator
+	v.size("PLMNIdentity", len(ie.PLMNIdentity.Value), 3, 3)
+	v.size("UACTypeList", len(ie.UACTypeList), 1, maxnoofUACperPLMN)
+	for i := range ie.UACTypeList {
+		v.item("UACTypeList", i, &ie.UACTypeList[i])
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
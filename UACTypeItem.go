@@ -0,0 +1,87 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type UACTypeItem struct {
+	UACReductionIndication UACReductionIndication `mandatory`
+	UACCategoryType        UACCategoryType        `mandatory`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[UACTypeItem] `optional,extension`
+}
+
+func (ie *UACTypeItem) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *UACTypeItem) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 1)
+	}
+	if err = w.WriteBits(optionals, 1); err != nil {
+		return
+	}
+	if err = ie.UACReductionIndication.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode UACReductionIndication", err)
+		return
+	}
+	if err = ie.UACCategoryType.encode(w); err != nil {
+		err = utils.WrapError("Encode UACCategoryType", err)
+		return
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *UACTypeItem) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *UACTypeItem) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(1); err != nil {
+		return
+	}
+	if err = ie.UACReductionIndication.Decode(r.AperReader); err != nil {
+		err = readError("UACReductionIndication", err)
+		return
+	}
+	if err = ie.UACCategoryType.decode(r); err != nil {
+		err = readError("UACCategoryType", err)
+		return
+	}
+	if aper.IsBitSet(optionals, 1) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *UACTypeItem) Validate() error {
+	var v validator
+	v.ie("UACReductionIndication", &ie.UACReductionIndication)
+	v.ie("UACCategoryType", &ie.UACCategoryType)
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,278 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type UEContextModificationConfirm struct {
+	GNBCUUEF1APID                           GNBCUUEF1APID                            `mandatory,reject`
+	GNBDUUEF1APID                           GNBDUUEF1APID                            `mandatory,reject`
+	ResourceCoordinationTransferContainer   *ResourceCoordinationTransferContainer   `optional,ignore`
+	DRBsModifiedConfList                    []DRBsModifiedConfItem                   `optional,ignore`
+	RRCContainer                            *RRCContainer                            `optional,ignore`
+	CriticalityDiagnostics                  *CriticalityDiagnostics                  `optional,ignore`
+	ExecuteDuplication                      *ExecuteDuplication                      `optional,ignore`
+	ResourceCoordinationTransferInformation *ResourceCoordinationTransferInformation `optional,ignore`
+	SLDRBsModifiedConfList                  []SLDRBsModifiedConfItem                 `optional,ignore`
+	DRBsModifiedConfListUnknownItems        []RawIE
+	SLDRBsModifiedConfListUnknownItems      []RawIE
+	UnknownIEs                              []RawIE
+}
+
+func (msg *UEContextModificationConfirm) ProcedureCode() int64 {
+	return ProcedureCode_UEContextModificationRequired
+}
+
+func (msg *UEContextModificationConfirm) Present() uint8 {
+	return F1apPduSuccessfulOutcome
+}
+
+func (msg *UEContextModificationConfirm) Criticality() aper.Enumerated {
+	return Criticality_PresentReject
+}
+
+func (msg *UEContextModificationConfirm) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("UEContextModificationConfirm"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *UEContextModificationConfirm) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("UEContextModificationConfirm"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *UEContextModificationConfirm) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	if msg.ResourceCoordinationTransferContainer != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ResourceCoordinationTransferContainer},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.ResourceCoordinationTransferContainer,
+		})
+	}
+	if len(msg.DRBsModifiedConfList) > 0 || len(msg.DRBsModifiedConfListUnknownItems) > 0 {
+		tmp_DRBsModifiedConfList := SingleContainerList[*DRBsModifiedConfItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsModifiedConfItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.DRBsModifiedConfListUnknownItems,
+		}
+		for k := range msg.DRBsModifiedConfList {
+			tmp_DRBsModifiedConfList.Value = append(tmp_DRBsModifiedConfList.Value, &msg.DRBsModifiedConfList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DRBsModifiedConfList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_DRBsModifiedConfList,
+		})
+	}
+	if msg.RRCContainer != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RRCContainer},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.RRCContainer,
+		})
+	}
+	if msg.CriticalityDiagnostics != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CriticalityDiagnostics},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.CriticalityDiagnostics,
+		})
+	}
+	if msg.ExecuteDuplication != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ExecuteDuplication},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.ExecuteDuplication,
+		})
+	}
+	if msg.ResourceCoordinationTransferInformation != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ResourceCoordinationTransferInformation},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.ResourceCoordinationTransferInformation,
+		})
+	}
+	if len(msg.SLDRBsModifiedConfList) > 0 || len(msg.SLDRBsModifiedConfListUnknownItems) > 0 {
+		tmp_SLDRBsModifiedConfList := SingleContainerList[*SLDRBsModifiedConfItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsModifiedConfItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.SLDRBsModifiedConfListUnknownItems,
+		}
+		for k := range msg.SLDRBsModifiedConfList {
+			tmp_SLDRBsModifiedConfList.Value = append(tmp_SLDRBsModifiedConfList.Value, &msg.SLDRBsModifiedConfList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SLDRBsModifiedConfList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_SLDRBsModifiedConfList,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *UEContextModificationConfirm) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *UEContextModificationConfirm) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *UEContextModificationConfirm) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("UEContextModificationConfirm", wire, limits, ueContextModificationConfirmIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var ueContextModificationConfirmIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_ResourceCoordinationTransferContainer, Criticality_PresentIgnore, false},
+	{ProtocolIEID_DRBsModifiedConfList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_RRCContainer, Criticality_PresentIgnore, false},
+	{ProtocolIEID_CriticalityDiagnostics, Criticality_PresentIgnore, false},
+	{ProtocolIEID_ExecuteDuplication, Criticality_PresentIgnore, false},
+	{ProtocolIEID_ResourceCoordinationTransferInformation, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SLDRBsModifiedConfList, Criticality_PresentIgnore, false},
+}
+
+func (msg *UEContextModificationConfirm) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_ResourceCoordinationTransferContainer:
+		var tmp ResourceCoordinationTransferContainer
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("ResourceCoordinationTransferContainer", err)
+			return
+		}
+		msg.ResourceCoordinationTransferContainer = &tmp
+
+	case ProtocolIEID_DRBsModifiedConfList:
+		tmp := SingleContainerList[*DRBsModifiedConfItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsModifiedConfItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *DRBsModifiedConfItem { return new(DRBsModifiedConfItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("DRBsModifiedConfList", err)
+			return
+		}
+		msg.DRBsModifiedConfList = []DRBsModifiedConfItem{}
+		for _, i := range tmp.Value {
+			msg.DRBsModifiedConfList = append(msg.DRBsModifiedConfList, *i)
+		}
+		msg.DRBsModifiedConfListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_RRCContainer:
+		var tmp RRCContainer
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RRCContainer", err)
+			return
+		}
+		msg.RRCContainer = &tmp
+
+	case ProtocolIEID_CriticalityDiagnostics:
+		var tmp CriticalityDiagnostics
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("CriticalityDiagnostics", err)
+			return
+		}
+		msg.CriticalityDiagnostics = &tmp
+
+	case ProtocolIEID_ExecuteDuplication:
+		var tmp ExecuteDuplication
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("ExecuteDuplication", err)
+			return
+		}
+		msg.ExecuteDuplication = &tmp
+
+	case ProtocolIEID_ResourceCoordinationTransferInformation:
+		var tmp ResourceCoordinationTransferInformation
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("ResourceCoordinationTransferInformation", err)
+			return
+		}
+		msg.ResourceCoordinationTransferInformation = &tmp
+
+	case ProtocolIEID_SLDRBsModifiedConfList:
+		tmp := SingleContainerList[*SLDRBsModifiedConfItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsModifiedConfItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *SLDRBsModifiedConfItem { return new(SLDRBsModifiedConfItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SLDRBsModifiedConfList", err)
+			return
+		}
+		msg.SLDRBsModifiedConfList = []SLDRBsModifiedConfItem{}
+		for _, i := range tmp.Value {
+			msg.SLDRBsModifiedConfList = append(msg.SLDRBsModifiedConfList, *i)
+		}
+		msg.SLDRBsModifiedConfListUnknownItems = tmp.Unknown
+
+	}
+	return
+}
+
+func (msg *UEContextModificationConfirm) Validate() error {
+	var v validator
+	v.integer("GNBCUUEF1APID", int64(msg.GNBCUUEF1APID.Value), 0, 4294967295)
+	v.integer("GNBDUUEF1APID", int64(msg.GNBDUUEF1APID.Value), 0, 4294967295)
+	if len(msg.DRBsModifiedConfList) > 0 {
+		v.size("DRBsModifiedConfList", len(msg.DRBsModifiedConfList), 1, maxnoofDRBs)
+	}
+	if msg.ExecuteDuplication != nil {
+		v.enumerated("ExecuteDuplication", msg.ExecuteDuplication.Value, 1)
+	}
+	if len(msg.SLDRBsModifiedConfList) > 0 {
+		v.size("SLDRBsModifiedConfList", len(msg.SLDRBsModifiedConfList), 1, maxnoofSLDRBs)
+		for i := range msg.SLDRBsModifiedConfList {
+			v.item("SLDRBsModifiedConfList", i, &msg.SLDRBsModifiedConfList[i])
+		}
+	}
+	return v.messageErr("UEContextModificationConfirm")
+}
@@ -0,0 +1,25 @@
+package ies
+
+import "testing"
+
+func TestUEContextModificationConfirmSLDRBsWire(t *testing.T) {
+	m := &UEContextModificationConfirm{
+		GNBCUUEF1APID: GNBCUUEF1APID{Value: 1},
+		GNBDUUEF1APID: GNBDUUEF1APID{Value: 2},
+		SLDRBsModifiedConfList: []SLDRBsModifiedConfItem{
+			{SLDRBID: SLDRBID{Value: 1}},
+			{SLDRBID: SLDRBID{Value: 300}},
+		},
+	}
+	want := []byte{
+		0x20, 0x08, 0x00, 0x23, // successfulOutcome, uEContextModificationRequired, reject, length
+		0x00, 0x00, 0x03, // extension bit, 3 IEs
+		0x00, 0x28, 0x00, 0x02, 0x00, 0x01, // gNB-CU-UE-F1AP-ID 1
+		0x00, 0x29, 0x00, 0x02, 0x00, 0x02, // gNB-DU-UE-F1AP-ID 2
+		0x01, 0x51, 0x40, 0x10, // SLDRBs-ModifiedConf-List
+		0x00, 0x01, // 2 items
+		0x01, 0x52, 0x40, 0x03, 0x00, 0x00, 0x00, // SL DRB 1
+		0x01, 0x52, 0x40, 0x03, 0x00, 0x01, 0x2b, // SL DRB 300
+	}
+	checkMessage(t, m, new(UEContextModificationConfirm), want)
+}
@@ -0,0 +1,1225 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type UEContextModificationRequest struct {
+	GNBCUUEF1APID                           GNBCUUEF1APID                            `mandatory,reject`
+	GNBDUUEF1APID                           GNBDUUEF1APID                            `mandatory,reject`
+	SpCellID                                *NRCGI                                   `optional,ignore`
+	ServCellIndex                           *ServCellIndex                           `optional,reject`
+	SpCellULConfigured                      *CellULConfigured                        `optional,ignore`
+	DRXCycle                                *DRXCycle                                `optional,ignore`
+	CUtoDURRCInformation                    *CUtoDURRCInformation                    `optional,reject`
+	TransmissionActionIndicator             *TransmissionActionIndicator             `optional,ignore`
+	ResourceCoordinationTransferContainer   *ResourceCoordinationTransferContainer   `optional,ignore`
+	RRCReconfigurationCompleteIndicator     *RRCReconfigurationCompleteIndicator     `optional,ignore`
+	RRCContainer                            *RRCContainer                            `optional,reject`
+	SCellToBeSetupModList                   []SCellToBeSetupModItem                  `optional,ignore`
+	SCellToBeRemovedList                    []SCellToBeRemovedItem                   `optional,ignore`
+	SRBsToBeSetupModList                    []SRBsToBeSetupModItem                   `optional,reject`
+	DRBsToBeSetupModList                    []DRBsToBeSetupModItem                   `optional,reject`
+	DRBsToBeModifiedList                    []DRBsToBeModifiedItem                   `optional,reject`
+	SRBsToBeReleasedList                    []SRBsToBeReleasedItem                   `optional,reject`
+	DRBsToBeReleasedList                    []DRBsToBeReleasedItem                   `optional,reject`
+	InactivityMonitoringRequest             *InactivityMonitoringRequest             `optional,reject`
+	RATFrequencyPriorityInformation         *RATFrequencyPriorityInformation         `optional,reject`
+	DRXConfigurationIndicator               *DRXConfigurationIndicator               `optional,ignore`
+	RLCFailureIndication                    *RLCFailureIndication                    `optional,ignore`
+	UplinkTxDirectCurrentListInformation    *UplinkTxDirectCurrentListInformation    `optional,ignore`
+	GNBDUConfigurationQuery                 *GNBDUConfigurationQuery                 `optional,reject`
+	GNBDUUEAMBRUL                           *BitRate                                 `optional,ignore`
+	ExecuteDuplication                      *ExecuteDuplication                      `optional,ignore`
+	RRCDeliveryStatusRequest                *RRCDeliveryStatusRequest                `optional,ignore`
+	ResourceCoordinationTransferInformation *ResourceCoordinationTransferInformation `optional,ignore`
+	ServingCellMO                           *ServingCellMO                           `optional,ignore`
+	NeedForGap                              *NeedforGap                              `optional,ignore`
+	FullConfiguration                       *FullConfiguration                       `optional,reject`
+	AdditionalRRMPriorityIndex              *AdditionalRRMPriorityIndex              `optional,ignore`
+	LowerLayerPresenceStatusChange          *LowerLayerPresenceStatusChange          `optional,ignore`
+	BHChannelsToBeSetupModList              []BHChannelsToBeSetupModItem             `optional,reject`
+	BHChannelsToBeModifiedList              []BHChannelsToBeModifiedItem             `optional,reject`
+	BHChannelsToBeReleasedList              []BHChannelsToBeReleasedItem             `optional,reject`
+	NRV2XServicesAuthorized                 *NRV2XServicesAuthorized                 `optional,ignore`
+	LTEV2XServicesAuthorized                *LTEV2XServicesAuthorized                `optional,ignore`
+	NRUESidelinkAggregateMaximumBitrate     *NRUESidelinkAggregateMaximumBitrate     `optional,ignore`
+	LTEUESidelinkAggregateMaximumBitrate    *LTEUESidelinkAggregateMaximumBitrate    `optional,ignore`
+	PC5LinkAMBR                             *BitRate                                 `optional,ignore`
+	SLDRBsToBeSetupModList                  []SLDRBsToBeSetupModItem                 `optional,reject`
+	SLDRBsToBeModifiedList                  []SLDRBsToBeModifiedItem                 `optional,reject`
+	SLDRBsToBeReleasedList                  []SLDRBsToBeReleasedItem                 `optional,reject`
+	ConditionalIntraDUMobilityInformation   *ConditionalIntraDUMobilityInformation   `optional,reject`
+	ManagementBasedMDTPLMNList              []PLMNIdentity                           `optional,ignore`
+	SCellToBeSetupModListUnknownItems       []RawIE
+	SCellToBeRemovedListUnknownItems        []RawIE
+	SRBsToBeSetupModListUnknownItems        []RawIE
+	DRBsToBeSetupModListUnknownItems        []RawIE
+	DRBsToBeModifiedListUnknownItems        []RawIE
+	SRBsToBeReleasedListUnknownItems        []RawIE
+	DRBsToBeReleasedListUnknownItems        []RawIE
+	BHChannelsToBeSetupModListUnknownItems  []RawIE
+	BHChannelsToBeModifiedListUnknownItems  []RawIE
+	BHChannelsToBeReleasedListUnknownItems  []RawIE
+	SLDRBsToBeSetupModListUnknownItems      []RawIE
+	SLDRBsToBeModifiedListUnknownItems      []RawIE
+	SLDRBsToBeReleasedListUnknownItems      []RawIE
+	UnknownIEs                              []RawIE
+}
+
+func (msg *UEContextModificationRequest) ProcedureCode() int64 {
+	return ProcedureCode_UEContextModification
+}
+
+func (msg *UEContextModificationRequest) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *UEContextModificationRequest) Criticality() aper.Enumerated {
+	return Criticality_PresentReject
+}
+
+func (msg *UEContextModificationRequest) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("UEContextModificationRequest"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *UEContextModificationRequest) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("UEContextModificationRequest"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *UEContextModificationRequest) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	if msg.SpCellID != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SpCellID},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.SpCellID,
+		})
+	}
+	if msg.ServCellIndex != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ServCellIndex},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.ServCellIndex,
+		})
+	}
+	if msg.SpCellULConfigured != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SpCellULConfigured},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.SpCellULConfigured,
+		})
+	}
+	if msg.DRXCycle != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DRXCycle},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.DRXCycle,
+		})
+	}
+	if msg.CUtoDURRCInformation != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CUtoDURRCInformation},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.CUtoDURRCInformation,
+		})
+	}
+	if msg.TransmissionActionIndicator != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_TransmissionActionIndicator},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.TransmissionActionIndicator,
+		})
+	}
+	if msg.ResourceCoordinationTransferContainer != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ResourceCoordinationTransferContainer},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.ResourceCoordinationTransferContainer,
+		})
+	}
+	if msg.RRCReconfigurationCompleteIndicator != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RRCReconfigurationCompleteIndicator},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.RRCReconfigurationCompleteIndicator,
+		})
+	}
+	if msg.RRCContainer != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RRCContainer},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.RRCContainer,
+		})
+	}
+	if len(msg.SCellToBeSetupModList) > 0 || len(msg.SCellToBeSetupModListUnknownItems) > 0 {
+		tmp_SCellToBeSetupModList := SingleContainerList[*SCellToBeSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSCells},
+			id:          ProtocolIEID_SCellToBeSetupModItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.SCellToBeSetupModListUnknownItems,
+		}
+		for k := range msg.SCellToBeSetupModList {
+			tmp_SCellToBeSetupModList.Value = append(tmp_SCellToBeSetupModList.Value, &msg.SCellToBeSetupModList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SCellToBeSetupModList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_SCellToBeSetupModList,
+		})
+	}
+	if len(msg.SCellToBeRemovedList) > 0 || len(msg.SCellToBeRemovedListUnknownItems) > 0 {
+		tmp_SCellToBeRemovedList := SingleContainerList[*SCellToBeRemovedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSCells},
+			id:          ProtocolIEID_SCellToBeRemovedItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.SCellToBeRemovedListUnknownItems,
+		}
+		for k := range msg.SCellToBeRemovedList {
+			tmp_SCellToBeRemovedList.Value = append(tmp_SCellToBeRemovedList.Value, &msg.SCellToBeRemovedList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SCellToBeRemovedList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_SCellToBeRemovedList,
+		})
+	}
+	if len(msg.SRBsToBeSetupModList) > 0 || len(msg.SRBsToBeSetupModListUnknownItems) > 0 {
+		tmp_SRBsToBeSetupModList := SingleContainerList[*SRBsToBeSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSRBs},
+			id:          ProtocolIEID_SRBsToBeSetupModItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.SRBsToBeSetupModListUnknownItems,
+		}
+		for k := range msg.SRBsToBeSetupModList {
+			tmp_SRBsToBeSetupModList.Value = append(tmp_SRBsToBeSetupModList.Value, &msg.SRBsToBeSetupModList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SRBsToBeSetupModList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_SRBsToBeSetupModList,
+		})
+	}
+	if len(msg.DRBsToBeSetupModList) > 0 || len(msg.DRBsToBeSetupModListUnknownItems) > 0 {
+		tmp_DRBsToBeSetupModList := SingleContainerList[*DRBsToBeSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsToBeSetupModItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.DRBsToBeSetupModListUnknownItems,
+		}
+		for k := range msg.DRBsToBeSetupModList {
+			tmp_DRBsToBeSetupModList.Value = append(tmp_DRBsToBeSetupModList.Value, &msg.DRBsToBeSetupModList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DRBsToBeSetupModList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_DRBsToBeSetupModList,
+		})
+	}
+	if len(msg.DRBsToBeModifiedList) > 0 || len(msg.DRBsToBeModifiedListUnknownItems) > 0 {
+		tmp_DRBsToBeModifiedList := SingleContainerList[*DRBsToBeModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsToBeModifiedItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.DRBsToBeModifiedListUnknownItems,
+		}
+		for k := range msg.DRBsToBeModifiedList {
+			tmp_DRBsToBeModifiedList.Value = append(tmp_DRBsToBeModifiedList.Value, &msg.DRBsToBeModifiedList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DRBsToBeModifiedList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_DRBsToBeModifiedList,
+		})
+	}
+	if len(msg.SRBsToBeReleasedList) > 0 || len(msg.SRBsToBeReleasedListUnknownItems) > 0 {
+		tmp_SRBsToBeReleasedList := SingleContainerList[*SRBsToBeReleasedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSRBs},
+			id:          ProtocolIEID_SRBsToBeReleasedItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.SRBsToBeReleasedListUnknownItems,
+		}
+		for k := range msg.SRBsToBeReleasedList {
+			tmp_SRBsToBeReleasedList.Value = append(tmp_SRBsToBeReleasedList.Value, &msg.SRBsToBeReleasedList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SRBsToBeReleasedList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_SRBsToBeReleasedList,
+		})
+	}
+	if len(msg.DRBsToBeReleasedList) > 0 || len(msg.DRBsToBeReleasedListUnknownItems) > 0 {
+		tmp_DRBsToBeReleasedList := SingleContainerList[*DRBsToBeReleasedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsToBeReleasedItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.DRBsToBeReleasedListUnknownItems,
+		}
+		for k := range msg.DRBsToBeReleasedList {
+			tmp_DRBsToBeReleasedList.Value = append(tmp_DRBsToBeReleasedList.Value, &msg.DRBsToBeReleasedList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DRBsToBeReleasedList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_DRBsToBeReleasedList,
+		})
+	}
+	if msg.InactivityMonitoringRequest != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_InactivityMonitoringRequest},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.InactivityMonitoringRequest,
+		})
+	}
+	if msg.RATFrequencyPriorityInformation != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RATFrequencyPriorityInformation},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.RATFrequencyPriorityInformation,
+		})
+	}
+	if msg.DRXConfigurationIndicator != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DRXConfigurationIndicator},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.DRXConfigurationIndicator,
+		})
+	}
+	if msg.RLCFailureIndication != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RLCFailureIndication},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.RLCFailureIndication,
+		})
+	}
+	if msg.UplinkTxDirectCurrentListInformation != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_UplinkTxDirectCurrentListInformation},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.UplinkTxDirectCurrentListInformation,
+		})
+	}
+	if msg.GNBDUConfigurationQuery != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_GNBDUConfigurationQuery},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.GNBDUConfigurationQuery,
+		})
+	}
+	if msg.GNBDUUEAMBRUL != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_GNBDUUEAMBRUL},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.GNBDUUEAMBRUL,
+		})
+	}
+	if msg.ExecuteDuplication != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ExecuteDuplication},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.ExecuteDuplication,
+		})
+	}
+	if msg.RRCDeliveryStatusRequest != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RRCDeliveryStatusRequest},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.RRCDeliveryStatusRequest,
+		})
+	}
+	if msg.ResourceCoordinationTransferInformation != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ResourceCoordinationTransferInformation},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.ResourceCoordinationTransferInformation,
+		})
+	}
+	if msg.ServingCellMO != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ServingCellMO},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.ServingCellMO,
+		})
+	}
+	if msg.NeedForGap != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_NeedForGap},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.NeedForGap,
+		})
+	}
+	if msg.FullConfiguration != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_FullConfiguration},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.FullConfiguration,
+		})
+	}
+	if msg.AdditionalRRMPriorityIndex != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_AdditionalRRMPriorityIndex},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.AdditionalRRMPriorityIndex,
+		})
+	}
+	if msg.LowerLayerPresenceStatusChange != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_LowerLayerPresenceStatusChange},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.LowerLayerPresenceStatusChange,
+		})
+	}
+	if len(msg.BHChannelsToBeSetupModList) > 0 || len(msg.BHChannelsToBeSetupModListUnknownItems) > 0 {
+		tmp_BHChannelsToBeSetupModList := SingleContainerList[*BHChannelsToBeSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsToBeSetupModItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.BHChannelsToBeSetupModListUnknownItems,
+		}
+		for k := range msg.BHChannelsToBeSetupModList {
+			tmp_BHChannelsToBeSetupModList.Value = append(tmp_BHChannelsToBeSetupModList.Value, &msg.BHChannelsToBeSetupModList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_BHChannelsToBeSetupModList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_BHChannelsToBeSetupModList,
+		})
+	}
+	if len(msg.BHChannelsToBeModifiedList) > 0 || len(msg.BHChannelsToBeModifiedListUnknownItems) > 0 {
+		tmp_BHChannelsToBeModifiedList := SingleContainerList[*BHChannelsToBeModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsToBeModifiedItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.BHChannelsToBeModifiedListUnknownItems,
+		}
+		for k := range msg.BHChannelsToBeModifiedList {
+			tmp_BHChannelsToBeModifiedList.Value = append(tmp_BHChannelsToBeModifiedList.Value, &msg.BHChannelsToBeModifiedList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_BHChannelsToBeModifiedList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_BHChannelsToBeModifiedList,
+		})
+	}
+	if len(msg.BHChannelsToBeReleasedList) > 0 || len(msg.BHChannelsToBeReleasedListUnknownItems) > 0 {
+		tmp_BHChannelsToBeReleasedList := SingleContainerList[*BHChannelsToBeReleasedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsToBeReleasedItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.BHChannelsToBeReleasedListUnknownItems,
+		}
+		for k := range msg.BHChannelsToBeReleasedList {
+			tmp_BHChannelsToBeReleasedList.Value = append(tmp_BHChannelsToBeReleasedList.Value, &msg.BHChannelsToBeReleasedList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_BHChannelsToBeReleasedList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_BHChannelsToBeReleasedList,
+		})
+	}
+	if msg.NRV2XServicesAuthorized != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_NRV2XServicesAuthorized},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.NRV2XServicesAuthorized,
+		})
+	}
+	if msg.LTEV2XServicesAuthorized != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_LTEV2XServicesAuthorized},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.LTEV2XServicesAuthorized,
+		})
+	}
+	if msg.NRUESidelinkAggregateMaximumBitrate != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_NRUESidelinkAggregateMaximumBitrate},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.NRUESidelinkAggregateMaximumBitrate,
+		})
+	}
+	if msg.LTEUESidelinkAggregateMaximumBitrate != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_LTEUESidelinkAggregateMaximumBitrate},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.LTEUESidelinkAggregateMaximumBitrate,
+		})
+	}
+	if msg.PC5LinkAMBR != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_PC5LinkAMBR},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.PC5LinkAMBR,
+		})
+	}
+	if len(msg.SLDRBsToBeSetupModList) > 0 || len(msg.SLDRBsToBeSetupModListUnknownItems) > 0 {
+		tmp_SLDRBsToBeSetupModList := SingleContainerList[*SLDRBsToBeSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsToBeSetupModItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.SLDRBsToBeSetupModListUnknownItems,
+		}
+		for k := range msg.SLDRBsToBeSetupModList {
+			tmp_SLDRBsToBeSetupModList.Value = append(tmp_SLDRBsToBeSetupModList.Value, &msg.SLDRBsToBeSetupModList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SLDRBsToBeSetupModList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_SLDRBsToBeSetupModList,
+		})
+	}
+	if len(msg.SLDRBsToBeModifiedList) > 0 || len(msg.SLDRBsToBeModifiedListUnknownItems) > 0 {
+		tmp_SLDRBsToBeModifiedList := SingleContainerList[*SLDRBsToBeModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsToBeModifiedItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.SLDRBsToBeModifiedListUnknownItems,
+		}
+		for k := range msg.SLDRBsToBeModifiedList {
+			tmp_SLDRBsToBeModifiedList.Value = append(tmp_SLDRBsToBeModifiedList.Value, &msg.SLDRBsToBeModifiedList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SLDRBsToBeModifiedList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_SLDRBsToBeModifiedList,
+		})
+	}
+	if len(msg.SLDRBsToBeReleasedList) > 0 || len(msg.SLDRBsToBeReleasedListUnknownItems) > 0 {
+		tmp_SLDRBsToBeReleasedList := SingleContainerList[*SLDRBsToBeReleasedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsToBeReleasedItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.SLDRBsToBeReleasedListUnknownItems,
+		}
+		for k := range msg.SLDRBsToBeReleasedList {
+			tmp_SLDRBsToBeReleasedList.Value = append(tmp_SLDRBsToBeReleasedList.Value, &msg.SLDRBsToBeReleasedList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SLDRBsToBeReleasedList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_SLDRBsToBeReleasedList,
+		})
+	}
+	if msg.ConditionalIntraDUMobilityInformation != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ConditionalIntraDUMobilityInformation},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.ConditionalIntraDUMobilityInformation,
+		})
+	}
+	if len(msg.ManagementBasedMDTPLMNList) > 0 {
+		tmp_ManagementBasedMDTPLMNList := sequenceOf[*PLMNIdentity]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofMDTPLMNs},
+			ext: false,
+		}
+		for k := range msg.ManagementBasedMDTPLMNList {
+			tmp_ManagementBasedMDTPLMNList.Value = append(tmp_ManagementBasedMDTPLMNList.Value, &msg.ManagementBasedMDTPLMNList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ManagementBasedMDTPLMNList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_ManagementBasedMDTPLMNList,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *UEContextModificationRequest) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *UEContextModificationRequest) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *UEContextModificationRequest) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("UEContextModificationRequest", wire, limits, ueContextModificationRequestIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var ueContextModificationRequestIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_SpCellID, Criticality_PresentIgnore, false},
+	{ProtocolIEID_ServCellIndex, Criticality_PresentReject, false},
+	{ProtocolIEID_SpCellULConfigured, Criticality_PresentIgnore, false},
+	{ProtocolIEID_DRXCycle, Criticality_PresentIgnore, false},
+	{ProtocolIEID_CUtoDURRCInformation, Criticality_PresentReject, false},
+	{ProtocolIEID_TransmissionActionIndicator, Criticality_PresentIgnore, false},
+	{ProtocolIEID_ResourceCoordinationTransferContainer, Criticality_PresentIgnore, false},
+	{ProtocolIEID_RRCReconfigurationCompleteIndicator, Criticality_PresentIgnore, false},
+	{ProtocolIEID_RRCContainer, Criticality_PresentReject, false},
+	{ProtocolIEID_SCellToBeSetupModList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SCellToBeRemovedList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SRBsToBeSetupModList, Criticality_PresentReject, false},
+	{ProtocolIEID_DRBsToBeSetupModList, Criticality_PresentReject, false},
+	{ProtocolIEID_DRBsToBeModifiedList, Criticality_PresentReject, false},
+	{ProtocolIEID_SRBsToBeReleasedList, Criticality_PresentReject, false},
+	{ProtocolIEID_DRBsToBeReleasedList, Criticality_PresentReject, false},
+	{ProtocolIEID_InactivityMonitoringRequest, Criticality_PresentReject, false},
+	{ProtocolIEID_RATFrequencyPriorityInformation, Criticality_PresentReject, false},
+	{ProtocolIEID_DRXConfigurationIndicator, Criticality_PresentIgnore, false},
+	{ProtocolIEID_RLCFailureIndication, Criticality_PresentIgnore, false},
+	{ProtocolIEID_UplinkTxDirectCurrentListInformation, Criticality_PresentIgnore, false},
+	{ProtocolIEID_GNBDUConfigurationQuery, Criticality_PresentReject, false},
+	{ProtocolIEID_GNBDUUEAMBRUL, Criticality_PresentIgnore, false},
+	{ProtocolIEID_ExecuteDuplication, Criticality_PresentIgnore, false},
+	{ProtocolIEID_RRCDeliveryStatusRequest, Criticality_PresentIgnore, false},
+	{ProtocolIEID_ResourceCoordinationTransferInformation, Criticality_PresentIgnore, false},
+	{ProtocolIEID_ServingCellMO, Criticality_PresentIgnore, false},
+	{ProtocolIEID_NeedForGap, Criticality_PresentIgnore, false},
+	{ProtocolIEID_FullConfiguration, Criticality_PresentReject, false},
+	{ProtocolIEID_AdditionalRRMPriorityIndex, Criticality_PresentIgnore, false},
+	{ProtocolIEID_LowerLayerPresenceStatusChange, Criticality_PresentIgnore, false},
+	{ProtocolIEID_BHChannelsToBeSetupModList, Criticality_PresentReject, false},
+	{ProtocolIEID_BHChannelsToBeModifiedList, Criticality_PresentReject, false},
+	{ProtocolIEID_BHChannelsToBeReleasedList, Criticality_PresentReject, false},
+	{ProtocolIEID_NRV2XServicesAuthorized, Criticality_PresentIgnore, false},
+	{ProtocolIEID_LTEV2XServicesAuthorized, Criticality_PresentIgnore, false},
+	{ProtocolIEID_NRUESidelinkAggregateMaximumBitrate, Criticality_PresentIgnore, false},
+	{ProtocolIEID_LTEUESidelinkAggregateMaximumBitrate, Criticality_PresentIgnore, false},
+	{ProtocolIEID_PC5LinkAMBR, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SLDRBsToBeSetupModList, Criticality_PresentReject, false},
+	{ProtocolIEID_SLDRBsToBeModifiedList, Criticality_PresentReject, false},
+	{ProtocolIEID_SLDRBsToBeReleasedList, Criticality_PresentReject, false},
+	{ProtocolIEID_ConditionalIntraDUMobilityInformation, Criticality_PresentReject, false},
+	{ProtocolIEID_ManagementBasedMDTPLMNList, Criticality_PresentIgnore, false},
+}
+
+func (msg *UEContextModificationRequest) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_SpCellID:
+		var tmp NRCGI
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("SpCellID", err)
+			return
+		}
+		msg.SpCellID = &tmp
+
+	case ProtocolIEID_ServCellIndex:
+		var tmp ServCellIndex
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("ServCellIndex", err)
+			return
+		}
+		msg.ServCellIndex = &tmp
+
+	case ProtocolIEID_SpCellULConfigured:
+		var tmp CellULConfigured
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("SpCellULConfigured", err)
+			return
+		}
+		msg.SpCellULConfigured = &tmp
+
+	case ProtocolIEID_DRXCycle:
+		var tmp DRXCycle
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("DRXCycle", err)
+			return
+		}
+		msg.DRXCycle = &tmp
+
+	case ProtocolIEID_CUtoDURRCInformation:
+		var tmp CUtoDURRCInformation
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("CUtoDURRCInformation", err)
+			return
+		}
+		msg.CUtoDURRCInformation = &tmp
+
+	case ProtocolIEID_TransmissionActionIndicator:
+		var tmp TransmissionActionIndicator
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("TransmissionActionIndicator", err)
+			return
+		}
+		msg.TransmissionActionIndicator = &tmp
+
+	case ProtocolIEID_ResourceCoordinationTransferContainer:
+		var tmp ResourceCoordinationTransferContainer
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("ResourceCoordinationTransferContainer", err)
+			return
+		}
+		msg.ResourceCoordinationTransferContainer = &tmp
+
+	case ProtocolIEID_RRCReconfigurationCompleteIndicator:
+		var tmp RRCReconfigurationCompleteIndicator
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RRCReconfigurationCompleteIndicator", err)
+			return
+		}
+		msg.RRCReconfigurationCompleteIndicator = &tmp
+
+	case ProtocolIEID_RRCContainer:
+		var tmp RRCContainer
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RRCContainer", err)
+			return
+		}
+		msg.RRCContainer = &tmp
+
+	case ProtocolIEID_SCellToBeSetupModList:
+		tmp := SingleContainerList[*SCellToBeSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSCells},
+			id:          ProtocolIEID_SCellToBeSetupModItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *SCellToBeSetupModItem { return new(SCellToBeSetupModItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SCellToBeSetupModList", err)
+			return
+		}
+		msg.SCellToBeSetupModList = []SCellToBeSetupModItem{}
+		for _, i := range tmp.Value {
+			msg.SCellToBeSetupModList = append(msg.SCellToBeSetupModList, *i)
+		}
+		msg.SCellToBeSetupModListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_SCellToBeRemovedList:
+		tmp := SingleContainerList[*SCellToBeRemovedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSCells},
+			id:          ProtocolIEID_SCellToBeRemovedItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *SCellToBeRemovedItem { return new(SCellToBeRemovedItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SCellToBeRemovedList", err)
+			return
+		}
+		msg.SCellToBeRemovedList = []SCellToBeRemovedItem{}
+		for _, i := range tmp.Value {
+			msg.SCellToBeRemovedList = append(msg.SCellToBeRemovedList, *i)
+		}
+		msg.SCellToBeRemovedListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_SRBsToBeSetupModList:
+		tmp := SingleContainerList[*SRBsToBeSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSRBs},
+			id:          ProtocolIEID_SRBsToBeSetupModItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *SRBsToBeSetupModItem { return new(SRBsToBeSetupModItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SRBsToBeSetupModList", err)
+			return
+		}
+		msg.SRBsToBeSetupModList = []SRBsToBeSetupModItem{}
+		for _, i := range tmp.Value {
+			msg.SRBsToBeSetupModList = append(msg.SRBsToBeSetupModList, *i)
+		}
+		msg.SRBsToBeSetupModListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_DRBsToBeSetupModList:
+		tmp := SingleContainerList[*DRBsToBeSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsToBeSetupModItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *DRBsToBeSetupModItem { return new(DRBsToBeSetupModItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("DRBsToBeSetupModList", err)
+			return
+		}
+		msg.DRBsToBeSetupModList = []DRBsToBeSetupModItem{}
+		for _, i := range tmp.Value {
+			msg.DRBsToBeSetupModList = append(msg.DRBsToBeSetupModList, *i)
+		}
+		msg.DRBsToBeSetupModListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_DRBsToBeModifiedList:
+		tmp := SingleContainerList[*DRBsToBeModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsToBeModifiedItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *DRBsToBeModifiedItem { return new(DRBsToBeModifiedItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("DRBsToBeModifiedList", err)
+			return
+		}
+		msg.DRBsToBeModifiedList = []DRBsToBeModifiedItem{}
+		for _, i := range tmp.Value {
+			msg.DRBsToBeModifiedList = append(msg.DRBsToBeModifiedList, *i)
+		}
+		msg.DRBsToBeModifiedListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_SRBsToBeReleasedList:
+		tmp := SingleContainerList[*SRBsToBeReleasedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSRBs},
+			id:          ProtocolIEID_SRBsToBeReleasedItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *SRBsToBeReleasedItem { return new(SRBsToBeReleasedItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SRBsToBeReleasedList", err)
+			return
+		}
+		msg.SRBsToBeReleasedList = []SRBsToBeReleasedItem{}
+		for _, i := range tmp.Value {
+			msg.SRBsToBeReleasedList = append(msg.SRBsToBeReleasedList, *i)
+		}
+		msg.SRBsToBeReleasedListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_DRBsToBeReleasedList:
+		tmp := SingleContainerList[*DRBsToBeReleasedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsToBeReleasedItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *DRBsToBeReleasedItem { return new(DRBsToBeReleasedItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("DRBsToBeReleasedList", err)
+			return
+		}
+		msg.DRBsToBeReleasedList = []DRBsToBeReleasedItem{}
+		for _, i := range tmp.Value {
+			msg.DRBsToBeReleasedList = append(msg.DRBsToBeReleasedList, *i)
+		}
+		msg.DRBsToBeReleasedListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_InactivityMonitoringRequest:
+		var tmp InactivityMonitoringRequest
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("InactivityMonitoringRequest", err)
+			return
+		}
+		msg.InactivityMonitoringRequest = &tmp
+
+	case ProtocolIEID_RATFrequencyPriorityInformation:
+		var tmp RATFrequencyPriorityInformation
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RATFrequencyPriorityInformation", err)
+			return
+		}
+		msg.RATFrequencyPriorityInformation = &tmp
+
+	case ProtocolIEID_DRXConfigurationIndicator:
+		var tmp DRXConfigurationIndicator
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("DRXConfigurationIndicator", err)
+			return
+		}
+		msg.DRXConfigurationIndicator = &tmp
+
+	case ProtocolIEID_RLCFailureIndication:
+		var tmp RLCFailureIndication
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RLCFailureIndication", err)
+			return
+		}
+		msg.RLCFailureIndication = &tmp
+
+	case ProtocolIEID_UplinkTxDirectCurrentListInformation:
+		var tmp UplinkTxDirectCurrentListInformation
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("UplinkTxDirectCurrentListInformation", err)
+			return
+		}
+		msg.UplinkTxDirectCurrentListInformation = &tmp
+
+	case ProtocolIEID_GNBDUConfigurationQuery:
+		var tmp GNBDUConfigurationQuery
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUConfigurationQuery", err)
+			return
+		}
+		msg.GNBDUConfigurationQuery = &tmp
+
+	case ProtocolIEID_GNBDUUEAMBRUL:
+		var tmp BitRate
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEAMBRUL", err)
+			return
+		}
+		msg.GNBDUUEAMBRUL = &tmp
+
+	case ProtocolIEID_ExecuteDuplication:
+		var tmp ExecuteDuplication
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("ExecuteDuplication", err)
+			return
+		}
+		msg.ExecuteDuplication = &tmp
+
+	case ProtocolIEID_RRCDeliveryStatusRequest:
+		var tmp RRCDeliveryStatusRequest
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RRCDeliveryStatusRequest", err)
+			return
+		}
+		msg.RRCDeliveryStatusRequest = &tmp
+
+	case ProtocolIEID_ResourceCoordinationTransferInformation:
+		var tmp ResourceCoordinationTransferInformation
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("ResourceCoordinationTransferInformation", err)
+			return
+		}
+		msg.ResourceCoordinationTransferInformation = &tmp
+
+	case ProtocolIEID_ServingCellMO:
+		var tmp ServingCellMO
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("ServingCellMO", err)
+			return
+		}
+		msg.ServingCellMO = &tmp
+
+	case ProtocolIEID_NeedForGap:
+		var tmp NeedforGap
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("NeedForGap", err)
+			return
+		}
+		msg.NeedForGap = &tmp
+
+	case ProtocolIEID_FullConfiguration:
+		var tmp FullConfiguration
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("FullConfiguration", err)
+			return
+		}
+		msg.FullConfiguration = &tmp
+
+	case ProtocolIEID_AdditionalRRMPriorityIndex:
+		var tmp AdditionalRRMPriorityIndex
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("AdditionalRRMPriorityIndex", err)
+			return
+		}
+		msg.AdditionalRRMPriorityIndex = &tmp
+
+	case ProtocolIEID_LowerLayerPresenceStatusChange:
+		var tmp LowerLayerPresenceStatusChange
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("LowerLayerPresenceStatusChange", err)
+			return
+		}
+		msg.LowerLayerPresenceStatusChange = &tmp
+
+	case ProtocolIEID_BHChannelsToBeSetupModList:
+		tmp := SingleContainerList[*BHChannelsToBeSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsToBeSetupModItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *BHChannelsToBeSetupModItem { return new(BHChannelsToBeSetupModItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("BHChannelsToBeSetupModList", err)
+			return
+		}
+		msg.BHChannelsToBeSetupModList = []BHChannelsToBeSetupModItem{}
+		for _, i := range tmp.Value {
+			msg.BHChannelsToBeSetupModList = append(msg.BHChannelsToBeSetupModList, *i)
+		}
+		msg.BHChannelsToBeSetupModListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_BHChannelsToBeModifiedList:
+		tmp := SingleContainerList[*BHChannelsToBeModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsToBeModifiedItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *BHChannelsToBeModifiedItem { return new(BHChannelsToBeModifiedItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("BHChannelsToBeModifiedList", err)
+			return
+		}
+		msg.BHChannelsToBeModifiedList = []BHChannelsToBeModifiedItem{}
+		for _, i := range tmp.Value {
+			msg.BHChannelsToBeModifiedList = append(msg.BHChannelsToBeModifiedList, *i)
+		}
+		msg.BHChannelsToBeModifiedListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_BHChannelsToBeReleasedList:
+		tmp := SingleContainerList[*BHChannelsToBeReleasedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsToBeReleasedItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *BHChannelsToBeReleasedItem { return new(BHChannelsToBeReleasedItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("BHChannelsToBeReleasedList", err)
+			return
+		}
+		msg.BHChannelsToBeReleasedList = []BHChannelsToBeReleasedItem{}
+		for _, i := range tmp.Value {
+			msg.BHChannelsToBeReleasedList = append(msg.BHChannelsToBeReleasedList, *i)
+		}
+		msg.BHChannelsToBeReleasedListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_NRV2XServicesAuthorized:
+		var tmp NRV2XServicesAuthorized
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("NRV2XServicesAuthorized", err)
+			return
+		}
+		msg.NRV2XServicesAuthorized = &tmp
+
+	case ProtocolIEID_LTEV2XServicesAuthorized:
+		var tmp LTEV2XServicesAuthorized
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("LTEV2XServicesAuthorized", err)
+			return
+		}
+		msg.LTEV2XServicesAuthorized = &tmp
+
+	case ProtocolIEID_NRUESidelinkAggregateMaximumBitrate:
+		var tmp NRUESidelinkAggregateMaximumBitrate
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("NRUESidelinkAggregateMaximumBitrate", err)
+			return
+		}
+		msg.NRUESidelinkAggregateMaximumBitrate = &tmp
+
+	case ProtocolIEID_LTEUESidelinkAggregateMaximumBitrate:
+		var tmp LTEUESidelinkAggregateMaximumBitrate
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("LTEUESidelinkAggregateMaximumBitrate", err)
+			return
+		}
+		msg.LTEUESidelinkAggregateMaximumBitrate = &tmp
+
+	case ProtocolIEID_PC5LinkAMBR:
+		var tmp BitRate
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("PC5LinkAMBR", err)
+			return
+		}
+		msg.PC5LinkAMBR = &tmp
+
+	case ProtocolIEID_SLDRBsToBeSetupModList:
+		tmp := SingleContainerList[*SLDRBsToBeSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsToBeSetupModItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *SLDRBsToBeSetupModItem { return new(SLDRBsToBeSetupModItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SLDRBsToBeSetupModList", err)
+			return
+		}
+		msg.SLDRBsToBeSetupModList = []SLDRBsToBeSetupModItem{}
+		for _, i := range tmp.Value {
+			msg.SLDRBsToBeSetupModList = append(msg.SLDRBsToBeSetupModList, *i)
+		}
+		msg.SLDRBsToBeSetupModListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_SLDRBsToBeModifiedList:
+		tmp := SingleContainerList[*SLDRBsToBeModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsToBeModifiedItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *SLDRBsToBeModifiedItem { return new(SLDRBsToBeModifiedItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SLDRBsToBeModifiedList", err)
+			return
+		}
+		msg.SLDRBsToBeModifiedList = []SLDRBsToBeModifiedItem{}
+		for _, i := range tmp.Value {
+			msg.SLDRBsToBeModifiedList = append(msg.SLDRBsToBeModifiedList, *i)
+		}
+		msg.SLDRBsToBeModifiedListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_SLDRBsToBeReleasedList:
+		tmp := SingleContainerList[*SLDRBsToBeReleasedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsToBeReleasedItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *SLDRBsToBeReleasedItem { return new(SLDRBsToBeReleasedItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SLDRBsToBeReleasedList", err)
+			return
+		}
+		msg.SLDRBsToBeReleasedList = []SLDRBsToBeReleasedItem{}
+		for _, i := range tmp.Value {
+			msg.SLDRBsToBeReleasedList = append(msg.SLDRBsToBeReleasedList, *i)
+		}
+		msg.SLDRBsToBeReleasedListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_ConditionalIntraDUMobilityInformation:
+		var tmp ConditionalIntraDUMobilityInformation
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("ConditionalIntraDUMobilityInformation", err)
+			return
+		}
+		msg.ConditionalIntraDUMobilityInformation = &tmp
+
+	case ProtocolIEID_ManagementBasedMDTPLMNList:
+		var tmp []*PLMNIdentity
+		if tmp, err = readList(ieR, aper.Constraint{Lb: 1, Ub: maxnoofMDTPLMNs}, false, func() *PLMNIdentity { return new(PLMNIdentity) }); err != nil {
+			err = readError("ManagementBasedMDTPLMNList", err)
+			return
+		}
+		msg.ManagementBasedMDTPLMNList = make([]PLMNIdentity, len(tmp))
+		for k, i := range tmp {
+			msg.ManagementBasedMDTPLMNList[k] = *i
+		}
+
+	}
+	return
+}
+
+func (msg *UEContextModificationRequest) Validate() error {
+	var v validator
+	v.integer("GNBCUUEF1APID", int64(msg.GNBCUUEF1APID.Value), 0, 4294967295)
+	v.integer("GNBDUUEF1APID", int64(msg.GNBDUUEF1APID.Value), 0, 4294967295)
+	if msg.ServCellIndex != nil {
+		v.integer("ServCellIndex", int64(msg.ServCellIndex.Value), 0, 31)
+	}
+	if msg.SpCellULConfigured != nil {
+		v.enumerated("SpCellULConfigured", msg.SpCellULConfigured.Value, 4)
+	}
+	if len(msg.SCellToBeSetupModList) > 0 {
+		v.size("SCellToBeSetupModList", len(msg.SCellToBeSetupModList), 1, maxnoofSCells)
+	}
+	if len(msg.SCellToBeRemovedList) > 0 {
+		v.size("SCellToBeRemovedList", len(msg.SCellToBeRemovedList), 1, maxnoofSCells)
+	}
+	if len(msg.SRBsToBeSetupModList) > 0 {
+		v.size("SRBsToBeSetupModList", len(msg.SRBsToBeSetupModList), 1, maxnoofSRBs)
+	}
+	if len(msg.DRBsToBeSetupModList) > 0 {
+		v.size("DRBsToBeSetupModList", len(msg.DRBsToBeSetupModList), 1, maxnoofDRBs)
+		for i := range msg.DRBsToBeSetupModList {
+			v.item("DRBsToBeSetupModList", i, &msg.DRBsToBeSetupModList[i])
+		}
+	}
+	if len(msg.DRBsToBeModifiedList) > 0 {
+		v.size("DRBsToBeModifiedList", len(msg.DRBsToBeModifiedList), 1, maxnoofDRBs)
+		for i := range msg.DRBsToBeModifiedList {
+			v.item("DRBsToBeModifiedList", i, &msg.DRBsToBeModifiedList[i])
+		}
+	}
+	if len(msg.SRBsToBeReleasedList) > 0 {
+		v.size("SRBsToBeReleasedList", len(msg.SRBsToBeReleasedList), 1, maxnoofSRBs)
+	}
+	if len(msg.DRBsToBeReleasedList) > 0 {
+		v.size("DRBsToBeReleasedList", len(msg.DRBsToBeReleasedList), 1, maxnoofDRBs)
+	}
+	if msg.InactivityMonitoringRequest != nil {
+		v.enumerated("InactivityMonitoringRequest", msg.InactivityMonitoringRequest.Value, 1)
+	}
+	if msg.GNBDUUEAMBRUL != nil {
+		v.ie("GNBDUUEAMBRUL", msg.GNBDUUEAMBRUL)
+	}
+	if msg.ExecuteDuplication != nil {
+		v.enumerated("ExecuteDuplication", msg.ExecuteDuplication.Value, 1)
+	}
+	if msg.RRCDeliveryStatusRequest != nil {
+		v.enumerated("RRCDeliveryStatusRequest", msg.RRCDeliveryStatusRequest.Value, 1)
+	}
+	if msg.ServingCellMO != nil {
+		v.integer("ServingCellMO", int64(msg.ServingCellMO.Value), 1, 64)
+	}
+	if msg.AdditionalRRMPriorityIndex != nil {
+		v.size("AdditionalRRMPriorityIndex", int(msg.AdditionalRRMPriorityIndex.Value.NumBits), 32, 32)
+	}
+	if len(msg.BHChannelsToBeSetupModList) > 0 {
+		v.size("BHChannelsToBeSetupModList", len(msg.BHChannelsToBeSetupModList), 1, maxnoofBHRLCChannels)
+		for i := range msg.BHChannelsToBeSetupModList {
+			v.item("BHChannelsToBeSetupModList", i, &msg.BHChannelsToBeSetupModList[i])
+		}
+	}
+	if len(msg.BHChannelsToBeModifiedList) > 0 {
+		v.size("BHChannelsToBeModifiedList", len(msg.BHChannelsToBeModifiedList), 1, maxnoofBHRLCChannels)
+		for i := range msg.BHChannelsToBeModifiedList {
+			v.item("BHChannelsToBeModifiedList", i, &msg.BHChannelsToBeModifiedList[i])
+		}
+	}
+	if len(msg.BHChannelsToBeReleasedList) > 0 {
+		v.size("BHChannelsToBeReleasedList", len(msg.BHChannelsToBeReleasedList), 1, maxnoofBHRLCChannels)
+		for i := range msg.BHChannelsToBeReleasedList {
+			v.item("BHChannelsToBeReleasedList", i, &msg.BHChannelsToBeReleasedList[i])
+		}
+	}
+	if msg.NRV2XServicesAuthorized != nil {
+		v.ie("NRV2XServicesAuthorized", msg.NRV2XServicesAuthorized)
+	}
+	if msg.LTEV2XServicesAuthorized != nil {
+		v.ie("LTEV2XServicesAuthorized", msg.LTEV2XServicesAuthorized)
+	}
+	if msg.NRUESidelinkAggregateMaximumBitrate != nil {
+		v.ie("NRUESidelinkAggregateMaximumBitrate", msg.NRUESidelinkAggregateMaximumBitrate)
+	}
+	if msg.LTEUESidelinkAggregateMaximumBitrate != nil {
+		v.ie("LTEUESidelinkAggregateMaximumBitrate", msg.LTEUESidelinkAggregateMaximumBitrate)
+	}
+	if msg.PC5LinkAMBR != nil {
+		v.ie("PC5LinkAMBR", msg.PC5LinkAMBR)
+	}
+	if len(msg.SLDRBsToBeSetupModList) > 0 {
+		v.size("SLDRBsToBeSetupModList", len(msg.SLDRBsToBeSetupModList), 1, maxnoofSLDRBs)
+		for i := range msg.SLDRBsToBeSetupModList {
+			v.item("SLDRBsToBeSetupModList", i, &msg.SLDRBsToBeSetupModList[i])
+		}
+	}
+	if len(msg.SLDRBsToBeModifiedList) > 0 {
+		v.size("SLDRBsToBeModifiedList", len(msg.SLDRBsToBeModifiedList), 1, maxnoofSLDRBs)
+		for i := range msg.SLDRBsToBeModifiedList {
+			v.item("SLDRBsToBeModifiedList", i, &msg.SLDRBsToBeModifiedList[i])
+		}
+	}
+	if len(msg.SLDRBsToBeReleasedList) > 0 {
+		v.size("SLDRBsToBeReleasedList", len(msg.SLDRBsToBeReleasedList), 1, maxnoofSLDRBs)
+		for i := range msg.SLDRBsToBeReleasedList {
+			v.item("SLDRBsToBeReleasedList", i, &msg.SLDRBsToBeReleasedList[i])
+		}
+	}
+	if msg.ConditionalIntraDUMobilityInformation != nil {
+		v.ie("ConditionalIntraDUMobilityInformation", msg.ConditionalIntraDUMobilityInformation)
+	}
+	if len(msg.ManagementBasedMDTPLMNList) > 0 {
+		v.size("ManagementBasedMDTPLMNList", len(msg.ManagementBasedMDTPLMNList), 1, maxnoofMDTPLMNs)
+		for i := range msg.ManagementBasedMDTPLMNList {
+			v.size(itemName("ManagementBasedMDTPLMNList", i), len(msg.ManagementBasedMDTPLMNList[i].Value), 3, 3)
+		}
+	}
+	return v.messageErr("UEContextModificationRequest")
+}
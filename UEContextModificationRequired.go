@@ -0,0 +1,404 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type UEContextModificationRequired struct {
+	GNBCUUEF1APID                                  GNBCUUEF1APID                          `mandatory,reject`
+	GNBDUUEF1APID                                  GNBDUUEF1APID                          `mandatory,reject`
+	ResourceCoordinationTransferContainer          *ResourceCoordinationTransferContainer `optional,ignore`
+	DUtoCURRCInformation                           *DUtoCURRCInformation                  `optional,reject`
+	DRBsRequiredToBeModifiedList                   []DRBsRequiredToBeModifiedItem         `optional,reject`
+	SRBsRequiredToBeReleasedList                   []SRBsRequiredToBeReleasedItem         `optional,reject`
+	DRBsRequiredToBeReleasedList                   []DRBsRequiredToBeReleasedItem         `optional,reject`
+	Cause                                          Cause                                  `mandatory,ignore`
+	BHChannelsRequiredToBeReleasedList             []BHChannelsRequiredToBeReleasedItem   `optional,reject`
+	SLDRBsRequiredToBeModifiedList                 []SLDRBsRequiredToBeModifiedItem       `optional,reject`
+	SLDRBsRequiredToBeReleasedList                 []SLDRBsRequiredToBeReleasedItem       `optional,reject`
+	DRBsRequiredToBeModifiedListUnknownItems       []RawIE
+	SRBsRequiredToBeReleasedListUnknownItems       []RawIE
+	DRBsRequiredToBeReleasedListUnknownItems       []RawIE
+	BHChannelsRequiredToBeReleasedListUnknownItems []RawIE
+	SLDRBsRequiredToBeModifiedListUnknownItems     []RawIE
+	SLDRBsRequiredToBeReleasedListUnknownItems     []RawIE
+	UnknownIEs                                     []RawIE
+}
+
+func (msg *UEContextModificationRequired) ProcedureCode() int64 {
+	return ProcedureCode_UEContextModificationRequired
+}
+
+func (msg *UEContextModificationRequired) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *UEContextModificationRequired) Criticality() aper.Enumerated {
+	return Criticality_PresentReject
+}
+
+func (msg *UEContextModificationRequired) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("UEContextModificationRequired"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *UEContextModificationRequired) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("UEContextModificationRequired"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *UEContextModificationRequired) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	if msg.ResourceCoordinationTransferContainer != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ResourceCoordinationTransferContainer},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.ResourceCoordinationTransferContainer,
+		})
+	}
+	if msg.DUtoCURRCInformation != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DUtoCURRCInformation},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.DUtoCURRCInformation,
+		})
+	}
+	if len(msg.DRBsRequiredToBeModifiedList) > 0 || len(msg.DRBsRequiredToBeModifiedListUnknownItems) > 0 {
+		tmp_DRBsRequiredToBeModifiedList := SingleContainerList[*DRBsRequiredToBeModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsRequiredToBeModifiedItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.DRBsRequiredToBeModifiedListUnknownItems,
+		}
+		for k := range msg.DRBsRequiredToBeModifiedList {
+			tmp_DRBsRequiredToBeModifiedList.Value = append(tmp_DRBsRequiredToBeModifiedList.Value, &msg.DRBsRequiredToBeModifiedList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DRBsRequiredToBeModifiedList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_DRBsRequiredToBeModifiedList,
+		})
+	}
+	if len(msg.SRBsRequiredToBeReleasedList) > 0 || len(msg.SRBsRequiredToBeReleasedListUnknownItems) > 0 {
+		tmp_SRBsRequiredToBeReleasedList := SingleContainerList[*SRBsRequiredToBeReleasedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSRBs},
+			id:          ProtocolIEID_SRBsRequiredToBeReleasedItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.SRBsRequiredToBeReleasedListUnknownItems,
+		}
+		for k := range msg.SRBsRequiredToBeReleasedList {
+			tmp_SRBsRequiredToBeReleasedList.Value = append(tmp_SRBsRequiredToBeReleasedList.Value, &msg.SRBsRequiredToBeReleasedList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SRBsRequiredToBeReleasedList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_SRBsRequiredToBeReleasedList,
+		})
+	}
+	if len(msg.DRBsRequiredToBeReleasedList) > 0 || len(msg.DRBsRequiredToBeReleasedListUnknownItems) > 0 {
+		tmp_DRBsRequiredToBeReleasedList := SingleContainerList[*DRBsRequiredToBeReleasedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsRequiredToBeReleasedItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.DRBsRequiredToBeReleasedListUnknownItems,
+		}
+		for k := range msg.DRBsRequiredToBeReleasedList {
+			tmp_DRBsRequiredToBeReleasedList.Value = append(tmp_DRBsRequiredToBeReleasedList.Value, &msg.DRBsRequiredToBeReleasedList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DRBsRequiredToBeReleasedList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_DRBsRequiredToBeReleasedList,
+		})
+	}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_Cause},
+		Criticality: Criticality{Value: Criticality_PresentIgnore},
+		Value:       &msg.Cause,
+	})
+	if len(msg.BHChannelsRequiredToBeReleasedList) > 0 || len(msg.BHChannelsRequiredToBeReleasedListUnknownItems) > 0 {
+		tmp_BHChannelsRequiredToBeReleasedList := SingleContainerList[*BHChannelsRequiredToBeReleasedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsRequiredToBeReleasedItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.BHChannelsRequiredToBeReleasedListUnknownItems,
+		}
+		for k := range msg.BHChannelsRequiredToBeReleasedList {
+			tmp_BHChannelsRequiredToBeReleasedList.Value = append(tmp_BHChannelsRequiredToBeReleasedList.Value, &msg.BHChannelsRequiredToBeReleasedList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_BHChannelsRequiredToBeReleasedList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_BHChannelsRequiredToBeReleasedList,
+		})
+	}
+	if len(msg.SLDRBsRequiredToBeModifiedList) > 0 || len(msg.SLDRBsRequiredToBeModifiedListUnknownItems) > 0 {
+		tmp_SLDRBsRequiredToBeModifiedList := SingleContainerList[*SLDRBsRequiredToBeModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsRequiredToBeModifiedItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.SLDRBsRequiredToBeModifiedListUnknownItems,
+		}
+		for k := range msg.SLDRBsRequiredToBeModifiedList {
+			tmp_SLDRBsRequiredToBeModifiedList.Value = append(tmp_SLDRBsRequiredToBeModifiedList.Value, &msg.SLDRBsRequiredToBeModifiedList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SLDRBsRequiredToBeModifiedList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_SLDRBsRequiredToBeModifiedList,
+		})
+	}
+	if len(msg.SLDRBsRequiredToBeReleasedList) > 0 || len(msg.SLDRBsRequiredToBeReleasedListUnknownItems) > 0 {
+		tmp_SLDRBsRequiredToBeReleasedList := SingleContainerList[*SLDRBsRequiredToBeReleasedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsRequiredToBeReleasedItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.SLDRBsRequiredToBeReleasedListUnknownItems,
+		}
+		for k := range msg.SLDRBsRequiredToBeReleasedList {
+			tmp_SLDRBsRequiredToBeReleasedList.Value = append(tmp_SLDRBsRequiredToBeReleasedList.Value, &msg.SLDRBsRequiredToBeReleasedList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SLDRBsRequiredToBeReleasedList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_SLDRBsRequiredToBeReleasedList,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *UEContextModificationRequired) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *UEContextModificationRequired) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *UEContextModificationRequired) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("UEContextModificationRequired", wire, limits, ueContextModificationRequiredIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var ueContextModificationRequiredIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_ResourceCoordinationTransferContainer, Criticality_PresentIgnore, false},
+	{ProtocolIEID_DUtoCURRCInformation, Criticality_PresentReject, false},
+	{ProtocolIEID_DRBsRequiredToBeModifiedList, Criticality_PresentReject, false},
+	{ProtocolIEID_SRBsRequiredToBeReleasedList, Criticality_PresentReject, false},
+	{ProtocolIEID_DRBsRequiredToBeReleasedList, Criticality_PresentReject, false},
+	{ProtocolIEID_Cause, Criticality_PresentIgnore, true},
+	{ProtocolIEID_BHChannelsRequiredToBeReleasedList, Criticality_PresentReject, false},
+	{ProtocolIEID_SLDRBsRequiredToBeModifiedList, Criticality_PresentReject, false},
+	{ProtocolIEID_SLDRBsRequiredToBeReleasedList, Criticality_PresentReject, false},
+}
+
+func (msg *UEContextModificationRequired) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_ResourceCoordinationTransferContainer:
+		var tmp ResourceCoordinationTransferContainer
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("ResourceCoordinationTransferContainer", err)
+			return
+		}
+		msg.ResourceCoordinationTransferContainer = &tmp
+
+	case ProtocolIEID_DUtoCURRCInformation:
+		var tmp DUtoCURRCInformation
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("DUtoCURRCInformation", err)
+			return
+		}
+		msg.DUtoCURRCInformation = &tmp
+
+	case ProtocolIEID_DRBsRequiredToBeModifiedList:
+		tmp := SingleContainerList[*DRBsRequiredToBeModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsRequiredToBeModifiedItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *DRBsRequiredToBeModifiedItem { return new(DRBsRequiredToBeModifiedItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("DRBsRequiredToBeModifiedList", err)
+			return
+		}
+		msg.DRBsRequiredToBeModifiedList = []DRBsRequiredToBeModifiedItem{}
+		for _, i := range tmp.Value {
+			msg.DRBsRequiredToBeModifiedList = append(msg.DRBsRequiredToBeModifiedList, *i)
+		}
+		msg.DRBsRequiredToBeModifiedListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_SRBsRequiredToBeReleasedList:
+		tmp := SingleContainerList[*SRBsRequiredToBeReleasedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSRBs},
+			id:          ProtocolIEID_SRBsRequiredToBeReleasedItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *SRBsRequiredToBeReleasedItem { return new(SRBsRequiredToBeReleasedItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SRBsRequiredToBeReleasedList", err)
+			return
+		}
+		msg.SRBsRequiredToBeReleasedList = []SRBsRequiredToBeReleasedItem{}
+		for _, i := range tmp.Value {
+			msg.SRBsRequiredToBeReleasedList = append(msg.SRBsRequiredToBeReleasedList, *i)
+		}
+		msg.SRBsRequiredToBeReleasedListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_DRBsRequiredToBeReleasedList:
+		tmp := SingleContainerList[*DRBsRequiredToBeReleasedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsRequiredToBeReleasedItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *DRBsRequiredToBeReleasedItem { return new(DRBsRequiredToBeReleasedItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("DRBsRequiredToBeReleasedList", err)
+			return
+		}
+		msg.DRBsRequiredToBeReleasedList = []DRBsRequiredToBeReleasedItem{}
+		for _, i := range tmp.Value {
+			msg.DRBsRequiredToBeReleasedList = append(msg.DRBsRequiredToBeReleasedList, *i)
+		}
+		msg.DRBsRequiredToBeReleasedListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_Cause:
+		var tmp Cause
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("Cause", err)
+			return
+		}
+		msg.Cause = tmp
+
+	case ProtocolIEID_BHChannelsRequiredToBeReleasedList:
+		tmp := SingleContainerList[*BHChannelsRequiredToBeReleasedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsRequiredToBeReleasedItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *BHChannelsRequiredToBeReleasedItem { return new(BHChannelsRequiredToBeReleasedItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("BHChannelsRequiredToBeReleasedList", err)
+			return
+		}
+		msg.BHChannelsRequiredToBeReleasedList = []BHChannelsRequiredToBeReleasedItem{}
+		for _, i := range tmp.Value {
+			msg.BHChannelsRequiredToBeReleasedList = append(msg.BHChannelsRequiredToBeReleasedList, *i)
+		}
+		msg.BHChannelsRequiredToBeReleasedListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_SLDRBsRequiredToBeModifiedList:
+		tmp := SingleContainerList[*SLDRBsRequiredToBeModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsRequiredToBeModifiedItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *SLDRBsRequiredToBeModifiedItem { return new(SLDRBsRequiredToBeModifiedItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SLDRBsRequiredToBeModifiedList", err)
+			return
+		}
+		msg.SLDRBsRequiredToBeModifiedList = []SLDRBsRequiredToBeModifiedItem{}
+		for _, i := range tmp.Value {
+			msg.SLDRBsRequiredToBeModifiedList = append(msg.SLDRBsRequiredToBeModifiedList, *i)
+		}
+		msg.SLDRBsRequiredToBeModifiedListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_SLDRBsRequiredToBeReleasedList:
+		tmp := SingleContainerList[*SLDRBsRequiredToBeReleasedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsRequiredToBeReleasedItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *SLDRBsRequiredToBeReleasedItem { return new(SLDRBsRequiredToBeReleasedItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SLDRBsRequiredToBeReleasedList", err)
+			return
+		}
+		msg.SLDRBsRequiredToBeReleasedList = []SLDRBsRequiredToBeReleasedItem{}
+		for _, i := range tmp.Value {
+			msg.SLDRBsRequiredToBeReleasedList = append(msg.SLDRBsRequiredToBeReleasedList, *i)
+		}
+		msg.SLDRBsRequiredToBeReleasedListUnknownItems = tmp.Unknown
+
+	}
+	return
+}
+
+func (msg *UEContextModificationRequired) Validate() error {
+	var v validator
+	v.integer("GNBCUUEF1APID", int64(msg.GNBCUUEF1APID.Value), 0, 4294967295)
+	v.integer("GNBDUUEF1APID", int64(msg.GNBDUUEF1APID.Value), 0, 4294967295)
+	if len(msg.DRBsRequiredToBeModifiedList) > 0 {
+		v.size("DRBsRequiredToBeModifiedList", len(msg.DRBsRequiredToBeModifiedList), 1, maxnoofDRBs)
+		for i := range msg.DRBsRequiredToBeModifiedList {
+			v.item("DRBsRequiredToBeModifiedList", i, &msg.DRBsRequiredToBeModifiedList[i])
+		}
+	}
+	if len(msg.SRBsRequiredToBeReleasedList) > 0 {
+		v.size("SRBsRequiredToBeReleasedList", len(msg.SRBsRequiredToBeReleasedList), 1, maxnoofSRBs)
+	}
+	if len(msg.DRBsRequiredToBeReleasedList) > 0 {
+		v.size("DRBsRequiredToBeReleasedList", len(msg.DRBsRequiredToBeReleasedList), 1, maxnoofDRBs)
+	}
+	if len(msg.BHChannelsRequiredToBeReleasedList) > 0 {
+		v.size("BHChannelsRequiredToBeReleasedList", len(msg.BHChannelsRequiredToBeReleasedList), 1, maxnoofBHRLCChannels)
+		for i := range msg.BHChannelsRequiredToBeReleasedList {
+			v.item("BHChannelsRequiredToBeReleasedList", i, &msg.BHChannelsRequiredToBeReleasedList[i])
+		}
+	}
+	if len(msg.SLDRBsRequiredToBeModifiedList) > 0 {
+		v.size("SLDRBsRequiredToBeModifiedList", len(msg.SLDRBsRequiredToBeModifiedList), 1, maxnoofSLDRBs)
+		for i := range msg.SLDRBsRequiredToBeModifiedList {
+			v.item("SLDRBsRequiredToBeModifiedList", i, &msg.SLDRBsRequiredToBeModifiedList[i])
+		}
+	}
+	if len(msg.SLDRBsRequiredToBeReleasedList) > 0 {
+		v.size("SLDRBsRequiredToBeReleasedList", len(msg.SLDRBsRequiredToBeReleasedList), 1, maxnoofSLDRBs)
+		for i := range msg.SLDRBsRequiredToBeReleasedList {
+			v.item("SLDRBsRequiredToBeReleasedList", i, &msg.SLDRBsRequiredToBeReleasedList[i])
+		}
+	}
+	return v.messageErr("UEContextModificationRequired")
+}
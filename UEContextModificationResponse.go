@@ -0,0 +1,918 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type UEContextModificationResponse struct {
+	GNBCUUEF1APID                                GNBCUUEF1APID                          `mandatory,reject`
+	GNBDUUEF1APID                                GNBDUUEF1APID                          `mandatory,reject`
+	ResourceCoordinationTransferContainer        *ResourceCoordinationTransferContainer `optional,ignore`
+	DUtoCURRCInformation                         *DUtoCURRCInformation                  `optional,reject`
+	DRBsSetupModList                             []DRBsSetupModItem                     `optional,ignore`
+	DRBsModifiedList                             []DRBsModifiedItem                     `optional,ignore`
+	SRBsFailedToBeSetupModList                   []SRBsFailedToBeSetupModItem           `optional,ignore`
+	DRBsFailedToBeSetupModList                   []DRBsFailedToBeSetupModItem           `optional,ignore`
+	SCellFailedToSetupModList                    []SCellFailedtoSetupModItem            `optional,ignore`
+	DRBsFailedToBeModifiedList                   []DRBsFailedToBeModifiedItem           `optional,ignore`
+	InactivityMonitoringResponse                 *InactivityMonitoringResponse          `optional,reject`
+	CriticalityDiagnostics                       *CriticalityDiagnostics                `optional,ignore`
+	CRNTI                                        *CRNTI                                 `optional,ignore`
+	AssociatedSCellList                          []AssociatedSCellItem                  `optional,ignore`
+	SRBsSetupModList                             []SRBsSetupModItem                     `optional,ignore`
+	SRBsModifiedList                             []SRBsModifiedItem                     `optional,ignore`
+	FullConfiguration                            *FullConfiguration                     `optional,reject`
+	BHChannelsSetupModList                       []BHChannelsSetupModItem               `optional,ignore`
+	BHChannelsModifiedList                       []BHChannelsModifiedItem               `optional,ignore`
+	BHChannelsFailedToBeSetupModList             []BHChannelsFailedToBeSetupModItem     `optional,ignore`
+	BHChannelsFailedToBeModifiedList             []BHChannelsFailedToBeModifiedItem     `optional,ignore`
+	SLDRBsSetupModList                           []SLDRBsSetupModItem                   `optional,ignore`
+	SLDRBsModifiedList                           []SLDRBsModifiedItem                   `optional,ignore`
+	SLDRBsFailedToBeSetupModList                 []SLDRBsFailedToBeSetupModItem         `optional,ignore`
+	SLDRBsFailedToBeModifiedList                 []SLDRBsFailedToBeModifiedItem         `optional,ignore`
+	RequestedTargetCellGlobalID                  *NRCGI                                 `optional,reject`
+	DRBsSetupModListUnknownItems                 []RawIE
+	DRBsModifiedListUnknownItems                 []RawIE
+	SRBsFailedToBeSetupModListUnknownItems       []RawIE
+	DRBsFailedToBeSetupModListUnknownItems       []RawIE
+	SCellFailedToSetupModListUnknownItems        []RawIE
+	DRBsFailedToBeModifiedListUnknownItems       []RawIE
+	AssociatedSCellListUnknownItems              []RawIE
+	SRBsSetupModListUnknownItems                 []RawIE
+	SRBsModifiedListUnknownItems                 []RawIE
+	BHChannelsSetupModListUnknownItems           []RawIE
+	BHChannelsModifiedListUnknownItems           []RawIE
+	BHChannelsFailedToBeSetupModListUnknownItems []RawIE
+	BHChannelsFailedToBeModifiedListUnknownItems []RawIE
+	SLDRBsSetupModListUnknownItems               []RawIE
+	SLDRBsModifiedListUnknownItems               []RawIE
+	SLDRBsFailedToBeSetupModListUnknownItems     []RawIE
+	SLDRBsFailedToBeModifiedListUnknownItems     []RawIE
+	UnknownIEs                                   []RawIE
+}
+
+func (msg *UEContextModificationResponse) ProcedureCode() int64 {
+	return ProcedureCode_UEContextModification
+}
+
+func (msg *UEContextModificationResponse) Present() uint8 {
+	return F1apPduSuccessfulOutcome
+}
+
+func (msg *UEContextModificationResponse) Criticality() aper.Enumerated {
+	return Criticality_PresentReject
+}
+
+func (msg *UEContextModificationResponse) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("UEContextModificationResponse"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *UEContextModificationResponse) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("UEContextModificationResponse"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *UEContextModificationResponse) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	if msg.ResourceCoordinationTransferContainer != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ResourceCoordinationTransferContainer},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.ResourceCoordinationTransferContainer,
+		})
+	}
+	if msg.DUtoCURRCInformation != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DUtoCURRCInformation},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.DUtoCURRCInformation,
+		})
+	}
+	if len(msg.DRBsSetupModList) > 0 || len(msg.DRBsSetupModListUnknownItems) > 0 {
+		tmp_DRBsSetupModList := SingleContainerList[*DRBsSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsSetupModItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.DRBsSetupModListUnknownItems,
+		}
+		for k := range msg.DRBsSetupModList {
+			tmp_DRBsSetupModList.Value = append(tmp_DRBsSetupModList.Value, &msg.DRBsSetupModList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DRBsSetupModList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_DRBsSetupModList,
+		})
+	}
+	if len(msg.DRBsModifiedList) > 0 || len(msg.DRBsModifiedListUnknownItems) > 0 {
+		tmp_DRBsModifiedList := SingleContainerList[*DRBsModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsModifiedItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.DRBsModifiedListUnknownItems,
+		}
+		for k := range msg.DRBsModifiedList {
+			tmp_DRBsModifiedList.Value = append(tmp_DRBsModifiedList.Value, &msg.DRBsModifiedList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DRBsModifiedList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_DRBsModifiedList,
+		})
+	}
+	if len(msg.SRBsFailedToBeSetupModList) > 0 || len(msg.SRBsFailedToBeSetupModListUnknownItems) > 0 {
+		tmp_SRBsFailedToBeSetupModList := SingleContainerList[*SRBsFailedToBeSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSRBs},
+			id:          ProtocolIEID_SRBsFailedToBeSetupModItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.SRBsFailedToBeSetupModListUnknownItems,
+		}
+		for k := range msg.SRBsFailedToBeSetupModList {
+			tmp_SRBsFailedToBeSetupModList.Value = append(tmp_SRBsFailedToBeSetupModList.Value, &msg.SRBsFailedToBeSetupModList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SRBsFailedToBeSetupModList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_SRBsFailedToBeSetupModList,
+		})
+	}
+	if len(msg.DRBsFailedToBeSetupModList) > 0 || len(msg.DRBsFailedToBeSetupModListUnknownItems) > 0 {
+		tmp_DRBsFailedToBeSetupModList := SingleContainerList[*DRBsFailedToBeSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsFailedToBeSetupModItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.DRBsFailedToBeSetupModListUnknownItems,
+		}
+		for k := range msg.DRBsFailedToBeSetupModList {
+			tmp_DRBsFailedToBeSetupModList.Value = append(tmp_DRBsFailedToBeSetupModList.Value, &msg.DRBsFailedToBeSetupModList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DRBsFailedToBeSetupModList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_DRBsFailedToBeSetupModList,
+		})
+	}
+	if len(msg.SCellFailedToSetupModList) > 0 || len(msg.SCellFailedToSetupModListUnknownItems) > 0 {
+		tmp_SCellFailedToSetupModList := SingleContainerList[*SCellFailedtoSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSCells},
+			id:          ProtocolIEID_SCellFailedToSetupModItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.SCellFailedToSetupModListUnknownItems,
+		}
+		for k := range msg.SCellFailedToSetupModList {
+			tmp_SCellFailedToSetupModList.Value = append(tmp_SCellFailedToSetupModList.Value, &msg.SCellFailedToSetupModList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SCellFailedToSetupModList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_SCellFailedToSetupModList,
+		})
+	}
+	if len(msg.DRBsFailedToBeModifiedList) > 0 || len(msg.DRBsFailedToBeModifiedListUnknownItems) > 0 {
+		tmp_DRBsFailedToBeModifiedList := SingleContainerList[*DRBsFailedToBeModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsFailedToBeModifiedItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.DRBsFailedToBeModifiedListUnknownItems,
+		}
+		for k := range msg.DRBsFailedToBeModifiedList {
+			tmp_DRBsFailedToBeModifiedList.Value = append(tmp_DRBsFailedToBeModifiedList.Value, &msg.DRBsFailedToBeModifiedList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DRBsFailedToBeModifiedList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_DRBsFailedToBeModifiedList,
+		})
+	}
+	if msg.InactivityMonitoringResponse != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_InactivityMonitoringResponse},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.InactivityMonitoringResponse,
+		})
+	}
+	if msg.CriticalityDiagnostics != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CriticalityDiagnostics},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.CriticalityDiagnostics,
+		})
+	}
+	if msg.CRNTI != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CRNTI},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.CRNTI,
+		})
+	}
+	if len(msg.AssociatedSCellList) > 0 || len(msg.AssociatedSCellListUnknownItems) > 0 {
+		tmp_AssociatedSCellList := SingleContainerList[*AssociatedSCellItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSCells},
+			id:          ProtocolIEID_AssociatedSCellItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.AssociatedSCellListUnknownItems,
+		}
+		for k := range msg.AssociatedSCellList {
+			tmp_AssociatedSCellList.Value = append(tmp_AssociatedSCellList.Value, &msg.AssociatedSCellList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_AssociatedSCellList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_AssociatedSCellList,
+		})
+	}
+	if len(msg.SRBsSetupModList) > 0 || len(msg.SRBsSetupModListUnknownItems) > 0 {
+		tmp_SRBsSetupModList := SingleContainerList[*SRBsSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSRBs},
+			id:          ProtocolIEID_SRBsSetupModItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.SRBsSetupModListUnknownItems,
+		}
+		for k := range msg.SRBsSetupModList {
+			tmp_SRBsSetupModList.Value = append(tmp_SRBsSetupModList.Value, &msg.SRBsSetupModList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SRBsSetupModList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_SRBsSetupModList,
+		})
+	}
+	if len(msg.SRBsModifiedList) > 0 || len(msg.SRBsModifiedListUnknownItems) > 0 {
+		tmp_SRBsModifiedList := SingleContainerList[*SRBsModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSRBs},
+			id:          ProtocolIEID_SRBsModifiedItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.SRBsModifiedListUnknownItems,
+		}
+		for k := range msg.SRBsModifiedList {
+			tmp_SRBsModifiedList.Value = append(tmp_SRBsModifiedList.Value, &msg.SRBsModifiedList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SRBsModifiedList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_SRBsModifiedList,
+		})
+	}
+	if msg.FullConfiguration != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_FullConfiguration},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.FullConfiguration,
+		})
+	}
+	if len(msg.BHChannelsSetupModList) > 0 || len(msg.BHChannelsSetupModListUnknownItems) > 0 {
+		tmp_BHChannelsSetupModList := SingleContainerList[*BHChannelsSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsSetupModItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.BHChannelsSetupModListUnknownItems,
+		}
+		for k := range msg.BHChannelsSetupModList {
+			tmp_BHChannelsSetupModList.Value = append(tmp_BHChannelsSetupModList.Value, &msg.BHChannelsSetupModList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_BHChannelsSetupModList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_BHChannelsSetupModList,
+		})
+	}
+	if len(msg.BHChannelsModifiedList) > 0 || len(msg.BHChannelsModifiedListUnknownItems) > 0 {
+		tmp_BHChannelsModifiedList := SingleContainerList[*BHChannelsModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsModifiedItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.BHChannelsModifiedListUnknownItems,
+		}
+		for k := range msg.BHChannelsModifiedList {
+			tmp_BHChannelsModifiedList.Value = append(tmp_BHChannelsModifiedList.Value, &msg.BHChannelsModifiedList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_BHChannelsModifiedList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_BHChannelsModifiedList,
+		})
+	}
+	if len(msg.BHChannelsFailedToBeSetupModList) > 0 || len(msg.BHChannelsFailedToBeSetupModListUnknownItems) > 0 {
+		tmp_BHChannelsFailedToBeSetupModList := SingleContainerList[*BHChannelsFailedToBeSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsFailedToBeSetupModItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.BHChannelsFailedToBeSetupModListUnknownItems,
+		}
+		for k := range msg.BHChannelsFailedToBeSetupModList {
+			tmp_BHChannelsFailedToBeSetupModList.Value = append(tmp_BHChannelsFailedToBeSetupModList.Value, &msg.BHChannelsFailedToBeSetupModList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_BHChannelsFailedToBeSetupModList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_BHChannelsFailedToBeSetupModList,
+		})
+	}
+	if len(msg.BHChannelsFailedToBeModifiedList) > 0 || len(msg.BHChannelsFailedToBeModifiedListUnknownItems) > 0 {
+		tmp_BHChannelsFailedToBeModifiedList := SingleContainerList[*BHChannelsFailedToBeModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsFailedToBeModifiedItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.BHChannelsFailedToBeModifiedListUnknownItems,
+		}
+		for k := range msg.BHChannelsFailedToBeModifiedList {
+			tmp_BHChannelsFailedToBeModifiedList.Value = append(tmp_BHChannelsFailedToBeModifiedList.Value, &msg.BHChannelsFailedToBeModifiedList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_BHChannelsFailedToBeModifiedList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_BHChannelsFailedToBeModifiedList,
+		})
+	}
+	if len(msg.SLDRBsSetupModList) > 0 || len(msg.SLDRBsSetupModListUnknownItems) > 0 {
+		tmp_SLDRBsSetupModList := SingleContainerList[*SLDRBsSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsSetupModItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.SLDRBsSetupModListUnknownItems,
+		}
+		for k := range msg.SLDRBsSetupModList {
+			tmp_SLDRBsSetupModList.Value = append(tmp_SLDRBsSetupModList.Value, &msg.SLDRBsSetupModList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SLDRBsSetupModList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_SLDRBsSetupModList,
+		})
+	}
+	if len(msg.SLDRBsModifiedList) > 0 || len(msg.SLDRBsModifiedListUnknownItems) > 0 {
+		tmp_SLDRBsModifiedList := SingleContainerList[*SLDRBsModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsModifiedItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.SLDRBsModifiedListUnknownItems,
+		}
+		for k := range msg.SLDRBsModifiedList {
+			tmp_SLDRBsModifiedList.Value = append(tmp_SLDRBsModifiedList.Value, &msg.SLDRBsModifiedList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SLDRBsModifiedList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_SLDRBsModifiedList,
+		})
+	}
+	if len(msg.SLDRBsFailedToBeSetupModList) > 0 || len(msg.SLDRBsFailedToBeSetupModListUnknownItems) > 0 {
+		tmp_SLDRBsFailedToBeSetupModList := SingleContainerList[*SLDRBsFailedToBeSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsFailedToBeSetupModItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.SLDRBsFailedToBeSetupModListUnknownItems,
+		}
+		for k := range msg.SLDRBsFailedToBeSetupModList {
+			tmp_SLDRBsFailedToBeSetupModList.Value = append(tmp_SLDRBsFailedToBeSetupModList.Value, &msg.SLDRBsFailedToBeSetupModList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SLDRBsFailedToBeSetupModList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_SLDRBsFailedToBeSetupModList,
+		})
+	}
+	if len(msg.SLDRBsFailedToBeModifiedList) > 0 || len(msg.SLDRBsFailedToBeModifiedListUnknownItems) > 0 {
+		tmp_SLDRBsFailedToBeModifiedList := SingleContainerList[*SLDRBsFailedToBeModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsFailedToBeModifiedItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.SLDRBsFailedToBeModifiedListUnknownItems,
+		}
+		for k := range msg.SLDRBsFailedToBeModifiedList {
+			tmp_SLDRBsFailedToBeModifiedList.Value = append(tmp_SLDRBsFailedToBeModifiedList.Value, &msg.SLDRBsFailedToBeModifiedList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SLDRBsFailedToBeModifiedList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_SLDRBsFailedToBeModifiedList,
+		})
+	}
+	if msg.RequestedTargetCellGlobalID != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RequestedTargetCellGlobalID},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.RequestedTargetCellGlobalID,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *UEContextModificationResponse) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *UEContextModificationResponse) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *UEContextModificationResponse) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("UEContextModificationResponse", wire, limits, ueContextModificationResponseIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var ueContextModificationResponseIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_ResourceCoordinationTransferContainer, Criticality_PresentIgnore, false},
+	{ProtocolIEID_DUtoCURRCInformation, Criticality_PresentReject, false},
+	{ProtocolIEID_DRBsSetupModList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_DRBsModifiedList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SRBsFailedToBeSetupModList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_DRBsFailedToBeSetupModList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SCellFailedToSetupModList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_DRBsFailedToBeModifiedList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_InactivityMonitoringResponse, Criticality_PresentReject, false},
+	{ProtocolIEID_CriticalityDiagnostics, Criticality_PresentIgnore, false},
+	{ProtocolIEID_CRNTI, Criticality_PresentIgnore, false},
+	{ProtocolIEID_AssociatedSCellList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SRBsSetupModList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SRBsModifiedList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_FullConfiguration, Criticality_PresentReject, false},
+	{ProtocolIEID_BHChannelsSetupModList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_BHChannelsModifiedList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_BHChannelsFailedToBeSetupModList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_BHChannelsFailedToBeModifiedList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SLDRBsSetupModList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SLDRBsModifiedList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SLDRBsFailedToBeSetupModList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SLDRBsFailedToBeModifiedList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_RequestedTargetCellGlobalID, Criticality_PresentReject, false},
+}
+
+func (msg *UEContextModificationResponse) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_ResourceCoordinationTransferContainer:
+		var tmp ResourceCoordinationTransferContainer
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("ResourceCoordinationTransferContainer", err)
+			return
+		}
+		msg.ResourceCoordinationTransferContainer = &tmp
+
+	case ProtocolIEID_DUtoCURRCInformation:
+		var tmp DUtoCURRCInformation
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("DUtoCURRCInformation", err)
+			return
+		}
+		msg.DUtoCURRCInformation = &tmp
+
+	case ProtocolIEID_DRBsSetupModList:
+		tmp := SingleContainerList[*DRBsSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsSetupModItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *DRBsSetupModItem { return new(DRBsSetupModItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("DRBsSetupModList", err)
+			return
+		}
+		msg.DRBsSetupModList = []DRBsSetupModItem{}
+		for _, i := range tmp.Value {
+			msg.DRBsSetupModList = append(msg.DRBsSetupModList, *i)
+		}
+		msg.DRBsSetupModListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_DRBsModifiedList:
+		tmp := SingleContainerList[*DRBsModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsModifiedItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *DRBsModifiedItem { return new(DRBsModifiedItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("DRBsModifiedList", err)
+			return
+		}
+		msg.DRBsModifiedList = []DRBsModifiedItem{}
+		for _, i := range tmp.Value {
+			msg.DRBsModifiedList = append(msg.DRBsModifiedList, *i)
+		}
+		msg.DRBsModifiedListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_SRBsFailedToBeSetupModList:
+		tmp := SingleContainerList[*SRBsFailedToBeSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSRBs},
+			id:          ProtocolIEID_SRBsFailedToBeSetupModItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *SRBsFailedToBeSetupModItem { return new(SRBsFailedToBeSetupModItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SRBsFailedToBeSetupModList", err)
+			return
+		}
+		msg.SRBsFailedToBeSetupModList = []SRBsFailedToBeSetupModItem{}
+		for _, i := range tmp.Value {
+			msg.SRBsFailedToBeSetupModList = append(msg.SRBsFailedToBeSetupModList, *i)
+		}
+		msg.SRBsFailedToBeSetupModListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_DRBsFailedToBeSetupModList:
+		tmp := SingleContainerList[*DRBsFailedToBeSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsFailedToBeSetupModItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *DRBsFailedToBeSetupModItem { return new(DRBsFailedToBeSetupModItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("DRBsFailedToBeSetupModList", err)
+			return
+		}
+		msg.DRBsFailedToBeSetupModList = []DRBsFailedToBeSetupModItem{}
+		for _, i := range tmp.Value {
+			msg.DRBsFailedToBeSetupModList = append(msg.DRBsFailedToBeSetupModList, *i)
+		}
+		msg.DRBsFailedToBeSetupModListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_SCellFailedToSetupModList:
+		tmp := SingleContainerList[*SCellFailedtoSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSCells},
+			id:          ProtocolIEID_SCellFailedToSetupModItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *SCellFailedtoSetupModItem { return new(SCellFailedtoSetupModItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SCellFailedToSetupModList", err)
+			return
+		}
+		msg.SCellFailedToSetupModList = []SCellFailedtoSetupModItem{}
+		for _, i := range tmp.Value {
+			msg.SCellFailedToSetupModList = append(msg.SCellFailedToSetupModList, *i)
+		}
+		msg.SCellFailedToSetupModListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_DRBsFailedToBeModifiedList:
+		tmp := SingleContainerList[*DRBsFailedToBeModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsFailedToBeModifiedItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *DRBsFailedToBeModifiedItem { return new(DRBsFailedToBeModifiedItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("DRBsFailedToBeModifiedList", err)
+			return
+		}
+		msg.DRBsFailedToBeModifiedList = []DRBsFailedToBeModifiedItem{}
+		for _, i := range tmp.Value {
+			msg.DRBsFailedToBeModifiedList = append(msg.DRBsFailedToBeModifiedList, *i)
+		}
+		msg.DRBsFailedToBeModifiedListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_InactivityMonitoringResponse:
+		var tmp InactivityMonitoringResponse
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("InactivityMonitoringResponse", err)
+			return
+		}
+		msg.InactivityMonitoringResponse = &tmp
+
+	case ProtocolIEID_CriticalityDiagnostics:
+		var tmp CriticalityDiagnostics
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("CriticalityDiagnostics", err)
+			return
+		}
+		msg.CriticalityDiagnostics = &tmp
+
+	case ProtocolIEID_CRNTI:
+		var tmp CRNTI
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("CRNTI", err)
+			return
+		}
+		msg.CRNTI = &tmp
+
+	case ProtocolIEID_AssociatedSCellList:
+		tmp := SingleContainerList[*AssociatedSCellItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSCells},
+			id:          ProtocolIEID_AssociatedSCellItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *AssociatedSCellItem { return new(AssociatedSCellItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("AssociatedSCellList", err)
+			return
+		}
+		msg.AssociatedSCellList = []AssociatedSCellItem{}
+		for _, i := range tmp.Value {
+			msg.AssociatedSCellList = append(msg.AssociatedSCellList, *i)
+		}
+		msg.AssociatedSCellListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_SRBsSetupModList:
+		tmp := SingleContainerList[*SRBsSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSRBs},
+			id:          ProtocolIEID_SRBsSetupModItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *SRBsSetupModItem { return new(SRBsSetupModItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SRBsSetupModList", err)
+			return
+		}
+		msg.SRBsSetupModList = []SRBsSetupModItem{}
+		for _, i := range tmp.Value {
+			msg.SRBsSetupModList = append(msg.SRBsSetupModList, *i)
+		}
+		msg.SRBsSetupModListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_SRBsModifiedList:
+		tmp := SingleContainerList[*SRBsModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSRBs},
+			id:          ProtocolIEID_SRBsModifiedItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *SRBsModifiedItem { return new(SRBsModifiedItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SRBsModifiedList", err)
+			return
+		}
+		msg.SRBsModifiedList = []SRBsModifiedItem{}
+		for _, i := range tmp.Value {
+			msg.SRBsModifiedList = append(msg.SRBsModifiedList, *i)
+		}
+		msg.SRBsModifiedListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_FullConfiguration:
+		var tmp FullConfiguration
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("FullConfiguration", err)
+			return
+		}
+		msg.FullConfiguration = &tmp
+
+	case ProtocolIEID_BHChannelsSetupModList:
+		tmp := SingleContainerList[*BHChannelsSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsSetupModItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *BHChannelsSetupModItem { return new(BHChannelsSetupModItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("BHChannelsSetupModList", err)
+			return
+		}
+		msg.BHChannelsSetupModList = []BHChannelsSetupModItem{}
+		for _, i := range tmp.Value {
+			msg.BHChannelsSetupModList = append(msg.BHChannelsSetupModList, *i)
+		}
+		msg.BHChannelsSetupModListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_BHChannelsModifiedList:
+		tmp := SingleContainerList[*BHChannelsModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsModifiedItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *BHChannelsModifiedItem { return new(BHChannelsModifiedItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("BHChannelsModifiedList", err)
+			return
+		}
+		msg.BHChannelsModifiedList = []BHChannelsModifiedItem{}
+		for _, i := range tmp.Value {
+			msg.BHChannelsModifiedList = append(msg.BHChannelsModifiedList, *i)
+		}
+		msg.BHChannelsModifiedListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_BHChannelsFailedToBeSetupModList:
+		tmp := SingleContainerList[*BHChannelsFailedToBeSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsFailedToBeSetupModItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *BHChannelsFailedToBeSetupModItem { return new(BHChannelsFailedToBeSetupModItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("BHChannelsFailedToBeSetupModList", err)
+			return
+		}
+		msg.BHChannelsFailedToBeSetupModList = []BHChannelsFailedToBeSetupModItem{}
+		for _, i := range tmp.Value {
+			msg.BHChannelsFailedToBeSetupModList = append(msg.BHChannelsFailedToBeSetupModList, *i)
+		}
+		msg.BHChannelsFailedToBeSetupModListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_BHChannelsFailedToBeModifiedList:
+		tmp := SingleContainerList[*BHChannelsFailedToBeModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsFailedToBeModifiedItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *BHChannelsFailedToBeModifiedItem { return new(BHChannelsFailedToBeModifiedItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("BHChannelsFailedToBeModifiedList", err)
+			return
+		}
+		msg.BHChannelsFailedToBeModifiedList = []BHChannelsFailedToBeModifiedItem{}
+		for _, i := range tmp.Value {
+			msg.BHChannelsFailedToBeModifiedList = append(msg.BHChannelsFailedToBeModifiedList, *i)
+		}
+		msg.BHChannelsFailedToBeModifiedListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_SLDRBsSetupModList:
+		tmp := SingleContainerList[*SLDRBsSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsSetupModItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *SLDRBsSetupModItem { return new(SLDRBsSetupModItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SLDRBsSetupModList", err)
+			return
+		}
+		msg.SLDRBsSetupModList = []SLDRBsSetupModItem{}
+		for _, i := range tmp.Value {
+			msg.SLDRBsSetupModList = append(msg.SLDRBsSetupModList, *i)
+		}
+		msg.SLDRBsSetupModListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_SLDRBsModifiedList:
+		tmp := SingleContainerList[*SLDRBsModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsModifiedItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *SLDRBsModifiedItem { return new(SLDRBsModifiedItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SLDRBsModifiedList", err)
+			return
+		}
+		msg.SLDRBsModifiedList = []SLDRBsModifiedItem{}
+		for _, i := range tmp.Value {
+			msg.SLDRBsModifiedList = append(msg.SLDRBsModifiedList, *i)
+		}
+		msg.SLDRBsModifiedListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_SLDRBsFailedToBeSetupModList:
+		tmp := SingleContainerList[*SLDRBsFailedToBeSetupModItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsFailedToBeSetupModItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *SLDRBsFailedToBeSetupModItem { return new(SLDRBsFailedToBeSetupModItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SLDRBsFailedToBeSetupModList", err)
+			return
+		}
+		msg.SLDRBsFailedToBeSetupModList = []SLDRBsFailedToBeSetupModItem{}
+		for _, i := range tmp.Value {
+			msg.SLDRBsFailedToBeSetupModList = append(msg.SLDRBsFailedToBeSetupModList, *i)
+		}
+		msg.SLDRBsFailedToBeSetupModListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_SLDRBsFailedToBeModifiedList:
+		tmp := SingleContainerList[*SLDRBsFailedToBeModifiedItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsFailedToBeModifiedItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *SLDRBsFailedToBeModifiedItem { return new(SLDRBsFailedToBeModifiedItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SLDRBsFailedToBeModifiedList", err)
+			return
+		}
+		msg.SLDRBsFailedToBeModifiedList = []SLDRBsFailedToBeModifiedItem{}
+		for _, i := range tmp.Value {
+			msg.SLDRBsFailedToBeModifiedList = append(msg.SLDRBsFailedToBeModifiedList, *i)
+		}
+		msg.SLDRBsFailedToBeModifiedListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_RequestedTargetCellGlobalID:
+		var tmp NRCGI
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RequestedTargetCellGlobalID", err)
+			return
+		}
+		msg.RequestedTargetCellGlobalID = &tmp
+
+	}
+	return
+}
+
+func (msg *UEContextModificationResponse) Validate() error {
+	var v validator
+	v.integer("GNBCUUEF1APID", int64(msg.GNBCUUEF1APID.Value), 0, 4294967295)
+	v.integer("GNBDUUEF1APID", int64(msg.GNBDUUEF1APID.Value), 0, 4294967295)
+	if len(msg.DRBsSetupModList) > 0 {
+		v.size("DRBsSetupModList", len(msg.DRBsSetupModList), 1, maxnoofDRBs)
+	}
+	if len(msg.DRBsModifiedList) > 0 {
+		v.size("DRBsModifiedList", len(msg.DRBsModifiedList), 1, maxnoofDRBs)
+	}
+	if len(msg.SRBsFailedToBeSetupModList) > 0 {
+		v.size("SRBsFailedToBeSetupModList", len(msg.SRBsFailedToBeSetupModList), 1, maxnoofSRBs)
+	}
+	if len(msg.DRBsFailedToBeSetupModList) > 0 {
+		v.size("DRBsFailedToBeSetupModList", len(msg.DRBsFailedToBeSetupModList), 1, maxnoofDRBs)
+	}
+	if len(msg.SCellFailedToSetupModList) > 0 {
+		v.size("SCellFailedToSetupModList", len(msg.SCellFailedToSetupModList), 1, maxnoofSCells)
+	}
+	if len(msg.DRBsFailedToBeModifiedList) > 0 {
+		v.size("DRBsFailedToBeModifiedList", len(msg.DRBsFailedToBeModifiedList), 1, maxnoofDRBs)
+	}
+	if msg.CRNTI != nil {
+		v.integer("CRNTI", int64(msg.CRNTI.Value), 0, 65535)
+	}
+	if len(msg.AssociatedSCellList) > 0 {
+		v.size("AssociatedSCellList", len(msg.AssociatedSCellList), 1, maxnoofSCells)
+	}
+	if len(msg.SRBsSetupModList) > 0 {
+		v.size("SRBsSetupModList", len(msg.SRBsSetupModList), 1, maxnoofSRBs)
+	}
+	if len(msg.SRBsModifiedList) > 0 {
+		v.size("SRBsModifiedList", len(msg.SRBsModifiedList), 1, maxnoofSRBs)
+	}
+	if len(msg.BHChannelsSetupModList) > 0 {
+		v.size("BHChannelsSetupModList", len(msg.BHChannelsSetupModList), 1, maxnoofBHRLCChannels)
+		for i := range msg.BHChannelsSetupModList {
+			v.item("BHChannelsSetupModList", i, &msg.BHChannelsSetupModList[i])
+		}
+	}
+	if len(msg.BHChannelsModifiedList) > 0 {
+		v.size("BHChannelsModifiedList", len(msg.BHChannelsModifiedList), 1, maxnoofBHRLCChannels)
+		for i := range msg.BHChannelsModifiedList {
+			v.item("BHChannelsModifiedList", i, &msg.BHChannelsModifiedList[i])
+		}
+	}
+	if len(msg.BHChannelsFailedToBeSetupModList) > 0 {
+		v.size("BHChannelsFailedToBeSetupModList", len(msg.BHChannelsFailedToBeSetupModList), 1, maxnoofBHRLCChannels)
+		for i := range msg.BHChannelsFailedToBeSetupModList {
+			v.item("BHChannelsFailedToBeSetupModList", i, &msg.BHChannelsFailedToBeSetupModList[i])
+		}
+	}
+	if len(msg.BHChannelsFailedToBeModifiedList) > 0 {
+		v.size("BHChannelsFailedToBeModifiedList", len(msg.BHChannelsFailedToBeModifiedList), 1, maxnoofBHRLCChannels)
+		for i := range msg.BHChannelsFailedToBeModifiedList {
+			v.item("BHChannelsFailedToBeModifiedList", i, &msg.BHChannelsFailedToBeModifiedList[i])
+		}
+	}
+	if len(msg.SLDRBsSetupModList) > 0 {
+		v.size("SLDRBsSetupModList", len(msg.SLDRBsSetupModList), 1, maxnoofSLDRBs)
+		for i := range msg.SLDRBsSetupModList {
+			v.item("SLDRBsSetupModList", i, &msg.SLDRBsSetupModList[i])
+		}
+	}
+	if len(msg.SLDRBsModifiedList) > 0 {
+		v.size("SLDRBsModifiedList", len(msg.SLDRBsModifiedList), 1, maxnoofSLDRBs)
+		for i := range msg.SLDRBsModifiedList {
+			v.item("SLDRBsModifiedList", i, &msg.SLDRBsModifiedList[i])
+		}
+	}
+	if len(msg.SLDRBsFailedToBeSetupModList) > 0 {
+		v.size("SLDRBsFailedToBeSetupModList", len(msg.SLDRBsFailedToBeSetupModList), 1, maxnoofSLDRBs)
+		for i := range msg.SLDRBsFailedToBeSetupModList {
+			v.item("SLDRBsFailedToBeSetupModList", i, &msg.SLDRBsFailedToBeSetupModList[i])
+		}
+	}
+	if len(msg.SLDRBsFailedToBeModifiedList) > 0 {
+		v.size("SLDRBsFailedToBeModifiedList", len(msg.SLDRBsFailedToBeModifiedList), 1, maxnoofSLDRBs)
+		for i := range msg.SLDRBsFailedToBeModifiedList {
+			v.item("SLDRBsFailedToBeModifiedList", i, &msg.SLDRBsFailedToBeModifiedList[i])
+		}
+	}
+	return v.messageErr("UEContextModificationResponse")
+}
@@ -0,0 +1,157 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type UEContextReleaseRequest struct {
+	GNBCUUEF1APID       GNBCUUEF1APID        `mandatory,reject`
+	GNBDUUEF1APID       GNBDUUEF1APID        `mandatory,reject`
+	Cause               Cause                `mandatory,ignore`
+	TargetCellsToCancel []TargetCellListItem `optional,reject`
+	UnknownIEs          []RawIE
+}
+
+func (msg *UEContextReleaseRequest) ProcedureCode() int64 {
+	return ProcedureCode_UEContextReleaseRequest
+}
+
+func (msg *UEContextReleaseRequest) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *UEContextReleaseRequest) Criticality() aper.Enumerated {
+	return Criticality_PresentIgnore
+}
+
+func (msg *UEContextReleaseRequest) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("UEContextReleaseRequest"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *UEContextReleaseRequest) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("UEContextReleaseRequest"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *UEContextReleaseRequest) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_Cause},
+		Criticality: Criticality{Value: Criticality_PresentIgnore},
+		Value:       &msg.Cause,
+	})
+	if len(msg.TargetCellsToCancel) > 0 {
+		tmp_TargetCellsToCancel := sequenceOf[*TargetCellListItem]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofCHOcells},
+			ext: false,
+		}
+		for k := range msg.TargetCellsToCancel {
+			tmp_TargetCellsToCancel.Value = append(tmp_TargetCellsToCancel.Value, &msg.TargetCellsToCancel[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_TargetCellsToCancel},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_TargetCellsToCancel,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *UEContextReleaseRequest) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *UEContextReleaseRequest) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *UEContextReleaseRequest) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("UEContextReleaseRequest", wire, limits, ueContextReleaseRequestIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var ueContextReleaseRequestIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_Cause, Criticality_PresentIgnore, true},
+	{ProtocolIEID_TargetCellsToCancel, Criticality_PresentReject, false},
+}
+
+func (msg *UEContextReleaseRequest) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_Cause:
+		var tmp Cause
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("Cause", err)
+			return
+		}
+		msg.Cause = tmp
+
+	case ProtocolIEID_TargetCellsToCancel:
+		var tmp []*TargetCellListItem
+		if tmp, err = readList(ieR, aper.Constraint{Lb: 1, Ub: maxnoofCHOcells}, false, func() *TargetCellListItem { return new(TargetCellListItem) }); err != nil {
+			err = readError("TargetCellsToCancel", err)
+			return
+		}
+		msg.TargetCellsToCancel = make([]TargetCellListItem, len(tmp))
+		for k, i := range tmp {
+			msg.TargetCellsToCancel[k] = *i
+		}
+
+	}
+	return
+}
+
+func (msg *UEContextReleaseRequest) Validate() error {
+	var v validator
+	v.integer("GNBCUUEF1APID", int64(msg.GNBCUUEF1APID.Value), 0, 4294967295)
+	v.integer("GNBDUUEF1APID", int64(msg.GNBDUUEF1APID.Value), 0, 4294967295)
+	if len(msg.TargetCellsToCancel) > 0 {
+		v.size("TargetCellsToCancel", len(msg.TargetCellsToCancel), 1, maxnoofCHOcells)
+		for i := range msg.TargetCellsToCancel {
+			v.item("TargetCellsToCancel", i, &msg.TargetCellsToCancel[i])
+		}
+	}
+	return v.messageErr("UEContextReleaseRequest")
+}
@@ -0,0 +1,900 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type UEContextSetupRequest struct {
+	GNBCUUEF1APID                           GNBCUUEF1APID                            `mandatory,reject`
+	GNBDUUEF1APID                           *GNBDUUEF1APID                           `optional,ignore`
+	SpCellID                                NRCGI                                    `mandatory,reject`
+	ServCellIndex                           ServCellIndex                            `mandatory,reject`
+	SpCellULConfigured                      *CellULConfigured                        `optional,ignore`
+	CUtoDURRCInformation                    CUtoDURRCInformation                     `mandatory,reject`
+	CandidateSpCellList                     []CandidateSpCellItem                    `optional,ignore`
+	DRXCycle                                *DRXCycle                                `optional,ignore`
+	ResourceCoordinationTransferContainer   *ResourceCoordinationTransferContainer   `optional,ignore`
+	SCellToBeSetupList                      []SCellToBeSetupItem                     `optional,ignore`
+	SRBsToBeSetupList                       []SRBsToBeSetupItem                      `optional,reject`
+	DRBsToBeSetupList                       []DRBsToBeSetupItem                      `optional,reject`
+	InactivityMonitoringRequest             *InactivityMonitoringRequest             `optional,reject`
+	RATFrequencyPriorityInformation         *RATFrequencyPriorityInformation         `optional,reject`
+	RRCContainer                            *RRCContainer                            `optional,ignore`
+	MaskedIMEISV                            *MaskedIMEISV                            `optional,ignore`
+	ServingPLMN                             *PLMNIdentity                            `optional,ignore`
+	GNBDUUEAMBRUL                           *BitRate                                 `optional,ignore`
+	RRCDeliveryStatusRequest                *RRCDeliveryStatusRequest                `optional,ignore`
+	ResourceCoordinationTransferInformation *ResourceCoordinationTransferInformation `optional,ignore`
+	ServingCellMO                           *ServingCellMO                           `optional,ignore`
+	NewGNBCUUEF1APID                        *GNBCUUEF1APID                           `optional,reject`
+	RANUEID                                 *RANUEID                                 `optional,ignore`
+	TraceActivation                         *TraceActivation                         `optional,ignore`
+	AdditionalRRMPriorityIndex              *AdditionalRRMPriorityIndex              `optional,ignore`
+	BHChannelsToBeSetupList                 []BHChannelsToBeSetupItem                `optional,reject`
+	ConfiguredBAPAddress                    *BAPAddress                              `optional,reject`
+	NRV2XServicesAuthorized                 *NRV2XServicesAuthorized                 `optional,ignore`
+	LTEV2XServicesAuthorized                *LTEV2XServicesAuthorized                `optional,ignore`
+	NRUESidelinkAggregateMaximumBitrate     *NRUESidelinkAggregateMaximumBitrate     `optional,ignore`
+	LTEUESidelinkAggregateMaximumBitrate    *LTEUESidelinkAggregateMaximumBitrate    `optional,ignore`
+	PC5LinkAMBR                             *BitRate                                 `optional,ignore`
+	SLDRBsToBeSetupList                     []SLDRBsToBeSetupItem                    `optional,reject`
+	ConditionalInterDUMobilityInformation   *ConditionalInterDUMobilityInformation   `optional,reject`
+	ManagementBasedMDTPLMNList              []PLMNIdentity                           `optional,ignore`
+	ServingNID                              *NID                                     `optional,reject`
+	CandidateSpCellListUnknownItems         []RawIE
+	SCellToBeSetupListUnknownItems          []RawIE
+	SRBsToBeSetupListUnknownItems           []RawIE
+	DRBsToBeSetupListUnknownItems           []RawIE
+	BHChannelsToBeSetupListUnknownItems     []RawIE
+	SLDRBsToBeSetupListUnknownItems         []RawIE
+	UnknownIEs                              []RawIE
+}
+
+func (msg *UEContextSetupRequest) ProcedureCode() int64 {
+	return ProcedureCode_UEContextSetup
+}
+
+func (msg *UEContextSetupRequest) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *UEContextSetupRequest) Criticality() aper.Enumerated {
+	return Criticality_PresentReject
+}
+
+func (msg *UEContextSetupRequest) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("UEContextSetupRequest"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *UEContextSetupRequest) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("UEContextSetupRequest"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *UEContextSetupRequest) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	if msg.GNBDUUEF1APID != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.GNBDUUEF1APID,
+		})
+	}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_SpCellID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.SpCellID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_ServCellIndex},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.ServCellIndex,
+	})
+	if msg.SpCellULConfigured != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SpCellULConfigured},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.SpCellULConfigured,
+		})
+	}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_CUtoDURRCInformation},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.CUtoDURRCInformation,
+	})
+	if len(msg.CandidateSpCellList) > 0 || len(msg.CandidateSpCellListUnknownItems) > 0 {
+		tmp_CandidateSpCellList := SingleContainerList[*CandidateSpCellItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofCandidateSpCells},
+			id:          ProtocolIEID_CandidateSpCellItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.CandidateSpCellListUnknownItems,
+		}
+		for k := range msg.CandidateSpCellList {
+			tmp_CandidateSpCellList.Value = append(tmp_CandidateSpCellList.Value, &msg.CandidateSpCellList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CandidateSpCellList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_CandidateSpCellList,
+		})
+	}
+	if msg.DRXCycle != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DRXCycle},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.DRXCycle,
+		})
+	}
+	if msg.ResourceCoordinationTransferContainer != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ResourceCoordinationTransferContainer},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.ResourceCoordinationTransferContainer,
+		})
+	}
+	if len(msg.SCellToBeSetupList) > 0 || len(msg.SCellToBeSetupListUnknownItems) > 0 {
+		tmp_SCellToBeSetupList := SingleContainerList[*SCellToBeSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSCells},
+			id:          ProtocolIEID_SCellToBeSetupItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.SCellToBeSetupListUnknownItems,
+		}
+		for k := range msg.SCellToBeSetupList {
+			tmp_SCellToBeSetupList.Value = append(tmp_SCellToBeSetupList.Value, &msg.SCellToBeSetupList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SCellToBeSetupList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_SCellToBeSetupList,
+		})
+	}
+	if len(msg.SRBsToBeSetupList) > 0 || len(msg.SRBsToBeSetupListUnknownItems) > 0 {
+		tmp_SRBsToBeSetupList := SingleContainerList[*SRBsToBeSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSRBs},
+			id:          ProtocolIEID_SRBsToBeSetupItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.SRBsToBeSetupListUnknownItems,
+		}
+		for k := range msg.SRBsToBeSetupList {
+			tmp_SRBsToBeSetupList.Value = append(tmp_SRBsToBeSetupList.Value, &msg.SRBsToBeSetupList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SRBsToBeSetupList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_SRBsToBeSetupList,
+		})
+	}
+	if len(msg.DRBsToBeSetupList) > 0 || len(msg.DRBsToBeSetupListUnknownItems) > 0 {
+		tmp_DRBsToBeSetupList := SingleContainerList[*DRBsToBeSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsToBeSetupItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.DRBsToBeSetupListUnknownItems,
+		}
+		for k := range msg.DRBsToBeSetupList {
+			tmp_DRBsToBeSetupList.Value = append(tmp_DRBsToBeSetupList.Value, &msg.DRBsToBeSetupList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DRBsToBeSetupList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_DRBsToBeSetupList,
+		})
+	}
+	if msg.InactivityMonitoringRequest != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_InactivityMonitoringRequest},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.InactivityMonitoringRequest,
+		})
+	}
+	if msg.RATFrequencyPriorityInformation != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RATFrequencyPriorityInformation},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.RATFrequencyPriorityInformation,
+		})
+	}
+	if msg.RRCContainer != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RRCContainer},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.RRCContainer,
+		})
+	}
+	if msg.MaskedIMEISV != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_MaskedIMEISV},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.MaskedIMEISV,
+		})
+	}
+	if msg.ServingPLMN != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ServingPLMN},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.ServingPLMN,
+		})
+	}
+	if msg.GNBDUUEAMBRUL != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_GNBDUUEAMBRUL},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.GNBDUUEAMBRUL,
+		})
+	}
+	if msg.RRCDeliveryStatusRequest != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RRCDeliveryStatusRequest},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.RRCDeliveryStatusRequest,
+		})
+	}
+	if msg.ResourceCoordinationTransferInformation != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ResourceCoordinationTransferInformation},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.ResourceCoordinationTransferInformation,
+		})
+	}
+	if msg.ServingCellMO != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ServingCellMO},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.ServingCellMO,
+		})
+	}
+	if msg.NewGNBCUUEF1APID != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_NewGNBCUUEF1APID},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.NewGNBCUUEF1APID,
+		})
+	}
+	if msg.RANUEID != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RANUEID},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.RANUEID,
+		})
+	}
+	if msg.TraceActivation != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_TraceActivation},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.TraceActivation,
+		})
+	}
+	if msg.AdditionalRRMPriorityIndex != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_AdditionalRRMPriorityIndex},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.AdditionalRRMPriorityIndex,
+		})
+	}
+	if len(msg.BHChannelsToBeSetupList) > 0 || len(msg.BHChannelsToBeSetupListUnknownItems) > 0 {
+		tmp_BHChannelsToBeSetupList := SingleContainerList[*BHChannelsToBeSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsToBeSetupItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.BHChannelsToBeSetupListUnknownItems,
+		}
+		for k := range msg.BHChannelsToBeSetupList {
+			tmp_BHChannelsToBeSetupList.Value = append(tmp_BHChannelsToBeSetupList.Value, &msg.BHChannelsToBeSetupList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_BHChannelsToBeSetupList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_BHChannelsToBeSetupList,
+		})
+	}
+	if msg.ConfiguredBAPAddress != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ConfiguredBAPAddress},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.ConfiguredBAPAddress,
+		})
+	}
+	if msg.NRV2XServicesAuthorized != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_NRV2XServicesAuthorized},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.NRV2XServicesAuthorized,
+		})
+	}
+	if msg.LTEV2XServicesAuthorized != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_LTEV2XServicesAuthorized},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.LTEV2XServicesAuthorized,
+		})
+	}
+	if msg.NRUESidelinkAggregateMaximumBitrate != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_NRUESidelinkAggregateMaximumBitrate},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.NRUESidelinkAggregateMaximumBitrate,
+		})
+	}
+	if msg.LTEUESidelinkAggregateMaximumBitrate != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_LTEUESidelinkAggregateMaximumBitrate},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.LTEUESidelinkAggregateMaximumBitrate,
+		})
+	}
+	if msg.PC5LinkAMBR != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_PC5LinkAMBR},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.PC5LinkAMBR,
+		})
+	}
+	if len(msg.SLDRBsToBeSetupList) > 0 || len(msg.SLDRBsToBeSetupListUnknownItems) > 0 {
+		tmp_SLDRBsToBeSetupList := SingleContainerList[*SLDRBsToBeSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsToBeSetupItem,
+			criticality: Criticality_PresentReject,
+			Unknown:     msg.SLDRBsToBeSetupListUnknownItems,
+		}
+		for k := range msg.SLDRBsToBeSetupList {
+			tmp_SLDRBsToBeSetupList.Value = append(tmp_SLDRBsToBeSetupList.Value, &msg.SLDRBsToBeSetupList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SLDRBsToBeSetupList},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       &tmp_SLDRBsToBeSetupList,
+		})
+	}
+	if msg.ConditionalInterDUMobilityInformation != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ConditionalInterDUMobilityInformation},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.ConditionalInterDUMobilityInformation,
+		})
+	}
+	if len(msg.ManagementBasedMDTPLMNList) > 0 {
+		tmp_ManagementBasedMDTPLMNList := sequenceOf[*PLMNIdentity]{
+			c:   aper.Constraint{Lb: 1, Ub: maxnoofMDTPLMNs},
+			ext: false,
+		}
+		for k := range msg.ManagementBasedMDTPLMNList {
+			tmp_ManagementBasedMDTPLMNList.Value = append(tmp_ManagementBasedMDTPLMNList.Value, &msg.ManagementBasedMDTPLMNList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ManagementBasedMDTPLMNList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_ManagementBasedMDTPLMNList,
+		})
+	}
+	if msg.ServingNID != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ServingNID},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.ServingNID,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *UEContextSetupRequest) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *UEContextSetupRequest) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *UEContextSetupRequest) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("UEContextSetupRequest", wire, limits, ueContextSetupRequestIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var ueContextSetupRequestIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SpCellID, Criticality_PresentReject, true},
+	{ProtocolIEID_ServCellIndex, Criticality_PresentReject, true},
+	{ProtocolIEID_SpCellULConfigured, Criticality_PresentIgnore, false},
+	{ProtocolIEID_CUtoDURRCInformation, Criticality_PresentReject, true},
+	{ProtocolIEID_CandidateSpCellList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_DRXCycle, Criticality_PresentIgnore, false},
+	{ProtocolIEID_ResourceCoordinationTransferContainer, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SCellToBeSetupList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SRBsToBeSetupList, Criticality_PresentReject, false},
+	{ProtocolIEID_DRBsToBeSetupList, Criticality_PresentReject, false},
+	{ProtocolIEID_InactivityMonitoringRequest, Criticality_PresentReject, false},
+	{ProtocolIEID_RATFrequencyPriorityInformation, Criticality_PresentReject, false},
+	{ProtocolIEID_RRCContainer, Criticality_PresentIgnore, false},
+	{ProtocolIEID_MaskedIMEISV, Criticality_PresentIgnore, false},
+	{ProtocolIEID_ServingPLMN, Criticality_PresentIgnore, false},
+	{ProtocolIEID_GNBDUUEAMBRUL, Criticality_PresentIgnore, false},
+	{ProtocolIEID_RRCDeliveryStatusRequest, Criticality_PresentIgnore, false},
+	{ProtocolIEID_ResourceCoordinationTransferInformation, Criticality_PresentIgnore, false},
+	{ProtocolIEID_ServingCellMO, Criticality_PresentIgnore, false},
+	{ProtocolIEID_NewGNBCUUEF1APID, Criticality_PresentReject, false},
+	{ProtocolIEID_RANUEID, Criticality_PresentIgnore, false},
+	{ProtocolIEID_TraceActivation, Criticality_PresentIgnore, false},
+	{ProtocolIEID_AdditionalRRMPriorityIndex, Criticality_PresentIgnore, false},
+	{ProtocolIEID_BHChannelsToBeSetupList, Criticality_PresentReject, false},
+	{ProtocolIEID_ConfiguredBAPAddress, Criticality_PresentReject, false},
+	{ProtocolIEID_NRV2XServicesAuthorized, Criticality_PresentIgnore, false},
+	{ProtocolIEID_LTEV2XServicesAuthorized, Criticality_PresentIgnore, false},
+	{ProtocolIEID_NRUESidelinkAggregateMaximumBitrate, Criticality_PresentIgnore, false},
+	{ProtocolIEID_LTEUESidelinkAggregateMaximumBitrate, Criticality_PresentIgnore, false},
+	{ProtocolIEID_PC5LinkAMBR, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SLDRBsToBeSetupList, Criticality_PresentReject, false},
+	{ProtocolIEID_ConditionalInterDUMobilityInformation, Criticality_PresentReject, false},
+	{ProtocolIEID_ManagementBasedMDTPLMNList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_ServingNID, Criticality_PresentReject, false},
+}
+
+func (msg *UEContextSetupRequest) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = &tmp
+
+	case ProtocolIEID_SpCellID:
+		var tmp NRCGI
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("SpCellID", err)
+			return
+		}
+		msg.SpCellID = tmp
+
+	case ProtocolIEID_ServCellIndex:
+		var tmp ServCellIndex
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("ServCellIndex", err)
+			return
+		}
+		msg.ServCellIndex = tmp
+
+	case ProtocolIEID_SpCellULConfigured:
+		var tmp CellULConfigured
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("SpCellULConfigured", err)
+			return
+		}
+		msg.SpCellULConfigured = &tmp
+
+	case ProtocolIEID_CUtoDURRCInformation:
+		var tmp CUtoDURRCInformation
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("CUtoDURRCInformation", err)
+			return
+		}
+		msg.CUtoDURRCInformation = tmp
+
+	case ProtocolIEID_CandidateSpCellList:
+		tmp := SingleContainerList[*CandidateSpCellItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofCandidateSpCells},
+			id:          ProtocolIEID_CandidateSpCellItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *CandidateSpCellItem { return new(CandidateSpCellItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("CandidateSpCellList", err)
+			return
+		}
+		msg.CandidateSpCellList = []CandidateSpCellItem{}
+		for _, i := range tmp.Value {
+			msg.CandidateSpCellList = append(msg.CandidateSpCellList, *i)
+		}
+		msg.CandidateSpCellListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_DRXCycle:
+		var tmp DRXCycle
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("DRXCycle", err)
+			return
+		}
+		msg.DRXCycle = &tmp
+
+	case ProtocolIEID_ResourceCoordinationTransferContainer:
+		var tmp ResourceCoordinationTransferContainer
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("ResourceCoordinationTransferContainer", err)
+			return
+		}
+		msg.ResourceCoordinationTransferContainer = &tmp
+
+	case ProtocolIEID_SCellToBeSetupList:
+		tmp := SingleContainerList[*SCellToBeSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSCells},
+			id:          ProtocolIEID_SCellToBeSetupItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *SCellToBeSetupItem { return new(SCellToBeSetupItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SCellToBeSetupList", err)
+			return
+		}
+		msg.SCellToBeSetupList = []SCellToBeSetupItem{}
+		for _, i := range tmp.Value {
+			msg.SCellToBeSetupList = append(msg.SCellToBeSetupList, *i)
+		}
+		msg.SCellToBeSetupListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_SRBsToBeSetupList:
+		tmp := SingleContainerList[*SRBsToBeSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSRBs},
+			id:          ProtocolIEID_SRBsToBeSetupItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *SRBsToBeSetupItem { return new(SRBsToBeSetupItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SRBsToBeSetupList", err)
+			return
+		}
+		msg.SRBsToBeSetupList = []SRBsToBeSetupItem{}
+		for _, i := range tmp.Value {
+			msg.SRBsToBeSetupList = append(msg.SRBsToBeSetupList, *i)
+		}
+		msg.SRBsToBeSetupListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_DRBsToBeSetupList:
+		tmp := SingleContainerList[*DRBsToBeSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsToBeSetupItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *DRBsToBeSetupItem { return new(DRBsToBeSetupItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("DRBsToBeSetupList", err)
+			return
+		}
+		msg.DRBsToBeSetupList = []DRBsToBeSetupItem{}
+		for _, i := range tmp.Value {
+			msg.DRBsToBeSetupList = append(msg.DRBsToBeSetupList, *i)
+		}
+		msg.DRBsToBeSetupListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_InactivityMonitoringRequest:
+		var tmp InactivityMonitoringRequest
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("InactivityMonitoringRequest", err)
+			return
+		}
+		msg.InactivityMonitoringRequest = &tmp
+
+	case ProtocolIEID_RATFrequencyPriorityInformation:
+		var tmp RATFrequencyPriorityInformation
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RATFrequencyPriorityInformation", err)
+			return
+		}
+		msg.RATFrequencyPriorityInformation = &tmp
+
+	case ProtocolIEID_RRCContainer:
+		var tmp RRCContainer
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RRCContainer", err)
+			return
+		}
+		msg.RRCContainer = &tmp
+
+	case ProtocolIEID_MaskedIMEISV:
+		var tmp MaskedIMEISV
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("MaskedIMEISV", err)
+			return
+		}
+		msg.MaskedIMEISV = &tmp
+
+	case ProtocolIEID_ServingPLMN:
+		var tmp PLMNIdentity
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("ServingPLMN", err)
+			return
+		}
+		msg.ServingPLMN = &tmp
+
+	case ProtocolIEID_GNBDUUEAMBRUL:
+		var tmp BitRate
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEAMBRUL", err)
+			return
+		}
+		msg.GNBDUUEAMBRUL = &tmp
+
+	case ProtocolIEID_RRCDeliveryStatusRequest:
+		var tmp RRCDeliveryStatusRequest
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RRCDeliveryStatusRequest", err)
+			return
+		}
+		msg.RRCDeliveryStatusRequest = &tmp
+
+	case ProtocolIEID_ResourceCoordinationTransferInformation:
+		var tmp ResourceCoordinationTransferInformation
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("ResourceCoordinationTransferInformation", err)
+			return
+		}
+		msg.ResourceCoordinationTransferInformation = &tmp
+
+	case ProtocolIEID_ServingCellMO:
+		var tmp ServingCellMO
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("ServingCellMO", err)
+			return
+		}
+		msg.ServingCellMO = &tmp
+
+	case ProtocolIEID_NewGNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("NewGNBCUUEF1APID", err)
+			return
+		}
+		msg.NewGNBCUUEF1APID = &tmp
+
+	case ProtocolIEID_RANUEID:
+		var tmp RANUEID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RANUEID", err)
+			return
+		}
+		msg.RANUEID = &tmp
+
+	case ProtocolIEID_TraceActivation:
+		var tmp TraceActivation
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("TraceActivation", err)
+			return
+		}
+		msg.TraceActivation = &tmp
+
+	case ProtocolIEID_AdditionalRRMPriorityIndex:
+		var tmp AdditionalRRMPriorityIndex
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("AdditionalRRMPriorityIndex", err)
+			return
+		}
+		msg.AdditionalRRMPriorityIndex = &tmp
+
+	case ProtocolIEID_BHChannelsToBeSetupList:
+		tmp := SingleContainerList[*BHChannelsToBeSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsToBeSetupItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *BHChannelsToBeSetupItem { return new(BHChannelsToBeSetupItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("BHChannelsToBeSetupList", err)
+			return
+		}
+		msg.BHChannelsToBeSetupList = []BHChannelsToBeSetupItem{}
+		for _, i := range tmp.Value {
+			msg.BHChannelsToBeSetupList = append(msg.BHChannelsToBeSetupList, *i)
+		}
+		msg.BHChannelsToBeSetupListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_ConfiguredBAPAddress:
+		var tmp BAPAddress
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("ConfiguredBAPAddress", err)
+			return
+		}
+		msg.ConfiguredBAPAddress = &tmp
+
+	case ProtocolIEID_NRV2XServicesAuthorized:
+		var tmp NRV2XServicesAuthorized
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("NRV2XServicesAuthorized", err)
+			return
+		}
+		msg.NRV2XServicesAuthorized = &tmp
+
+	case ProtocolIEID_LTEV2XServicesAuthorized:
+		var tmp LTEV2XServicesAuthorized
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("LTEV2XServicesAuthorized", err)
+			return
+		}
+		msg.LTEV2XServicesAuthorized = &tmp
+
+	case ProtocolIEID_NRUESidelinkAggregateMaximumBitrate:
+		var tmp NRUESidelinkAggregateMaximumBitrate
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("NRUESidelinkAggregateMaximumBitrate", err)
+			return
+		}
+		msg.NRUESidelinkAggregateMaximumBitrate = &tmp
+
+	case ProtocolIEID_LTEUESidelinkAggregateMaximumBitrate:
+		var tmp LTEUESidelinkAggregateMaximumBitrate
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("LTEUESidelinkAggregateMaximumBitrate", err)
+			return
+		}
+		msg.LTEUESidelinkAggregateMaximumBitrate = &tmp
+
+	case ProtocolIEID_PC5LinkAMBR:
+		var tmp BitRate
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("PC5LinkAMBR", err)
+			return
+		}
+		msg.PC5LinkAMBR = &tmp
+
+	case ProtocolIEID_SLDRBsToBeSetupList:
+		tmp := SingleContainerList[*SLDRBsToBeSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsToBeSetupItem,
+			criticality: Criticality_PresentReject,
+		}
+		fn := func() *SLDRBsToBeSetupItem { return new(SLDRBsToBeSetupItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SLDRBsToBeSetupList", err)
+			return
+		}
+		msg.SLDRBsToBeSetupList = []SLDRBsToBeSetupItem{}
+		for _, i := range tmp.Value {
+			msg.SLDRBsToBeSetupList = append(msg.SLDRBsToBeSetupList, *i)
+		}
+		msg.SLDRBsToBeSetupListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_ConditionalInterDUMobilityInformation:
+		var tmp ConditionalInterDUMobilityInformation
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("ConditionalInterDUMobilityInformation", err)
+			return
+		}
+		msg.ConditionalInterDUMobilityInformation = &tmp
+
+	case ProtocolIEID_ManagementBasedMDTPLMNList:
+		var tmp []*PLMNIdentity
+		if tmp, err = readList(ieR, aper.Constraint{Lb: 1, Ub: maxnoofMDTPLMNs}, false, func() *PLMNIdentity { return new(PLMNIdentity) }); err != nil {
+			err = readError("ManagementBasedMDTPLMNList", err)
+			return
+		}
+		msg.ManagementBasedMDTPLMNList = make([]PLMNIdentity, len(tmp))
+		for k, i := range tmp {
+			msg.ManagementBasedMDTPLMNList[k] = *i
+		}
+
+	case ProtocolIEID_ServingNID:
+		var tmp NID
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("ServingNID", err)
+			return
+		}
+		msg.ServingNID = &tmp
+
+	}
+	return
+}
+
+func (msg *UEContextSetupRequest) Validate() error {
+	var v validator
+	v.integer("GNBCUUEF1APID", int64(msg.GNBCUUEF1APID.Value), 0, 4294967295)
+	if msg.GNBDUUEF1APID != nil {
+		v.integer("GNBDUUEF1APID", int64(msg.GNBDUUEF1APID.Value), 0, 4294967295)
+	}
+	v.integer("ServCellIndex", int64(msg.ServCellIndex.Value), 0, 31)
+	if msg.SpCellULConfigured != nil {
+		v.enumerated("SpCellULConfigured", msg.SpCellULConfigured.Value, 4)
+	}
+	if len(msg.CandidateSpCellList) > 0 {
+		v.size("CandidateSpCellList", len(msg.CandidateSpCellList), 1, maxnoofCandidateSpCells)
+	}
+	if len(msg.SCellToBeSetupList) > 0 {
+		v.size("SCellToBeSetupList", len(msg.SCellToBeSetupList), 1, maxnoofSCells)
+	}
+	if len(msg.SRBsToBeSetupList) > 0 {
+		v.size("SRBsToBeSetupList", len(msg.SRBsToBeSetupList), 1, maxnoofSRBs)
+	}
+	if len(msg.DRBsToBeSetupList) > 0 {
+		v.size("DRBsToBeSetupList", len(msg.DRBsToBeSetupList), 1, maxnoofDRBs)
+		for i := range msg.DRBsToBeSetupList {
+			v.item("DRBsToBeSetupList", i, &msg.DRBsToBeSetupList[i])
+		}
+	}
+	if msg.InactivityMonitoringRequest != nil {
+		v.enumerated("InactivityMonitoringRequest", msg.InactivityMonitoringRequest.Value, 1)
+	}
+	if msg.MaskedIMEISV != nil {
+		v.size("MaskedIMEISV", int(msg.MaskedIMEISV.Value.NumBits), 64, 64)
+	}
+	if msg.ServingPLMN != nil {
+		v.size("ServingPLMN", len(msg.ServingPLMN.Value), 3, 3)
+	}
+	if msg.GNBDUUEAMBRUL != nil {
+		v.ie("GNBDUUEAMBRUL", msg.GNBDUUEAMBRUL)
+	}
+	if msg.RRCDeliveryStatusRequest != nil {
+		v.enumerated("RRCDeliveryStatusRequest", msg.RRCDeliveryStatusRequest.Value, 1)
+	}
+	if msg.ServingCellMO != nil {
+		v.integer("ServingCellMO", int64(msg.ServingCellMO.Value), 1, 64)
+	}
+	if msg.NewGNBCUUEF1APID != nil {
+		v.integer("NewGNBCUUEF1APID", int64(msg.NewGNBCUUEF1APID.Value), 0, 4294967295)
+	}
+	if msg.RANUEID != nil {
+		v.size("RANUEID", len(msg.RANUEID.Value), 8, 8)
+	}
+	if msg.TraceActivation != nil {
+		v.ie("TraceActivation", msg.TraceActivation)
+	}
+	if msg.AdditionalRRMPriorityIndex != nil {
+		v.size("AdditionalRRMPriorityIndex", int(msg.AdditionalRRMPriorityIndex.Value.NumBits), 32, 32)
+	}
+	if len(msg.BHChannelsToBeSetupList) > 0 {
+		v.size("BHChannelsToBeSetupList", len(msg.BHChannelsToBeSetupList), 1, maxnoofBHRLCChannels)
+		for i := range msg.BHChannelsToBeSetupList {
+			v.item("BHChannelsToBeSetupList", i, &msg.BHChannelsToBeSetupList[i])
+		}
+	}
+	if msg.ConfiguredBAPAddress != nil {
+		v.ie("ConfiguredBAPAddress", msg.ConfiguredBAPAddress)
+	}
+	if msg.NRV2XServicesAuthorized != nil {
+		v.ie("NRV2XServicesAuthorized", msg.NRV2XServicesAuthorized)
+	}
+	if msg.LTEV2XServicesAuthorized != nil {
+		v.ie("LTEV2XServicesAuthorized", msg.LTEV2XServicesAuthorized)
+	}
+	if msg.NRUESidelinkAggregateMaximumBitrate != nil {
+		v.ie("NRUESidelinkAggregateMaximumBitrate", msg.NRUESidelinkAggregateMaximumBitrate)
+	}
+	if msg.LTEUESidelinkAggregateMaximumBitrate != nil {
+		v.ie("LTEUESidelinkAggregateMaximumBitrate", msg.LTEUESidelinkAggregateMaximumBitrate)
+	}
+	if msg.PC5LinkAMBR != nil {
+		v.ie("PC5LinkAMBR", msg.PC5LinkAMBR)
+	}
+	if len(msg.SLDRBsToBeSetupList) > 0 {
+		v.size("SLDRBsToBeSetupList", len(msg.SLDRBsToBeSetupList), 1, maxnoofSLDRBs)
+		for i := range msg.SLDRBsToBeSetupList {
+			v.item("SLDRBsToBeSetupList", i, &msg.SLDRBsToBeSetupList[i])
+		}
+	}
+	if msg.ConditionalInterDUMobilityInformation != nil {
+		v.ie("ConditionalInterDUMobilityInformation", msg.ConditionalInterDUMobilityInformation)
+	}
+	if len(msg.ManagementBasedMDTPLMNList) > 0 {
+		v.size("ManagementBasedMDTPLMNList", len(msg.ManagementBasedMDTPLMNList), 1, maxnoofMDTPLMNs)
+		for i := range msg.ManagementBasedMDTPLMNList {
+			v.size(itemName("ManagementBasedMDTPLMNList", i), len(msg.ManagementBasedMDTPLMNList[i].Value), 3, 3)
+		}
+	}
+	if msg.ServingNID != nil {
+		v.ie("ServingNID", msg.ServingNID)
+	}
+	return v.messageErr("UEContextSetupRequest")
+}
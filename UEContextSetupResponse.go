@@ -0,0 +1,592 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type UEContextSetupResponse struct {
+	GNBCUUEF1APID                             GNBCUUEF1APID                          `mandatory,reject`
+	GNBDUUEF1APID                             GNBDUUEF1APID                          `mandatory,reject`
+	DUtoCURRCInformation                      DUtoCURRCInformation                   `mandatory,reject`
+	CRNTI                                     *CRNTI                                 `optional,ignore`
+	ResourceCoordinationTransferContainer     *ResourceCoordinationTransferContainer `optional,ignore`
+	FullConfiguration                         *FullConfiguration                     `optional,reject`
+	DRBsSetupList                             []DRBsSetupItem                        `optional,ignore`
+	SRBsFailedToBeSetupList                   []SRBsFailedToBeSetupItem              `optional,ignore`
+	DRBsFailedToBeSetupList                   []DRBsFailedToBeSetupItem              `optional,ignore`
+	SCellFailedToSetupList                    []SCellFailedtoSetupItem               `optional,ignore`
+	InactivityMonitoringResponse              *InactivityMonitoringResponse          `optional,reject`
+	CriticalityDiagnostics                    *CriticalityDiagnostics                `optional,ignore`
+	SRBsSetupList                             []SRBsSetupItem                        `optional,ignore`
+	BHChannelsSetupList                       []BHChannelsSetupItem                  `optional,ignore`
+	BHChannelsFailedToBeSetupList             []BHChannelsFailedToBeSetupItem        `optional,ignore`
+	SLDRBsSetupList                           []SLDRBsSetupItem                      `optional,ignore`
+	SLDRBsFailedToBeSetupList                 []SLDRBsFailedToBeSetupItem            `optional,ignore`
+	RequestedTargetCellGlobalID               *NRCGI                                 `optional,reject`
+	DRBsSetupListUnknownItems                 []RawIE
+	SRBsFailedToBeSetupListUnknownItems       []RawIE
+	DRBsFailedToBeSetupListUnknownItems       []RawIE
+	SCellFailedToSetupListUnknownItems        []RawIE
+	SRBsSetupListUnknownItems                 []RawIE
+	BHChannelsSetupListUnknownItems           []RawIE
+	BHChannelsFailedToBeSetupListUnknownItems []RawIE
+	SLDRBsSetupListUnknownItems               []RawIE
+	SLDRBsFailedToBeSetupListUnknownItems     []RawIE
+	UnknownIEs                                []RawIE
+}
+
+func (msg *UEContextSetupResponse) ProcedureCode() int64 {
+	return ProcedureCode_UEContextSetup
+}
+
+func (msg *UEContextSetupResponse) Present() uint8 {
+	return F1apPduSuccessfulOutcome
+}
+
+func (msg *UEContextSetupResponse) Criticality() aper.Enumerated {
+	return Criticality_PresentReject
+}
+
+func (msg *UEContextSetupResponse) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("UEContextSetupResponse"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *UEContextSetupResponse) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("UEContextSetupResponse"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *UEContextSetupResponse) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_DUtoCURRCInformation},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.DUtoCURRCInformation,
+	})
+	if msg.CRNTI != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CRNTI},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.CRNTI,
+		})
+	}
+	if msg.ResourceCoordinationTransferContainer != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_ResourceCoordinationTransferContainer},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.ResourceCoordinationTransferContainer,
+		})
+	}
+	if msg.FullConfiguration != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_FullConfiguration},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.FullConfiguration,
+		})
+	}
+	if len(msg.DRBsSetupList) > 0 || len(msg.DRBsSetupListUnknownItems) > 0 {
+		tmp_DRBsSetupList := SingleContainerList[*DRBsSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsSetupItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.DRBsSetupListUnknownItems,
+		}
+		for k := range msg.DRBsSetupList {
+			tmp_DRBsSetupList.Value = append(tmp_DRBsSetupList.Value, &msg.DRBsSetupList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DRBsSetupList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_DRBsSetupList,
+		})
+	}
+	if len(msg.SRBsFailedToBeSetupList) > 0 || len(msg.SRBsFailedToBeSetupListUnknownItems) > 0 {
+		tmp_SRBsFailedToBeSetupList := SingleContainerList[*SRBsFailedToBeSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSRBs},
+			id:          ProtocolIEID_SRBsFailedToBeSetupItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.SRBsFailedToBeSetupListUnknownItems,
+		}
+		for k := range msg.SRBsFailedToBeSetupList {
+			tmp_SRBsFailedToBeSetupList.Value = append(tmp_SRBsFailedToBeSetupList.Value, &msg.SRBsFailedToBeSetupList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SRBsFailedToBeSetupList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_SRBsFailedToBeSetupList,
+		})
+	}
+	if len(msg.DRBsFailedToBeSetupList) > 0 || len(msg.DRBsFailedToBeSetupListUnknownItems) > 0 {
+		tmp_DRBsFailedToBeSetupList := SingleContainerList[*DRBsFailedToBeSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsFailedToBeSetupItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.DRBsFailedToBeSetupListUnknownItems,
+		}
+		for k := range msg.DRBsFailedToBeSetupList {
+			tmp_DRBsFailedToBeSetupList.Value = append(tmp_DRBsFailedToBeSetupList.Value, &msg.DRBsFailedToBeSetupList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_DRBsFailedToBeSetupList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_DRBsFailedToBeSetupList,
+		})
+	}
+	if len(msg.SCellFailedToSetupList) > 0 || len(msg.SCellFailedToSetupListUnknownItems) > 0 {
+		tmp_SCellFailedToSetupList := SingleContainerList[*SCellFailedtoSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSCells},
+			id:          ProtocolIEID_SCellFailedToSetupItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.SCellFailedToSetupListUnknownItems,
+		}
+		for k := range msg.SCellFailedToSetupList {
+			tmp_SCellFailedToSetupList.Value = append(tmp_SCellFailedToSetupList.Value, &msg.SCellFailedToSetupList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SCellFailedToSetupList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_SCellFailedToSetupList,
+		})
+	}
+	if msg.InactivityMonitoringResponse != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_InactivityMonitoringResponse},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.InactivityMonitoringResponse,
+		})
+	}
+	if msg.CriticalityDiagnostics != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_CriticalityDiagnostics},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       msg.CriticalityDiagnostics,
+		})
+	}
+	if len(msg.SRBsSetupList) > 0 || len(msg.SRBsSetupListUnknownItems) > 0 {
+		tmp_SRBsSetupList := SingleContainerList[*SRBsSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSRBs},
+			id:          ProtocolIEID_SRBsSetupItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.SRBsSetupListUnknownItems,
+		}
+		for k := range msg.SRBsSetupList {
+			tmp_SRBsSetupList.Value = append(tmp_SRBsSetupList.Value, &msg.SRBsSetupList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SRBsSetupList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_SRBsSetupList,
+		})
+	}
+	if len(msg.BHChannelsSetupList) > 0 || len(msg.BHChannelsSetupListUnknownItems) > 0 {
+		tmp_BHChannelsSetupList := SingleContainerList[*BHChannelsSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsSetupItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.BHChannelsSetupListUnknownItems,
+		}
+		for k := range msg.BHChannelsSetupList {
+			tmp_BHChannelsSetupList.Value = append(tmp_BHChannelsSetupList.Value, &msg.BHChannelsSetupList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_BHChannelsSetupList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_BHChannelsSetupList,
+		})
+	}
+	if len(msg.BHChannelsFailedToBeSetupList) > 0 || len(msg.BHChannelsFailedToBeSetupListUnknownItems) > 0 {
+		tmp_BHChannelsFailedToBeSetupList := SingleContainerList[*BHChannelsFailedToBeSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsFailedToBeSetupItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.BHChannelsFailedToBeSetupListUnknownItems,
+		}
+		for k := range msg.BHChannelsFailedToBeSetupList {
+			tmp_BHChannelsFailedToBeSetupList.Value = append(tmp_BHChannelsFailedToBeSetupList.Value, &msg.BHChannelsFailedToBeSetupList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_BHChannelsFailedToBeSetupList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_BHChannelsFailedToBeSetupList,
+		})
+	}
+	if len(msg.SLDRBsSetupList) > 0 || len(msg.SLDRBsSetupListUnknownItems) > 0 {
+		tmp_SLDRBsSetupList := SingleContainerList[*SLDRBsSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsSetupItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.SLDRBsSetupListUnknownItems,
+		}
+		for k := range msg.SLDRBsSetupList {
+			tmp_SLDRBsSetupList.Value = append(tmp_SLDRBsSetupList.Value, &msg.SLDRBsSetupList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SLDRBsSetupList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_SLDRBsSetupList,
+		})
+	}
+	if len(msg.SLDRBsFailedToBeSetupList) > 0 || len(msg.SLDRBsFailedToBeSetupListUnknownItems) > 0 {
+		tmp_SLDRBsFailedToBeSetupList := SingleContainerList[*SLDRBsFailedToBeSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsFailedToBeSetupItem,
+			criticality: Criticality_PresentIgnore,
+			Unknown:     msg.SLDRBsFailedToBeSetupListUnknownItems,
+		}
+		for k := range msg.SLDRBsFailedToBeSetupList {
+			tmp_SLDRBsFailedToBeSetupList.Value = append(tmp_SLDRBsFailedToBeSetupList.Value, &msg.SLDRBsFailedToBeSetupList[k])
+		}
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SLDRBsFailedToBeSetupList},
+			Criticality: Criticality{Value: Criticality_PresentIgnore},
+			Value:       &tmp_SLDRBsFailedToBeSetupList,
+		})
+	}
+	if msg.RequestedTargetCellGlobalID != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_RequestedTargetCellGlobalID},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.RequestedTargetCellGlobalID,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *UEContextSetupResponse) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *UEContextSetupResponse) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *UEContextSetupResponse) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("UEContextSetupResponse", wire, limits, ueContextSetupResponseIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var ueContextSetupResponseIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_DUtoCURRCInformation, Criticality_PresentReject, true},
+	{ProtocolIEID_CRNTI, Criticality_PresentIgnore, false},
+	{ProtocolIEID_ResourceCoordinationTransferContainer, Criticality_PresentIgnore, false},
+	{ProtocolIEID_FullConfiguration, Criticality_PresentReject, false},
+	{ProtocolIEID_DRBsSetupList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SRBsFailedToBeSetupList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_DRBsFailedToBeSetupList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SCellFailedToSetupList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_InactivityMonitoringResponse, Criticality_PresentReject, false},
+	{ProtocolIEID_CriticalityDiagnostics, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SRBsSetupList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_BHChannelsSetupList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_BHChannelsFailedToBeSetupList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SLDRBsSetupList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_SLDRBsFailedToBeSetupList, Criticality_PresentIgnore, false},
+	{ProtocolIEID_RequestedTargetCellGlobalID, Criticality_PresentReject, false},
+}
+
+func (msg *UEContextSetupResponse) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_DUtoCURRCInformation:
+		var tmp DUtoCURRCInformation
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("DUtoCURRCInformation", err)
+			return
+		}
+		msg.DUtoCURRCInformation = tmp
+
+	case ProtocolIEID_CRNTI:
+		var tmp CRNTI
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("CRNTI", err)
+			return
+		}
+		msg.CRNTI = &tmp
+
+	case ProtocolIEID_ResourceCoordinationTransferContainer:
+		var tmp ResourceCoordinationTransferContainer
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("ResourceCoordinationTransferContainer", err)
+			return
+		}
+		msg.ResourceCoordinationTransferContainer = &tmp
+
+	case ProtocolIEID_FullConfiguration:
+		var tmp FullConfiguration
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("FullConfiguration", err)
+			return
+		}
+		msg.FullConfiguration = &tmp
+
+	case ProtocolIEID_DRBsSetupList:
+		tmp := SingleContainerList[*DRBsSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsSetupItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *DRBsSetupItem { return new(DRBsSetupItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("DRBsSetupList", err)
+			return
+		}
+		msg.DRBsSetupList = []DRBsSetupItem{}
+		for _, i := range tmp.Value {
+			msg.DRBsSetupList = append(msg.DRBsSetupList, *i)
+		}
+		msg.DRBsSetupListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_SRBsFailedToBeSetupList:
+		tmp := SingleContainerList[*SRBsFailedToBeSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSRBs},
+			id:          ProtocolIEID_SRBsFailedToBeSetupItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *SRBsFailedToBeSetupItem { return new(SRBsFailedToBeSetupItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SRBsFailedToBeSetupList", err)
+			return
+		}
+		msg.SRBsFailedToBeSetupList = []SRBsFailedToBeSetupItem{}
+		for _, i := range tmp.Value {
+			msg.SRBsFailedToBeSetupList = append(msg.SRBsFailedToBeSetupList, *i)
+		}
+		msg.SRBsFailedToBeSetupListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_DRBsFailedToBeSetupList:
+		tmp := SingleContainerList[*DRBsFailedToBeSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofDRBs},
+			id:          ProtocolIEID_DRBsFailedToBeSetupItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *DRBsFailedToBeSetupItem { return new(DRBsFailedToBeSetupItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("DRBsFailedToBeSetupList", err)
+			return
+		}
+		msg.DRBsFailedToBeSetupList = []DRBsFailedToBeSetupItem{}
+		for _, i := range tmp.Value {
+			msg.DRBsFailedToBeSetupList = append(msg.DRBsFailedToBeSetupList, *i)
+		}
+		msg.DRBsFailedToBeSetupListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_SCellFailedToSetupList:
+		tmp := SingleContainerList[*SCellFailedtoSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSCells},
+			id:          ProtocolIEID_SCellFailedToSetupItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *SCellFailedtoSetupItem { return new(SCellFailedtoSetupItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SCellFailedToSetupList", err)
+			return
+		}
+		msg.SCellFailedToSetupList = []SCellFailedtoSetupItem{}
+		for _, i := range tmp.Value {
+			msg.SCellFailedToSetupList = append(msg.SCellFailedToSetupList, *i)
+		}
+		msg.SCellFailedToSetupListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_InactivityMonitoringResponse:
+		var tmp InactivityMonitoringResponse
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("InactivityMonitoringResponse", err)
+			return
+		}
+		msg.InactivityMonitoringResponse = &tmp
+
+	case ProtocolIEID_CriticalityDiagnostics:
+		var tmp CriticalityDiagnostics
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("CriticalityDiagnostics", err)
+			return
+		}
+		msg.CriticalityDiagnostics = &tmp
+
+	case ProtocolIEID_SRBsSetupList:
+		tmp := SingleContainerList[*SRBsSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSRBs},
+			id:          ProtocolIEID_SRBsSetupItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *SRBsSetupItem { return new(SRBsSetupItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SRBsSetupList", err)
+			return
+		}
+		msg.SRBsSetupList = []SRBsSetupItem{}
+		for _, i := range tmp.Value {
+			msg.SRBsSetupList = append(msg.SRBsSetupList, *i)
+		}
+		msg.SRBsSetupListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_BHChannelsSetupList:
+		tmp := SingleContainerList[*BHChannelsSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsSetupItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *BHChannelsSetupItem { return new(BHChannelsSetupItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("BHChannelsSetupList", err)
+			return
+		}
+		msg.BHChannelsSetupList = []BHChannelsSetupItem{}
+		for _, i := range tmp.Value {
+			msg.BHChannelsSetupList = append(msg.BHChannelsSetupList, *i)
+		}
+		msg.BHChannelsSetupListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_BHChannelsFailedToBeSetupList:
+		tmp := SingleContainerList[*BHChannelsFailedToBeSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofBHRLCChannels},
+			id:          ProtocolIEID_BHChannelsFailedToBeSetupItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *BHChannelsFailedToBeSetupItem { return new(BHChannelsFailedToBeSetupItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("BHChannelsFailedToBeSetupList", err)
+			return
+		}
+		msg.BHChannelsFailedToBeSetupList = []BHChannelsFailedToBeSetupItem{}
+		for _, i := range tmp.Value {
+			msg.BHChannelsFailedToBeSetupList = append(msg.BHChannelsFailedToBeSetupList, *i)
+		}
+		msg.BHChannelsFailedToBeSetupListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_SLDRBsSetupList:
+		tmp := SingleContainerList[*SLDRBsSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsSetupItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *SLDRBsSetupItem { return new(SLDRBsSetupItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SLDRBsSetupList", err)
+			return
+		}
+		msg.SLDRBsSetupList = []SLDRBsSetupItem{}
+		for _, i := range tmp.Value {
+			msg.SLDRBsSetupList = append(msg.SLDRBsSetupList, *i)
+		}
+		msg.SLDRBsSetupListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_SLDRBsFailedToBeSetupList:
+		tmp := SingleContainerList[*SLDRBsFailedToBeSetupItem]{
+			c:           aper.Constraint{Lb: 1, Ub: maxnoofSLDRBs},
+			id:          ProtocolIEID_SLDRBsFailedToBeSetupItem,
+			criticality: Criticality_PresentIgnore,
+		}
+		fn := func() *SLDRBsFailedToBeSetupItem { return new(SLDRBsFailedToBeSetupItem) }
+		if err = tmp.decode(ieR, fn); err != nil {
+			err = readError("SLDRBsFailedToBeSetupList", err)
+			return
+		}
+		msg.SLDRBsFailedToBeSetupList = []SLDRBsFailedToBeSetupItem{}
+		for _, i := range tmp.Value {
+			msg.SLDRBsFailedToBeSetupList = append(msg.SLDRBsFailedToBeSetupList, *i)
+		}
+		msg.SLDRBsFailedToBeSetupListUnknownItems = tmp.Unknown
+
+	case ProtocolIEID_RequestedTargetCellGlobalID:
+		var tmp NRCGI
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RequestedTargetCellGlobalID", err)
+			return
+		}
+		msg.RequestedTargetCellGlobalID = &tmp
+
+	}
+	return
+}
+
+func (msg *UEContextSetupResponse) Validate() error {
+	var v validator
+	v.integer("GNBCUUEF1APID", int64(msg.GNBCUUEF1APID.Value), 0, 4294967295)
+	v.integer("GNBDUUEF1APID", int64(msg.GNBDUUEF1APID.Value), 0, 4294967295)
+	if msg.CRNTI != nil {
+		v.integer("CRNTI", int64(msg.CRNTI.Value), 0, 65535)
+	}
+	if len(msg.DRBsSetupList) > 0 {
+		v.size("DRBsSetupList", len(msg.DRBsSetupList), 1, maxnoofDRBs)
+	}
+	if len(msg.SRBsFailedToBeSetupList) > 0 {
+		v.size("SRBsFailedToBeSetupList", len(msg.SRBsFailedToBeSetupList), 1, maxnoofSRBs)
+	}
+	if len(msg.DRBsFailedToBeSetupList) > 0 {
+		v.size("DRBsFailedToBeSetupList", len(msg.DRBsFailedToBeSetupList), 1, maxnoofDRBs)
+	}
+	if len(msg.SCellFailedToSetupList) > 0 {
+		v.size("SCellFailedToSetupList", len(msg.SCellFailedToSetupList), 1, maxnoofSCells)
+	}
+	if len(msg.SRBsSetupList) > 0 {
+		v.size("SRBsSetupList", len(msg.SRBsSetupList), 1, maxnoofSRBs)
+	}
+	if len(msg.BHChannelsSetupList) > 0 {
+		v.size("BHChannelsSetupList", len(msg.BHChannelsSetupList), 1, maxnoofBHRLCChannels)
+		for i := range msg.BHChannelsSetupList {
+			v.item("BHChannelsSetupList", i, &msg.BHChannelsSetupList[i])
+		}
+	}
+	if len(msg.BHChannelsFailedToBeSetupList) > 0 {
+		v.size("BHChannelsFailedToBeSetupList", len(msg.BHChannelsFailedToBeSetupList), 1, maxnoofBHRLCChannels)
+		for i := range msg.BHChannelsFailedToBeSetupList {
+			v.item("BHChannelsFailedToBeSetupList", i, &msg.BHChannelsFailedToBeSetupList[i])
+		}
+	}
+	if len(msg.SLDRBsSetupList) > 0 {
+		v.size("SLDRBsSetupList", len(msg.SLDRBsSetupList), 1, maxnoofSLDRBs)
+		for i := range msg.SLDRBsSetupList {
+			v.item("SLDRBsSetupList", i, &msg.SLDRBsSetupList[i])
+		}
+	}
+	if len(msg.SLDRBsFailedToBeSetupList) > 0 {
+		v.size("SLDRBsFailedToBeSetupList", len(msg.SLDRBsFailedToBeSetupList), 1, maxnoofSLDRBs)
+		for i := range msg.SLDRBsFailedToBeSetupList {
+			v.item("SLDRBsFailedToBeSetupList", i, &msg.SLDRBsFailedToBeSetupList[i])
+		}
+	}
+	return v.messageErr("UEContextSetupResponse")
+}
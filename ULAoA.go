@@ -0,0 +1,138 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+type ULAoA struct {
+	AzimuthAoA          int64                   `lb:0,ub:3599,mandatory`
+	ZenithAoA           *int64                  `lb:0,ub:1799,optional`
+	LCSToGCSTranslation *LCSToGCSTranslationAoA `optional`
+	// IEExtensions
+	Extensions ProtocolExtensionContainer[ULAoA] `optional,extension`
+}
+
+func (ie *ULAoA) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *ULAoA) encode(w *writer) (err error) {
+	if err = w.WriteBool(aper.Zero); err != nil {
+		return
+	}
+	exts := ie.Extensions.insert(nil)
+	optionals := []byte{0x0}
+	if ie.ZenithAoA != nil {
+		aper.SetBit(optionals, 1)
+	}
+	if ie.LCSToGCSTranslation != nil {
+		aper.SetBit(optionals, 2)
+	}
+	if len(exts) > 0 {
+		aper.SetBit(optionals, 3)
+	}
+	if err = w.WriteBits(optionals, 3); err != nil {
+		return
+	}
+	tmp_AzimuthAoA := INTEGER{
+		c:     aper.Constraint{Lb: 0, Ub: 3599},
+		ext:   false,
+		Value: aper.Integer(ie.AzimuthAoA),
+	}
+	if err = tmp_AzimuthAoA.Encode(w.AperWriter); err != nil {
+		err = utils.WrapError("Encode AzimuthAoA", err)
+		return
+	}
+	if ie.ZenithAoA != nil {
+		tmp_ZenithAoA := INTEGER{
+			c:     aper.Constraint{Lb: 0, Ub: 1799},
+			ext:   false,
+			Value: aper.Integer(*ie.ZenithAoA),
+		}
+		if err = tmp_ZenithAoA.Encode(w.AperWriter); err != nil {
+			err = utils.WrapError("Encode ZenithAoA", err)
+			return
+		}
+	}
+	if ie.LCSToGCSTranslation != nil {
+		if err = ie.LCSToGCSTranslation.encode(w); err != nil {
+			err = utils.WrapError("Encode LCSToGCSTranslation", err)
+			return
+		}
+	}
+	if len(exts) > 0 {
+		if err = writeExtensions(w, exts); err != nil {
+			err = utils.WrapError("Encode IEExtensions", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *ULAoA) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *ULAoA) decode(r *decoder) (err error) {
+	var extended bool
+	if extended, err = r.ReadBool(); err != nil {
+		return
+	}
+	var optionals []byte
+	if optionals, err = r.ReadBits(3); err != nil {
+		return
+	}
+	tmp_AzimuthAoA := INTEGER{
+		c:   aper.Constraint{Lb: 0, Ub: 3599},
+		ext: false,
+	}
+	if err = tmp_AzimuthAoA.Decode(r.AperReader); err != nil {
+		err = readError("AzimuthAoA", err)
+		return
+	}
+	ie.AzimuthAoA = int64(tmp_AzimuthAoA.Value)
+	if aper.IsBitSet(optionals, 1) {
+		tmp_ZenithAoA := INTEGER{
+			c:   aper.Constraint{Lb: 0, Ub: 1799},
+			ext: false,
+		}
+		if err = tmp_ZenithAoA.Decode(r.AperReader); err != nil {
+			err = readError("ZenithAoA", err)
+			return
+		}
+		tmp := int64(tmp_ZenithAoA.Value)
+		ie.ZenithAoA = &tmp
+	}
+	if aper.IsBitSet(optionals, 2) {
+		tmp := new(LCSToGCSTranslationAoA)
+		if err = tmp.decode(r); err != nil {
+			err = readError("LCSToGCSTranslation", err)
+			return
+		}
+		ie.LCSToGCSTranslation = tmp
+	}
+	if aper.IsBitSet(optionals, 3) {
+		if err = ie.Extensions.decode(r, nil); err != nil {
+			err = readError("IEExtensions", err)
+			return
+		}
+	}
+	if extended {
+		err = skipExtensions(r)
+	}
+	return
+}
+
+func (ie *ULAoA) Validate() error {
+	var v validator
+	v.integer("AzimuthAoA", ie.AzimuthAoA, 0, 3599)
+	if ie.ZenithAoA != nil {
+		v.integer("ZenithAoA", *ie.ZenithAoA, 0, 1799)
+	}
+	if ie.LCSToGCSTranslation != nil {
+		v.ie("LCSToGCSTranslation", ie.LCSToGCSTranslation)
+	}
+	v.ie("Extensions", &ie.Extensions)
+	return v.err()
+}
@@ -0,0 +1,200 @@
+package ies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+type ULRRCMessageTransfer struct {
+	GNBCUUEF1APID    GNBCUUEF1APID  `mandatory,reject`
+	GNBDUUEF1APID    GNBDUUEF1APID  `mandatory,reject`
+	SRBID            SRBID          `mandatory,reject`
+	RRCContainer     RRCContainer   `mandatory,reject`
+	SelectedPLMNID   *PLMNIdentity  `optional,reject`
+	NID              *NID           `optional,reject`
+	NewGNBDUUEF1APID *GNBDUUEF1APID `optional,reject`
+	UnknownIEs       []RawIE
+}
+
+func (msg *ULRRCMessageTransfer) ProcedureCode() int64 {
+	return ProcedureCode_ULRRCMessageTransfer
+}
+
+func (msg *ULRRCMessageTransfer) Present() uint8 {
+	return F1apPduInitiatingMessage
+}
+
+func (msg *ULRRCMessageTransfer) Criticality() aper.Enumerated {
+	return Criticality_PresentIgnore
+}
+
+func (msg *ULRRCMessageTransfer) Encode(w io.Writer) (err error) {
+	var ies []F1apMessageIE
+	if ies, err = msg.toIes(); err != nil {
+		err = msgErrors(fmt.Errorf("ULRRCMessageTransfer"), err)
+		return
+	}
+	return encodeMessage(w, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *ULRRCMessageTransfer) AppendEncode(dst []byte) ([]byte, error) {
+	ies, err := msg.toIes()
+	if err != nil {
+		return dst, msgErrors(fmt.Errorf("ULRRCMessageTransfer"), err)
+	}
+	return appendMessage(dst, msg.Present(), msg.ProcedureCode(), msg.Criticality(), ies)
+}
+
+func (msg *ULRRCMessageTransfer) toIes() (ies []F1apMessageIE, err error) {
+	ies = []F1apMessageIE{}
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBCUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBCUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_gNBDUUEF1APID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.GNBDUUEF1APID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_SRBID},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.SRBID,
+	})
+	ies = append(ies, F1apMessageIE{
+		Id:          ProtocolIEID{Value: ProtocolIEID_RRCContainer},
+		Criticality: Criticality{Value: Criticality_PresentReject},
+		Value:       &msg.RRCContainer,
+	})
+	if msg.SelectedPLMNID != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_SelectedPLMNID},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.SelectedPLMNID,
+		})
+	}
+	if msg.NID != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_NID},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.NID,
+		})
+	}
+	if msg.NewGNBDUUEF1APID != nil {
+		ies = append(ies, F1apMessageIE{
+			Id:          ProtocolIEID{Value: ProtocolIEID_NewGNBDUUEF1APID},
+			Criticality: Criticality{Value: Criticality_PresentReject},
+			Value:       msg.NewGNBDUUEF1APID,
+		})
+	}
+	ies = insertRawIEs(ies, msg.UnknownIEs)
+	return
+}
+
+func (msg *ULRRCMessageTransfer) Decode(wire []byte) (err error, diagList []CriticalityDiagnosticsIEItem) {
+	var rep *DecodeReport
+	if rep, err = msg.DecodeWithReport(wire); rep != nil {
+		diagList = rep.Diagnostics()
+	}
+	return
+}
+
+func (msg *ULRRCMessageTransfer) DecodeWithReport(wire []byte) (*DecodeReport, error) {
+	return msg.DecodeWithLimits(wire, DefaultDecodeLimits())
+}
+
+func (msg *ULRRCMessageTransfer) DecodeWithLimits(wire []byte, limits DecodeLimits) (*DecodeReport, error) {
+	return decodeMessage("ULRRCMessageTransfer", wire, limits, ulrrcMessageTransferIEs, msg.decodeIE, &msg.UnknownIEs)
+}
+
+var ulrrcMessageTransferIEs = []messageIE{
+	{ProtocolIEID_gNBCUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_gNBDUUEF1APID, Criticality_PresentReject, true},
+	{ProtocolIEID_SRBID, Criticality_PresentReject, true},
+	{ProtocolIEID_RRCContainer, Criticality_PresentReject, true},
+	{ProtocolIEID_SelectedPLMNID, Criticality_PresentReject, false},
+	{ProtocolIEID_NID, Criticality_PresentReject, false},
+	{ProtocolIEID_NewGNBDUUEF1APID, Criticality_PresentReject, false},
+}
+
+func (msg *ULRRCMessageTransfer) decodeIE(id aper.Integer, ieR *decoder) (err error) {
+	switch id {
+	case ProtocolIEID_gNBCUUEF1APID:
+		var tmp GNBCUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBCUUEF1APID", err)
+			return
+		}
+		msg.GNBCUUEF1APID = tmp
+
+	case ProtocolIEID_gNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("GNBDUUEF1APID", err)
+			return
+		}
+		msg.GNBDUUEF1APID = tmp
+
+	case ProtocolIEID_SRBID:
+		var tmp SRBID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("SRBID", err)
+			return
+		}
+		msg.SRBID = tmp
+
+	case ProtocolIEID_RRCContainer:
+		var tmp RRCContainer
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("RRCContainer", err)
+			return
+		}
+		msg.RRCContainer = tmp
+
+	case ProtocolIEID_SelectedPLMNID:
+		var tmp PLMNIdentity
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("SelectedPLMNID", err)
+			return
+		}
+		msg.SelectedPLMNID = &tmp
+
+	case ProtocolIEID_NID:
+		var tmp NID
+		if err = tmp.decode(ieR); err != nil {
+			err = readError("NID", err)
+			return
+		}
+		msg.NID = &tmp
+
+	case ProtocolIEID_NewGNBDUUEF1APID:
+		var tmp GNBDUUEF1APID
+		if err = tmp.Decode(ieR.AperReader); err != nil {
+			err = readError("NewGNBDUUEF1APID", err)
+			return
+		}
+		msg.NewGNBDUUEF1APID = &tmp
+
+	}
+	return
+}
+
+func (msg *ULRRCMessageTransfer) Validate() error {
+	var v validator
+	v.integer("GNBCUUEF1APID", int64(msg.GNBCUUEF1APID.Value), 0, 4294967295)
+	v.integer("GNBDUUEF1APID", int64(msg.GNBDUUEF1APID.Value), 0, 4294967295)
+	v.integer("SRBID", int64(msg.SRBID.Value), 0, 3)
+	if msg.SelectedPLMNID != nil {
+		v.size("SelectedPLMNID", len(msg.SelectedPLMNID.Value), 3, 3)
+	}
+	if msg.NID != nil {
+		v.ie("NID", msg.NID)
+	}
+	if msg.NewGNBDUUEF1APID != nil {
+		v.integer("NewGNBDUUEF1APID", int64(msg.NewGNBDUUEF1APID.Value), 0, 4294967295)
+	}
+	return v.messageErr("ULRRCMessageTransfer")
+}
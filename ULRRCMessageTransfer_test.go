@@ -0,0 +1,30 @@
+package ies
+
+import (
+	"testing"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+func TestULRRCMessageTransferWire(t *testing.T) {
+	m := &ULRRCMessageTransfer{
+		GNBCUUEF1APID: GNBCUUEF1APID{Value: 1},
+		GNBDUUEF1APID: GNBDUUEF1APID{Value: 2},
+		SRBID:         SRBID{Value: 1},
+		RRCContainer:  RRCContainer{Value: []byte{0xab, 0xcd}},
+		NID:           &NID{Value: aper.BitString{Bytes: []byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xb0}, NumBits: 44}},
+	}
+	want := []byte{
+		0x00, 0x0d, 0x40, 0x25, // initiatingMessage, uLRRCMessageTransfer, ignore, length
+		0x00, 0x00, 0x05, // extension bit, 5 IEs
+		0x00, 0x28, 0x00, 0x02, 0x00, 0x01, // gNB-CU-UE-F1AP-ID 1
+		0x00, 0x29, 0x00, 0x02, 0x00, 0x02, // gNB-DU-UE-F1AP-ID 2
+		0x00, 0x40, 0x00, 0x01, 0x20, // SRBID 1
+		0x00, 0x32, 0x00, 0x03, 0x02, 0xab, 0xcd, // RRCContainer
+		0x01, 0x81, 0x00, 0x06, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xb0, // NID
+	}
+	checkMessage(t, m, new(ULRRCMessageTransfer), want)
+	if name := protocolIEIDNames[ProtocolIEID_NID]; name != "NID" {
+		t.Fatalf("IE 385 is named %q", name)
+	}
+}
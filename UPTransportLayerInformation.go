@@ -0,0 +1,87 @@
+package ies
+
+import (
+	"github.com/lvdund/ngap/aper"
+	"github.com/reogac/utils"
+)
+
+const (
+	UPTransportLayerInformationPresentNothing uint64 = iota
+	UPTransportLayerInformationPresentGTPTunnel
+	UPTransportLayerInformationPresentChoiceExtension
+)
+
+type UPTransportLayerInformation struct {
+	Choice          uint64
+	GTPTunnel       *GTPTunnel
+	ChoiceExtension *RawIE
+}
+
+func (ie *UPTransportLayerInformation) Encode(w *aper.AperWriter) error {
+	return ie.encode(newWriter(w))
+}
+
+func (ie *UPTransportLayerInformation) encode(w *writer) (err error) {
+	if err = w.WriteChoice(ie.Choice, 1, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case UPTransportLayerInformationPresentGTPTunnel:
+		if err = ie.GTPTunnel.encode(w); err != nil {
+			err = utils.WrapError("Encode GTPTunnel", err)
+			return
+		}
+	case UPTransportLayerInformationPresentChoiceExtension:
+		if err = writeChoiceExtension(w, ie.ChoiceExtension); err != nil {
+			err = utils.WrapError("Encode ChoiceExtension", err)
+			return
+		}
+	}
+	return
+}
+
+func (ie *UPTransportLayerInformation) Decode(r *aper.AperReader) error {
+	return ie.decode(newDecoder(r))
+}
+
+func (ie *UPTransportLayerInformation) decode(r *decoder) (err error) {
+	if ie.Choice, err = r.ReadChoice(1, false); err != nil {
+		return
+	}
+	switch ie.Choice {
+	case UPTransportLayerInformationPresentGTPTunnel:
+		tmp := new(GTPTunnel)
+		if err = tmp.decode(r); err != nil {
+			err = readError("GTPTunnel", err)
+			return
+		}
+		ie.GTPTunnel = tmp
+	case UPTransportLayerInformationPresentChoiceExtension:
+		if ie.ChoiceExtension, err = readChoiceExtension(r, nil); err != nil {
+			err = readError("ChoiceExtension", err)
+			return
+		}
+	default:
+		err = &ConstraintViolation{Field: "Choice", Value: ie.Choice}
+	}
+	return
+}
+
+func (ie *UPTransportLayerInformation) Validate() error {
+	var v validator
+	switch ie.Choice {
+	case UPTransportLayerInformationPresentGTPTunnel:
+		if ie.GTPTunnel == nil {
+			v.absent("GTPTunnel")
+		} else {
+			v.ie("GTPTunnel", ie.GTPTunnel)
+		}
+	case UPTransportLayerInformationPresentChoiceExtension:
+		if ie.ChoiceExtension == nil {
+			v.absent("ChoiceExtension")
+		}
+	default:
+		v.violation("Choice", ie.Choice)
+	}
+	return v.err()
+}
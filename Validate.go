@@ -0,0 +1,143 @@
+package ies
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+// ValidationError lists the violations found by Validate, each a
+// *ConstraintViolation or a *MissingMandatoryIE
+type ValidationError struct {
+	Violations []error
+}
+
+func (e *ValidationError) Error() string {
+	items := make([]string, len(e.Violations))
+	for i, err := range e.Violations {
+		items[i] = err.Error()
+	}
+	return strings.Join(items, "; ")
+}
+
+func (e *ValidationError) Unwrap() []error {
+	return e.Violations
+}
+
+// collect the violations of an IE and of the IEs it contains. Ranges and
+// sizes are checked against their root: values beyond an extension marker are
+// reserved for later releases and are not sent.
+type validator struct {
+	errs []error
+}
+
+// record a value out of the constraints of a field
+func (v *validator) violation(field string, value any) {
+	v.errs = append(v.errs, &ConstraintViolation{Field: field, Value: value})
+}
+
+// record a field that is absent although its alternative is selected or its
+// condition holds; path leads to the IE holding the field
+func (v *validator) absent(field string, path ...string) {
+	v.errs = append(v.errs, &ConstraintViolation{ErrorPath: ErrorPath{Path: path}, Field: field})
+}
+
+// record a mandatory message IE that is absent
+func (v *validator) missing(id aper.Integer) {
+	v.errs = append(v.errs, &MissingMandatoryIE{ID: id})
+}
+
+func (v *validator) integer(field string, value int64, lb, ub int64) {
+	if value < lb || value > ub {
+		v.violation(field, value)
+	}
+}
+
+// n is the number of values of an enumerated with extension additions
+func (v *validator) enumerated(field string, value aper.Enumerated, n int64) {
+	if int64(value) < 0 || int64(value) >= n {
+		v.violation(field, value)
+	}
+}
+
+// check the size of a string or list
+func (v *validator) size(field string, n int, lb, ub int64) {
+	if int64(n) < lb || int64(n) > ub {
+		v.violation(field, n)
+	}
+}
+
+// an IE whose constraints can be checked
+type validatable interface {
+	Validate() error
+}
+
+// validate a contained IE; the path of its violations is prefixed with name
+func (v *validator) ie(name string, x validatable) {
+	err := x.Validate()
+	if err == nil {
+		return
+	}
+	var ve *ValidationError
+	if !errors.As(err, &ve) {
+		v.errs = append(v.errs, validationError(name, err))
+		return
+	}
+	for _, e := range ve.Violations {
+		v.errs = append(v.errs, validationError(name, e))
+	}
+}
+
+// validate a value whose type is known only once set, as the value of a
+// private IE or of an extension IE; a value without Validate method is a
+// violation
+func (v *validator) value(name string, x any) {
+	if x == nil {
+		v.absent(name)
+		return
+	}
+	c, ok := x.(validatable)
+	if !ok {
+		v.violation(name, fmt.Errorf("%T has no Validate method", x))
+		return
+	}
+	v.ie(name, c)
+}
+
+// validate the i-th item of a list
+func (v *validator) item(name string, i int, x validatable) {
+	v.ie(itemName(name, i), x)
+}
+
+// prefix the path of a violation with the name of the IE holding it; any
+// other error returned by a Validate method becomes a violation of that IE
+func validationError(name string, err error) error {
+	var pe interface{ errorPath() *ErrorPath }
+	if errors.As(err, &pe) {
+		p := pe.errorPath()
+		p.Path = append([]string{name}, p.Path...)
+		return err
+	}
+	return &ConstraintViolation{Field: name, Value: err}
+}
+
+func itemName(name string, i int) string {
+	return fmt.Sprintf("%s[%d]", name, i)
+}
+
+func (v *validator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return &ValidationError{Violations: v.errs}
+}
+
+// return the violations of a message
+func (v *validator) messageErr(name string) error {
+	for _, e := range v.errs {
+		messageError(name, e)
+	}
+	return v.err()
+}
@@ -0,0 +1,228 @@
+package ies
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/lvdund/ngap/aper"
+)
+
+// a private IE value whose Validate fails with an error of its own
+type vendorFlag struct {
+	vendorLoad
+}
+
+var errVendorFlag = errors.New("flag not supported")
+
+func (v *vendorFlag) Validate() error {
+	return errVendorFlag
+}
+
+// return the only violation of err
+func violation(t *testing.T, err error) *ConstraintViolation {
+	t.Helper()
+	var ve *ValidationError
+	if !errors.As(err, &ve) || len(ve.Violations) != 1 {
+		t.Fatalf("error %v", err)
+	}
+	var cv *ConstraintViolation
+	if !errors.As(ve.Violations[0], &cv) {
+		t.Fatalf("violation %v", ve.Violations[0])
+	}
+	return cv
+}
+
+func TestValidateValueError(t *testing.T) {
+	local := int64(7)
+	msg := PrivateMessage{PrivateIEs: []PrivateIE{{
+		Id:          PrivateIEID{Choice: PrivateIEIDPresentLocal, Local: &local},
+		Criticality: Criticality{Value: Criticality_PresentIgnore},
+		Value:       &vendorFlag{},
+	}}}
+	err := msg.Validate()
+	if errors.Is(err, ErrTransferSyntax) {
+		t.Fatalf("validation reported as %v", err)
+	}
+	cv := violation(t, err)
+	want := ErrorPath{Message: "PrivateMessage", Path: []string{"PrivateIEs[0]"}}
+	if !reflect.DeepEqual(cv.ErrorPath, want) || cv.Field != "Value" || cv.Value != errVendorFlag {
+		t.Fatalf("violation %+v", cv)
+	}
+
+	msg.PrivateIEs[0].Value = nil
+	if cv = violation(t, msg.Validate()); cv.Field != "Value" || cv.Value != nil {
+		t.Fatalf("violation %+v", cv)
+	}
+}
+
+func TestValidateWithoutMethod(t *testing.T) {
+	ie := NRFreqInfo{
+		NRARFCN:        5,
+		FreqBandListNr: []FreqBandNrItem{{FreqBandIndicatorNr: 78}},
+		Extensions: ProtocolExtensionContainer[NRFreqInfo]{Items: []ProtocolExtensionField{{
+			Id:          7777,
+			Criticality: Criticality_PresentIgnore,
+			Value:       &vendorExtension{V: 1},
+		}}},
+	}
+	cv := violation(t, ie.Validate())
+	if !reflect.DeepEqual(cv.Path, []string{"Extensions"}) || cv.Field != "Items[0]" {
+		t.Fatalf("violation %+v", cv)
+	}
+
+	ie.Extensions.Items[0].Value = &ExtensionRaw{Value: []byte{1}}
+	if err := ie.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// gNB-DU Served Cells List is optional in TS 38.473 but required to encode the
+// message, as it always was
+func TestF1SetupRequestWithoutServedCells(t *testing.T) {
+	msg := F1SetupRequest{
+		TransactionID:   TransactionID{Value: 1},
+		GNBDUID:         GNBDUID{Value: 1},
+		GNBDURRCVersion: RRCVersion{LatestRRCVersion: aper.BitString{Bytes: []byte{0x20}, NumBits: 3}},
+	}
+	if err := msg.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := msg.toIes(); err == nil || err.Error() != "GNBDUServedCellsList is nil" {
+		t.Fatalf("error %v", err)
+	}
+}
+
+func TestValidateF1SetupRequest(t *testing.T) {
+	msg := F1SetupRequest{
+		TransactionID:   TransactionID{Value: 256},
+		GNBDUID:         GNBDUID{Value: maxGNBDUID + 1},
+		GNBDURRCVersion: RRCVersion{LatestRRCVersion: aper.BitString{Bytes: []byte{0x20, 0x00}, NumBits: 9}},
+	}
+	var ve *ValidationError
+	if err := msg.Validate(); !errors.As(err, &ve) {
+		t.Fatalf("error %v", err)
+	}
+	var fields []string
+	for _, err := range ve.Violations {
+		cv := err.(*ConstraintViolation)
+		if cv.Message != "F1SetupRequest" {
+			t.Fatalf("violation %+v", cv)
+		}
+		fields = append(fields, cv.Field)
+	}
+	if want := []string{"TransactionID", "GNBDUID", "GNBDURRCVersion"}; !reflect.DeepEqual(fields, want) {
+		t.Fatalf("violations of %v", fields)
+	}
+}
+
+func TestValidateUEContextSetupRequest(t *testing.T) {
+	msg := UEContextSetupRequest{
+		ServCellIndex:              ServCellIndex{Value: 32},
+		ManagementBasedMDTPLMNList: []PLMNIdentity{{Value: []byte{0x02, 0xf8, 0x39}}, {Value: []byte{0x02, 0xf8}}},
+	}
+	var ve *ValidationError
+	if err := msg.Validate(); !errors.As(err, &ve) || len(ve.Violations) != 2 {
+		t.Fatalf("error %v", err)
+	}
+	if cv := ve.Violations[0].(*ConstraintViolation); cv.Field != "ServCellIndex" || cv.Value != int64(32) {
+		t.Fatalf("violation %+v", cv)
+	}
+	if cv := ve.Violations[1].(*ConstraintViolation); cv.Field != "ManagementBasedMDTPLMNList[1]" || cv.Value != 2 {
+		t.Fatalf("violation %+v", cv)
+	}
+
+	msg.ServCellIndex.Value = 31
+	msg.ManagementBasedMDTPLMNList = msg.ManagementBasedMDTPLMNList[:1]
+	msg.RANUEID = &RANUEID{Value: []byte{1, 2, 3}}
+	if cv := violation(t, msg.Validate()); cv.Field != "RANUEID" || cv.Value != 3 {
+		t.Fatalf("violation %+v", cv)
+	}
+}
+
+func TestValidateCHOTargetID(t *testing.T) {
+	ie := ConditionalInterDUMobilityInformation{
+		ChoTrigger: CHOTriggerInterDU{Value: CHOTriggerInterDUChoreplace},
+	}
+	if cv := violation(t, ie.Validate()); cv.Field != "TargetgNBDUUEF1APID" || cv.Value != nil {
+		t.Fatalf("violation %+v", cv)
+	}
+
+	ie.TargetgNBDUUEF1APID = &GNBDUUEF1APID{Value: 1 << 32}
+	if cv := violation(t, ie.Validate()); cv.Field != "TargetgNBDUUEF1APID" || cv.Value != int64(1<<32) {
+		t.Fatalf("violation %+v", cv)
+	}
+
+	ie.TargetgNBDUUEF1APID.Value = 5
+	if err := ie.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	ie.ChoTrigger.Value = CHOTriggerInterDUChoinitiation
+	if cv := violation(t, ie.Validate()); cv.Field != "TargetgNBDUUEF1APID" || cv.Value != aper.Integer(5) {
+		t.Fatalf("violation %+v", cv)
+	}
+}
+
+func TestValidateDCBasedDuplication(t *testing.T) {
+	ie := DRBsToBeModifiedItem{
+		DRBID: DRBID{Value: 4},
+		ULUPTNLInformationToBeSetupList: []ULUPTNLInformationToBeSetupItem{{
+			ULUPTNLInformation: UPTransportLayerInformation{
+				Choice: UPTransportLayerInformationPresentGTPTunnel,
+				GTPTunnel: &GTPTunnel{
+					TransportLayerAddress: TransportLayerAddress{Value: aper.BitString{Bytes: []byte{10, 0, 0, 1}, NumBits: 32}},
+					GTPTEID:               GTPTEID{Value: aper.OctetString{0, 0, 0, 1}},
+				},
+			},
+		}},
+		DCBasedDuplicationActivation: &DuplicationActivation{Value: DuplicationActivationActive},
+	}
+	if cv := violation(t, ie.Validate()); cv.Field != "DCBasedDuplicationActivation" || cv.Value != DuplicationActivationActive {
+		t.Fatalf("violation %+v", cv)
+	}
+
+	ie.DCBasedDuplicationConfigured = &DCBasedDuplicationConfigured{Value: DCBasedDuplicationConfiguredFalse}
+	if cv := violation(t, ie.Validate()); cv.Field != "DCBasedDuplicationActivation" {
+		t.Fatalf("violation %+v", cv)
+	}
+
+	ie.DCBasedDuplicationConfigured.Value = DCBasedDuplicationConfiguredTrue
+	if err := ie.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	ie.DCBasedDuplicationActivation = nil
+	if cv := violation(t, ie.Validate()); cv.Field != "DCBasedDuplicationActivation" || cv.Value != nil {
+		t.Fatalf("violation %+v", cv)
+	}
+}
+
+func TestValidateNotify(t *testing.T) {
+	var msg Notify
+	err := msg.Validate()
+	var missing *MissingMandatoryIE
+	if !errors.Is(err, ErrMissingMandatoryIE) || !errors.As(err, &missing) ||
+		missing.Message != "Notify" || missing.ID != ProtocolIEID_DRBNotifyList {
+		t.Fatalf("error %v", err)
+	}
+
+	msg.DRBNotifyList = []DRBNotifyItem{
+		{DRBID: DRBID{Value: 5}, NotificationCause: NotificationCause{Value: NotificationCauseFulfilled}},
+		{DRBID: DRBID{Value: 40}, NotificationCause: NotificationCause{Value: NotificationCauseFulfilled}},
+	}
+	err = msg.Validate()
+	if !errors.Is(err, ErrConstraintViolation) {
+		t.Fatalf("error %v", err)
+	}
+	cv := violation(t, err)
+	want := ErrorPath{Message: "Notify", Path: []string{"DRBNotifyList[1]", "DRBID"}}
+	if !reflect.DeepEqual(cv.ErrorPath, want) || cv.Field != "Value" || cv.Value != int64(40) {
+		t.Fatalf("violation %+v", cv)
+	}
+
+	msg.DRBNotifyList[1].DRBID.Value = 6
+	if err = msg.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -1,7 +1,7 @@
 package ies
 
 import (
-	"bytes"
+	"errors"
 	"fmt"
 	"io"
 
@@ -9,76 +9,306 @@ import (
 )
 
 func encodeMessage(w io.Writer, present uint8, procedureCode int64, criticality aper.Enumerated, ies []F1apMessageIE) (err error) {
-	aw := aper.NewWriter(w)
-	if err = aw.WriteBool(aper.Zero); err != nil {
+	if len(ies) == 0 {
+		err = fmt.Errorf("empty message")
 		return
 	}
-	if err = aw.WriteChoice(uint64(present), 2, true); err != nil {
-		return
+	return writePdu(w, present, procedureCode, criticality, writeIEs(ies))
+}
+
+// append the encoded message to dst
+func appendMessage(dst []byte, present uint8, procedureCode int64, criticality aper.Enumerated, ies []F1apMessageIE) ([]byte, error) {
+	if len(ies) == 0 {
+		return dst, fmt.Errorf("empty message")
 	}
-	pCode := ProcedureCode{
-		Value: aper.Integer(procedureCode),
+	return appendPdu(dst, present, procedureCode, criticality, writeIEs(ies))
+}
+
+// write the IE container of a message
+func writeIEs(ies []F1apMessageIE) func(*encoder) error {
+	return func(e *encoder) error {
+		return writeContainer(e, ies, aper.Constraint{
+			Lb: 0,
+			Ub: int64(aper.POW_16 - 1),
+		})
 	}
-	if err = pCode.Encode(aw); err != nil {
+}
+
+// represent an IE in F1ap messages
+type F1apMessageIE struct {
+	Id          ProtocolIEID // protocol IE identity
+	Criticality Criticality
+	Value       aper.AperMarshaller // open type
+}
+
+func (ie F1apMessageIE) Encode(w *aper.AperWriter) (err error) {
+	return ie.encode(newWriter(w))
+}
+
+func (ie F1apMessageIE) encode(w *writer) (err error) {
+	if err = ie.encodeHeader(w.AperWriter); err != nil {
+		return
+	}
+	return w.writeOpenType(ie.Value)
+}
+
+// encode the id and criticality of the IE
+func (ie *F1apMessageIE) encodeHeader(w *aper.AperWriter) (err error) {
+	//1. encode protocol Ie Id
+	if err = ie.Id.Encode(w); err != nil {
 		return
 	}
-	cr := Criticality{
-		Value: criticality,
+	//2. encode criticality
+	err = ie.Criticality.Encode(w)
+	return
+}
+
+// an IE value that can be carried in an open type
+type ieCodec interface {
+	Encode(*aper.AperWriter) error
+	Decode(*aper.AperReader) error
+}
+
+// represent a SEQUENCE OF ProtocolIE-SingleContainer where all items carry
+// the same IE; items carrying another IE are kept in Unknown
+type SingleContainerList[T ieCodec] struct {
+	c           aper.Constraint
+	id          aper.Integer
+	criticality aper.Enumerated
+	Value       []T
+	Unknown     []RawIE
+}
+
+func (l *SingleContainerList[T]) Encode(w *aper.AperWriter) (err error) {
+	return l.encode(newWriter(w))
+}
+
+func (l *SingleContainerList[T]) encode(w *writer) (err error) {
+	items := []F1apMessageIE{}
+	for _, v := range l.Value {
+		items = append(items, F1apMessageIE{
+			Id:          ProtocolIEID{Value: l.id},
+			Criticality: Criticality{Value: l.criticality},
+			Value:       v,
+		})
 	}
-	if err = cr.Encode(aw); err != nil {
+	items = insertRawIEs(items, l.Unknown)
+	return writeList(w, items, l.c, false)
+}
+
+func (l *SingleContainerList[T]) decode(r *decoder, fn func() T) (err error) {
+	decodeItem := func(*aper.AperReader) (msgIe *F1apMessageIE, err error) {
+		var buf []byte
+		n := len(l.Value) + len(l.Unknown)
+		if err = checkListLength(r, n+1); err != nil {
+			return
+		}
+		if msgIe, buf, err = readIE(r); err != nil {
+			return
+		}
+		if msgIe.Id.Value != l.id {
+			l.Unknown = append(l.Unknown, RawIE{
+				Id:          msgIe.Id.Value,
+				Criticality: msgIe.Criticality.Value,
+				Value:       buf,
+				Position:    n,
+			})
+			return
+		}
+		var itemR *decoder
+		if itemR, err = newOpenTypeReader(r, buf); err != nil {
+			return
+		}
+		item := fn()
+		if err = decodeValue(itemR, item); err != nil {
+			return
+		}
+		msgIe.Value = item
+		l.Value = append(l.Value, item)
 		return
 	}
-	if len(ies) == 0 {
-		err = fmt.Errorf("empty message")
+	_, err = aper.ReadSequenceOf[F1apMessageIE](decodeItem, r.AperReader, &l.c, false)
+	return
+}
+
+// read a SEQUENCE OF; the length of the list is checked before each item is
+// decoded into a new value returned by fn
+func readList[T ieCodec](r *decoder, c aper.Constraint, ext bool, fn func() T) ([]T, error) {
+	n := 0
+	decodeItem := func(*aper.AperReader) (*T, error) {
+		n++
+		if err := checkListLength(r, n); err != nil {
+			return nil, err
+		}
+		item := fn()
+		if err := decodeValue(r, item); err != nil {
+			return nil, err
+		}
+		return &item, nil
+	}
+	return aper.ReadSequenceOf[T](decodeItem, r.AperReader, &c, ext)
+}
+
+// write the extension IEs of an IE as a ProtocolExtensionContainer
+func writeExtensions(w *writer, exts []F1apMessageIE) error {
+	return writeList(w, exts, aper.Constraint{
+		Lb: 1,
+		Ub: maxProtocolExtensions,
+	}, false)
+}
+
+// an IE unknown to the decoder, or a duplicate of an IE it knows, kept to be
+// encoded again as received so that a decoded message is forwarded without
+// loss. SingleContainerList keeps them in Unknown, which a message copies to
+// the field named after the list, such as DRBNotifyListUnknownItems; messages
+// keep their own in UnknownIEs and choices in ChoiceExtension.
+type RawIE struct {
+	Id          aper.Integer
+	Criticality aper.Enumerated
+	Value       []byte // contents of the open type
+	Position    int    // index in the container the IE was received in
+}
+
+// the undecoded value of an open type
+type rawValue []byte
+
+func (v rawValue) Encode(w *aper.AperWriter) (err error) {
+	return w.WriteBits(v, uint(len(v)*8))
+}
+
+// insert raw IEs at their received positions in the IEs of a container;
+// raws are in increasing position as decoded, a position beyond the end
+// appends the IE
+func insertRawIEs(ies []F1apMessageIE, raws []RawIE) []F1apMessageIE {
+	for _, raw := range raws {
+		ie := F1apMessageIE{
+			Id:          ProtocolIEID{Value: raw.Id},
+			Criticality: Criticality{Value: raw.Criticality},
+			Value:       rawValue(raw.Value),
+		}
+		if raw.Position >= len(ies) {
+			ies = append(ies, ie)
+			continue
+		}
+		ies = append(ies, F1apMessageIE{})
+		copy(ies[raw.Position+1:], ies[raw.Position:])
+		ies[raw.Position] = ie
+	}
+	return ies
+}
+
+// read the ProtocolIE-SingleContainer of a choice-extension; decode is
+// called with the id and a reader over the value and reports whether it
+// knows the IE, an IE it does not know is returned raw. decode is nil for a
+// choice without typed choice-extension.
+func readChoiceExtension(r *decoder, decode func(aper.Integer, *decoder) (bool, error)) (raw *RawIE, err error) {
+	var ie *F1apMessageIE
+	var buf []byte
+	if ie, buf, err = readIE(r); err != nil {
 		return
 	}
+	if decode != nil {
+		var ieR *decoder
+		if ieR, err = newOpenTypeReader(r, buf); err != nil {
+			return
+		}
+		var ok bool
+		if ok, err = decode(ie.Id.Value, ieR); ok || err != nil {
+			return
+		}
+	}
+	raw = &RawIE{Id: ie.Id.Value, Criticality: ie.Criticality.Value, Value: buf}
+	return
+}
+
+// write a choice-extension kept raw
+func writeChoiceExtension(w *writer, raw *RawIE) error {
+	if raw == nil {
+		return fmt.Errorf("ChoiceExtension is nil")
+	}
+	ie := F1apMessageIE{
+		Id:          ProtocolIEID{Value: raw.Id},
+		Criticality: Criticality{Value: raw.Criticality},
+		Value:       rawValue(raw.Value),
+	}
+	return ie.encode(w)
+}
 
-	var buf bytes.Buffer
-	cW := aper.NewWriter(&buf) // container writer
-	cW.WriteBool(aper.Zero)
-	if err = aper.WriteSequenceOf[F1apMessageIE](ies, cW, &aper.Constraint{
-		Lb: 0,
-		Ub: int64(aper.POW_16 - 1),
-	}, false); err != nil {
+// skip the extension additions of a SEQUENCE (X.691 19.7): a normally small
+// number of additions, a bit map of the present ones and an open type for
+// each of them. No SEQUENCE of this release has additions, their values are
+// dropped.
+func skipExtensions(r *decoder) (err error) {
+	var n int
+	if n, err = readSmallLength(r); err != nil {
 		return
 	}
+	var present []byte
+	if present, err = r.ReadBits(uint(n)); err != nil {
+		return
+	}
+	for i := 1; i <= n; i++ {
+		if aper.IsBitSet(present, uint(i)) {
+			if _, err = readOpenType(r); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
 
-	if err = cW.Close(); err != nil {
+// read a normally small length (X.691 11.9.3.4): up to 64 in a bit and 6
+// bits, a longer one as a length determinant
+func readSmallLength(r *decoder) (n int, err error) {
+	var long bool
+	if long, err = r.ReadBool(); err != nil {
 		return
 	}
-	if err = aw.WriteOpenType(buf.Bytes()); err != nil {
+	if long {
+		var frag bool
+		if n, frag, err = readLength(r); err == nil && frag {
+			err = fmt.Errorf("fragmented length of %d extension additions", n)
+		}
 		return
 	}
-	err = aw.Close()
+	var v int64
+	if v, err = r.ReadInteger(&aper.Constraint{Lb: 0, Ub: 63}, false); err == nil {
+		n = int(v) + 1
+	}
 	return
 }
 
-// represent an IE in F1ap messages
-type F1apMessageIE struct {
-	Id          ProtocolIEID // protocol IE identity
-	Criticality Criticality
-	Value       aper.AperMarshaller // open type
+// read the id and criticality of an IE and return its value undecoded; ie
+// is set along with a *LimitError when the value exceeds MaxAllocation
+func readIE(r *decoder) (ie *F1apMessageIE, buf []byte, err error) {
+	return readContainerIE(r, 0)
 }
 
-func (ie F1apMessageIE) Encode(w *aper.AperWriter) (err error) {
-	//1. encode protocol Ie Id
-	if err = ie.Id.Encode(w); err != nil {
+// read the n-th IE of a protocol IE or extension container as readIE does;
+// the number of IEs is checked against MaxIEs before the value is read, ie is
+// set along with the *LimitError
+func readContainerIE(r *decoder, n int) (ie *F1apMessageIE, buf []byte, err error) {
+	var id int64
+	var c uint64
+	if id, err = r.ReadInteger(&aper.Constraint{Lb: 0, Ub: int64(aper.POW_16) - 1}, false); err != nil {
 		return
 	}
-	//2. encode criticality
-	if err = ie.Criticality.Encode(w); err != nil {
+	if c, err = r.ReadEnumerate(aper.Constraint{Lb: 0, Ub: 2}, false); err != nil {
 		return
 	}
-	//3. encode F1apIE
-	//encode IE into a byte array first
-	var buf bytes.Buffer
-	ieW := aper.NewWriter(&buf)
-	if err = ie.Value.Encode(ieW); err != nil {
-		return
+	ie = &F1apMessageIE{
+		Id:          ProtocolIEID{Value: aper.Integer(id)},
+		Criticality: Criticality{Value: aper.Enumerated(c)},
+	}
+	if err = checkIEs(r, n); err == nil {
+		buf, err = readOpenType(r)
+	}
+	if err != nil {
+		var le *LimitError
+		if !errors.As(err, &le) {
+			ie = nil
+		}
 	}
-	ieW.Close()
-	//then write the array as open type
-	err = w.WriteOpenType(buf.Bytes())
 	return
 }
 
@@ -87,7 +317,7 @@ type ProcedureCode struct {
 }
 
 func (ie *ProcedureCode) Decode(r *aper.AperReader) error {
-	if v, err := r.ReadInteger(&aper.Constraint{Lb: 0, Ub: 2}, false); err != nil {
+	if v, err := r.ReadInteger(&aper.Constraint{Lb: 0, Ub: 255}, false); err != nil {
 		return err
 	} else {
 		ie.Value = aper.Integer(v)
@@ -100,6 +330,11 @@ func (ie *ProcedureCode) Encode(r *aper.AperWriter) (err error) {
 	}
 	return nil
 }
+func (ie *ProcedureCode) Validate() error {
+	var v validator
+	v.integer("Value", int64(ie.Value), 0, 255)
+	return v.err()
+}
 
 type TriggeringMessage struct {
 	Value aper.Enumerated `aper:"valueLB:0,valueUB:2"`
@@ -119,6 +354,11 @@ func (ie *TriggeringMessage) Encode(r *aper.AperWriter) (err error) {
 	}
 	return nil
 }
+func (ie *TriggeringMessage) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 3)
+	return v.err()
+}
 
 type Criticality struct {
 	Value aper.Enumerated `aper:"valueLB:0,valueUB:2"`
@@ -138,6 +378,11 @@ func (ie *Criticality) Encode(r *aper.AperWriter) (err error) {
 	}
 	return nil
 }
+func (ie *Criticality) Validate() error {
+	var v validator
+	v.enumerated("Value", ie.Value, 3)
+	return v.err()
+}
 
 type ProtocolIEID struct {
 	Value aper.Integer `aper:"valueLB:0,valueUB:65535"`
@@ -157,6 +402,11 @@ func (ie *ProtocolIEID) Encode(r *aper.AperWriter) (err error) {
 	}
 	return nil
 }
+func (ie *ProtocolIEID) Validate() error {
+	var v validator
+	v.integer("Value", int64(ie.Value), 0, 65535)
+	return v.err()
+}
 
 // type TransactionID struct {
 // 	Value aper.Integer `aper:"valueLB:0,valueUB:255"`
@@ -182,7 +432,7 @@ func msgErrors(err1, err2 error) error {
 	if err2 == nil {
 		return err1
 	}
-	return fmt.Errorf("%v: %v", err1, err2)
+	return fmt.Errorf("%v: %w", err1, err2)
 }
 
 const (
@@ -254,6 +504,68 @@ const (
 	ProcedureCode_ReferenceTimeInformationReportingControl = 58
 )
 
+// upper bounds of lists
+const (
+	maxnoofTRPs                         = 65535
+	maxnoofTRPInfoTypes                 = 64
+	maxnoofPRSresourceSets              = 8
+	maxnoofPRSresources                 = 64
+	maxnoofPRSResourcesPerSet           = 64
+	maxnoofSSBs                         = 255
+	maxnooflcsgcstranslation            = 3
+	maxnoSRSCarriers                    = 32
+	maxnoSCSs                           = 5
+	maxnoSRSResources                   = 64
+	maxnoSRSPosResources                = 64
+	maxnoSRSResourceSets                = 16
+	maxnoSRSResourcePerSet              = 16
+	maxnoSRSPosResourceSets             = 16
+	maxnoSRSPosResourcePerSet           = 16
+	maxnoofSRSTriggerStates             = 3
+	maxNRARFCN                          = 3279165
+	maxnoofMeasECID                     = 64
+	maxnoofslots                        = 5120
+	maxnoofUACPLMNs                     = 12
+	maxnoofUACperPLMN                   = 64
+	maxPrivateIEs                       = 65535
+	maxnoBcastCell                      = 16384
+	maxnoofAssistInfoFailureListItems   = 32
+	maxnoofSLDRBs                       = 512
+	maxnoofPC5QoSFlows                  = 2048
+	maxnoofDRBs                         = 64
+	maxnoofSRBs                         = 8
+	maxnoofSCells                       = 32
+	maxnoofCandidateSpCells             = 64
+	maxProtocolExtensions               = 65535
+	maxnoofMDTPLMNs                     = 16
+	maxnoofBPLMNsNR                     = 12
+	maxnoofNIDsupported                 = 12
+	maxnoofCAGsupported                 = 12
+	maxnoofBPLMNs                       = 6
+	maxnoofUEIDs                        = 65536
+	maxnoofTNLAssociations              = 32
+	maxnoofExtendedBPLMNs               = 6
+	maxnoofCHOcells                     = 8
+	maxnoofQoSFlows                     = 64
+	maxnoofQoSParaSets                  = 8
+	maxnoofULUPTNLInformation           = 2
+	maxnoofDLUPTNLInformation           = 2
+	maxnoofAdditionalPDCPDuplicationTNL = 2
+	maxnoofRLCDuplicationState          = 3
+	maxnoofNrCellBands                  = 32
+	maxnoofSliceItems                   = 1024
+	maxnoofExtSliceItems                = 65535
+	maxnoofPRACHconfigs                 = 16
+	maxnoofPhysicalResourceBlocks       = 275
+	maxnoofSIBTypes                     = 32
+	maxnoofSITypes                      = 32
+	maxnoofBHRLCChannels                = 65536
+	maxnoofMappingEntries               = 67108864
+	maxnoofDSInfo                       = 64
+	maxnoofEgressLinks                  = 2
+	maxGNBDUID                          = 68719476735
+)
+
 const (
 	Criticality_PresentReject aper.Enumerated = 0
 	Criticality_PresentIgnore aper.Enumerated = 1
@@ -675,4 +987,424 @@ const (
 	ProtocolIEID_ConfiguredTACIndication                        = 425
 	ProtocolIEID_ExtendedGNBDUName                              = 426
 	ProtocolIEID_ExtendedGNBCUName                              = 427
+	ProtocolIEID_EstimatedArrivalProbability                    = 589
 )
+
+// names of the protocol IE ids, also used to tell unknown ids apart
+var protocolIEIDNames = map[aper.Integer]string{
+	ProtocolIEID_Cause:                                          "Cause",
+	ProtocolIEID_CellsFailedToBeActivatedList:                   "CellsFailedToBeActivatedList",
+	ProtocolIEID_CellsFailedToBeActivatedListItem:               "CellsFailedToBeActivatedListItem",
+	ProtocolIEID_CellsToBeActivatedList:                         "CellsToBeActivatedList",
+	ProtocolIEID_CellsToBeActivatedListItem:                     "CellsToBeActivatedListItem",
+	ProtocolIEID_CellsToBeDeactivatedList:                       "CellsToBeDeactivatedList",
+	ProtocolIEID_CellsToBeDeactivatedListItem:                   "CellsToBeDeactivatedListItem",
+	ProtocolIEID_CriticalityDiagnostics:                         "CriticalityDiagnostics",
+	ProtocolIEID_CUtoDURRCInformation:                           "CUtoDURRCInformation",
+	ProtocolIEID_DRBsFailedToBeModifiedItem:                     "DRBsFailedToBeModifiedItem",
+	ProtocolIEID_DRBsFailedToBeModifiedList:                     "DRBsFailedToBeModifiedList",
+	ProtocolIEID_DRBsFailedToBeSetupItem:                        "DRBsFailedToBeSetupItem",
+	ProtocolIEID_DRBsFailedToBeSetupList:                        "DRBsFailedToBeSetupList",
+	ProtocolIEID_DRBsFailedToBeSetupModItem:                     "DRBsFailedToBeSetupModItem",
+	ProtocolIEID_DRBsFailedToBeSetupModList:                     "DRBsFailedToBeSetupModList",
+	ProtocolIEID_DRBsModifiedConfItem:                           "DRBsModifiedConfItem",
+	ProtocolIEID_DRBsModifiedConfList:                           "DRBsModifiedConfList",
+	ProtocolIEID_DRBsModifiedItem:                               "DRBsModifiedItem",
+	ProtocolIEID_DRBsModifiedList:                               "DRBsModifiedList",
+	ProtocolIEID_DRBsRequiredToBeModifiedItem:                   "DRBsRequiredToBeModifiedItem",
+	ProtocolIEID_DRBsRequiredToBeModifiedList:                   "DRBsRequiredToBeModifiedList",
+	ProtocolIEID_DRBsRequiredToBeReleasedItem:                   "DRBsRequiredToBeReleasedItem",
+	ProtocolIEID_DRBsRequiredToBeReleasedList:                   "DRBsRequiredToBeReleasedList",
+	ProtocolIEID_DRBsSetupItem:                                  "DRBsSetupItem",
+	ProtocolIEID_DRBsSetupList:                                  "DRBsSetupList",
+	ProtocolIEID_DRBsSetupModItem:                               "DRBsSetupModItem",
+	ProtocolIEID_DRBsSetupModList:                               "DRBsSetupModList",
+	ProtocolIEID_DRBsToBeModifiedItem:                           "DRBsToBeModifiedItem",
+	ProtocolIEID_DRBsToBeModifiedList:                           "DRBsToBeModifiedList",
+	ProtocolIEID_DRBsToBeReleasedItem:                           "DRBsToBeReleasedItem",
+	ProtocolIEID_DRBsToBeReleasedList:                           "DRBsToBeReleasedList",
+	ProtocolIEID_DRBsToBeSetupItem:                              "DRBsToBeSetupItem",
+	ProtocolIEID_DRBsToBeSetupList:                              "DRBsToBeSetupList",
+	ProtocolIEID_DRBsToBeSetupModItem:                           "DRBsToBeSetupModItem",
+	ProtocolIEID_DRBsToBeSetupModList:                           "DRBsToBeSetupModList",
+	ProtocolIEID_DRXCycle:                                       "DRXCycle",
+	ProtocolIEID_DUtoCURRCInformation:                           "DUtoCURRCInformation",
+	ProtocolIEID_gNBCUUEF1APID:                                  "gNBCUUEF1APID",
+	ProtocolIEID_gNBDUUEF1APID:                                  "gNBDUUEF1APID",
+	ProtocolIEID_gNBDUID:                                        "gNBDUID",
+	ProtocolIEID_gNBDUServedCellsItem:                           "gNBDUServedCellsItem",
+	ProtocolIEID_gNBDUServedCellsList:                           "gNBDUServedCellsList",
+	ProtocolIEID_gNBDUName:                                      "gNBDUName",
+	ProtocolIEID_NRCellID:                                       "NRCellID",
+	ProtocolIEID_oldgNBDUUEF1APID:                               "oldgNBDUUEF1APID",
+	ProtocolIEID_ResetType:                                      "ResetType",
+	ProtocolIEID_ResourceCoordinationTransferContainer:          "ResourceCoordinationTransferContainer",
+	ProtocolIEID_RRCContainer:                                   "RRCContainer",
+	ProtocolIEID_SCellToBeRemovedItem:                           "SCellToBeRemovedItem",
+	ProtocolIEID_SCellToBeRemovedList:                           "SCellToBeRemovedList",
+	ProtocolIEID_SCellToBeSetupItem:                             "SCellToBeSetupItem",
+	ProtocolIEID_SCellToBeSetupList:                             "SCellToBeSetupList",
+	ProtocolIEID_SCellToBeSetupModItem:                          "SCellToBeSetupModItem",
+	ProtocolIEID_SCellToBeSetupModList:                          "SCellToBeSetupModList",
+	ProtocolIEID_ServedCellsToAddItem:                           "ServedCellsToAddItem",
+	ProtocolIEID_ServedCellsToAddList:                           "ServedCellsToAddList",
+	ProtocolIEID_ServedCellsToDeleteItem:                        "ServedCellsToDeleteItem",
+	ProtocolIEID_ServedCellsToDeleteList:                        "ServedCellsToDeleteList",
+	ProtocolIEID_ServedCellsToModifyItem:                        "ServedCellsToModifyItem",
+	ProtocolIEID_ServedCellsToModifyList:                        "ServedCellsToModifyList",
+	ProtocolIEID_SpCellID:                                       "SpCellID",
+	ProtocolIEID_SRBID:                                          "SRBID",
+	ProtocolIEID_SRBsFailedToBeSetupItem:                        "SRBsFailedToBeSetupItem",
+	ProtocolIEID_SRBsFailedToBeSetupList:                        "SRBsFailedToBeSetupList",
+	ProtocolIEID_SRBsFailedToBeSetupModItem:                     "SRBsFailedToBeSetupModItem",
+	ProtocolIEID_SRBsFailedToBeSetupModList:                     "SRBsFailedToBeSetupModList",
+	ProtocolIEID_SRBsRequiredToBeReleasedItem:                   "SRBsRequiredToBeReleasedItem",
+	ProtocolIEID_SRBsRequiredToBeReleasedList:                   "SRBsRequiredToBeReleasedList",
+	ProtocolIEID_SRBsToBeReleasedItem:                           "SRBsToBeReleasedItem",
+	ProtocolIEID_SRBsToBeReleasedList:                           "SRBsToBeReleasedList",
+	ProtocolIEID_SRBsToBeSetupItem:                              "SRBsToBeSetupItem",
+	ProtocolIEID_SRBsToBeSetupList:                              "SRBsToBeSetupList",
+	ProtocolIEID_SRBsToBeSetupModItem:                           "SRBsToBeSetupModItem",
+	ProtocolIEID_SRBsToBeSetupModList:                           "SRBsToBeSetupModList",
+	ProtocolIEID_TimeToWait:                                     "TimeToWait",
+	ProtocolIEID_TransactionID:                                  "TransactionID",
+	ProtocolIEID_TransmissionActionIndicator:                    "TransmissionActionIndicator",
+	ProtocolIEID_UEAssociatedLogicalF1ConnectionItem:            "UEAssociatedLogicalF1ConnectionItem",
+	ProtocolIEID_UEAssociatedLogicalF1ConnectionListResAck:      "UEAssociatedLogicalF1ConnectionListResAck",
+	ProtocolIEID_gNBCUName:                                      "gNBCUName",
+	ProtocolIEID_SCellFailedToSetupList:                         "SCellFailedToSetupList",
+	ProtocolIEID_SCellFailedToSetupItem:                         "SCellFailedToSetupItem",
+	ProtocolIEID_SCellFailedToSetupModList:                      "SCellFailedToSetupModList",
+	ProtocolIEID_SCellFailedToSetupModItem:                      "SCellFailedToSetupModItem",
+	ProtocolIEID_RRCReconfigurationCompleteIndicator:            "RRCReconfigurationCompleteIndicator",
+	ProtocolIEID_CellsStatusItem:                                "CellsStatusItem",
+	ProtocolIEID_CellsStatusList:                                "CellsStatusList",
+	ProtocolIEID_CandidateSpCellList:                            "CandidateSpCellList",
+	ProtocolIEID_CandidateSpCellItem:                            "CandidateSpCellItem",
+	ProtocolIEID_PotentialSpCellList:                            "PotentialSpCellList",
+	ProtocolIEID_PotentialSpCellItem:                            "PotentialSpCellItem",
+	ProtocolIEID_FullConfiguration:                              "FullConfiguration",
+	ProtocolIEID_CRNTI:                                          "CRNTI",
+	ProtocolIEID_SpCellULConfigured:                             "SpCellULConfigured",
+	ProtocolIEID_InactivityMonitoringRequest:                    "InactivityMonitoringRequest",
+	ProtocolIEID_InactivityMonitoringResponse:                   "InactivityMonitoringResponse",
+	ProtocolIEID_DRBActivityItem:                                "DRBActivityItem",
+	ProtocolIEID_DRBActivityList:                                "DRBActivityList",
+	ProtocolIEID_EUTRANRCellResourceCoordinationReqContainer:    "EUTRANRCellResourceCoordinationReqContainer",
+	ProtocolIEID_EUTRANRCellResourceCoordinationReqAckContainer: "EUTRANRCellResourceCoordinationReqAckContainer",
+	ProtocolIEID_ProtectedEUTRAResourcesList:                    "ProtectedEUTRAResourcesList",
+	ProtocolIEID_RequestType:                                    "RequestType",
+	ProtocolIEID_ServCellIndex:                                  "ServCellIndex",
+	ProtocolIEID_RATFrequencyPriorityInformation:                "RATFrequencyPriorityInformation",
+	ProtocolIEID_ExecuteDuplication:                             "ExecuteDuplication",
+	ProtocolIEID_NRCGI:                                          "NRCGI",
+	ProtocolIEID_PagingCellItem:                                 "PagingCellItem",
+	ProtocolIEID_PagingCellList:                                 "PagingCellList",
+	ProtocolIEID_PagingDRX:                                      "PagingDRX",
+	ProtocolIEID_PagingPriority:                                 "PagingPriority",
+	ProtocolIEID_SITypeList:                                     "SITypeList",
+	ProtocolIEID_UEIdentityIndexValue:                           "UEIdentityIndexValue",
+	ProtocolIEID_gNBCUSystemInformation:                         "gNBCUSystemInformation",
+	ProtocolIEID_HandoverPreparationInformation:                 "HandoverPreparationInformation",
+	ProtocolIEID_GNBCUTNLAssociationToAddItem:                   "GNBCUTNLAssociationToAddItem",
+	ProtocolIEID_GNBCUTNLAssociationToAddList:                   "GNBCUTNLAssociationToAddList",
+	ProtocolIEID_GNBCUTNLAssociationToRemoveItem:                "GNBCUTNLAssociationToRemoveItem",
+	ProtocolIEID_GNBCUTNLAssociationToRemoveList:                "GNBCUTNLAssociationToRemoveList",
+	ProtocolIEID_GNBCUTNLAssociationToUpdateItem:                "GNBCUTNLAssociationToUpdateItem",
+	ProtocolIEID_GNBCUTNLAssociationToUpdateList:                "GNBCUTNLAssociationToUpdateList",
+	ProtocolIEID_MaskedIMEISV:                                   "MaskedIMEISV",
+	ProtocolIEID_PagingIdentity:                                 "PagingIdentity",
+	ProtocolIEID_DUtoCURRCContainer:                             "DUtoCURRCContainer",
+	ProtocolIEID_CellsToBeBarredList:                            "CellsToBeBarredList",
+	ProtocolIEID_CellsToBeBarredItem:                            "CellsToBeBarredItem",
+	ProtocolIEID_TAISliceSupportList:                            "TAISliceSupportList",
+	ProtocolIEID_GNBCUTNLAssociationSetupList:                   "GNBCUTNLAssociationSetupList",
+	ProtocolIEID_GNBCUTNLAssociationSetupItem:                   "GNBCUTNLAssociationSetupItem",
+	ProtocolIEID_GNBCUTNLAssociationFailedToSetupList:           "GNBCUTNLAssociationFailedToSetupList",
+	ProtocolIEID_GNBCUTNLAssociationFailedToSetupItem:           "GNBCUTNLAssociationFailedToSetupItem",
+	ProtocolIEID_DRBNotifyItem:                                  "DRBNotifyItem",
+	ProtocolIEID_DRBNotifyList:                                  "DRBNotifyList",
+	ProtocolIEID_NotificationControl:                            "NotificationControl",
+	ProtocolIEID_RANAC:                                          "RANAC",
+	ProtocolIEID_PWSSystemInformation:                           "PWSSystemInformation",
+	ProtocolIEID_RepetitionPeriod:                               "RepetitionPeriod",
+	ProtocolIEID_NumberOfBroadcastRequest:                       "NumberOfBroadcastRequest",
+	ProtocolIEID_CellsToBeBroadcastList:                         "CellsToBeBroadcastList",
+	ProtocolIEID_CellsToBeBroadcastItem:                         "CellsToBeBroadcastItem",
+	ProtocolIEID_CellsBroadcastCompletedList:                    "CellsBroadcastCompletedList",
+	ProtocolIEID_CellsBroadcastCompletedItem:                    "CellsBroadcastCompletedItem",
+	ProtocolIEID_BroadcastToBeCancelledList:                     "BroadcastToBeCancelledList",
+	ProtocolIEID_BroadcastToBeCancelledItem:                     "BroadcastToBeCancelledItem",
+	ProtocolIEID_CellsBroadcastCancelledList:                    "CellsBroadcastCancelledList",
+	ProtocolIEID_CellsBroadcastCancelledItem:                    "CellsBroadcastCancelledItem",
+	ProtocolIEID_NRCGIListForRestartList:                        "NRCGIListForRestartList",
+	ProtocolIEID_NRCGIListForRestartItem:                        "NRCGIListForRestartItem",
+	ProtocolIEID_PWSFailedNRCGIList:                             "PWSFailedNRCGIList",
+	ProtocolIEID_PWSFailedNRCGIItem:                             "PWSFailedNRCGIItem",
+	ProtocolIEID_ConfirmedUEID:                                  "ConfirmedUEID",
+	ProtocolIEID_CancelAllWarningMessagesIndicator:              "CancelAllWarningMessagesIndicator",
+	ProtocolIEID_GNBDUUEAMBRUL:                                  "GNBDUUEAMBRUL",
+	ProtocolIEID_DRXConfigurationIndicator:                      "DRXConfigurationIndicator",
+	ProtocolIEID_RLCStatus:                                      "RLCStatus",
+	ProtocolIEID_DLPDCPSNLength:                                 "DLPDCPSNLength",
+	ProtocolIEID_GNBDUConfigurationQuery:                        "GNBDUConfigurationQuery",
+	ProtocolIEID_MeasurementTimingConfiguration:                 "MeasurementTimingConfiguration",
+	ProtocolIEID_DRBInformation:                                 "DRBInformation",
+	ProtocolIEID_ServingPLMN:                                    "ServingPLMN",
+	ProtocolIEID_ProtectedEUTRAResourcesItem:                    "ProtectedEUTRAResourcesItem",
+	ProtocolIEID_GNBCURRCVersion:                                "GNBCURRCVersion",
+	ProtocolIEID_GNBDURRCVersion:                                "GNBDURRCVersion",
+	ProtocolIEID_GNBDUOverloadInformation:                       "GNBDUOverloadInformation",
+	ProtocolIEID_CellGroupConfig:                                "CellGroupConfig",
+	ProtocolIEID_RLCFailureIndication:                           "RLCFailureIndication",
+	ProtocolIEID_UplinkTxDirectCurrentListInformation:           "UplinkTxDirectCurrentListInformation",
+	ProtocolIEID_DCBasedDuplicationConfigured:                   "DCBasedDuplicationConfigured",
+	ProtocolIEID_DCBasedDuplicationActivation:                   "DCBasedDuplicationActivation",
+	ProtocolIEID_SULAccessIndication:                            "SULAccessIndication",
+	ProtocolIEID_AvailablePLMNList:                              "AvailablePLMNList",
+	ProtocolIEID_PDUSessionID:                                   "PDUSessionID",
+	ProtocolIEID_ULPDUSessionAggregateMaximumBitRate:            "ULPDUSessionAggregateMaximumBitRate",
+	ProtocolIEID_ServingCellMO:                                  "ServingCellMO",
+	ProtocolIEID_QoSFlowMappingIndication:                       "QoSFlowMappingIndication",
+	ProtocolIEID_RRCDeliveryStatusRequest:                       "RRCDeliveryStatusRequest",
+	ProtocolIEID_RRCDeliveryStatus:                              "RRCDeliveryStatus",
+	ProtocolIEID_BearerTypeChange:                               "BearerTypeChange",
+	ProtocolIEID_RLCMode:                                        "RLCMode",
+	ProtocolIEID_DuplicationActivation:                          "DuplicationActivation",
+	ProtocolIEID_DedicatedSIDeliveryNeededUEList:                "DedicatedSIDeliveryNeededUEList",
+	ProtocolIEID_DedicatedSIDeliveryNeededUEItem:                "DedicatedSIDeliveryNeededUEItem",
+	ProtocolIEID_DRXLongCycleStartOffset:                        "DRXLongCycleStartOffset",
+	ProtocolIEID_ULPDCPSNLength:                                 "ULPDCPSNLength",
+	ProtocolIEID_SelectedBandCombinationIndex:                   "SelectedBandCombinationIndex",
+	ProtocolIEID_SelectedFeatureSetEntryIndex:                   "SelectedFeatureSetEntryIndex",
+	ProtocolIEID_ResourceCoordinationTransferInformation:        "ResourceCoordinationTransferInformation",
+	ProtocolIEID_ExtendedServedPLMNsList:                        "ExtendedServedPLMNsList",
+	ProtocolIEID_ExtendedAvailablePLMNList:                      "ExtendedAvailablePLMNList",
+	ProtocolIEID_AssociatedSCellList:                            "AssociatedSCellList",
+	ProtocolIEID_LatestRRCVersionEnhanced:                       "LatestRRCVersionEnhanced",
+	ProtocolIEID_AssociatedSCellItem:                            "AssociatedSCellItem",
+	ProtocolIEID_CellDirection:                                  "CellDirection",
+	ProtocolIEID_SRBsSetupList:                                  "SRBsSetupList",
+	ProtocolIEID_SRBsSetupItem:                                  "SRBsSetupItem",
+	ProtocolIEID_SRBsSetupModList:                               "SRBsSetupModList",
+	ProtocolIEID_SRBsSetupModItem:                               "SRBsSetupModItem",
+	ProtocolIEID_SRBsModifiedList:                               "SRBsModifiedList",
+	ProtocolIEID_SRBsModifiedItem:                               "SRBsModifiedItem",
+	ProtocolIEID_PhInfoSCG:                                      "PhInfoSCG",
+	ProtocolIEID_RequestedBandCombinationIndex:                  "RequestedBandCombinationIndex",
+	ProtocolIEID_RequestedFeatureSetEntryIndex:                  "RequestedFeatureSetEntryIndex",
+	ProtocolIEID_RequestedPMaxFR2:                               "RequestedPMaxFR2",
+	ProtocolIEID_DRXConfig:                                      "DRXConfig",
+	ProtocolIEID_IgnoreResourceCoordinationContainer:            "IgnoreResourceCoordinationContainer",
+	ProtocolIEID_UEAssistanceInformation:                        "UEAssistanceInformation",
+	ProtocolIEID_NeedForGap:                                     "NeedForGap",
+	ProtocolIEID_PagingOrigin:                                   "PagingOrigin",
+	ProtocolIEID_NewGNBCUUEF1APID:                               "NewGNBCUUEF1APID",
+	ProtocolIEID_RedirectedRRCMessage:                           "RedirectedRRCMessage",
+	ProtocolIEID_NewGNBDUUEF1APID:                               "NewGNBDUUEF1APID",
+	ProtocolIEID_NotificationInformation:                        "NotificationInformation",
+	ProtocolIEID_PLMNAssistanceInfoForNetShar:                   "PLMNAssistanceInfoForNetShar",
+	ProtocolIEID_UEContextNotRetrievable:                        "UEContextNotRetrievable",
+	ProtocolIEID_BPLMNIDInfoList:                                "BPLMNIDInfoList",
+	ProtocolIEID_SelectedPLMNID:                                 "SelectedPLMNID",
+	ProtocolIEID_UACAssistanceInfo:                              "UACAssistanceInfo",
+	ProtocolIEID_RANUEID:                                        "RANUEID",
+	ProtocolIEID_GNBDUTNLAssociationToRemoveItem:                "GNBDUTNLAssociationToRemoveItem",
+	ProtocolIEID_GNBDUTNLAssociationToRemoveList:                "GNBDUTNLAssociationToRemoveList",
+	ProtocolIEID_TNLAssociationTransportLayerAddressgNBDU:       "TNLAssociationTransportLayerAddressgNBDU",
+	ProtocolIEID_PortNumber:                                     "PortNumber",
+	ProtocolIEID_AdditionalSIBMessageList:                       "AdditionalSIBMessageList",
+	ProtocolIEID_CellType:                                       "CellType",
+	ProtocolIEID_IgnorePRACHConfiguration:                       "IgnorePRACHConfiguration",
+	ProtocolIEID_CGConfig:                                       "CGConfig",
+	ProtocolIEID_PDCCHBlindDetectionSCG:                         "PDCCHBlindDetectionSCG",
+	ProtocolIEID_RequestedPDCCHBlindDetectionSCG:                "RequestedPDCCHBlindDetectionSCG",
+	ProtocolIEID_PhInfoMCG:                                      "PhInfoMCG",
+	ProtocolIEID_MeasGapSharingConfig:                           "MeasGapSharingConfig",
+	ProtocolIEID_SystemInformationAreaID:                        "SystemInformationAreaID",
+	ProtocolIEID_AreaScope:                                      "AreaScope",
+	ProtocolIEID_RRCContainerRRCSetupComplete:                   "RRCContainerRRCSetupComplete",
+	ProtocolIEID_TraceActivation:                                "TraceActivation",
+	ProtocolIEID_TraceID:                                        "TraceID",
+	ProtocolIEID_NeighbourCellInformationList:                   "NeighbourCellInformationList",
+	ProtocolIEID_SymbolAllocInSlot:                              "SymbolAllocInSlot",
+	ProtocolIEID_NumDLULSymbols:                                 "NumDLULSymbols",
+	ProtocolIEID_AdditionalRRMPriorityIndex:                     "AdditionalRRMPriorityIndex",
+	ProtocolIEID_DUCURadioInformationType:                       "DUCURadioInformationType",
+	ProtocolIEID_CUDURadioInformationType:                       "CUDURadioInformationType",
+	ProtocolIEID_AggressorGNBDUSetID:                            "AggressorGNBDUSetID",
+	ProtocolIEID_VictimGNBDUSetID:                               "VictimGNBDUSetID",
+	ProtocolIEID_LowerLayerPresenceStatusChange:                 "LowerLayerPresenceStatusChange",
+	ProtocolIEID_TransportLayerAddressInfo:                      "TransportLayerAddressInfo",
+	ProtocolIEID_NeighbourCellInformationItem:                   "NeighbourCellInformationItem",
+	ProtocolIEID_IntendedTDDDLULConfig:                          "IntendedTDDDLULConfig",
+	ProtocolIEID_QosMonitoringRequest:                           "QosMonitoringRequest",
+	ProtocolIEID_BHChannelsToBeSetupList:                        "BHChannelsToBeSetupList",
+	ProtocolIEID_BHChannelsToBeSetupItem:                        "BHChannelsToBeSetupItem",
+	ProtocolIEID_BHChannelsSetupList:                            "BHChannelsSetupList",
+	ProtocolIEID_BHChannelsSetupItem:                            "BHChannelsSetupItem",
+	ProtocolIEID_BHChannelsToBeModifiedItem:                     "BHChannelsToBeModifiedItem",
+	ProtocolIEID_BHChannelsToBeModifiedList:                     "BHChannelsToBeModifiedList",
+	ProtocolIEID_BHChannelsToBeReleasedItem:                     "BHChannelsToBeReleasedItem",
+	ProtocolIEID_BHChannelsToBeReleasedList:                     "BHChannelsToBeReleasedList",
+	ProtocolIEID_BHChannelsToBeSetupModItem:                     "BHChannelsToBeSetupModItem",
+	ProtocolIEID_BHChannelsToBeSetupModList:                     "BHChannelsToBeSetupModList",
+	ProtocolIEID_BHChannelsFailedToBeModifiedItem:               "BHChannelsFailedToBeModifiedItem",
+	ProtocolIEID_BHChannelsFailedToBeModifiedList:               "BHChannelsFailedToBeModifiedList",
+	ProtocolIEID_BHChannelsFailedToBeSetupModItem:               "BHChannelsFailedToBeSetupModItem",
+	ProtocolIEID_BHChannelsFailedToBeSetupModList:               "BHChannelsFailedToBeSetupModList",
+	ProtocolIEID_BHChannelsModifiedItem:                         "BHChannelsModifiedItem",
+	ProtocolIEID_BHChannelsModifiedList:                         "BHChannelsModifiedList",
+	ProtocolIEID_BHChannelsSetupModItem:                         "BHChannelsSetupModItem",
+	ProtocolIEID_BHChannelsSetupModList:                         "BHChannelsSetupModList",
+	ProtocolIEID_BHChannelsRequiredToBeReleasedItem:             "BHChannelsRequiredToBeReleasedItem",
+	ProtocolIEID_BHChannelsRequiredToBeReleasedList:             "BHChannelsRequiredToBeReleasedList",
+	ProtocolIEID_BHChannelsFailedToBeSetupItem:                  "BHChannelsFailedToBeSetupItem",
+	ProtocolIEID_BHChannelsFailedToBeSetupList:                  "BHChannelsFailedToBeSetupList",
+	ProtocolIEID_BHInfo:                                         "BHInfo",
+	ProtocolIEID_BAPAddress:                                     "BAPAddress",
+	ProtocolIEID_ConfiguredBAPAddress:                           "ConfiguredBAPAddress",
+	ProtocolIEID_BHRoutingInformationAddedList:                  "BHRoutingInformationAddedList",
+	ProtocolIEID_BHRoutingInformationAddedListItem:              "BHRoutingInformationAddedListItem",
+	ProtocolIEID_BHRoutingInformationRemovedList:                "BHRoutingInformationRemovedList",
+	ProtocolIEID_BHRoutingInformationRemovedListItem:            "BHRoutingInformationRemovedListItem",
+	ProtocolIEID_ULBHNonUPTrafficMapping:                        "ULBHNonUPTrafficMapping",
+	ProtocolIEID_ActivatedCellsToBeUpdatedList:                  "ActivatedCellsToBeUpdatedList",
+	ProtocolIEID_ChildNodesList:                                 "ChildNodesList",
+	ProtocolIEID_IABInfoIABDU:                                   "IABInfoIABDU",
+	ProtocolIEID_IABInfoIABDonorCU:                              "IABInfoIABDonorCU",
+	ProtocolIEID_IABTNLAddressesToRemoveList:                    "IABTNLAddressesToRemoveList",
+	ProtocolIEID_IABTNLAddressesToRemoveItem:                    "IABTNLAddressesToRemoveItem",
+	ProtocolIEID_IABAllocatedTNLAddressList:                     "IABAllocatedTNLAddressList",
+	ProtocolIEID_IABAllocatedTNLAddressItem:                     "IABAllocatedTNLAddressItem",
+	ProtocolIEID_IABIPv6RequestType:                             "IABIPv6RequestType",
+	ProtocolIEID_IABv4AddressesRequested:                        "IABv4AddressesRequested",
+	ProtocolIEID_IABBarred:                                      "IABBarred",
+	ProtocolIEID_TrafficMappingInformation:                      "TrafficMappingInformation",
+	ProtocolIEID_ULUPTNLInformationToUpdateList:                 "ULUPTNLInformationToUpdateList",
+	ProtocolIEID_ULUPTNLInformationToUpdateListItem:             "ULUPTNLInformationToUpdateListItem",
+	ProtocolIEID_ULUPTNLAddressToUpdateList:                     "ULUPTNLAddressToUpdateList",
+	ProtocolIEID_ULUPTNLAddressToUpdateListItem:                 "ULUPTNLAddressToUpdateListItem",
+	ProtocolIEID_DLUPTNLAddressToUpdateList:                     "DLUPTNLAddressToUpdateList",
+	ProtocolIEID_DLUPTNLAddressToUpdateListItem:                 "DLUPTNLAddressToUpdateListItem",
+	ProtocolIEID_NRV2XServicesAuthorized:                        "NRV2XServicesAuthorized",
+	ProtocolIEID_LTEV2XServicesAuthorized:                       "LTEV2XServicesAuthorized",
+	ProtocolIEID_NRUESidelinkAggregateMaximumBitrate:            "NRUESidelinkAggregateMaximumBitrate",
+	ProtocolIEID_LTEUESidelinkAggregateMaximumBitrate:           "LTEUESidelinkAggregateMaximumBitrate",
+	ProtocolIEID_SIB12Message:                                   "SIB12Message",
+	ProtocolIEID_SIB13Message:                                   "SIB13Message",
+	ProtocolIEID_SIB14Message:                                   "SIB14Message",
+	ProtocolIEID_SLDRBsFailedToBeModifiedItem:                   "SLDRBsFailedToBeModifiedItem",
+	ProtocolIEID_SLDRBsFailedToBeModifiedList:                   "SLDRBsFailedToBeModifiedList",
+	ProtocolIEID_SLDRBsFailedToBeSetupItem:                      "SLDRBsFailedToBeSetupItem",
+	ProtocolIEID_SLDRBsFailedToBeSetupList:                      "SLDRBsFailedToBeSetupList",
+	ProtocolIEID_SLDRBsModifiedItem:                             "SLDRBsModifiedItem",
+	ProtocolIEID_SLDRBsModifiedList:                             "SLDRBsModifiedList",
+	ProtocolIEID_SLDRBsRequiredToBeModifiedItem:                 "SLDRBsRequiredToBeModifiedItem",
+	ProtocolIEID_SLDRBsRequiredToBeModifiedList:                 "SLDRBsRequiredToBeModifiedList",
+	ProtocolIEID_SLDRBsRequiredToBeReleasedItem:                 "SLDRBsRequiredToBeReleasedItem",
+	ProtocolIEID_SLDRBsRequiredToBeReleasedList:                 "SLDRBsRequiredToBeReleasedList",
+	ProtocolIEID_SLDRBsSetupItem:                                "SLDRBsSetupItem",
+	ProtocolIEID_SLDRBsSetupList:                                "SLDRBsSetupList",
+	ProtocolIEID_SLDRBsToBeModifiedItem:                         "SLDRBsToBeModifiedItem",
+	ProtocolIEID_SLDRBsToBeModifiedList:                         "SLDRBsToBeModifiedList",
+	ProtocolIEID_SLDRBsToBeReleasedItem:                         "SLDRBsToBeReleasedItem",
+	ProtocolIEID_SLDRBsToBeReleasedList:                         "SLDRBsToBeReleasedList",
+	ProtocolIEID_SLDRBsToBeSetupItem:                            "SLDRBsToBeSetupItem",
+	ProtocolIEID_SLDRBsToBeSetupList:                            "SLDRBsToBeSetupList",
+	ProtocolIEID_SLDRBsToBeSetupModItem:                         "SLDRBsToBeSetupModItem",
+	ProtocolIEID_SLDRBsToBeSetupModList:                         "SLDRBsToBeSetupModList",
+	ProtocolIEID_SLDRBsSetupModList:                             "SLDRBsSetupModList",
+	ProtocolIEID_SLDRBsFailedToBeSetupModList:                   "SLDRBsFailedToBeSetupModList",
+	ProtocolIEID_SLDRBsSetupModItem:                             "SLDRBsSetupModItem",
+	ProtocolIEID_SLDRBsFailedToBeSetupModItem:                   "SLDRBsFailedToBeSetupModItem",
+	ProtocolIEID_SLDRBsModifiedConfList:                         "SLDRBsModifiedConfList",
+	ProtocolIEID_SLDRBsModifiedConfItem:                         "SLDRBsModifiedConfItem",
+	ProtocolIEID_UEAssistanceInformationEUTRA:                   "UEAssistanceInformationEUTRA",
+	ProtocolIEID_PC5LinkAMBR:                                    "PC5LinkAMBR",
+	ProtocolIEID_SLPHYMACRLCConfig:                              "SLPHYMACRLCConfig",
+	ProtocolIEID_SLConfigDedicatedEUTRA:                         "SLConfigDedicatedEUTRA",
+	ProtocolIEID_AlternativeQoSParaSetList:                      "AlternativeQoSParaSetList",
+	ProtocolIEID_CurrentQoSParaSetIndex:                         "CurrentQoSParaSetIndex",
+	ProtocolIEID_gNBCUMeasurementID:                             "gNBCUMeasurementID",
+	ProtocolIEID_gNBDUMeasurementID:                             "gNBDUMeasurementID",
+	ProtocolIEID_RegistrationRequest:                            "RegistrationRequest",
+	ProtocolIEID_ReportCharacteristics:                          "ReportCharacteristics",
+	ProtocolIEID_CellToReportList:                               "CellToReportList",
+	ProtocolIEID_CellMeasurementResultList:                      "CellMeasurementResultList",
+	ProtocolIEID_HardwareLoadIndicator:                          "HardwareLoadIndicator",
+	ProtocolIEID_ReportingPeriodicity:                           "ReportingPeriodicity",
+	ProtocolIEID_TNLCapacityIndicator:                           "TNLCapacityIndicator",
+	ProtocolIEID_CarrierList:                                    "CarrierList",
+	ProtocolIEID_ULCarrierList:                                  "ULCarrierList",
+	ProtocolIEID_FrequencyShift7p5khz:                           "FrequencyShift7p5khz",
+	ProtocolIEID_SSBPositionsInBurst:                            "SSBPositionsInBurst",
+	ProtocolIEID_NRPRACHConfig:                                  "NRPRACHConfig",
+	ProtocolIEID_RACHReportInformationList:                      "RACHReportInformationList",
+	ProtocolIEID_RLFReportInformationList:                       "RLFReportInformationList",
+	ProtocolIEID_TDDULDLConfigCommonNR:                          "TDDULDLConfigCommonNR",
+	ProtocolIEID_CNPacketDelayBudgetDownlink:                    "CNPacketDelayBudgetDownlink",
+	ProtocolIEID_ExtendedPacketDelayBudget:                      "ExtendedPacketDelayBudget",
+	ProtocolIEID_TSCTrafficCharacteristics:                      "TSCTrafficCharacteristics",
+	ProtocolIEID_ReportingRequestType:                           "ReportingRequestType",
+	ProtocolIEID_TimeReferenceInformation:                       "TimeReferenceInformation",
+	ProtocolIEID_CNPacketDelayBudgetUplink:                      "CNPacketDelayBudgetUplink",
+	ProtocolIEID_AdditionalPDCPDuplicationTNLList:               "AdditionalPDCPDuplicationTNLList",
+	ProtocolIEID_RLCDuplicationInformation:                      "RLCDuplicationInformation",
+	ProtocolIEID_AdditionalDuplicationIndication:                "AdditionalDuplicationIndication",
+	ProtocolIEID_ConditionalInterDUMobilityInformation:          "ConditionalInterDUMobilityInformation",
+	ProtocolIEID_ConditionalIntraDUMobilityInformation:          "ConditionalIntraDUMobilityInformation",
+	ProtocolIEID_TargetCellsToCancel:                            "TargetCellsToCancel",
+	ProtocolIEID_RequestedTargetCellGlobalID:                    "RequestedTargetCellGlobalID",
+	ProtocolIEID_ManagementBasedMDTPLMNList:                     "ManagementBasedMDTPLMNList",
+	ProtocolIEID_TraceCollectionEntityIPAddress:                 "TraceCollectionEntityIPAddress",
+	ProtocolIEID_PrivacyIndicator:                               "PrivacyIndicator",
+	ProtocolIEID_TraceCollectionEntityURI:                       "TraceCollectionEntityURI",
+	ProtocolIEID_MDTConfiguration:                               "MDTConfiguration",
+	ProtocolIEID_ServingNID:                                     "ServingNID",
+	ProtocolIEID_NPNBroadcastInformation:                        "NPNBroadcastInformation",
+	ProtocolIEID_NPNSupportInfo:                                 "NPNSupportInfo",
+	ProtocolIEID_NID:                                            "NID",
+	ProtocolIEID_AvailableSNPNIDList:                            "AvailableSNPNIDList",
+	ProtocolIEID_SIB10Message:                                   "SIB10Message",
+	ProtocolIEID_DLCarrierList:                                  "DLCarrierList",
+	ProtocolIEID_ExtendedTAISliceSupportList:                    "ExtendedTAISliceSupportList",
+	ProtocolIEID_RequestedSRSTransmissionCharacteristics:        "RequestedSRSTransmissionCharacteristics",
+	ProtocolIEID_PosAssistanceInformation:                       "PosAssistanceInformation",
+	ProtocolIEID_PosBroadcast:                                   "PosBroadcast",
+	ProtocolIEID_RoutingID:                                      "RoutingID",
+	ProtocolIEID_PosAssistanceInformationFailureList:            "PosAssistanceInformationFailureList",
+	ProtocolIEID_PosMeasurementQuantities:                       "PosMeasurementQuantities",
+	ProtocolIEID_PosMeasurementResultList:                       "PosMeasurementResultList",
+	ProtocolIEID_TRPInformationTypeListTRPReq:                   "TRPInformationTypeListTRPReq",
+	ProtocolIEID_TRPInformationTypeItem:                         "TRPInformationTypeItem",
+	ProtocolIEID_TRPInformationListTRPResp:                      "TRPInformationListTRPResp",
+	ProtocolIEID_TRPInformationItem:                             "TRPInformationItem",
+	ProtocolIEID_LMFMeasurementID:                               "LMFMeasurementID",
+	ProtocolIEID_SRSType:                                        "SRSType",
+	ProtocolIEID_ActivationTime:                                 "ActivationTime",
+	ProtocolIEID_AbortTransmission:                              "AbortTransmission",
+	ProtocolIEID_PositioningBroadcastCells:                      "PositioningBroadcastCells",
+	ProtocolIEID_SRSConfiguration:                               "SRSConfiguration",
+	ProtocolIEID_PosReportCharacteristics:                       "PosReportCharacteristics",
+	ProtocolIEID_PosMeasurementPeriodicity:                      "PosMeasurementPeriodicity",
+	ProtocolIEID_TRPList:                                        "TRPList",
+	ProtocolIEID_RANMeasurementID:                               "RANMeasurementID",
+	ProtocolIEID_LMFUEMeasurementID:                             "LMFUEMeasurementID",
+	ProtocolIEID_RANUEMeasurementID:                             "RANUEMeasurementID",
+	ProtocolIEID_ECIDMeasurementQuantities:                      "ECIDMeasurementQuantities",
+	ProtocolIEID_ECIDMeasurementQuantitiesItem:                  "ECIDMeasurementQuantitiesItem",
+	ProtocolIEID_ECIDMeasurementPeriodicity:                     "ECIDMeasurementPeriodicity",
+	ProtocolIEID_ECIDMeasurementResult:                          "ECIDMeasurementResult",
+	ProtocolIEID_CellPortionID:                                  "CellPortionID",
+	ProtocolIEID_SFNInitialisationTime:                          "SFNInitialisationTime",
+	ProtocolIEID_SystemFrameNumber:                              "SystemFrameNumber",
+	ProtocolIEID_SlotNumber:                                     "SlotNumber",
+	ProtocolIEID_TRPMeasurementRequestList:                      "TRPMeasurementRequestList",
+	ProtocolIEID_MeasurementBeamInfoRequest:                     "MeasurementBeamInfoRequest",
+	ProtocolIEID_ECIDReportCharacteristics:                      "ECIDReportCharacteristics",
+	ProtocolIEID_ConfiguredTACIndication:                        "ConfiguredTACIndication",
+	ProtocolIEID_ExtendedGNBDUName:                              "ExtendedGNBDUName",
+	ProtocolIEID_ExtendedGNBCUName:                              "ExtendedGNBCUName",
+	ProtocolIEID_EstimatedArrivalProbability:                    "EstimatedArrivalProbability",
+}
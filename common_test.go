@@ -0,0 +1,90 @@
+package ies
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestChoiceExtensionRaw(t *testing.T) {
+	in := NRModeInfo{
+		Choice:          NRModeInfoPresentChoiceExtension,
+		ChoiceExtension: &RawIE{Id: 7777, Criticality: Criticality_PresentIgnore, Value: []byte{0xab, 0xcd}},
+	}
+	want := []byte{
+		0x80,                   // choice-extension
+		0x1e, 0x61, 0x40, 0x02, // id 7777, ignore, length
+		0xab, 0xcd,
+	}
+	if got := encodeIE(t, &in); !bytes.Equal(got, want) {
+		t.Fatalf("encoded %x, want %x", got, want)
+	}
+	var out NRModeInfo
+	if err := DecodeValue(want, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out, in) {
+		t.Fatalf("decoded %+v, want %+v", out, in)
+	}
+	if err := out.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	// an unknown id in a typed choice-extension
+	var qos QoSInformation
+	if err := DecodeValue(want, &qos); err != nil {
+		t.Fatal(err)
+	}
+	if qos.Choice != QoSInformationPresentDRBInformation || qos.DRBInformation != nil ||
+		!reflect.DeepEqual(qos.ChoiceExtension, in.ChoiceExtension) {
+		t.Fatalf("decoded %+v", qos)
+	}
+	if got := encodeIE(t, &qos); !bytes.Equal(got, want) {
+		t.Fatalf("encoded %x, want %x", got, want)
+	}
+}
+
+func TestSingleContainerListUnknownItems(t *testing.T) {
+	in := Notify{
+		GNBCUUEF1APID: GNBCUUEF1APID{Value: 1},
+		GNBDUUEF1APID: GNBDUUEF1APID{Value: 2},
+		DRBNotifyList: []DRBNotifyItem{{
+			DRBID:             DRBID{Value: 5},
+			NotificationCause: NotificationCause{Value: NotificationCauseFulfilled},
+		}},
+		DRBNotifyListUnknownItems: []RawIE{{Id: 9, Criticality: Criticality_PresentIgnore, Value: []byte{0xff}}},
+	}
+	want := []byte{
+		0x00, 0x13, 0x40, 0x1f, // initiatingMessage, notify, ignore, length
+		0x00, 0x00, 0x03, // extension bit, 3 IEs
+		0x00, 0x28, 0x00, 0x02, 0x00, 0x01, // gNB-CU-UE-F1AP-ID 1
+		0x00, 0x29, 0x00, 0x02, 0x00, 0x02, // gNB-DU-UE-F1AP-ID 2
+		0x00, 0x89, 0x00, 0x0c, 0x04, // DRB-Notify-List of 2 items
+		0x00, 0x09, 0x40, 0x01, 0xff, // unknown item
+		0x00, 0x88, 0x00, 0x02, 0x04, 0x00, // DRB 5 fulfilled
+	}
+	checkEncoding(t, &in, want)
+	var out Notify
+	if err, _ := out.Decode(want[4:]); err != nil {
+		t.Fatal(err)
+	}
+	out.DRBNotifyList[0].Extensions = in.DRBNotifyList[0].Extensions
+	if !reflect.DeepEqual(out, in) {
+		t.Fatalf("decoded %+v, want %+v", out, in)
+	}
+}
+
+func TestUnknownIEsReencoded(t *testing.T) {
+	m := &Notify{
+		GNBCUUEF1APID: GNBCUUEF1APID{Value: 1},
+		GNBDUUEF1APID: GNBDUUEF1APID{Value: 2},
+		DRBNotifyList: []DRBNotifyItem{{
+			DRBID:             DRBID{Value: 5},
+			NotificationCause: NotificationCause{Value: NotificationCauseFulfilled},
+		}},
+		UnknownIEs: []RawIE{{Id: 9999, Criticality: Criticality_PresentIgnore, Value: []byte{0x01}, Position: 1}},
+	}
+	want := append([]byte{0x00, 0x13, 0x40, 0x1f}, // initiatingMessage, notify, ignore, length
+		messageValue(notifyCU, unknownIE(0x40), notifyDU, notifyDRBs)...)
+	checkMessage(t, m, new(Notify), want)
+}